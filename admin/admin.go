@@ -11,6 +11,8 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 )
 
 type Client struct {
@@ -59,6 +61,17 @@ func New(httpClient *http.Client) (client *Client, err error) {
 	return
 }
 
+// newOperationRequest creates the request of a service method, the request context is named with the operation,
+// prefixed with admin., see models.WithOperation. A nil context is left to newRequest, which rejects it.
+func (c *Client) newOperationRequest(ctx context.Context, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+
+	if ctx != nil {
+		ctx = model.WithOperation(ctx, "admin."+operation)
+	}
+
+	return c.newRequest(ctx, method, apiEndpoint, payload)
+}
+
 func (c *Client) newRequest(ctx context.Context, method, apiEndpoint string, payload io.Reader) (request *http.Request, err error) {
 
 	relativePath, err := url.Parse(apiEndpoint)
@@ -113,6 +126,7 @@ func transformTheHTTPResponse(response *http.Response, structure interface{}) (r
 	responseTransformed.Code = response.StatusCode
 	responseTransformed.Endpoint = response.Request.URL.String()
 	responseTransformed.Method = response.Request.Method
+	responseTransformed.Operation = model.OperationFromContext(response.Request.Context())
 
 	var wasSuccess = response.StatusCode >= 200 && response.StatusCode < 300
 	if !wasSuccess {
@@ -137,11 +151,12 @@ func transformTheHTTPResponse(response *http.Response, structure interface{}) (r
 }
 
 type ResponseScheme struct {
-	Code     int
-	Endpoint string
-	Method   string
-	Bytes    bytes.Buffer
-	Headers  map[string][]string
+	Code      int
+	Endpoint  string
+	Method    string
+	Operation string
+	Bytes     bytes.Buffer
+	Headers   map[string][]string
 }
 
 var (
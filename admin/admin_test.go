@@ -236,6 +236,39 @@ func TestClient_newRequest(t *testing.T) {
 	}
 }
 
+func TestClient_newOperationRequest(t *testing.T) {
+
+	var operations []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := mockClient.newOperationRequest(context.Background(), "organization.get", http.MethodGet, "/admin/v1/orgs/org-id", nil)
+	assert.NoError(t, err)
+	operations = append(operations, model.OperationFromContext(request.Context()))
+
+	_, response, err := mockClient.Organization.Get(context.Background(), "org-id")
+	assert.NoError(t, err)
+	operations = append(operations, response.Operation)
+
+	_, response, err = mockClient.User.ProductAccess(context.Background(), "org-id", "account-id")
+	assert.NoError(t, err)
+	operations = append(operations, response.Operation)
+
+	assert.Equal(t, []string{"admin.organization.get", "admin.organization.get", "admin.user.productAccess"}, operations)
+
+	request, err = mockClient.newRequest(context.Background(), http.MethodGet, "/admin/v1/orgs", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, model.OperationFromContext(request.Context()))
+}
+
 func Test_transformStructToReader(t *testing.T) {
 	type args struct {
 		structure interface{}
@@ -32,7 +32,7 @@ func (o *OrganizationService) Gets(ctx context.Context, cursor string) (result *
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.gets", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return
 	}
@@ -58,7 +58,7 @@ func (o *OrganizationService) Get(ctx context.Context, organizationID string) (r
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -94,7 +94,7 @@ func (o *OrganizationService) Users(ctx context.Context, organizationID, cursor
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.users", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return
 	}
@@ -130,7 +130,7 @@ func (o *OrganizationService) Domains(ctx context.Context, organizationID, curso
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.domains", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return
 	}
@@ -160,7 +160,7 @@ func (o *OrganizationService) Domain(ctx context.Context, organizationID, domain
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/domains/%v", organizationID, domainID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.domain", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -218,7 +218,7 @@ func (o *OrganizationService) Events(ctx context.Context, organizationID string,
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.events", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return
 	}
@@ -248,7 +248,7 @@ func (o *OrganizationService) Event(ctx context.Context, organizationID, eventID
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/events/%v", organizationID, eventID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.event", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -274,7 +274,7 @@ func (o *OrganizationService) Actions(ctx context.Context, organizationID string
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/event-actions", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.actions", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -39,7 +39,7 @@ func (o *OrganizationPolicyService) Gets(ctx context.Context, organizationID, po
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.policy.gets", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return
 	}
@@ -69,7 +69,7 @@ func (o *OrganizationPolicyService) Get(ctx context.Context, organizationID, pol
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v", organizationID, policyID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.policy.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -100,7 +100,7 @@ func (o *OrganizationPolicyService) Create(ctx context.Context, organizationID s
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	request, err := o.client.newOperationRequest(ctx, "organization.policy.create", http.MethodPost, endpoint, payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -136,7 +136,7 @@ func (o *OrganizationPolicyService) Update(ctx context.Context, organizationID,
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v", organizationID, policyID)
 
-	request, err := o.client.newRequest(ctx, http.MethodPut, endpoint, payloadAsReader)
+	request, err := o.client.newOperationRequest(ctx, "organization.policy.update", http.MethodPut, endpoint, payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -167,7 +167,7 @@ func (o *OrganizationPolicyService) Delete(ctx context.Context, organizationID,
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v", organizationID, policyID)
 
-	request, err := o.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := o.client.newOperationRequest(ctx, "organization.policy.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return
 	}
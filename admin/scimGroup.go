@@ -32,7 +32,7 @@ func (g *SCIMGroupService) Gets(ctx context.Context, directoryID, filter string,
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Groups?%v", directoryID, params.Encode())
 
-	request, err := g.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := g.client.newOperationRequest(ctx, "scim.group.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -62,7 +62,7 @@ func (g *SCIMGroupService) Get(ctx context.Context, directoryID, groupID string)
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v", directoryID, groupID)
 
-	request, err := g.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := g.client.newOperationRequest(ctx, "scim.group.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -103,7 +103,7 @@ func (g *SCIMGroupService) Update(ctx context.Context, directoryID, groupID stri
 	}
 
 	payloadAsReader, _ := transformStructToReader(&payload)
-	request, err := g.client.newRequest(ctx, http.MethodPut, endpoint, payloadAsReader)
+	request, err := g.client.newOperationRequest(ctx, "scim.group.update", http.MethodPut, endpoint, payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -134,7 +134,7 @@ func (g *SCIMGroupService) Delete(ctx context.Context, directoryID, groupID stri
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v", directoryID, groupID)
 
-	request, err := g.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := g.client.newOperationRequest(ctx, "scim.group.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -170,7 +170,7 @@ func (g *SCIMGroupService) Create(ctx context.Context, directoryID, groupName st
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Groups", directoryID)
 
-	request, err := g.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	request, err := g.client.newOperationRequest(ctx, "scim.group.create", http.MethodPost, endpoint, payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -211,7 +211,7 @@ func (g *SCIMGroupService) Path(ctx context.Context, directoryID, groupID string
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v", directoryID, groupID)
 
-	request, err := g.client.newRequest(ctx, http.MethodPatch, endpoint, payloadAsReader)
+	request, err := g.client.newOperationRequest(ctx, "scim.group.path", http.MethodPatch, endpoint, payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -20,7 +20,7 @@ func (s *SCIMSchemeService) Gets(ctx context.Context, directoryID string) (resul
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.scheme.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -46,7 +46,7 @@ func (s *SCIMSchemeService) Group(ctx context.Context, directoryID string) (resu
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas/urn:ietf:params:scim:schemas:core:2.0:Group", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.scheme.group", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -72,7 +72,7 @@ func (s *SCIMSchemeService) User(ctx context.Context, directoryID string) (resul
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas/urn:ietf:params:scim:schemas:core:2.0:User", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.scheme.user", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -99,7 +99,7 @@ func (s *SCIMSchemeService) Enterprise(ctx context.Context, directoryID string)
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas/urn:ietf:params:scim:schemas:extension:enterprise:2.0:User", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.scheme.enterprise", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -127,7 +127,7 @@ func (s *SCIMSchemeService) Feature(ctx context.Context, directoryID string) (re
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/ServiceProviderConfig", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.scheme.feature", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
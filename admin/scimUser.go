@@ -47,7 +47,7 @@ func (s *SCIMUserService) Create(ctx context.Context, directoryID string, payloa
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint.String(), payloadAsReader)
+	request, err := s.client.newOperationRequest(ctx, "scim.user.create", http.MethodPost, endpoint.String(), payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -93,7 +93,7 @@ func (s *SCIMUserService) Gets(ctx context.Context, directoryID string, opts *mo
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Users?%v", directoryID, params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.user.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -137,7 +137,7 @@ func (s *SCIMUserService) Get(ctx context.Context, directoryID, userID string, a
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.user.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return
 	}
@@ -168,7 +168,7 @@ func (s *SCIMUserService) Deactivate(ctx context.Context, directoryID, userID st
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Users/%v", directoryID, userID)
 
-	request, err := s.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := s.client.newOperationRequest(ctx, "scim.user.deactivate", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -217,7 +217,7 @@ func (s *SCIMUserService) Path(ctx context.Context, directoryID, userID string,
 		return nil, nil, err
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodPatch, endpoint.String(), payloadAsReader)
+	request, err := s.client.newOperationRequest(ctx, "scim.user.path", http.MethodPatch, endpoint.String(), payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -269,7 +269,7 @@ func (s *SCIMUserService) Update(ctx context.Context, directoryID, userID string
 		return nil, nil, err
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodPut, endpoint.String(), payloadAsReader)
+	request, err := s.client.newOperationRequest(ctx, "scim.user.update", http.MethodPut, endpoint.String(), payloadAsReader)
 	if err != nil {
 		return
 	}
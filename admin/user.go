@@ -7,11 +7,15 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 )
 
 type UserService struct {
 	client *Client
 	Token  *UserTokenService
+
+	mu            sync.RWMutex
+	defaultGroups map[string]string
 }
 
 // Permissions returns the set of permissions you have for managing the specified Atlassian account, this func needs the following parameters:
@@ -35,7 +39,7 @@ func (u *UserService) Permissions(ctx context.Context, accountID string, privile
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := u.client.newOperationRequest(ctx, "user.permissions", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return
 	}
@@ -60,7 +64,7 @@ func (u *UserService) Get(ctx context.Context, accountID string) (result *model.
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/profile", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newOperationRequest(ctx, "user.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -95,7 +99,7 @@ func (u *UserService) Update(ctx context.Context, accountID string, payload map[
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/profile", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodPatch, endpoint, payloadAsReader)
+	request, err := u.client.newOperationRequest(ctx, "user.update", http.MethodPatch, endpoint, payloadAsReader)
 	if err != nil {
 		return
 	}
@@ -136,7 +140,7 @@ func (u *UserService) Disable(ctx context.Context, accountID, message string) (r
 		}
 
 		payloadAsReader, _ := transformStructToReader(&payload)
-		request, err = u.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+		request, err = u.client.newOperationRequest(ctx, "user.disable", http.MethodPost, endpoint, payloadAsReader)
 		if err != nil {
 			return
 		}
@@ -145,7 +149,7 @@ func (u *UserService) Disable(ctx context.Context, accountID, message string) (r
 		request.Header.Set("Content-Type", "application/json")
 
 	} else {
-		request, err = u.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+		request, err = u.client.newOperationRequest(ctx, "user.disable", http.MethodPost, endpoint, nil)
 		if err != nil {
 			return
 		}
@@ -173,7 +177,7 @@ func (u *UserService) Enable(ctx context.Context, accountID string) (response *R
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/lifecycle/enable", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := u.client.newOperationRequest(ctx, "user.enable", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -185,3 +189,221 @@ func (u *UserService) Enable(ctx context.Context, accountID string) (response *R
 
 	return
 }
+
+// SetProductDefaultGroup sets the default group of a product, the group is used to grant or revoke the product access
+// when the product role cannot be assigned directly to the user.
+func (u *UserService) SetProductDefaultGroup(productID, groupID string) {
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.defaultGroups == nil {
+		u.defaultGroups = make(map[string]string)
+	}
+
+	u.defaultGroups[productID] = groupID
+}
+
+// ProductAccess returns the products the user has access to on the organization, all the pages are fetched.
+func (u *UserService) ProductAccess(ctx context.Context, organizationID, accountID string) (result *model.AdminUserProductAccessScheme,
+	response *ResponseScheme, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, nil, model.ErrNoAdminOrganizationError
+	}
+
+	if len(accountID) == 0 {
+		return nil, nil, model.ErrNoAdminAccountIDError
+	}
+
+	result = &model.AdminUserProductAccessScheme{Data: &model.AdminUserProductAccessDataScheme{}}
+
+	var cursor string
+	for {
+
+		var endpoint strings.Builder
+		endpoint.WriteString(fmt.Sprintf("/admin/v1/orgs/%v/directory/users/%v/last-active-dates", organizationID, accountID))
+
+		if cursor != "" {
+			params := url.Values{}
+			params.Add("cursor", cursor)
+			endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+		}
+
+		request, err := u.client.newOperationRequest(ctx, "user.productAccess", http.MethodGet, endpoint.String(), nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.Header.Set("Accept", "application/json")
+
+		page := new(model.AdminUserProductAccessScheme)
+		response, err = u.client.call(request, page)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if page.Data != nil {
+			result.Data.ProductAccess = append(result.Data.ProductAccess, page.Data.ProductAccess...)
+			result.Data.AddedToOrg = page.Data.AddedToOrg
+		}
+
+		if page.Links == nil || page.Links.Next == "" || page.Links.Next == cursor {
+			break
+		}
+
+		cursor = page.Links.Next
+	}
+
+	return result, response, nil
+}
+
+// GrantProductAccess grants the product access to the user.
+// The product user role is assigned directly when the organization supports it, otherwise the user is added
+// to the default group of the product set with SetProductDefaultGroup. The result reports the mechanism used.
+func (u *UserService) GrantProductAccess(ctx context.Context, organizationID, accountID, productID string) (
+	result *model.AdminUserProductAccessResultScheme, response *ResponseScheme, err error) {
+	return u.changeProductAccess(ctx, organizationID, accountID, productID, true)
+}
+
+// RevokeProductAccess revokes the product access of the user.
+// The product user role is revoked directly when the organization supports it, otherwise the user is removed
+// from the default group of the product set with SetProductDefaultGroup. The result reports the mechanism used.
+func (u *UserService) RevokeProductAccess(ctx context.Context, organizationID, accountID, productID string) (
+	result *model.AdminUserProductAccessResultScheme, response *ResponseScheme, err error) {
+	return u.changeProductAccess(ctx, organizationID, accountID, productID, false)
+}
+
+func (u *UserService) changeProductAccess(ctx context.Context, organizationID, accountID, productID string, grant bool) (
+	result *model.AdminUserProductAccessResultScheme, response *ResponseScheme, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, nil, model.ErrNoAdminOrganizationError
+	}
+
+	if len(accountID) == 0 {
+		return nil, nil, model.ErrNoAdminAccountIDError
+	}
+
+	if len(productID) == 0 {
+		return nil, nil, model.ErrNoAdminProductIDError
+	}
+
+	result = &model.AdminUserProductAccessResultScheme{
+		AccountID: accountID,
+		ProductID: productID,
+		Mechanism: model.AdminProductAccessDirect,
+	}
+
+	response, err = u.assignProductRole(ctx, organizationID, accountID, productID, grant)
+	if err == nil {
+		return result, response, nil
+	}
+
+	if response == nil || !isProductRoleUnsupported(response.Code) {
+		return nil, response, err
+	}
+
+	u.mu.RLock()
+	groupID := u.defaultGroups[productID]
+	u.mu.RUnlock()
+
+	if groupID == "" {
+		return nil, response, model.ErrNoAdminProductGroupError
+	}
+
+	if grant {
+		response, err = u.addGroupMember(ctx, organizationID, groupID, accountID)
+	} else {
+		response, err = u.removeGroupMember(ctx, organizationID, groupID, accountID)
+	}
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	result.Mechanism = model.AdminProductAccessGroup
+	result.GroupID = groupID
+
+	return result, response, nil
+}
+
+// assignProductRole assigns or revokes the product user role, the "-" directory targets the directory of the user.
+func (u *UserService) assignProductRole(ctx context.Context, organizationID, accountID, productID string, grant bool) (*ResponseScheme, error) {
+
+	action := "revoke"
+	if grant {
+		action = "assign"
+	}
+
+	payload := struct {
+		ResourceID string `json:"resourceId"`
+		RoleID     string `json:"roleId"`
+	}{
+		ResourceID: productID,
+		RoleID:     "atlassian/user",
+	}
+
+	payloadAsReader, err := transformStructToReader(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = fmt.Sprintf("/admin/v2/orgs/%v/directories/-/users/%v/role-assignments/%v", organizationID, accountID, action)
+
+	request, err := u.client.newOperationRequest(ctx, "user.role."+action, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	return u.client.call(request, nil)
+}
+
+func (u *UserService) addGroupMember(ctx context.Context, organizationID, groupID, accountID string) (*ResponseScheme, error) {
+
+	payload := struct {
+		AccountID string `json:"account_id"`
+	}{
+		AccountID: accountID,
+	}
+
+	payloadAsReader, err := transformStructToReader(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/directory/groups/%v/memberships", organizationID, groupID)
+
+	request, err := u.client.newOperationRequest(ctx, "user.group.member.add", http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	return u.client.call(request, nil)
+}
+
+func (u *UserService) removeGroupMember(ctx context.Context, organizationID, groupID, accountID string) (*ResponseScheme, error) {
+
+	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/directory/groups/%v/memberships/%v", organizationID, groupID, accountID)
+
+	request, err := u.client.newOperationRequest(ctx, "user.group.member.remove", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	return u.client.call(request, nil)
+}
+
+// isProductRoleUnsupported reports whether the status code means the role assignments aren't available for the organization.
+// A not found response isn't a fallback, it's returned for an unknown organization or account.
+func isProductRoleUnsupported(code int) bool {
+	return code == http.StatusMethodNotAllowed || code == http.StatusNotImplemented
+}
@@ -20,7 +20,7 @@ func (u *UserTokenService) Gets(ctx context.Context, accountID string) (result *
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/api-tokens", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newOperationRequest(ctx, "user.token.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -49,7 +49,7 @@ func (u *UserTokenService) Delete(ctx context.Context, accountID, tokenID string
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/api-tokens/%v", accountID, tokenID)
 
-	request, err := u.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := u.client.newOperationRequest(ctx, "user.token.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return
 	}
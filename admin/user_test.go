@@ -3,9 +3,12 @@ package admin
 import (
 	"context"
 	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -848,3 +851,191 @@ func TestUserService_Enable(t *testing.T) {
 	}
 
 }
+
+func TestUserService_ProductAccess(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method != http.MethodGet || r.URL.Path != "/admin/v1/orgs/org-id/directory/users/account-id/last-active-dates" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = w.Write([]byte(`{"data":{"product_access":[{"id":"site-1","key":"jira-software"}],"added_to_org":"2021-01-01"},"links":{"next":"page-2"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"product_access":[{"id":"site-1","key":"confluence"}],"added_to_org":"2021-01-01"},"links":{}}`))
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotResult, gotResponse, err := mockClient.User.ProductAccess(context.Background(), "org-id", "account-id")
+	assert.NoError(t, err)
+	assert.NotNil(t, gotResponse)
+	assert.Equal(t, "2021-01-01", gotResult.Data.AddedToOrg)
+
+	var keys []string
+	for _, product := range gotResult.Data.ProductAccess {
+		keys = append(keys, product.Key)
+	}
+
+	assert.Equal(t, []string{"jira-software", "confluence"}, keys)
+
+	_, _, err = mockClient.User.ProductAccess(context.Background(), "", "account-id")
+	assert.ErrorIs(t, err, model.ErrNoAdminOrganizationError)
+
+	_, _, err = mockClient.User.ProductAccess(context.Background(), "org-id", "")
+	assert.ErrorIs(t, err, model.ErrNoAdminAccountIDError)
+}
+
+func TestUserService_GrantProductAccess(t *testing.T) {
+
+	testCases := []struct {
+		name          string
+		roleCode      int
+		defaultGroup  string
+		wantMechanism string
+		wantRequests  []string
+		wantErr       error
+	}{
+		{
+			name:          "when the product role can be assigned directly",
+			roleCode:      http.StatusOK,
+			defaultGroup:  "group-id",
+			wantMechanism: model.AdminProductAccessDirect,
+			wantRequests: []string{
+				"POST /admin/v2/orgs/org-id/directories/-/users/account-id/role-assignments/assign",
+			},
+		},
+
+		{
+			name:          "when the role assignments are not available",
+			roleCode:      http.StatusNotImplemented,
+			defaultGroup:  "group-id",
+			wantMechanism: model.AdminProductAccessGroup,
+			wantRequests: []string{
+				"POST /admin/v2/orgs/org-id/directories/-/users/account-id/role-assignments/assign",
+				"POST /admin/v1/orgs/org-id/directory/groups/group-id/memberships",
+			},
+		},
+
+		{
+			name:     "when the role assignments are not available and the product has no default group",
+			roleCode: http.StatusMethodNotAllowed,
+			wantRequests: []string{
+				"POST /admin/v2/orgs/org-id/directories/-/users/account-id/role-assignments/assign",
+			},
+			wantErr: model.ErrNoAdminProductGroupError,
+		},
+
+		{
+			name:         "when the account or the organization is not found",
+			roleCode:     http.StatusNotFound,
+			defaultGroup: "group-id",
+			wantRequests: []string{
+				"POST /admin/v2/orgs/org-id/directories/-/users/account-id/role-assignments/assign",
+			},
+			wantErr: fmt.Errorf(requestFailedError, http.StatusNotFound),
+		},
+
+		{
+			name:         "when the role assignment is rejected",
+			roleCode:     http.StatusForbidden,
+			defaultGroup: "group-id",
+			wantRequests: []string{
+				"POST /admin/v2/orgs/org-id/directories/-/users/account-id/role-assignments/assign",
+			},
+			wantErr: fmt.Errorf(requestFailedError, http.StatusForbidden),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var requests []string
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+				requests = append(requests, fmt.Sprintf("%v %v", r.Method, r.URL.Path))
+
+				if strings.Contains(r.URL.Path, "role-assignments") {
+					w.WriteHeader(testCase.roleCode)
+					return
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer mockServer.Close()
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.defaultGroup != "" {
+				mockClient.User.SetProductDefaultGroup("product-id", testCase.defaultGroup)
+			}
+
+			gotResult, _, err := mockClient.User.GrantProductAccess(context.Background(), "org-id", "account-id", "product-id")
+
+			assert.Equal(t, testCase.wantRequests, requests)
+
+			if testCase.wantErr != nil {
+				assert.EqualError(t, err, testCase.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantMechanism, gotResult.Mechanism)
+			assert.Equal(t, "product-id", gotResult.ProductID)
+
+			if testCase.wantMechanism == model.AdminProductAccessGroup {
+				assert.Equal(t, testCase.defaultGroup, gotResult.GroupID)
+			}
+		})
+	}
+}
+
+func TestUserService_RevokeProductAccess(t *testing.T) {
+
+	var requests []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		requests = append(requests, fmt.Sprintf("%v %v", r.Method, r.URL.Path))
+
+		if strings.Contains(r.URL.Path, "role-assignments") {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient.User.SetProductDefaultGroup("product-id", "group-id")
+
+	gotResult, gotResponse, err := mockClient.User.RevokeProductAccess(context.Background(), "org-id", "account-id", "product-id")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, gotResponse.Code)
+	assert.Equal(t, model.AdminProductAccessGroup, gotResult.Mechanism)
+	assert.Equal(t, []string{
+		"POST /admin/v2/orgs/org-id/directories/-/users/account-id/role-assignments/revoke",
+		"DELETE /admin/v1/orgs/org-id/directory/groups/group-id/memberships/account-id",
+	}, requests)
+
+	_, _, err = mockClient.User.RevokeProductAccess(context.Background(), "org-id", "account-id", "")
+	assert.ErrorIs(t, err, model.ErrNoAdminProductIDError)
+}
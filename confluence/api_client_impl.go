@@ -51,10 +51,12 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 
 	client.Auth = internal.NewAuthenticationService(client)
 	client.Content = internal.NewContentService(client, contentSubServices)
-	client.Space = internal.NewSpaceService(client, internal.NewSpacePermissionService(client))
+	client.Space = internal.NewSpaceService(client, internal.NewSpacePermissionService(client), internal.NewSpaceExportService(client))
 	client.Label = internal.NewLabelService(client)
 	client.Search = internal.NewSearchService(client)
 	client.LongTask = internal.NewTaskService(client)
+	client.BlogPost = internal.NewBlogPostService(client)
+	client.User = internal.NewUserService(client, internal.NewUserWatchService(client))
 
 	return client, nil
 }
@@ -68,6 +70,20 @@ type Client struct {
 	Label    *internal.LabelService
 	Search   *internal.SearchService
 	LongTask *internal.TaskService
+	BlogPost *internal.BlogPostService
+	User     *internal.UserService
+}
+
+// NewOperationRequest creates the request of a service method, the request context is named with the operation,
+// prefixed with confluence., see models.WithOperation.
+func (c *Client) NewOperationRequest(ctx context.Context, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+	return c.NewRequest(models.WithOperation(ctx, "confluence."+operation), method, apiEndpoint, payload)
+}
+
+// NewOperationFormRequest creates the form request of a service method, the request context is named with the operation,
+// prefixed with confluence., see models.WithOperation.
+func (c *Client) NewOperationFormRequest(ctx context.Context, operation, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
+	return c.NewFormRequest(models.WithOperation(ctx, "confluence."+operation), method, apiEndpoint, contentType, payload)
 }
 
 func (c *Client) NewFormRequest(ctx context.Context, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
@@ -140,13 +156,19 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 	return c.TransformTheHTTPResponse(response, structure)
 }
 
+// Stream sends the request and returns the HTTP response without reading its body, the caller closes the body.
+func (c *Client) Stream(request *http.Request) (*http.Response, error) {
+	return c.HTTP.Do(request)
+}
+
 func (c *Client) TransformTheHTTPResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
 
 	responseTransformed := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Operation: models.OperationFromContext(response.Request.Context()),
 	}
 
 	responseAsBytes, err := ioutil.ReadAll(response.Body)
@@ -155,6 +155,30 @@ func TestClient_Call(t *testing.T) {
 	}
 }
 
+func TestClient_Stream(t *testing.T) {
+
+	expectedResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader("Hello, world!")),
+	}
+
+	client := mocks.NewHttpClient(t)
+
+	client.On("Do", (*http.Request)(nil)).
+		Return(expectedResponse, nil)
+
+	c := &Client{HTTP: client}
+
+	got, err := c.Stream(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponse, got)
+
+	// The body is returned unread
+	body, err := ioutil.ReadAll(got.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello, world!", string(body))
+}
+
 func TestNewV2(t *testing.T) {
 
 	mockClient, err := New(http.DefaultClient, "https://ctreminiom.atlassian.net")
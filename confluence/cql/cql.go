@@ -0,0 +1,201 @@
+// Package cql builds Confluence Query Language (CQL) queries, the values are always quoted and escaped.
+//
+// The queries are immutable, every method returns a new query:
+//
+//	query := cql.Space("ENG").And(cql.Type("page"), cql.LabelIn("runbook", "howto")).OrderBy("lastmodified", cql.Desc)
+//
+// The query string is returned by the String method and can be used by ContentService.Search.
+package cql
+
+import (
+	"github.com/ctreminiom/go-atlassian/pkg/infra/query"
+	"time"
+)
+
+// Direction is the sort direction of an ORDER BY clause
+type Direction string
+
+const (
+	Asc  Direction = "asc"
+	Desc Direction = "desc"
+)
+
+// DateLayout is the layout of the dates of the CQL queries, the dates are interpreted by Confluence
+// on the time zone of the user running the query.
+const DateLayout = "2006/01/02 15:04"
+
+// Query is a CQL query or a clause of a query.
+type Query struct {
+	expression query.Expression
+}
+
+func newQuery(clause string) *Query {
+	return &Query{expression: query.Expression{Clause: clause}}
+}
+
+// String returns the CQL query.
+func (q *Query) String() string {
+	return q.expression.String()
+}
+
+// And joins the query and the clauses with the AND operator, the OR groups are wrapped on parentheses.
+func (q *Query) And(clauses ...*Query) *Query {
+	return q.join("AND", clauses)
+}
+
+// Or joins the query and the clauses with the OR operator, the AND groups are wrapped on parentheses.
+func (q *Query) Or(clauses ...*Query) *Query {
+	return q.join("OR", clauses)
+}
+
+// OrderBy sorts the results by the field, the calls are cumulative.
+func (q *Query) OrderBy(field string, direction Direction) *Query {
+
+	orderBy := field
+	if direction != "" {
+		orderBy += " " + string(direction)
+	}
+
+	return &Query{expression: q.expression.SortBy(orderBy)}
+}
+
+func (q *Query) join(operator string, clauses []*Query) *Query {
+
+	expressions := make([]query.Expression, 0, len(clauses))
+	for _, clause := range clauses {
+		if clause != nil {
+			expressions = append(expressions, clause.expression)
+		}
+	}
+
+	return &Query{expression: q.expression.Join(operator, expressions)}
+}
+
+// And joins the clauses with the AND operator.
+func And(clauses ...*Query) *Query {
+	return new(Query).And(clauses...)
+}
+
+// Or joins the clauses with the OR operator, the group is wrapped on parentheses when it's joined with other clauses.
+func Or(clauses ...*Query) *Query {
+	return new(Query).Or(clauses...)
+}
+
+// Not negates the clause.
+func Not(clause *Query) *Query {
+	return &Query{expression: query.Not(clause.expression)}
+}
+
+// Field compares a field to a value using an operator, e.g. Field("title", "!=", "Draft")
+func Field(field, operator, value string) *Query {
+	return newQuery(field + " " + operator + " " + Quote(value))
+}
+
+// FieldIn matches the fields equal to one of the values, the clause is skipped when there are no values.
+func FieldIn(field string, values ...string) *Query {
+
+	if len(values) == 0 {
+		return new(Query)
+	}
+
+	quoted := make([]string, len(values))
+	for index, value := range values {
+		quoted[index] = Quote(value)
+	}
+
+	return newQuery(field + " in " + query.List(quoted))
+}
+
+// Space matches the contents of the spaces, the clause is skipped when there are no spaces.
+func Space(spaceKeys ...string) *Query {
+	return equalOrIn("space", spaceKeys)
+}
+
+// Type matches the contents of the types, e.g. page, blogpost, comment or attachment, the clause is skipped
+// when there are no types.
+func Type(contentTypes ...string) *Query {
+	return equalOrIn("type", contentTypes)
+}
+
+// Label matches the contents with the label.
+func Label(label string) *Query {
+	return Field("label", "=", label)
+}
+
+// LabelIn matches the contents with one of the labels, the clause is skipped when there are no labels.
+func LabelIn(labels ...string) *Query {
+	return FieldIn("label", labels...)
+}
+
+// Title matches the contents with the exact title.
+func Title(title string) *Query {
+	return Field("title", "=", title)
+}
+
+// TitleContains matches the contents with a title containing the text.
+func TitleContains(text string) *Query {
+	return Field("title", "~", text)
+}
+
+// Text matches the contents with a title, body or label containing the text.
+//
+// The text is quoted, but the wildcards of the Confluence text search, like * and ?, keep their meaning.
+func Text(text string) *Query {
+	return Field("text", "~", text)
+}
+
+// Ancestor matches the contents below the page, at any level.
+func Ancestor(pageID string) *Query {
+	return Field("ancestor", "=", pageID)
+}
+
+// Parent matches the direct children of the page.
+func Parent(pageID string) *Query {
+	return Field("parent", "=", pageID)
+}
+
+// Creator matches the contents created by the user.
+func Creator(accountID string) *Query {
+	return Field("creator", "=", accountID)
+}
+
+// Contributor matches the contents created or edited by the user.
+func Contributor(accountID string) *Query {
+	return Field("contributor", "=", accountID)
+}
+
+// LastModifiedAfter matches the contents modified after the date.
+//
+// The date is formatted on its own location, use t.In to format it on the time zone of the user running the query.
+func LastModifiedAfter(t time.Time) *Query {
+	return Field("lastmodified", ">", t.Format(DateLayout))
+}
+
+// LastModifiedBefore matches the contents modified before the date.
+func LastModifiedBefore(t time.Time) *Query {
+	return Field("lastmodified", "<", t.Format(DateLayout))
+}
+
+// CreatedAfter matches the contents created after the date.
+func CreatedAfter(t time.Time) *Query {
+	return Field("created", ">", t.Format(DateLayout))
+}
+
+// CreatedBefore matches the contents created before the date.
+func CreatedBefore(t time.Time) *Query {
+	return Field("created", "<", t.Format(DateLayout))
+}
+
+// Quote returns the value wrapped on double quotes, escaping the backslashes and the double quotes of the value.
+func Quote(value string) string {
+	return query.Quote(value)
+}
+
+func equalOrIn(field string, values []string) *Query {
+
+	if len(values) == 1 {
+		return Field(field, "=", values[0])
+	}
+
+	return FieldIn(field, values...)
+}
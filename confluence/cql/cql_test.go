@@ -0,0 +1,92 @@
+package cql
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestQuery_String(t *testing.T) {
+
+	modified := time.Date(2023, 3, 14, 9, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name: "when the clauses are joined and sorted",
+			query: Space("ENG").
+				And(Type("page"), LabelIn("runbook", "howto"), LastModifiedAfter(modified)).
+				OrderBy("lastmodified", Desc),
+			want: `space = "ENG" AND type = "page" AND label in ("runbook", "howto") AND lastmodified > "2023/03/14 09:30" ORDER BY lastmodified desc`,
+		},
+
+		{
+			name:  "when an OR group is joined with AND",
+			query: Space("ENG", "OPS").And(Or(Label("runbook"), TitleContains("incident")), Not(Type("attachment"))),
+			want:  `space in ("ENG", "OPS") AND (label = "runbook" OR title ~ "incident") AND NOT type = "attachment"`,
+		},
+
+		{
+			name:  "when an AND group is joined with OR",
+			query: Or(And(Creator("5b10ac8d82e05b22cc7d4ef5"), Ancestor("65538")), Contributor("5b10ac8d82e05b22cc7d4ef5")),
+			want:  `(creator = "5b10ac8d82e05b22cc7d4ef5" AND ancestor = "65538") OR contributor = "5b10ac8d82e05b22cc7d4ef5"`,
+		},
+
+		{
+			name:  "when a group is negated",
+			query: Parent("65538").And(Not(Or(Label("draft"), Label("archived")))),
+			want:  `parent = "65538" AND NOT (label = "draft" OR label = "archived")`,
+		},
+
+		{
+			name:  "when the values contain quotes, backslashes and reserved words",
+			query: Title(`Release "2.0" AND C:\notes`).And(Text("order by")),
+			want:  `title = "Release \"2.0\" AND C:\\notes" AND text ~ "order by"`,
+		},
+
+		{
+			name:  "when the dates are formatted on their location",
+			query: CreatedAfter(modified.In(time.FixedZone("UTC-5", -5*60*60))).And(CreatedBefore(modified)),
+			want:  `created > "2023/03/14 04:30" AND created < "2023/03/14 09:30"`,
+		},
+
+		{
+			name:  "when several sort fields are set",
+			query: Type("blogpost").OrderBy("created", Asc).OrderBy("title", ""),
+			want:  `type = "blogpost" ORDER BY created asc, title`,
+		},
+
+		{
+			name:  "when the fields are compared with custom operators",
+			query: And(Field("title", "!=", "Draft"), FieldIn("id", "1", "2"), LastModifiedBefore(modified)),
+			want:  `title != "Draft" AND id in ("1", "2") AND lastmodified < "2023/03/14 09:30"`,
+		},
+
+		{
+			name:  "when the spaces, types and labels are not set",
+			query: Space().And(Type(), LabelIn(), Title("Runbook")).OrderBy("title", Asc),
+			want:  `title = "Runbook" ORDER BY title asc`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.query.String())
+		})
+	}
+}
+
+func TestQuery_Immutable(t *testing.T) {
+
+	base := Space("ENG")
+
+	pages := base.And(Type("page"))
+	sorted := base.OrderBy("title", Asc)
+
+	assert.Equal(t, `space = "ENG"`, base.String())
+	assert.Equal(t, `space = "ENG" AND type = "page"`, pages.String())
+	assert.Equal(t, `space = "ENG" ORDER BY title asc`, sorted.String())
+}
@@ -95,7 +95,7 @@ func (i *internalContentAttachmentImpl) Gets(ctx context.Context, contentID stri
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/child/attachment?%v", contentID, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.attachment.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,7 +152,7 @@ func (i *internalContentAttachmentImpl) CreateOrUpdate(ctx context.Context, atta
 
 	writer.Close()
 
-	request, err := i.c.NewFormRequest(ctx, http.MethodPut, endpoint.String(), writer.FormDataContentType(), reader)
+	request, err := service.NewOperationFormRequest(ctx, i.c, "content.attachment.createOrUpdate", http.MethodPut, endpoint.String(), writer.FormDataContentType(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -209,7 +209,7 @@ func (i *internalContentAttachmentImpl) Create(ctx context.Context, attachmentID
 
 	writer.Close()
 
-	request, err := i.c.NewFormRequest(ctx, http.MethodPost, endpoint.String(), writer.FormDataContentType(), reader)
+	request, err := service.NewOperationFormRequest(ctx, i.c, "content.attachment.create", http.MethodPost, endpoint.String(), writer.FormDataContentType(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
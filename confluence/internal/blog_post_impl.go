@@ -0,0 +1,325 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// blogPostDateRangePageSize is the chunk size used to list the blog posts of a date range
+const blogPostDateRangePageSize = 250
+
+func NewBlogPostService(client service.Client) *BlogPostService {
+
+	return &BlogPostService{
+		internalClient: &internalBlogPostImpl{c: client},
+	}
+}
+
+type BlogPostService struct {
+	internalClient confluence.BlogPostConnector
+}
+
+// Gets returns all blog posts, the blog posts can be filtered by space and sorted by their created date.
+//
+// The blog posts are paginated with a cursor, use the cursor of the returned chunk to fetch the next chunk.
+//
+// GET /wiki/api/v2/blogposts
+//
+// TODO: the documentation needs to be created
+func (b *BlogPostService) Gets(ctx context.Context, options *model.BlogPostOptionsScheme, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Gets(ctx, options, cursor, limit)
+}
+
+// Get returns a specific blog post.
+//
+// The body is returned on the format requested, the latest version is returned when the version is 0.
+//
+// GET /wiki/api/v2/blogposts/{id}
+//
+// TODO: the documentation needs to be created
+func (b *BlogPostService) Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Get(ctx, blogPostID, format, draft, version)
+}
+
+// Create creates a new blog post in the space specified by the spaceId.
+//
+// The created date can be set to backdate the blog post, only when the site permits it.
+//
+// POST /wiki/api/v2/blogposts
+//
+// TODO: the documentation needs to be created
+func (b *BlogPostService) Create(ctx context.Context, payload *model.BlogPostPayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Create(ctx, payload)
+}
+
+// Update updates a blog post by id.
+//
+// The version number of the payload must be the current version number incremented by one.
+//
+// PUT /wiki/api/v2/blogposts/{id}
+//
+// TODO: the documentation needs to be created
+func (b *BlogPostService) Update(ctx context.Context, blogPostID int, payload *model.BlogPostPayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Update(ctx, blogPostID, payload)
+}
+
+// Delete deletes a blog post by id.
+//
+// DELETE /wiki/api/v2/blogposts/{id}
+//
+// TODO: the documentation needs to be created
+func (b *BlogPostService) Delete(ctx context.Context, blogPostID int) (*model.ResponseScheme, error) {
+	return b.internalClient.Delete(ctx, blogPostID)
+}
+
+// GetsByDateRange returns the blog posts created between two dates, both dates included, newest first.
+//
+// The blog posts of every space are returned when no space ids are provided.
+//
+// GET /wiki/api/v2/blogposts
+//
+// TODO: the documentation needs to be created
+func (b *BlogPostService) GetsByDateRange(ctx context.Context, spaceIDs []int, from, to time.Time) ([]*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.GetsByDateRange(ctx, spaceIDs, from, to)
+}
+
+type internalBlogPostImpl struct {
+	c service.Client
+}
+
+func (i *internalBlogPostImpl) Gets(ctx context.Context, options *model.BlogPostOptionsScheme, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	if options != nil {
+
+		if len(options.BlogPostIDs) != 0 {
+			query.Add("id", joinIDs(options.BlogPostIDs))
+		}
+
+		if len(options.SpaceIDs) != 0 {
+			query.Add("space-id", joinIDs(options.SpaceIDs))
+		}
+
+		if options.Sort != "" {
+			query.Add("sort", options.Sort)
+		}
+
+		if len(options.Status) != 0 {
+			query.Add("status", strings.Join(options.Status, ","))
+		}
+
+		if options.Title != "" {
+			query.Add("title", options.Title)
+		}
+
+		if options.BodyFormat != "" {
+			query.Add("body-format", options.BodyFormat)
+		}
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts?%v", query.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "blogPost.gets", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chunk := new(model.BlogPostChunkScheme)
+	response, err := i.c.Call(request, chunk)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return chunk, response, nil
+}
+
+func (i *internalBlogPostImpl) Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, nil, model.ErrNoBlogPostIDError
+	}
+
+	query := url.Values{}
+
+	if format != "" {
+		query.Add("body-format", format)
+	}
+
+	if draft {
+		query.Add("get-draft", "true")
+	}
+
+	if version != 0 {
+		query.Add("version", strconv.Itoa(version))
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v", blogPostID)
+
+	if query.Encode() != "" {
+		endpoint = fmt.Sprintf("%v?%v", endpoint, query.Encode())
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "blogPost.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Create(ctx context.Context, payload *model.BlogPostPayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNilPayloadError
+	}
+
+	if payload.SpaceID == "" {
+		return nil, nil, model.ErrNoSpaceIDError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := "wiki/api/v2/blogposts"
+
+	request, err := service.NewOperationRequest(ctx, i.c, "blogPost.create", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Update(ctx context.Context, blogPostID int, payload *model.BlogPostPayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, nil, model.ErrNoBlogPostIDError
+	}
+
+	if payload == nil {
+		return nil, nil, model.ErrNilPayloadError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v", blogPostID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "blogPost.update", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Delete(ctx context.Context, blogPostID int) (*model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, model.ErrNoBlogPostIDError
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v", blogPostID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "blogPost.delete", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalBlogPostImpl) GetsByDateRange(ctx context.Context, spaceIDs []int, from, to time.Time) ([]*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if to.Before(from) {
+		return nil, nil, model.ErrInvalidDateRangeError
+	}
+
+	options := &model.BlogPostOptionsScheme{
+		SpaceIDs: spaceIDs,
+		Sort:     model.BlogPostSortByCreatedDateDesc,
+	}
+
+	var (
+		blogPosts []*model.BlogPostScheme
+		response  *model.ResponseScheme
+		cursor    string
+	)
+
+	for {
+
+		chunk, chunkResponse, err := i.Gets(ctx, options, cursor, blogPostDateRangePageSize)
+		if err != nil {
+			return nil, chunkResponse, err
+		}
+
+		response = chunkResponse
+
+		// The blog posts are sorted by their created date, newest first, so the range ends on the first older blog post
+		for _, blogPost := range chunk.Results {
+
+			created := blogPost.Created()
+			if created.IsZero() {
+				continue
+			}
+
+			if created.Before(from) {
+				return blogPosts, response, nil
+			}
+
+			if !created.After(to) {
+				blogPosts = append(blogPosts, blogPost)
+			}
+		}
+
+		if cursor = chunk.Cursor(); cursor == "" {
+			return blogPosts, response, nil
+		}
+	}
+}
+
+func joinIDs(ids []int) string {
+
+	values := make([]string, len(ids))
+	for index, id := range ids {
+		values[index] = strconv.Itoa(id)
+	}
+
+	return strings.Join(values, ",")
+}
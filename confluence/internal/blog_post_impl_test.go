@@ -0,0 +1,690 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_internalBlogPostImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.BlogPostOptionsScheme
+		cursor  string
+		limit   int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+				options: &model.BlogPostOptionsScheme{
+					SpaceIDs:   []int{10001, 10002},
+					Sort:       model.BlogPostSortByCreatedDateDesc,
+					Status:     []string{model.BlogPostStatusCurrent},
+					BodyFormat: model.BlogPostBodyFormatStorage,
+				},
+				cursor: "cursor-sample",
+				limit:  25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/blogposts?body-format=storage&cursor=cursor-sample&limit=25&sort=-created-date&space-id=10001%2C10002&status=current",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BlogPostChunkScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:   context.Background(),
+				limit: 25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/blogposts?limit=25",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBlogPostService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.options, testCase.args.cursor,
+				testCase.args.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalBlogPostImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx        context.Context
+		blogPostID int
+		format     string
+		draft      bool
+		version    int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				blogPostID: 20001,
+				format:     model.BlogPostBodyFormatAtlasDocFormat,
+				draft:      true,
+				version:    2,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/blogposts/20001?body-format=atlas_doc_format&get-draft=true&version=2",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BlogPostScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:        context.Background(),
+				blogPostID: 20001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/blogposts/20001",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BlogPostScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed"),
+		},
+
+		{
+			name: "when the blog post id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoBlogPostIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBlogPostService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.blogPostID, testCase.args.format,
+				testCase.args.draft, testCase.args.version)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalBlogPostImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.BlogPostPayloadScheme{
+		SpaceID: "10001",
+		Status:  model.BlogPostStatusCurrent,
+		Title:   "Release notes",
+		Body: &model.BodyTypeScheme{
+			Representation: "storage",
+			Value:          "<p>Release notes</p>",
+		},
+		CreatedAt: "2023-01-15T10:00:00Z",
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.BlogPostPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/api/v2/blogposts",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BlogPostScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the payload cannot be transformed",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(nil, model.ErrNilPayloadError)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayloadError,
+		},
+
+		{
+			name: "when the space id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.BlogPostPayloadScheme{Title: "Release notes"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceIDError,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayloadError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBlogPostService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalBlogPostImpl_Update(t *testing.T) {
+
+	payloadMocked := &model.BlogPostPayloadScheme{
+		ID:      "20001",
+		Status:  model.BlogPostStatusCurrent,
+		Title:   "Release notes",
+		Version: &model.BlogPostVersionScheme{Number: 2},
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx        context.Context
+		blogPostID int
+		payload    *model.BlogPostPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				blogPostID: 20001,
+				payload:    payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"wiki/api/v2/blogposts/20001",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BlogPostScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the blog post id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoBlogPostIDError,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:        context.Background(),
+				blogPostID: 20001,
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayloadError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBlogPostService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.blogPostID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalBlogPostImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx        context.Context
+		blogPostID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				blogPostID: 20001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/api/v2/blogposts/20001",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the blog post id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoBlogPostIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBlogPostService(testCase.fields.c)
+
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.blogPostID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+func Test_internalBlogPostImpl_GetsByDateRange(t *testing.T) {
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	mockChunk := func(client *mocks.Client, endpoint string, chunk *model.BlogPostChunkScheme) {
+
+		request := &http.Request{RequestURI: endpoint}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			endpoint,
+			nil).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.BlogPostChunkScheme) = *chunk
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceIDs []int
+		from, to time.Time
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the blog posts span several chunks",
+			args: args{
+				ctx:      context.Background(),
+				spaceIDs: []int{10001, 10002},
+				from:     from,
+				to:       to,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockChunk(client,
+					"wiki/api/v2/blogposts?limit=250&sort=-created-date&space-id=10001%2C10002",
+					&model.BlogPostChunkScheme{
+						Results: []*model.BlogPostScheme{
+							{ID: "1", CreatedAt: "2023-02-10T10:00:00.000Z"},
+							{ID: "2", CreatedAt: "2023-01-31T10:00:00.000Z"},
+						},
+						Links: &model.BlogPostChunkLinksScheme{Next: "/wiki/api/v2/blogposts?cursor=next-cursor&limit=250"},
+					})
+
+				mockChunk(client,
+					"wiki/api/v2/blogposts?cursor=next-cursor&limit=250&sort=-created-date&space-id=10001%2C10002",
+					&model.BlogPostChunkScheme{
+						Results: []*model.BlogPostScheme{
+							{ID: "3", CreatedAt: "2023-01-01T00:00:00Z"},
+							{ID: "4", CreatedAt: "2022-12-31T23:00:00Z"},
+						},
+						Links: &model.BlogPostChunkLinksScheme{Next: "/wiki/api/v2/blogposts?cursor=last-cursor&limit=250"},
+					})
+
+				fields.c = client
+			},
+			want: []string{"2", "3"},
+		},
+
+		{
+			name: "when the date range is invalid",
+			args: args{
+				ctx:  context.Background(),
+				from: to,
+				to:   from,
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidDateRangeError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBlogPostService(testCase.fields.c)
+
+			gotResult, _, err := newService.GetsByDateRange(testCase.args.ctx, testCase.args.spaceIDs, testCase.args.from,
+				testCase.args.to)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+
+				var got []string
+				for _, blogPost := range gotResult {
+					got = append(got, blogPost.ID)
+				}
+
+				assert.Equal(t, testCase.want, got)
+			}
+		})
+	}
+}
@@ -148,7 +148,7 @@ func (i *internalChildrenDescandantsImpl) Children(ctx context.Context, contentI
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.childrenDescendant.children", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -186,7 +186,7 @@ func (i *internalChildrenDescandantsImpl) ChildrenByType(ctx context.Context, co
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/child/%v?%v", contentID, contentType, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.childrenDescendant.childrenByType", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -219,7 +219,7 @@ func (i *internalChildrenDescandantsImpl) Descendants(ctx context.Context, conte
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.childrenDescendant.descendants", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -257,7 +257,7 @@ func (i *internalChildrenDescandantsImpl) DescendantsByType(ctx context.Context,
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/descendant/%v?%v", contentID, contentType, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.childrenDescendant.descendantsByType", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -284,7 +284,7 @@ func (i *internalChildrenDescandantsImpl) CopyHierarchy(ctx context.Context, con
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/pagehierarchy/copy", contentID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.childrenDescendant.copyHierarchy", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -319,7 +319,7 @@ func (i *internalChildrenDescandantsImpl) CopyPage(ctx context.Context, contentI
 		return nil, nil, err
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.childrenDescendant.copyPage", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
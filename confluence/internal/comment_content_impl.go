@@ -25,6 +25,8 @@ type CommentService struct {
 
 // Gets returns the comments on a piece of content.
 //
+// The location filters the comments, e.g. inline, footer or resolved, the replies are returned with the children.comment expand.
+//
 // GET /wiki/rest/api/content/{id}/child/comment
 //
 // https://docs.go-atlassian.io/confluence-cloud/content/comments#get-content-comments
@@ -32,6 +34,36 @@ func (c *CommentService) Gets(ctx context.Context, contentID string, expand, loc
 	return c.internalClient.Gets(ctx, contentID, expand, location, startAt, maxResults)
 }
 
+// Create creates a footer or an inline comment on a page or a blog post.
+//
+// The inline comments need the extensions inline properties with the original selection and the marker ref,
+// the marker ref must match a marker wrapping the selection on the page storage body.
+//
+// POST /wiki/rest/api/content
+//
+// TODO: the documentation needs to be created
+func (c *CommentService) Create(ctx context.Context, payload *model.ContentCommentPayloadScheme) (*model.ContentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Create(ctx, payload)
+}
+
+// Resolve resolves an inline comment, the footer comments can't be resolved.
+//
+// PUT /wiki/api/v2/inline-comments/{comment-id}
+//
+// TODO: the documentation needs to be created
+func (c *CommentService) Resolve(ctx context.Context, commentID string) (*model.InlineCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Resolve(ctx, commentID)
+}
+
+// Reopen reopens a resolved inline comment.
+//
+// PUT /wiki/api/v2/inline-comments/{comment-id}
+//
+// TODO: the documentation needs to be created
+func (c *CommentService) Reopen(ctx context.Context, commentID string) (*model.InlineCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Reopen(ctx, commentID)
+}
+
 type internalCommentImpl struct {
 	c service.Client
 }
@@ -56,7 +88,7 @@ func (i *internalCommentImpl) Gets(ctx context.Context, contentID string, expand
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/child/comment?%v", contentID, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.comment.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -69,3 +101,111 @@ func (i *internalCommentImpl) Gets(ctx context.Context, contentID string, expand
 
 	return page, response, nil
 }
+
+func (i *internalCommentImpl) Create(ctx context.Context, payload *model.ContentCommentPayloadScheme) (*model.ContentScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.Container == nil || payload.Container.ID == "" || payload.Container.Type == "" {
+		return nil, nil, model.ErrNoContentCommentContainerError
+	}
+
+	if payload.Body == nil || payload.Body.Storage == nil || payload.Body.Storage.Value == "" {
+		return nil, nil, model.ErrNoContentCommentBodyError
+	}
+
+	if payload.Extensions != nil && payload.Extensions.Location == model.CommentLocationInline {
+
+		properties := payload.Extensions.InlineProperties
+		if properties == nil || properties.OriginalSelection == "" || properties.MarkerRef == "" {
+			return nil, nil, model.ErrNoInlineCommentAnchorError
+		}
+	}
+
+	comment := struct {
+		Type string `json:"type"`
+		*model.ContentCommentPayloadScheme
+	}{
+		Type:                        "comment",
+		ContentCommentPayloadScheme: payload,
+	}
+
+	reader, err := i.c.TransformStructToReader(&comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "content.comment.create", http.MethodPost, "wiki/rest/api/content", reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := new(model.ContentScheme)
+	response, err := i.c.Call(request, content)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return content, response, nil
+}
+
+func (i *internalCommentImpl) Resolve(ctx context.Context, commentID string) (*model.InlineCommentScheme, *model.ResponseScheme, error) {
+	return i.resolve(ctx, "content.comment.resolve", commentID, true)
+}
+
+func (i *internalCommentImpl) Reopen(ctx context.Context, commentID string) (*model.InlineCommentScheme, *model.ResponseScheme, error) {
+	return i.resolve(ctx, "content.comment.reopen", commentID, false)
+}
+
+// resolve updates the resolution of an inline comment, the update requires the next version and the current body.
+func (i *internalCommentImpl) resolve(ctx context.Context, operation, commentID string, resolved bool) (*model.InlineCommentScheme, *model.ResponseScheme, error) {
+
+	if commentID == "" {
+		return nil, nil, model.ErrNoContentCommentIDError
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/inline-comments/%v?body-format=storage", commentID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current := new(model.InlineCommentScheme)
+	response, err := i.c.Call(request, current)
+	if err != nil {
+		return nil, response, err
+	}
+
+	version := 1
+	if current.Version != nil {
+		version = current.Version.Number + 1
+	}
+
+	body := &model.BodyTypeScheme{Representation: model.BlogPostBodyFormatStorage}
+	if current.Body != nil && current.Body.Storage != nil {
+		body.Value = current.Body.Storage.Value
+	}
+
+	payload := map[string]interface{}{
+		"version":  map[string]interface{}{"number": version},
+		"body":     body,
+		"resolved": resolved,
+	}
+
+	reader, err := i.c.TransformStructToReader(&payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err = service.NewOperationRequest(ctx, i.c, operation, http.MethodPut, fmt.Sprintf("wiki/api/v2/inline-comments/%v", commentID), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.InlineCommentScheme)
+	response, err = i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
@@ -1,12 +1,15 @@
 package internal
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -131,3 +134,225 @@ func Test_internalCommentImpl_Gets(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalCommentImpl_Create(t *testing.T) {
+
+	inline := &model.ContentCommentPayloadScheme{
+		Container: &model.ContentCommentContainerScheme{ID: "2326536000", Type: "page"},
+		Body:      &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: "<p>Is this date final?</p>", Representation: "storage"}},
+		Extensions: &model.ContentExtensionScheme{
+			Location: model.CommentLocationInline,
+			InlineProperties: &model.CommentInlinePropertiesScheme{
+				OriginalSelection: "ships on March 3rd",
+				MarkerRef:         "7d8c0b4e-3f21-4a6b-9d0e-1c2f3a4b5c6d",
+			},
+		},
+	}
+
+	footer := &model.ContentCommentPayloadScheme{
+		Container: &model.ContentCommentContainerScheme{ID: "2326536000", Type: "page"},
+		Ancestors: []*model.ContentScheme{{ID: "2326536100"}},
+		Body:      &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: "<p>Agreed</p>", Representation: "storage"}},
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.ContentCommentPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the comment is inline",
+			args: args{
+				ctx:     context.Background(),
+				payload: inline,
+			},
+			want: `{
+				"type": "comment",
+				"container": {"id": "2326536000", "type": "page"},
+				"body": {"storage": {"value": "<p>Is this date final?</p>", "representation": "storage"}},
+				"extensions": {
+					"location": "inline",
+					"inlineProperties": {"originalSelection": "ships on March 3rd", "markerRef": "7d8c0b4e-3f21-4a6b-9d0e-1c2f3a4b5c6d"}
+				}
+			}`,
+		},
+
+		{
+			name: "when the comment is a footer reply",
+			args: args{
+				ctx:     context.Background(),
+				payload: footer,
+			},
+			want: `{
+				"type": "comment",
+				"container": {"id": "2326536000", "type": "page"},
+				"ancestors": [{"id": "2326536100"}],
+				"body": {"storage": {"value": "<p>Agreed</p>", "representation": "storage"}}
+			}`,
+		},
+
+		{
+			name: "when the container is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.ContentCommentPayloadScheme{Body: footer.Body},
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentCommentContainerError,
+		},
+
+		{
+			name: "when the body is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.ContentCommentPayloadScheme{Container: footer.Container},
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentCommentBodyError,
+		},
+
+		{
+			name: "when the inline comment has no anchor",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.ContentCommentPayloadScheme{
+					Container:  footer.Container,
+					Body:       footer.Body,
+					Extensions: &model.ContentExtensionScheme{Location: model.CommentLocationInline},
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoInlineCommentAnchorError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.want != "" {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.MatchedBy(func(payload interface{}) bool {
+						encoded, err := json.Marshal(payload)
+						return err == nil && assert.JSONEq(t, testCase.want, string(encoded))
+					})).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/content",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				testCase.fields.c = client
+			}
+
+			newService := NewCommentService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalCommentImpl_Resolve(t *testing.T) {
+
+	for _, resolved := range []bool{true, false} {
+
+		client := mocks.NewClient(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"wiki/api/v2/inline-comments/2326536199?body-format=storage",
+			nil).
+			Return(&http.Request{RequestURI: "get"}, nil)
+
+		client.On("Call",
+			&http.Request{RequestURI: "get"},
+			&model.InlineCommentScheme{}).
+			Run(func(args mock.Arguments) {
+				comment := args.Get(1).(*model.InlineCommentScheme)
+				comment.Version = &model.BlogPostVersionScheme{Number: 3}
+				comment.Body = &model.BlogPostBodyScheme{Storage: &model.BodyTypeScheme{Representation: "storage", Value: "<p>Is this date final?</p>"}}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("TransformStructToReader",
+			&map[string]interface{}{
+				"version":  map[string]interface{}{"number": 4},
+				"body":     &model.BodyTypeScheme{Representation: "storage", Value: "<p>Is this date final?</p>"},
+				"resolved": resolved,
+			}).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"wiki/api/v2/inline-comments/2326536199",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{RequestURI: "put"}, nil)
+
+		client.On("Call",
+			&http.Request{RequestURI: "put"},
+			&model.InlineCommentScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+
+		newService := NewCommentService(client)
+
+		var (
+			gotResult   *model.InlineCommentScheme
+			gotResponse *model.ResponseScheme
+			err         error
+		)
+
+		if resolved {
+			gotResult, gotResponse, err = newService.Resolve(context.Background(), "2326536199")
+		} else {
+			gotResult, gotResponse, err = newService.Reopen(context.Background(), "2326536199")
+		}
+
+		assert.NoError(t, err)
+		assert.NotNil(t, gotResponse)
+		assert.NotNil(t, gotResult)
+	}
+
+	_, _, err := NewCommentService(nil).Resolve(context.Background(), "")
+	assert.ErrorIs(t, err, model.ErrNoContentCommentIDError)
+}
@@ -0,0 +1,293 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"sync"
+)
+
+const (
+	defaultContentExportConcurrency = 5
+	defaultContentExportPageSize    = 50
+	defaultContentExportMaxRetries  = 3
+)
+
+func (i *internalContentImpl) ExportTree(ctx context.Context, rootPageID string, options *model.ContentExportOptionsScheme, visitor model.ContentExportVisitorFunc) (*model.ResponseScheme, error) {
+
+	if rootPageID == "" {
+		return nil, model.ErrNoContentIDError
+	}
+
+	if visitor == nil {
+		return nil, model.ErrNoContentExportVisitorError
+	}
+
+	exporter := newContentTreeExporter(i, &internalChildrenDescandantsImpl{c: i.c}, &internalContentAttachmentImpl{c: i.c}, options)
+
+	return exporter.export(ctx, rootPageID, visitor)
+}
+
+type contentTreeExporter struct {
+	content    confluence.ContentConnector
+	children   confluence.ChildrenDescendantConnector
+	attachment confluence.ContentAttachmentConnector
+
+	concurrency, pageSize, maxRetries int
+	checkpoint                        string
+	expand                            []string
+
+	semaphore chan struct{}
+	fetches   sync.WaitGroup
+}
+
+// contentExportSlot holds a page of the tree, the slots are visited in depth-first order once their page is fetched.
+type contentExportSlot struct {
+	pageID      string
+	page        *model.ContentScheme
+	attachments []*model.ContentScheme
+	response    *model.ResponseScheme
+	err         error
+	done        chan struct{}
+}
+
+func newContentTreeExporter(content confluence.ContentConnector, children confluence.ChildrenDescendantConnector,
+	attachment confluence.ContentAttachmentConnector, options *model.ContentExportOptionsScheme) *contentTreeExporter {
+
+	exporter := &contentTreeExporter{
+		content:     content,
+		children:    children,
+		attachment:  attachment,
+		concurrency: defaultContentExportConcurrency,
+		pageSize:    defaultContentExportPageSize,
+		maxRetries:  defaultContentExportMaxRetries,
+		expand:      []string{"body.storage", "version"},
+	}
+
+	if options != nil {
+
+		if options.Concurrency > 0 {
+			exporter.concurrency = options.Concurrency
+		}
+
+		if options.PageSize > 0 {
+			exporter.pageSize = options.PageSize
+		}
+
+		if options.MaxRetries > 0 {
+			exporter.maxRetries = options.MaxRetries
+		}
+
+		exporter.checkpoint = options.Checkpoint
+		exporter.expand = append(exporter.expand, options.Expand...)
+	}
+
+	exporter.semaphore = make(chan struct{}, exporter.concurrency)
+
+	return exporter
+}
+
+type contentExportWalkResult struct {
+	response *model.ResponseScheme
+	err      error
+}
+
+func (e *contentTreeExporter) export(ctx context.Context, rootPageID string, visitor model.ContentExportVisitorFunc) (*model.ResponseScheme, error) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The buffer of the slots bounds how many pages are fetched ahead of the visitor
+	slots := make(chan *contentExportSlot, e.concurrency)
+	walked := make(chan contentExportWalkResult, 1)
+
+	go func() {
+		defer close(slots)
+
+		response, err := e.walk(ctx, rootPageID, slots)
+		walked <- contentExportWalkResult{response: response, err: err}
+	}()
+
+	// stop cancels the pending requests and waits for the walk and the fetches to return
+	stop := func() {
+		cancel()
+
+		for range slots {
+		}
+
+		e.fetches.Wait()
+	}
+
+	var response *model.ResponseScheme
+	for slot := range slots {
+
+		<-slot.done
+
+		if slot.response != nil {
+			response = slot.response
+		}
+
+		if slot.err != nil {
+			stop()
+			return response, slot.err
+		}
+
+		var body string
+		if slot.page.Body != nil && slot.page.Body.Storage != nil {
+			body = slot.page.Body.Storage.Value
+		}
+
+		if err := visitor(slot.page, body, slot.attachments); err != nil {
+			stop()
+			return response, err
+		}
+	}
+
+	e.fetches.Wait()
+
+	result := <-walked
+	if result.err != nil {
+		return result.response, result.err
+	}
+
+	return response, nil
+}
+
+// walk traverses the page tree depth-first, queueing the pages after the checkpoint and fetching them concurrently.
+func (e *contentTreeExporter) walk(ctx context.Context, rootPageID string, slots chan<- *contentExportSlot) (*model.ResponseScheme, error) {
+
+	skipping := e.checkpoint != ""
+	stack := []string{rootPageID}
+
+	var response *model.ResponseScheme
+	for len(stack) != 0 {
+
+		if err := ctx.Err(); err != nil {
+			return response, err
+		}
+
+		pageID := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if skipping {
+			skipping = pageID != e.checkpoint
+		} else if err := e.queue(ctx, pageID, slots); err != nil {
+			return response, err
+		}
+
+		childIDs, childResponse, err := e.childPageIDs(ctx, pageID)
+		if childResponse != nil {
+			response = childResponse
+		}
+
+		if err != nil {
+			return response, err
+		}
+
+		// The children are pushed in reverse order, so the first child is visited first
+		for index := len(childIDs) - 1; index >= 0; index-- {
+			stack = append(stack, childIDs[index])
+		}
+	}
+
+	if skipping {
+		return response, model.ErrContentCheckpointNotFoundError
+	}
+
+	return response, nil
+}
+
+// queue waits for a free fetch, then queues the page slot and fetches the page in the background.
+func (e *contentTreeExporter) queue(ctx context.Context, pageID string, slots chan<- *contentExportSlot) error {
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case e.semaphore <- struct{}{}:
+	}
+
+	slot := &contentExportSlot{pageID: pageID, done: make(chan struct{})}
+
+	select {
+	case <-ctx.Done():
+		<-e.semaphore
+		return ctx.Err()
+	case slots <- slot:
+	}
+
+	e.fetches.Add(1)
+	go e.fetch(ctx, slot)
+
+	return nil
+}
+
+// fetch gets the page with its storage body and the attachments of the page.
+func (e *contentTreeExporter) fetch(ctx context.Context, slot *contentExportSlot) {
+
+	defer e.fetches.Done()
+	defer close(slot.done)
+	defer func() { <-e.semaphore }()
+
+	slot.response, slot.err = throttle.Retry(ctx, e.maxRetries, func() (response *model.ResponseScheme, err error) {
+		slot.page, response, err = e.content.Get(ctx, slot.pageID, e.expand, 0)
+		return response, err
+	})
+	if slot.err != nil {
+		return
+	}
+
+	for startAt := 0; ; startAt += e.pageSize {
+
+		var page *model.ContentPageScheme
+		var response *model.ResponseScheme
+		var err error
+
+		response, err = throttle.Retry(ctx, e.maxRetries, func() (response *model.ResponseScheme, err error) {
+			page, response, err = e.attachment.Gets(ctx, slot.pageID, startAt, e.pageSize, nil)
+			return response, err
+		})
+		if err != nil {
+			slot.response, slot.err = response, err
+			return
+		}
+
+		slot.response = response
+		slot.attachments = append(slot.attachments, page.Results...)
+
+		if len(page.Results) == 0 || page.Links == nil || page.Links.Next == "" {
+			return
+		}
+	}
+}
+
+// childPageIDs returns the ids of the child pages, on their position order.
+func (e *contentTreeExporter) childPageIDs(ctx context.Context, pageID string) ([]string, *model.ResponseScheme, error) {
+
+	var (
+		pageIDs  []string
+		response *model.ResponseScheme
+	)
+
+	for startAt := 0; ; startAt += e.pageSize {
+
+		var page *model.ContentPageScheme
+		var err error
+
+		response, err = throttle.Retry(ctx, e.maxRetries, func() (response *model.ResponseScheme, err error) {
+			page, response, err = e.children.ChildrenByType(ctx, pageID, "page", 0, nil, startAt, e.pageSize)
+			return response, err
+		})
+		if err != nil {
+			return nil, response, err
+		}
+
+		for _, child := range page.Results {
+			pageIDs = append(pageIDs, child.ID)
+		}
+
+		if len(page.Results) == 0 || page.Links == nil || page.Links.Next == "" {
+			return pageIDs, response, nil
+		}
+	}
+}
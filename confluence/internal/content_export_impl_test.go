@@ -0,0 +1,311 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// mockExportChildren mocks the child pages listing of a page
+func mockExportChildren(client *mocks.Client, pageID string, childIDs ...string) {
+
+	request := &http.Request{RequestURI: "children-" + pageID}
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodGet,
+		"wiki/rest/api/content/"+pageID+"/child/page?limit=50&start=0",
+		nil).
+		Return(request, nil)
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*model.ContentPageScheme)
+			for _, childID := range childIDs {
+				page.Results = append(page.Results, &model.ContentScheme{ID: childID})
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+// mockExportPage mocks the page and the attachments fetched for a page
+func mockExportPage(client *mocks.Client, pageID string, attachmentIDs ...string) {
+
+	pageRequest := &http.Request{RequestURI: "page-" + pageID}
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodGet,
+		"wiki/rest/api/content/"+pageID+"?expand=body.storage%2Cversion&version=0",
+		nil).
+		Return(pageRequest, nil)
+
+	client.On("Call",
+		pageRequest,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.ContentScheme) = model.ContentScheme{
+				ID:   pageID,
+				Body: &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: "<p>" + pageID + "</p>", Representation: "storage"}},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	attachmentRequest := &http.Request{RequestURI: "attachment-" + pageID}
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodGet,
+		"wiki/rest/api/content/"+pageID+"/child/attachment?limit=50&start=0",
+		nil).
+		Return(attachmentRequest, nil)
+
+	client.On("Call",
+		attachmentRequest,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*model.ContentPageScheme)
+			for _, attachmentID := range attachmentIDs {
+				page.Results = append(page.Results, &model.ContentScheme{ID: attachmentID, Type: "attachment"})
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+func Test_internalContentImpl_ExportTree(t *testing.T) {
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx        context.Context
+		rootPageID string
+		options    *model.ContentExportOptionsScheme
+		visitorErr error
+		noVisitor  bool
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantVisited []string
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name: "when the tree is exported",
+			args: args{
+				ctx:        context.Background(),
+				rootPageID: "1",
+				options:    &model.ContentExportOptionsScheme{Concurrency: 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockExportChildren(client, "1", "2", "3")
+				mockExportChildren(client, "2", "4")
+				mockExportChildren(client, "3")
+				mockExportChildren(client, "4")
+
+				mockExportPage(client, "1", "att-1")
+				mockExportPage(client, "2")
+				mockExportPage(client, "3", "att-3a", "att-3b")
+				mockExportPage(client, "4")
+
+				fields.c = client
+			},
+			wantVisited: []string{"1:<p>1</p>:att-1", "2:<p>2</p>:", "4:<p>4</p>:", "3:<p>3</p>:att-3a,att-3b"},
+		},
+
+		{
+			name: "when the export resumes from a checkpoint",
+			args: args{
+				ctx:        context.Background(),
+				rootPageID: "1",
+				options:    &model.ContentExportOptionsScheme{Checkpoint: "2"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockExportChildren(client, "1", "2", "3")
+				mockExportChildren(client, "2", "4")
+				mockExportChildren(client, "3")
+				mockExportChildren(client, "4")
+
+				mockExportPage(client, "3")
+				mockExportPage(client, "4")
+
+				fields.c = client
+			},
+			wantVisited: []string{"4:<p>4</p>:", "3:<p>3</p>:"},
+		},
+
+		{
+			name: "when the checkpoint is not part of the tree",
+			args: args{
+				ctx:        context.Background(),
+				rootPageID: "1",
+				options:    &model.ContentExportOptionsScheme{Checkpoint: "99"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockExportChildren(client, "1")
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrContentCheckpointNotFoundError,
+		},
+
+		{
+			name: "when the visitor returns an error",
+			args: args{
+				ctx:        context.Background(),
+				rootPageID: "1",
+				visitorErr: errors.New("disk full"),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockExportChildren(client, "1")
+				mockExportPage(client, "1")
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("disk full"),
+		},
+
+		{
+			name: "when the page cannot be fetched",
+			args: args{
+				ctx:        context.Background(),
+				rootPageID: "1",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockExportChildren(client, "1")
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodGet,
+					"wiki/rest/api/content/1?expand=body.storage%2Cversion&version=0",
+					nil).
+					Return(&http.Request{}, errors.New("client: no http request created"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("client: no http request created"),
+		},
+
+		{
+			name: "when the context is cancelled",
+			args: args{
+				ctx:        cancelledCtx,
+				rootPageID: "1",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name: "when the root page id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentIDError,
+		},
+
+		{
+			name: "when the visitor is not provided",
+			args: args{
+				ctx:        context.Background(),
+				rootPageID: "1",
+				noVisitor:  true,
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentExportVisitorError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentService(testCase.fields.c, &ContentSubServices{})
+
+			var (
+				mu      sync.Mutex
+				visited []string
+			)
+
+			visitor := func(page *model.ContentScheme, body string, attachments []*model.ContentScheme) error {
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				var attachmentIDs string
+				for index, attachment := range attachments {
+					if index != 0 {
+						attachmentIDs += ","
+					}
+					attachmentIDs += attachment.ID
+				}
+
+				visited = append(visited, page.ID+":"+body+":"+attachmentIDs)
+				return testCase.args.visitorErr
+			}
+
+			var visitorFunc model.ContentExportVisitorFunc = visitor
+			if testCase.args.noVisitor {
+				visitorFunc = nil
+			}
+
+			_, err := newService.ExportTree(testCase.args.ctx, testCase.args.rootPageID, testCase.args.options, visitorFunc)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.wantVisited, visited)
+			}
+		})
+	}
+}
@@ -146,6 +146,21 @@ func (c *ContentService) Archive(ctx context.Context, payload *model.ContentArch
 	return c.internalClient.Archive(ctx, payload)
 }
 
+// ExportTree walks a page and its descendant pages depth-first, calling the visitor with the storage body
+//
+// and the attachments of each page. The pages are fetched concurrently but visited in depth-first order.
+//
+// The export resumes after the checkpoint page when options.Checkpoint is set.
+//
+// GET /wiki/rest/api/content/{id}
+//
+// GET /wiki/rest/api/content/{id}/child/page
+//
+// GET /wiki/rest/api/content/{id}/child/attachment
+func (c *ContentService) ExportTree(ctx context.Context, rootPageID string, options *model.ContentExportOptionsScheme, visitor model.ContentExportVisitorFunc) (*model.ResponseScheme, error) {
+	return c.internalClient.ExportTree(ctx, rootPageID, options, visitor)
+}
+
 type internalContentImpl struct {
 	c service.Client
 }
@@ -194,7 +209,7 @@ func (i *internalContentImpl) Gets(ctx context.Context, options *model.GetConten
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content?%v", query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -217,7 +232,7 @@ func (i *internalContentImpl) Create(ctx context.Context, payload *model.Content
 
 	endpoint := "wiki/rest/api/content"
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -255,7 +270,7 @@ func (i *internalContentImpl) Search(ctx context.Context, cql, cqlContext string
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/search?%v", query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -284,7 +299,7 @@ func (i *internalContentImpl) Get(ctx context.Context, contentID string, expand
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v?%v", contentID, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -311,7 +326,7 @@ func (i *internalContentImpl) Update(ctx context.Context, contentID string, payl
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v", contentID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -341,7 +356,7 @@ func (i *internalContentImpl) Delete(ctx context.Context, contentID, status stri
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.delete", http.MethodDelete, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -365,7 +380,7 @@ func (i *internalContentImpl) History(ctx context.Context, contentID string, exp
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.history", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -388,7 +403,7 @@ func (i *internalContentImpl) Archive(ctx context.Context, payload *model.Conten
 
 	endpoint := "wiki/rest/api/content/archive"
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.archive", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
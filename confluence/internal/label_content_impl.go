@@ -70,7 +70,7 @@ func (i *internalContentLabelImpl) Gets(ctx context.Context, contentID, prefix s
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/label?%v", contentID, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.label.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,7 +105,7 @@ func (i *internalContentLabelImpl) Add(ctx context.Context, contentID string, pa
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.label.add", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,7 +131,7 @@ func (i *internalContentLabelImpl) Remove(ctx context.Context, contentID, labelN
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/label/%v", contentID, labelName)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.label.remove", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
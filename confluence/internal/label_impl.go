@@ -49,7 +49,7 @@ func (i *internalLabelImpl) Get(ctx context.Context, labelName, labelType string
 
 	endpoint := fmt.Sprintf("wiki/rest/api/label?%v", query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "label.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
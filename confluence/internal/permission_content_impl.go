@@ -59,7 +59,7 @@ func (i *internalPermissionImpl) Check(ctx context.Context, contentID string, pa
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/permission/check", contentID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.permission.check", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
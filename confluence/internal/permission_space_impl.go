@@ -74,7 +74,7 @@ func (i *internalSpacePermissionImpl) Add(ctx context.Context, spaceKey string,
 
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/permission", spaceKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.permission.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,7 +101,7 @@ func (i *internalSpacePermissionImpl) Bulk(ctx context.Context, spaceKey string,
 
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/permission/custom-content", spaceKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.permission.bulk", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +117,7 @@ func (i *internalSpacePermissionImpl) Remove(ctx context.Context, spaceKey strin
 
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/permission/%v", spaceKey, permissionID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.permission.remove", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
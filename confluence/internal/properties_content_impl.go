@@ -79,7 +79,7 @@ func (i *internalPropertyImpl) Gets(ctx context.Context, contentID string, expan
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/property?%v", contentID, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.property.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -106,7 +106,7 @@ func (i *internalPropertyImpl) Create(ctx context.Context, contentID string, pay
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/property", contentID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.property.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,7 +132,7 @@ func (i *internalPropertyImpl) Get(ctx context.Context, contentID, key string) (
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/property/%v", contentID, key)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.property.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -158,7 +158,7 @@ func (i *internalPropertyImpl) Delete(ctx context.Context, contentID, key string
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/property/%v", contentID, key)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.property.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +81,7 @@ func (i *internalRestrictionImpl) Gets(ctx context.Context, contentID string, ex
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/restriction?%v", contentID, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,7 +116,7 @@ func (i *internalRestrictionImpl) Add(ctx context.Context, contentID string, pay
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.add", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,7 +146,7 @@ func (i *internalRestrictionImpl) Delete(ctx context.Context, contentID string,
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.delete", http.MethodDelete, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,7 +181,7 @@ func (i *internalRestrictionImpl) Update(ctx context.Context, contentID string,
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.update", http.MethodPut, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -69,7 +69,7 @@ func (i *internalRestrictionOperationImpl) Gets(ctx context.Context, contentID s
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.gets", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,7 +103,7 @@ func (i *internalRestrictionOperationImpl) Get(ctx context.Context, contentID, o
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/restriction/byOperation/%v?%v", contentID, operationKey, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
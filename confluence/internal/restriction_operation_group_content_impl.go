@@ -88,7 +88,7 @@ func (i *internalRestrictionOperationGroupImpl) Get(ctx context.Context, content
 		endpoint.WriteString(fmt.Sprintf("group/%v", groupNameOrID))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.group.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +123,7 @@ func (i *internalRestrictionOperationGroupImpl) Add(ctx context.Context, content
 		endpoint.WriteString(fmt.Sprintf("group/%v", groupNameOrID))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.group.add", http.MethodPut, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +158,7 @@ func (i *internalRestrictionOperationGroupImpl) Remove(ctx context.Context, cont
 		endpoint.WriteString(fmt.Sprintf("group/%v", groupNameOrID))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.group.remove", http.MethodDelete, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +75,7 @@ func (i *internalRestrictionOperationUserImpl) Get(ctx context.Context, contentI
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/restriction/byOperation/%v/user?%v", contentID, operationKey, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.user.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +102,7 @@ func (i *internalRestrictionOperationUserImpl) Add(ctx context.Context, contentI
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/restriction/byOperation/%v/user?%v", contentID, operationKey, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.user.add", http.MethodPut, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +129,7 @@ func (i *internalRestrictionOperationUserImpl) Remove(ctx context.Context, conte
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/restriction/byOperation/%v/user?%v", contentID, operationKey, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.restriction.operation.user.remove", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +109,7 @@ func (i *internalSearchImpl) Content(ctx context.Context, cql string, options *m
 
 	endpoint := fmt.Sprintf("wiki/rest/api/search?%v", query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "search.content", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -140,7 +140,7 @@ func (i *internalSearchImpl) Users(ctx context.Context, cql string, start, limit
 
 	endpoint := fmt.Sprintf("wiki/rest/api/search/user?%v", query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "search.users", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
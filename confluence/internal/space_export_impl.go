@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultSpaceExportPollInterval is the interval between the export long task polls
+const defaultSpaceExportPollInterval = 2 * time.Second
+
+func NewSpaceExportService(client service.StreamClient) *SpaceExportService {
+
+	return &SpaceExportService{
+		internalClient: &internalSpaceExportImpl{c: client, task: &internalTaskImpl{c: client}},
+	}
+}
+
+type SpaceExportService struct {
+	internalClient confluence.SpaceExportConnector
+}
+
+// Export triggers the HTML or XML export of a space, polls the long task until the export finishes
+// and streams the archive to the writer, only the download link is returned when the writer is nil.
+//
+// The Cloud REST API doesn't document the space export, the export endpoint is only available on the sites
+// with the space export REST resource, ErrSpaceExportUnsupportedError is returned on the other sites
+// and ErrSpaceExportNotPermittedError when the site plan or the user permissions don't allow the export.
+//
+// POST /wiki/rest/api/space/{spaceKey}/export
+//
+// TODO: the documentation needs to be created
+func (s *SpaceExportService) Export(ctx context.Context, spaceKey, format string, writer io.Writer, options *model.SpaceExportOptionsScheme) (
+	*model.SpaceExportScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Export(ctx, spaceKey, format, writer, options)
+}
+
+type internalSpaceExportImpl struct {
+	c    service.StreamClient
+	task confluence.TaskConnector
+}
+
+func (i *internalSpaceExportImpl) Export(ctx context.Context, spaceKey, format string, writer io.Writer, options *model.SpaceExportOptionsScheme) (
+	*model.SpaceExportScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKeyError
+	}
+
+	format = strings.ToUpper(format)
+	if format != model.SpaceExportHTML && format != model.SpaceExportXML {
+		return nil, nil, model.ErrInvalidSpaceExportFormatError
+	}
+
+	pollInterval := defaultSpaceExportPollInterval
+	if options != nil && options.PollInterval > 0 {
+		pollInterval = options.PollInterval
+	}
+
+	taskID, response, err := i.trigger(ctx, spaceKey, format)
+	if err != nil {
+		return nil, response, err
+	}
+
+	export := &model.SpaceExportScheme{SpaceKey: spaceKey, Format: format, TaskID: taskID}
+
+	task, response, err := i.wait(ctx, taskID, pollInterval)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if task.AdditionalDetails != nil {
+		export.DownloadURL = task.AdditionalDetails.DestinationURL
+	}
+
+	if export.DownloadURL == "" {
+		return nil, response, model.ErrNoSpaceExportDownloadError
+	}
+
+	if writer == nil {
+		return export, response, nil
+	}
+
+	export.Bytes, err = i.download(ctx, export.DownloadURL, writer)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return export, response, nil
+}
+
+// trigger starts the export, the forbidden and missing endpoint status codes are mapped to the space export errors.
+func (i *internalSpaceExportImpl) trigger(ctx context.Context, spaceKey, format string) (string, *model.ResponseScheme, error) {
+
+	payload := struct {
+		ExportType string `json:"exportType"`
+	}{
+		ExportType: format,
+	}
+
+	reader, err := i.c.TransformStructToReader(&payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/export", spaceKey)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "space.export.export", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return "", nil, err
+	}
+
+	task := struct {
+		ID     string `json:"id"`
+		TaskID string `json:"taskId"`
+	}{}
+
+	response, err := i.c.Call(request, &task)
+	if err != nil {
+
+		if response != nil {
+
+			switch response.Code {
+			case http.StatusPaymentRequired, http.StatusForbidden:
+				return "", response, model.ErrSpaceExportNotPermittedError
+			case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+				return "", response, model.ErrSpaceExportUnsupportedError
+			}
+		}
+
+		return "", response, err
+	}
+
+	if task.ID == "" {
+		task.ID = task.TaskID
+	}
+
+	if task.ID == "" {
+		return "", response, model.ErrSpaceExportUnsupportedError
+	}
+
+	return task.ID, response, nil
+}
+
+// wait polls the long task until it finishes, the error messages of the failed tasks are joined to the error.
+func (i *internalSpaceExportImpl) wait(ctx context.Context, taskID string, pollInterval time.Duration) (*model.LongTaskScheme, *model.ResponseScheme, error) {
+
+	for {
+
+		task, response, err := i.task.Get(ctx, taskID)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if task.Finished {
+
+			if task.Successful {
+				return task, response, nil
+			}
+
+			var messages []string
+			for _, message := range append(task.Errors, task.Messages...) {
+				if message != nil && message.Translation != "" {
+					messages = append(messages, message.Translation)
+				}
+			}
+
+			if len(messages) == 0 {
+				return nil, response, model.ErrSpaceExportFailedError
+			}
+
+			return nil, response, fmt.Errorf("%w: %v", model.ErrSpaceExportFailedError, strings.Join(messages, ", "))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, response, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// download streams the export archive to the writer, it returns the number of bytes written.
+func (i *internalSpaceExportImpl) download(ctx context.Context, downloadURL string, writer io.Writer) (int64, error) {
+
+	request, err := service.NewOperationRequest(ctx, i.c, "space.export.download", http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Accept", "*/*")
+
+	response, err := i.c.Stream(request)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return 0, model.ErrInvalidStatusCodeError
+	}
+
+	return io.Copy(writer, response.Body)
+}
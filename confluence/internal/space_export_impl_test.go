@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// spaceExportClientMock is a client returning the archive on the stream requests
+type spaceExportClientMock struct {
+	*mocks.Client
+	archive  string
+	requests []*http.Request
+}
+
+func (s *spaceExportClientMock) Stream(request *http.Request) (*http.Response, error) {
+
+	s.requests = append(s.requests, request)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(s.archive)),
+		Request:    request,
+	}, nil
+}
+
+// mockSpaceExportTrigger mocks the export trigger of the ENG space
+func mockSpaceExportTrigger(client *mocks.Client, code int, err error) {
+
+	client.On("TransformStructToReader",
+		&struct {
+			ExportType string `json:"exportType"`
+		}{ExportType: "XML"}).
+		Return(bytes.NewReader([]byte{}), nil)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"wiki/rest/api/space/ENG/export",
+		bytes.NewReader([]byte{})).
+		Return(&http.Request{RequestURI: "export"}, nil)
+
+	client.On("Call",
+		&http.Request{RequestURI: "export"},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			if err == nil {
+				args.Get(1).(*struct {
+					ID     string `json:"id"`
+					TaskID string `json:"taskId"`
+				}).TaskID = "task-id"
+			}
+		}).
+		Return(&model.ResponseScheme{Code: code}, err)
+}
+
+// mockSpaceExportTask mocks the polls of the export long task, the task finishes on the last poll
+func mockSpaceExportTask(client *mocks.Client, polls int, finished *model.LongTaskScheme) {
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"wiki/rest/api/longtask/task-id",
+		nil).
+		Return(&http.Request{RequestURI: "task"}, nil)
+
+	if polls > 1 {
+
+		client.On("Call",
+			&http.Request{RequestURI: "task"},
+			&model.LongTaskScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.LongTaskScheme).PercentageComplete = 50
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Times(polls - 1)
+	}
+
+	client.On("Call",
+		&http.Request{RequestURI: "task"},
+		&model.LongTaskScheme{}).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.LongTaskScheme) = *finished
+		}).
+		Return(&model.ResponseScheme{}, nil).
+		Once()
+}
+
+func Test_internalSpaceExportImpl_Export(t *testing.T) {
+
+	options := &model.SpaceExportOptionsScheme{PollInterval: time.Millisecond}
+	finished := &model.LongTaskScheme{
+		Finished:          true,
+		Successful:        true,
+		AdditionalDetails: &model.LongTaskDetailsScheme{DestinationURL: "https://ctreminiom.atlassian.net/wiki/download/temp/export.zip"},
+	}
+
+	t.Run("when the archive is downloaded", func(t *testing.T) {
+
+		client := &spaceExportClientMock{Client: mocks.NewClient(t), archive: "archive"}
+
+		mockSpaceExportTrigger(client.Client, http.StatusOK, nil)
+		mockSpaceExportTask(client.Client, 2, finished)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"https://ctreminiom.atlassian.net/wiki/download/temp/export.zip",
+			nil).
+			Return(&http.Request{RequestURI: "download", Header: http.Header{}}, nil)
+
+		var archive bytes.Buffer
+		export, _, err := NewSpaceExportService(client).Export(context.Background(), "ENG", "xml", &archive, options)
+		assert.NoError(t, err)
+
+		assert.Equal(t, &model.SpaceExportScheme{
+			SpaceKey:    "ENG",
+			Format:      "XML",
+			TaskID:      "task-id",
+			DownloadURL: "https://ctreminiom.atlassian.net/wiki/download/temp/export.zip",
+			Bytes:       7,
+		}, export)
+
+		assert.Equal(t, "archive", archive.String())
+		assert.Len(t, client.requests, 1)
+	})
+
+	t.Run("when only the download link is requested", func(t *testing.T) {
+
+		client := &spaceExportClientMock{Client: mocks.NewClient(t)}
+
+		mockSpaceExportTrigger(client.Client, http.StatusOK, nil)
+		mockSpaceExportTask(client.Client, 1, finished)
+
+		export, _, err := NewSpaceExportService(client).Export(context.Background(), "ENG", "XML", nil, options)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://ctreminiom.atlassian.net/wiki/download/temp/export.zip", export.DownloadURL)
+		assert.Empty(t, client.requests)
+	})
+
+	t.Run("when the export is not permitted", func(t *testing.T) {
+
+		client := &spaceExportClientMock{Client: mocks.NewClient(t)}
+
+		mockSpaceExportTrigger(client.Client, http.StatusForbidden, model.ErrInvalidStatusCodeError)
+
+		_, _, err := NewSpaceExportService(client).Export(context.Background(), "ENG", "XML", nil, options)
+		assert.Equal(t, model.ErrSpaceExportNotPermittedError, err)
+	})
+
+	t.Run("when the export endpoint is not available", func(t *testing.T) {
+
+		client := &spaceExportClientMock{Client: mocks.NewClient(t)}
+
+		mockSpaceExportTrigger(client.Client, http.StatusNotFound, model.ErrInvalidStatusCodeError)
+
+		_, _, err := NewSpaceExportService(client).Export(context.Background(), "ENG", "XML", nil, options)
+		assert.Equal(t, model.ErrSpaceExportUnsupportedError, err)
+	})
+
+	t.Run("when the export fails", func(t *testing.T) {
+
+		client := &spaceExportClientMock{Client: mocks.NewClient(t)}
+
+		mockSpaceExportTrigger(client.Client, http.StatusOK, nil)
+		mockSpaceExportTask(client.Client, 1, &model.LongTaskScheme{
+			Finished: true,
+			Errors:   []*model.LongTaskMessageScheme{{Translation: "The export exceeds the size limit"}},
+		})
+
+		_, _, err := NewSpaceExportService(client).Export(context.Background(), "ENG", "XML", nil, options)
+		assert.True(t, errors.Is(err, model.ErrSpaceExportFailedError))
+		assert.EqualError(t, err, "confluence: the space export failed: The export exceeds the size limit")
+	})
+
+	t.Run("when the parameters are not valid", func(t *testing.T) {
+
+		newService := NewSpaceExportService(nil)
+
+		_, _, err := newService.Export(context.Background(), "", "XML", nil, nil)
+		assert.Equal(t, model.ErrNoSpaceKeyError, err)
+
+		_, _, err = newService.Export(context.Background(), "ENG", "PDF", nil, nil)
+		assert.Equal(t, model.ErrInvalidSpaceExportFormatError, err)
+	})
+}
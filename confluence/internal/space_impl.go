@@ -12,17 +12,19 @@ import (
 	"strings"
 )
 
-func NewSpaceService(client service.Client, permission *SpacePermissionService) *SpaceService {
+func NewSpaceService(client service.Client, permission *SpacePermissionService, export *SpaceExportService) *SpaceService {
 
 	return &SpaceService{
 		internalClient: &internalSpaceImpl{c: client},
 		Permission:     permission,
+		Export:         export,
 	}
 }
 
 type SpaceService struct {
 	internalClient confluence.SpaceConnector
 	Permission     *SpacePermissionService
+	Export         *SpaceExportService
 }
 
 // Gets returns all spaces.
@@ -104,6 +106,29 @@ func (s *SpaceService) ContentByType(ctx context.Context, spaceKey, contentType,
 	return s.internalClient.ContentByType(ctx, spaceKey, contentType, depth, expand, startAt, maxResults)
 }
 
+// SubscribeUser adds a space watch for the user and, when includeExistingPages is set, adds a content watch
+// to each page of the space, watching the given number of pages at the same time.
+//
+// The pages that couldn't be watched are listed on the report, the walk continues after them.
+//
+// When the walk is interrupted, the report is returned with the error and a resume token, set it on the options to
+// resume the subscription.
+//
+// The rate limited requests are retried following the Retry-After header.
+func (s *SpaceService) SubscribeUser(ctx context.Context, spaceKey, accountID string, includeExistingPages bool, concurrency int,
+	options *model.SpaceSubscriptionOptionsScheme) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error) {
+	return s.internalClient.SubscribeUser(ctx, spaceKey, accountID, includeExistingPages, concurrency, options)
+}
+
+// UnsubscribeUserEverywhere removes the space watches of the user and the content watches of the pages and blog posts
+// of the spaces, the content watches of every space are removed when no space keys are set.
+//
+// The watched contents are found with the watcher CQL field, the unsubscription is idempotent, so it can be run again.
+func (s *SpaceService) UnsubscribeUserEverywhere(ctx context.Context, accountID string, spaceKeys []string,
+	options *model.SpaceSubscriptionOptionsScheme) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error) {
+	return s.internalClient.UnsubscribeUserEverywhere(ctx, accountID, spaceKeys, options)
+}
+
 type internalSpaceImpl struct {
 	c service.Client
 }
@@ -157,7 +182,7 @@ func (i *internalSpaceImpl) Gets(ctx context.Context, options *model.GetSpacesOp
 
 	var endpoint = fmt.Sprintf("wiki/rest/api/space?%v", query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,7 +218,7 @@ func (i *internalSpaceImpl) Create(ctx context.Context, payload *model.CreateSpa
 		endpoint.WriteString("/_private")
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.create", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -223,7 +248,7 @@ func (i *internalSpaceImpl) Get(ctx context.Context, spaceKey string, expand []s
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -250,7 +275,7 @@ func (i *internalSpaceImpl) Update(ctx context.Context, spaceKey string, payload
 
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v", spaceKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -272,7 +297,7 @@ func (i *internalSpaceImpl) Delete(ctx context.Context, spaceKey string) (*model
 
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v", spaceKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -306,7 +331,7 @@ func (i *internalSpaceImpl) Content(ctx context.Context, spaceKey, depth string,
 
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/content?%v", spaceKey, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.content", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -340,7 +365,7 @@ func (i *internalSpaceImpl) ContentByType(ctx context.Context, spaceKey, content
 
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/content/%v?%v", spaceKey, contentType, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "space.contentByType", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
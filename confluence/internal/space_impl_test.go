@@ -113,7 +113,7 @@ func Test_internalSpaceImpl_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewSpaceService(testCase.fields.c, nil)
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
 
 			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.options, testCase.args.startAt,
 				testCase.args.maxResults)
@@ -227,7 +227,7 @@ func Test_internalSpaceImpl_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewSpaceService(testCase.fields.c, nil)
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
 
 			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.spaceKey, testCase.args.expand)
 
@@ -347,7 +347,7 @@ func Test_internalSpaceImpl_Content(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewSpaceService(testCase.fields.c, nil)
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
 
 			gotResult, gotResponse, err := newService.Content(testCase.args.ctx, testCase.args.spaceKey, testCase.args.depth,
 				testCase.args.expand, testCase.args.startAt, testCase.args.maxResults)
@@ -470,7 +470,7 @@ func Test_internalSpaceImpl_ContentByType(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewSpaceService(testCase.fields.c, nil)
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
 
 			gotResult, gotResponse, err := newService.ContentByType(testCase.args.ctx, testCase.args.spaceKey,
 				testCase.args.contentType, testCase.args.depth, testCase.args.expand, testCase.args.startAt,
@@ -582,7 +582,7 @@ func Test_internalSpaceImpl_Delete(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewSpaceService(testCase.fields.c, nil)
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
 
 			gotResult, gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.spaceKey)
 
@@ -746,7 +746,7 @@ func Test_internalSpaceImpl_Create(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewSpaceService(testCase.fields.c, nil)
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
 
 			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.payload, testCase.args.private)
 
@@ -872,7 +872,7 @@ func Test_internalSpaceImpl_Update(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewSpaceService(testCase.fields.c, nil)
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
 
 			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.spaceKey, testCase.args.payload)
 
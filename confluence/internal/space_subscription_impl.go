@@ -0,0 +1,333 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/confluence/cql"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"sync"
+)
+
+const (
+	defaultSpaceSubscriptionConcurrency = 5
+	defaultSpaceSubscriptionPageSize    = 100
+	defaultSpaceSubscriptionMaxRetries  = 3
+)
+
+func (i *internalSpaceImpl) SubscribeUser(ctx context.Context, spaceKey, accountID string, includeExistingPages bool, concurrency int,
+	options *model.SpaceSubscriptionOptionsScheme) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKeyError
+	}
+
+	if accountID == "" {
+		return nil, nil, model.ErrNoAccountIDError
+	}
+
+	subscriber := newSpaceSubscriber(i, &internalUserWatchImpl{c: i.c}, &internalSearchImpl{c: i.c}, accountID, concurrency, options)
+
+	return subscriber.subscribe(ctx, spaceKey, includeExistingPages)
+}
+
+func (i *internalSpaceImpl) UnsubscribeUserEverywhere(ctx context.Context, accountID string, spaceKeys []string,
+	options *model.SpaceSubscriptionOptionsScheme) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, model.ErrNoAccountIDError
+	}
+
+	subscriber := newSpaceSubscriber(i, &internalUserWatchImpl{c: i.c}, &internalSearchImpl{c: i.c}, accountID, 0, options)
+
+	return subscriber.unsubscribe(ctx, spaceKeys)
+}
+
+type spaceSubscriber struct {
+	space  confluence.SpaceConnector
+	watch  confluence.UserWatchConnector
+	search confluence.SearchConnector
+
+	accountID                         string
+	concurrency, pageSize, maxRetries int
+	resumeToken                       string
+	progress                          func(processed, failed int)
+
+	mu                sync.Mutex
+	processed, failed int
+}
+
+// spaceSubscriptionToken is the state encoded on the resume tokens
+type spaceSubscriptionToken struct {
+	SpaceKey  string `json:"spaceKey"`
+	AccountID string `json:"accountId"`
+	StartAt   int    `json:"startAt"`
+}
+
+func newSpaceSubscriber(space confluence.SpaceConnector, watch confluence.UserWatchConnector, search confluence.SearchConnector,
+	accountID string, concurrency int, options *model.SpaceSubscriptionOptionsScheme) *spaceSubscriber {
+
+	subscriber := &spaceSubscriber{
+		space:       space,
+		watch:       watch,
+		search:      search,
+		accountID:   accountID,
+		concurrency: defaultSpaceSubscriptionConcurrency,
+		pageSize:    defaultSpaceSubscriptionPageSize,
+		maxRetries:  defaultSpaceSubscriptionMaxRetries,
+	}
+
+	if concurrency > 0 {
+		subscriber.concurrency = concurrency
+	}
+
+	if options != nil {
+
+		if options.PageSize > 0 {
+			subscriber.pageSize = options.PageSize
+		}
+
+		if options.MaxRetries > 0 {
+			subscriber.maxRetries = options.MaxRetries
+		}
+
+		subscriber.resumeToken = options.ResumeToken
+		subscriber.progress = options.Progress
+	}
+
+	return subscriber
+}
+
+func (s *spaceSubscriber) subscribe(ctx context.Context, spaceKey string, includeExistingPages bool) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error) {
+
+	report := &model.SpaceSubscriptionReportScheme{AccountID: s.accountID}
+
+	var (
+		response *model.ResponseScheme
+		err      error
+		startAt  int
+	)
+
+	// The space watch is added before the resume token is issued, so the resumed subscriptions only walk the pages
+	if s.resumeToken != "" {
+
+		token, err := decodeSpaceSubscriptionToken(s.resumeToken)
+		if err != nil || token.SpaceKey != spaceKey || token.AccountID != s.accountID {
+			return nil, nil, model.ErrInvalidResumeTokenError
+		}
+
+		startAt = token.StartAt
+
+	} else {
+
+		response, err = throttle.Retry(ctx, s.maxRetries, func() (*model.ResponseScheme, error) {
+			return s.watch.AddSpace(ctx, spaceKey, s.accountID)
+		})
+		if err != nil {
+			return nil, response, err
+		}
+
+		report.Spaces = append(report.Spaces, spaceKey)
+	}
+
+	if !includeExistingPages {
+		return report, response, nil
+	}
+
+	for ; ; startAt += s.pageSize {
+
+		var page *model.ContentPageScheme
+
+		pageResponse, err := throttle.Retry(ctx, s.maxRetries, func() (response *model.ResponseScheme, err error) {
+			page, response, err = s.space.ContentByType(ctx, spaceKey, "page", "all", nil, startAt, s.pageSize)
+			return response, err
+		})
+		if pageResponse != nil {
+			response = pageResponse
+		}
+
+		if err != nil {
+			report.ResumeToken = encodeSpaceSubscriptionToken(spaceKey, s.accountID, startAt)
+			return report, response, err
+		}
+
+		contentIDs := make([]string, len(page.Results))
+		for index, content := range page.Results {
+			contentIDs[index] = content.ID
+		}
+
+		s.apply(ctx, report, spaceKey, contentIDs, func(contentID string) (*model.ResponseScheme, error) {
+			return s.watch.AddContent(ctx, contentID, s.accountID)
+		})
+
+		// The pages of an interrupted batch are watched again when the subscription is resumed, the watches are idempotent
+		if ctx.Err() != nil {
+			report.ResumeToken = encodeSpaceSubscriptionToken(spaceKey, s.accountID, startAt)
+			return report, response, ctx.Err()
+		}
+
+		if len(page.Results) == 0 || page.Links == nil || page.Links.Next == "" {
+			return report, response, nil
+		}
+	}
+}
+
+func (s *spaceSubscriber) unsubscribe(ctx context.Context, spaceKeys []string) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error) {
+
+	report := &model.SpaceSubscriptionReportScheme{AccountID: s.accountID}
+
+	var response *model.ResponseScheme
+	for _, spaceKey := range spaceKeys {
+
+		spaceResponse, err := throttle.Retry(ctx, s.maxRetries, func() (*model.ResponseScheme, error) {
+			return s.watch.RemoveSpace(ctx, spaceKey, s.accountID)
+		})
+		if spaceResponse != nil {
+			response = spaceResponse
+		}
+
+		if ctx.Err() != nil {
+			return report, response, ctx.Err()
+		}
+
+		if err != nil {
+			report.Failed = append(report.Failed, &model.SpaceSubscriptionFailureScheme{SpaceKey: spaceKey, Err: err})
+			continue
+		}
+
+		report.Spaces = append(report.Spaces, spaceKey)
+	}
+
+	query := cql.Field("watcher", "=", s.accountID).And(cql.Type("page", "blogpost"))
+	if len(spaceKeys) != 0 {
+		query = query.And(cql.Space(spaceKeys...))
+	}
+
+	// The watched contents are listed before the watches are removed, removing them would shift the search pages
+	var contentIDs []string
+	for startAt := 0; ; startAt += s.pageSize {
+
+		var page *model.SearchPageScheme
+
+		searchResponse, err := throttle.Retry(ctx, s.maxRetries, func() (response *model.ResponseScheme, err error) {
+			page, response, err = s.search.Content(ctx, query.String(), &model.SearchContentOptions{Start: startAt, Limit: s.pageSize})
+			return response, err
+		})
+		if searchResponse != nil {
+			response = searchResponse
+		}
+
+		if err != nil {
+			return report, response, err
+		}
+
+		for _, result := range page.Results {
+			if result.Content != nil {
+				contentIDs = append(contentIDs, result.Content.ID)
+			}
+		}
+
+		if len(page.Results) == 0 || startAt+len(page.Results) >= page.TotalSize {
+			break
+		}
+	}
+
+	s.apply(ctx, report, "", contentIDs, func(contentID string) (*model.ResponseScheme, error) {
+		return s.watch.RemoveContent(ctx, contentID, s.accountID)
+	})
+
+	return report, response, ctx.Err()
+}
+
+// apply calls the watch function for each content concurrently, the contents are added to the report on their listed order.
+func (s *spaceSubscriber) apply(ctx context.Context, report *model.SpaceSubscriptionReportScheme, spaceKey string, contentIDs []string,
+	call func(contentID string) (*model.ResponseScheme, error)) {
+
+	errs := make([]error, len(contentIDs))
+	semaphore := make(chan struct{}, s.concurrency)
+
+	var wg sync.WaitGroup
+	for index, contentID := range contentIDs {
+
+		select {
+		case <-ctx.Done():
+		case semaphore <- struct{}{}:
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(index int, contentID string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			_, errs[index] = throttle.Retry(ctx, s.maxRetries, func() (*model.ResponseScheme, error) {
+				return call(contentID)
+			})
+
+			s.done(ctx, errs[index])
+		}(index, contentID)
+	}
+
+	wg.Wait()
+
+	// The calls interrupted by the cancellation are not reported, they're retried when the subscription is resumed
+	if ctx.Err() != nil {
+		return
+	}
+
+	for index, contentID := range contentIDs {
+
+		if errs[index] != nil {
+			report.Failed = append(report.Failed, &model.SpaceSubscriptionFailureScheme{SpaceKey: spaceKey, ContentID: contentID, Err: errs[index]})
+			continue
+		}
+
+		report.Contents = append(report.Contents, contentID)
+	}
+}
+
+// done counts a processed content and calls the progress function, the calls are serialized.
+func (s *spaceSubscriber) done(ctx context.Context, err error) {
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processed++
+	if err != nil {
+		s.failed++
+	}
+
+	if s.progress != nil {
+		s.progress(s.processed, s.failed)
+	}
+}
+
+func encodeSpaceSubscriptionToken(spaceKey, accountID string, startAt int) string {
+
+	token, _ := json.Marshal(&spaceSubscriptionToken{SpaceKey: spaceKey, AccountID: accountID, StartAt: startAt})
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func decodeSpaceSubscriptionToken(resumeToken string) (*spaceSubscriptionToken, error) {
+
+	decoded, err := base64.RawURLEncoding.DecodeString(resumeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token := new(spaceSubscriptionToken)
+	if err := json.Unmarshal(decoded, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
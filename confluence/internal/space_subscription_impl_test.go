@@ -0,0 +1,322 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// mockUserWatch mocks a watch added or removed for the account-id-sample user
+func mockUserWatch(client *mocks.Client, method, kind, keyOrID string, err error) {
+
+	request := &http.Request{Method: method, RequestURI: kind + "-" + keyOrID}
+
+	client.On("NewRequest",
+		mock.Anything,
+		method,
+		"wiki/rest/api/user/watch/"+kind+"/"+keyOrID+"?accountId=account-id-sample",
+		nil).
+		Return(request, nil)
+
+	client.On("Call",
+		request,
+		nil).
+		Return(&model.ResponseScheme{}, err)
+}
+
+// mockSpacePages mocks a page of the pages of the ENG space
+func mockSpacePages(client *mocks.Client, endpoint string, err error, pageIDs ...string) {
+
+	request := &http.Request{RequestURI: endpoint}
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodGet,
+		endpoint,
+		nil).
+		Return(request, nil)
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*model.ContentPageScheme)
+			for _, pageID := range pageIDs {
+				page.Results = append(page.Results, &model.ContentScheme{ID: pageID})
+			}
+		}).
+		Return(&model.ResponseScheme{}, err)
+}
+
+func Test_internalSpaceImpl_SubscribeUser(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx                  context.Context
+		spaceKey, accountID  string
+		includeExistingPages bool
+		options              *model.SpaceSubscriptionOptionsScheme
+	}
+
+	testCases := []struct {
+		name         string
+		fields       fields
+		args         args
+		on           func(*fields)
+		wantSpaces   []string
+		wantContents []string
+		wantFailed   []string
+		wantResume   bool
+		wantErr      bool
+		Err          error
+	}{
+		{
+			name: "when the space and its pages are watched",
+			args: args{
+				ctx:                  context.Background(),
+				spaceKey:             "ENG",
+				accountID:            "account-id-sample",
+				includeExistingPages: true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockUserWatch(client, http.MethodPost, "space", "ENG", nil)
+				mockSpacePages(client, "wiki/rest/api/space/ENG/content/page?depth=all&limit=100&start=0", nil, "1", "2", "3")
+				mockUserWatch(client, http.MethodPost, "content", "1", nil)
+				mockUserWatch(client, http.MethodPost, "content", "2", errors.New("error, request failed. Please fix the request"))
+				mockUserWatch(client, http.MethodPost, "content", "3", nil)
+
+				fields.c = client
+			},
+			wantSpaces:   []string{"ENG"},
+			wantContents: []string{"1", "3"},
+			wantFailed:   []string{"2"},
+		},
+
+		{
+			name: "when the existing pages are not included",
+			args: args{
+				ctx:       context.Background(),
+				spaceKey:  "ENG",
+				accountID: "account-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockUserWatch(client, http.MethodPost, "space", "ENG", nil)
+
+				fields.c = client
+			},
+			wantSpaces: []string{"ENG"},
+		},
+
+		{
+			name: "when the subscription is resumed",
+			args: args{
+				ctx:                  context.Background(),
+				spaceKey:             "ENG",
+				accountID:            "account-id-sample",
+				includeExistingPages: true,
+				options: &model.SpaceSubscriptionOptionsScheme{
+					PageSize:    2,
+					ResumeToken: encodeSpaceSubscriptionToken("ENG", "account-id-sample", 2),
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockSpacePages(client, "wiki/rest/api/space/ENG/content/page?depth=all&limit=2&start=2", nil, "3")
+				mockUserWatch(client, http.MethodPost, "content", "3", nil)
+
+				fields.c = client
+			},
+			wantContents: []string{"3"},
+		},
+
+		{
+			name: "when the pages cannot be listed",
+			args: args{
+				ctx:                  context.Background(),
+				spaceKey:             "ENG",
+				accountID:            "account-id-sample",
+				includeExistingPages: true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockUserWatch(client, http.MethodPost, "space", "ENG", nil)
+				mockSpacePages(client, "wiki/rest/api/space/ENG/content/page?depth=all&limit=100&start=0",
+					errors.New("error, request failed. Please fix the request"))
+
+				fields.c = client
+			},
+			wantSpaces: []string{"ENG"},
+			wantResume: true,
+			wantErr:    true,
+			Err:        errors.New("error, request failed. Please fix the request"),
+		},
+
+		{
+			name: "when the resume token belongs to another space",
+			args: args{
+				ctx:       context.Background(),
+				spaceKey:  "ENG",
+				accountID: "account-id-sample",
+				options: &model.SpaceSubscriptionOptionsScheme{
+					ResumeToken: encodeSpaceSubscriptionToken("OPS", "account-id-sample", 100),
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidResumeTokenError,
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:       context.Background(),
+				accountID: "account-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKeyError,
+		},
+
+		{
+			name: "when the account id is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "ENG",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSpaceService(testCase.fields.c, nil, nil)
+
+			gotReport, _, err := newService.SubscribeUser(testCase.args.ctx, testCase.args.spaceKey, testCase.args.accountID,
+				testCase.args.includeExistingPages, 2, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+				if !testCase.wantResume {
+					return
+				}
+
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NotNil(t, gotReport)
+			assert.Equal(t, testCase.wantSpaces, gotReport.Spaces)
+			assert.Equal(t, testCase.wantContents, gotReport.Contents)
+			assert.Equal(t, testCase.wantResume, gotReport.ResumeToken != "")
+
+			var gotFailed []string
+			for _, failure := range gotReport.Failed {
+				gotFailed = append(gotFailed, failure.ContentID)
+			}
+
+			assert.Equal(t, testCase.wantFailed, gotFailed)
+		})
+	}
+}
+
+func Test_internalSpaceImpl_SubscribeUser_Progress(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	mockUserWatch(client, http.MethodPost, "space", "ENG", nil)
+	mockSpacePages(client, "wiki/rest/api/space/ENG/content/page?depth=all&limit=100&start=0", nil, "1", "2")
+	mockUserWatch(client, http.MethodPost, "content", "1", nil)
+	mockUserWatch(client, http.MethodPost, "content", "2", errors.New("error, request failed. Please fix the request"))
+
+	var processed, failed int
+	options := &model.SpaceSubscriptionOptionsScheme{
+		Progress: func(gotProcessed, gotFailed int) {
+			processed, failed = gotProcessed, gotFailed
+		},
+	}
+
+	_, _, err := NewSpaceService(client, nil, nil).SubscribeUser(context.Background(), "ENG", "account-id-sample", true, 1, options)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, 1, failed)
+}
+
+func Test_internalSpaceImpl_UnsubscribeUserEverywhere(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	mockUserWatch(client, http.MethodDelete, "space", "ENG", nil)
+	mockUserWatch(client, http.MethodDelete, "space", "OPS", errors.New("error, request failed. Please fix the request"))
+
+	searchRequest := &http.Request{RequestURI: "search"}
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodGet,
+		mock.MatchedBy(func(endpoint string) bool {
+			return strings.HasPrefix(endpoint, "wiki/rest/api/search?") &&
+				strings.Contains(endpoint, "watcher+%3D+%22account-id-sample%22") &&
+				strings.Contains(endpoint, "space+in+%28%22ENG%22%2C+%22OPS%22%29")
+		}),
+		nil).
+		Return(searchRequest, nil)
+
+	client.On("Call",
+		searchRequest,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*model.SearchPageScheme)
+			page.TotalSize = 2
+			page.Results = []*model.SearchResultScheme{
+				{Content: &model.ContentScheme{ID: "10"}},
+				{Content: &model.ContentScheme{ID: "20"}},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	mockUserWatch(client, http.MethodDelete, "content", "10", nil)
+	mockUserWatch(client, http.MethodDelete, "content", "20", nil)
+
+	gotReport, _, err := NewSpaceService(client, nil, nil).UnsubscribeUserEverywhere(context.Background(), "account-id-sample",
+		[]string{"ENG", "OPS"}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ENG"}, gotReport.Spaces)
+	assert.Equal(t, []string{"10", "20"}, gotReport.Contents)
+	assert.Len(t, gotReport.Failed, 1)
+	assert.Equal(t, "OPS", gotReport.Failed[0].SpaceKey)
+
+	_, _, err = NewSpaceService(client, nil, nil).UnsubscribeUserEverywhere(context.Background(), "", nil, nil)
+	assert.Equal(t, model.ErrNoAccountIDError, err)
+}
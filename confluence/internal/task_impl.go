@@ -56,7 +56,7 @@ func (i *internalTaskImpl) Gets(ctx context.Context, start, limit int) (*model.L
 
 	endpoint := fmt.Sprintf("wiki/rest/api/longtask?%v", query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "longTask.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,7 +74,7 @@ func (i *internalTaskImpl) Get(ctx context.Context, taskID string) (*model.LongT
 
 	endpoint := fmt.Sprintf("wiki/rest/api/longtask/%v", taskID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "longTask.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
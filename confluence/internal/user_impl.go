@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func NewUserService(client service.Client, watch *UserWatchService) *UserService {
+
+	return &UserService{
+		internalClient: &internalUserImpl{c: client},
+		Watch:          watch,
+	}
+}
+
+type UserService struct {
+	internalClient confluence.UserConnector
+	Watch          *UserWatchService
+}
+
+// Groups returns the groups that a user is a member of.
+//
+// GET /wiki/rest/api/user/memberof
+func (u *UserService) Groups(ctx context.Context, accountID string, startAt, maxResults int) (*model.UserMembershipPageScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Groups(ctx, accountID, startAt, maxResults)
+}
+
+type internalUserImpl struct {
+	c service.Client
+}
+
+func (i *internalUserImpl) Groups(ctx context.Context, accountID string, startAt, maxResults int) (*model.UserMembershipPageScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, model.ErrNoAccountIDError
+	}
+
+	query := url.Values{}
+	query.Add("accountId", accountID)
+	query.Add("start", strconv.Itoa(startAt))
+	query.Add("limit", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("wiki/rest/api/user/memberof?%v", query.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "user.groups", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.UserMembershipPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
@@ -0,0 +1,164 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"net/http"
+	"net/url"
+)
+
+func NewUserWatchService(client service.Client) *UserWatchService {
+
+	return &UserWatchService{
+		internalClient: &internalUserWatchImpl{c: client},
+	}
+}
+
+type UserWatchService struct {
+	internalClient confluence.UserWatchConnector
+}
+
+// Content returns whether a user is watching a piece of content.
+//
+// GET /wiki/rest/api/user/watch/content/{contentId}
+func (w *UserWatchService) Content(ctx context.Context, contentID, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Content(ctx, contentID, accountID)
+}
+
+// AddContent adds a user as a watcher to a piece of content.
+//
+// POST /wiki/rest/api/user/watch/content/{contentId}
+func (w *UserWatchService) AddContent(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.AddContent(ctx, contentID, accountID)
+}
+
+// RemoveContent removes a user as a watcher from a piece of content.
+//
+// DELETE /wiki/rest/api/user/watch/content/{contentId}
+func (w *UserWatchService) RemoveContent(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.RemoveContent(ctx, contentID, accountID)
+}
+
+// Space returns whether a user is watching a space.
+//
+// GET /wiki/rest/api/user/watch/space/{spaceKey}
+func (w *UserWatchService) Space(ctx context.Context, spaceKey, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Space(ctx, spaceKey, accountID)
+}
+
+// AddSpace adds a user as a watcher to a space.
+//
+// POST /wiki/rest/api/user/watch/space/{spaceKey}
+func (w *UserWatchService) AddSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.AddSpace(ctx, spaceKey, accountID)
+}
+
+// RemoveSpace removes a user as a watcher from a space.
+//
+// DELETE /wiki/rest/api/user/watch/space/{spaceKey}
+func (w *UserWatchService) RemoveSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.RemoveSpace(ctx, spaceKey, accountID)
+}
+
+type internalUserWatchImpl struct {
+	c service.Client
+}
+
+func (i *internalUserWatchImpl) Content(ctx context.Context, contentID, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentIDError
+	}
+
+	return i.status(ctx, "user.watch.content", "content", contentID, accountID)
+}
+
+func (i *internalUserWatchImpl) AddContent(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, model.ErrNoContentIDError
+	}
+
+	return i.call(ctx, "user.watch.addContent", http.MethodPost, "content", contentID, accountID)
+}
+
+func (i *internalUserWatchImpl) RemoveContent(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, model.ErrNoContentIDError
+	}
+
+	return i.call(ctx, "user.watch.removeContent", http.MethodDelete, "content", contentID, accountID)
+}
+
+func (i *internalUserWatchImpl) Space(ctx context.Context, spaceKey, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKeyError
+	}
+
+	return i.status(ctx, "user.watch.space", "space", spaceKey, accountID)
+}
+
+func (i *internalUserWatchImpl) AddSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, model.ErrNoSpaceKeyError
+	}
+
+	return i.call(ctx, "user.watch.addSpace", http.MethodPost, "space", spaceKey, accountID)
+}
+
+func (i *internalUserWatchImpl) RemoveSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, model.ErrNoSpaceKeyError
+	}
+
+	return i.call(ctx, "user.watch.removeSpace", http.MethodDelete, "space", spaceKey, accountID)
+}
+
+func (i *internalUserWatchImpl) status(ctx context.Context, operation, kind, keyOrID, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, model.ErrNoAccountIDError
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodGet, userWatchEndpoint(kind, keyOrID, accountID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.WatchStatusScheme)
+	response, err := i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
+
+func (i *internalUserWatchImpl) call(ctx context.Context, operation, method, kind, keyOrID, accountID string) (*model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, model.ErrNoAccountIDError
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, operation, method, userWatchEndpoint(kind, keyOrID, accountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func userWatchEndpoint(kind, keyOrID, accountID string) string {
+
+	query := url.Values{}
+	query.Add("accountId", accountID)
+
+	return fmt.Sprintf("wiki/rest/api/user/watch/%v/%v?%v", kind, keyOrID, query.Encode())
+}
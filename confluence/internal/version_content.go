@@ -83,7 +83,7 @@ func (i *internalVersionImpl) Gets(ctx context.Context, contentID string, expand
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/version?%v", contentID, query.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.version.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -113,7 +113,7 @@ func (i *internalVersionImpl) Get(ctx context.Context, contentID string, version
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.version.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,7 +148,7 @@ func (i *internalVersionImpl) Restore(ctx context.Context, contentID string, pay
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.version.restore", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -170,7 +170,7 @@ func (i *internalVersionImpl) Delete(ctx context.Context, contentID string, vers
 
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/version/%v", contentID, versionNumber)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "content.version.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,245 @@
+package helpers
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultAccessReviewConcurrency = 5
+	confluenceMembershipPageSize   = 200
+)
+
+// NewAccessReviewService returns a service reviewing the groups and the Jira application roles of the users.
+//
+// The services are taken from clients already authenticated by the caller, e.g. jiraClient.User, jiraClient.Role
+// and confluenceClient.User, the Jira services can be taken from the v2 or the v3 client.
+//
+// A nil service skips its source, but at least one of the user services must be set.
+func NewAccessReviewService(jiraUser jira.UserConnector, jiraRole jira.AppRoleConnector, confluenceUser confluence.UserConnector) (*AccessReviewService, error) {
+
+	if jiraUser == nil && confluenceUser == nil {
+		return nil, model.ErrNoAccessReviewSourceError
+	}
+
+	return &AccessReviewService{
+		jiraUser:       jiraUser,
+		jiraRole:       jiraRole,
+		confluenceUser: confluenceUser,
+	}, nil
+}
+
+type AccessReviewService struct {
+	jiraUser       jira.UserConnector
+	jiraRole       jira.AppRoleConnector
+	confluenceUser confluence.UserConnector
+}
+
+// Review returns the groups of each user on Jira and Confluence, and the Jira application roles granted by those groups.
+//
+// The users are reviewed concurrently, 5 users at a time by default. A failed request doesn't stop the review,
+// it's added to the report errors and the data of the other sources is still returned.
+//
+// The users are returned on the order of the account ids, the groups are sorted by name, ignoring the case, and the roles by key.
+func (a *AccessReviewService) Review(ctx context.Context, accountIDs []string, options *model.AccessReviewOptionsScheme) (*model.AccessReviewReportScheme, error) {
+
+	reviews := newUserReviews(accountIDs)
+	if len(reviews) == 0 {
+		return nil, model.ErrNoAccountSliceError
+	}
+
+	concurrency := defaultAccessReviewConcurrency
+	if options != nil && options.Concurrency > 0 {
+		concurrency = options.Concurrency
+	}
+
+	var (
+		roles    []*model.ApplicationRoleScheme
+		rolesErr error
+		fetches  sync.WaitGroup
+	)
+
+	if a.jiraRole != nil {
+
+		fetches.Add(1)
+		go func() {
+			defer fetches.Done()
+			roles, _, rolesErr = a.jiraRole.Gets(ctx)
+		}()
+	}
+
+	queue := make(chan *userReview)
+	for worker := 0; worker < concurrency; worker++ {
+
+		fetches.Add(1)
+		go func() {
+			defer fetches.Done()
+
+			for review := range queue {
+				a.review(ctx, review)
+			}
+		}()
+	}
+
+	for _, review := range reviews {
+		queue <- review
+	}
+
+	close(queue)
+	fetches.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	report := &model.AccessReviewReportScheme{}
+	if rolesErr != nil {
+		report.Errors = append(report.Errors, &model.AccessReviewErrorScheme{Source: model.AccessReviewSourceJiraApplicationRoles, Err: rolesErr})
+	}
+
+	for _, review := range reviews {
+		report.Users = append(report.Users, review.user(roles))
+		report.Errors = append(report.Errors, review.errors...)
+	}
+
+	return report, nil
+}
+
+// review fetches the groups of a user on every source, the sources are queried on a fixed order.
+func (a *AccessReviewService) review(ctx context.Context, review *userReview) {
+
+	if a.jiraUser != nil {
+
+		groups, _, err := a.jiraUser.Groups(ctx, review.accountID)
+		if err != nil {
+			review.fail(model.AccessReviewSourceJira, err)
+		} else {
+			for _, group := range groups {
+				review.add(model.AccessReviewSourceJira, group.Name, "")
+			}
+		}
+	}
+
+	if a.confluenceUser != nil {
+
+		for startAt := 0; ; startAt += confluenceMembershipPageSize {
+
+			page, _, err := a.confluenceUser.Groups(ctx, review.accountID, startAt, confluenceMembershipPageSize)
+			if err != nil {
+				review.fail(model.AccessReviewSourceConfluence, err)
+				break
+			}
+
+			for _, group := range page.Results {
+				review.add(model.AccessReviewSourceConfluence, group.Name, group.ID)
+			}
+
+			if len(page.Results) == 0 || page.Links == nil || page.Links.Next == "" {
+				break
+			}
+		}
+	}
+}
+
+// userReview holds the groups of a user, it's only used by one worker at a time.
+type userReview struct {
+	accountID string
+	groups    map[string]*model.AccessReviewGroupScheme
+	errors    []*model.AccessReviewErrorScheme
+}
+
+// newUserReviews returns a review per account id, the duplicated and the empty account ids are skipped.
+func newUserReviews(accountIDs []string) []*userReview {
+
+	var (
+		reviews []*userReview
+		seen    = make(map[string]bool, len(accountIDs))
+	)
+
+	for _, accountID := range accountIDs {
+
+		if accountID == "" || seen[accountID] {
+			continue
+		}
+
+		seen[accountID] = true
+		reviews = append(reviews, &userReview{accountID: accountID, groups: make(map[string]*model.AccessReviewGroupScheme)})
+	}
+
+	return reviews
+}
+
+func (u *userReview) fail(source string, err error) {
+	u.errors = append(u.errors, &model.AccessReviewErrorScheme{Source: source, AccountID: u.accountID, Err: err})
+}
+
+// add merges the group with the groups of the other sources, the group names are case-insensitive.
+func (u *userReview) add(source, name, id string) {
+
+	key := strings.ToLower(name)
+
+	group, ok := u.groups[key]
+	if !ok {
+		group = &model.AccessReviewGroupScheme{Name: name}
+		u.groups[key] = group
+	}
+
+	if group.ID == "" {
+		group.ID = id
+	}
+
+	for _, groupSource := range group.Sources {
+		if groupSource == source {
+			return
+		}
+	}
+
+	group.Sources = append(group.Sources, source)
+}
+
+// user returns the reviewed user, joining the groups of the user with the groups of the application roles.
+func (u *userReview) user(roles []*model.ApplicationRoleScheme) *model.AccessReviewUserScheme {
+
+	user := &model.AccessReviewUserScheme{AccountID: u.accountID}
+
+	for _, group := range u.groups {
+		user.Groups = append(user.Groups, group)
+	}
+
+	// The groups are unique by their lower case name, so the lower case names are enough to sort them
+	sort.Slice(user.Groups, func(i, j int) bool {
+		return strings.ToLower(user.Groups[i].Name) < strings.ToLower(user.Groups[j].Name)
+	})
+
+	for _, role := range roles {
+
+		var granted []string
+		for _, roleGroup := range role.Groups {
+			if group, ok := u.groups[strings.ToLower(roleGroup)]; ok {
+				granted = append(granted, group.Name)
+			}
+		}
+
+		if len(granted) == 0 {
+			continue
+		}
+
+		sort.Strings(granted)
+		user.ApplicationRoles = append(user.ApplicationRoles, &model.AccessReviewApplicationRoleScheme{
+			Key:    role.Key,
+			Name:   role.Name,
+			Groups: granted,
+		})
+	}
+
+	sort.Slice(user.ApplicationRoles, func(i, j int) bool {
+		return user.ApplicationRoles[i].Key < user.ApplicationRoles[j].Key
+	})
+
+	return user
+}
@@ -0,0 +1,161 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/confluence"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeJiraUser struct {
+	jira.UserConnector
+	groups map[string][]string
+	err    error
+}
+
+func (f *fakeJiraUser) Groups(ctx context.Context, accountID string) ([]*model.UserGroupScheme, *model.ResponseScheme, error) {
+
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	var groups []*model.UserGroupScheme
+	for _, name := range f.groups[accountID] {
+		groups = append(groups, &model.UserGroupScheme{Name: name})
+	}
+
+	return groups, &model.ResponseScheme{}, nil
+}
+
+type fakeJiraRole struct {
+	jira.AppRoleConnector
+	roles []*model.ApplicationRoleScheme
+	err   error
+}
+
+func (f *fakeJiraRole) Gets(ctx context.Context) ([]*model.ApplicationRoleScheme, *model.ResponseScheme, error) {
+	return f.roles, &model.ResponseScheme{}, f.err
+}
+
+type fakeConfluenceUser struct {
+	confluence.UserConnector
+	pages map[string][]*model.UserMembershipPageScheme
+	err   error
+}
+
+func (f *fakeConfluenceUser) Groups(ctx context.Context, accountID string, startAt, maxResults int) (*model.UserMembershipPageScheme, *model.ResponseScheme, error) {
+
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	pages := f.pages[accountID]
+	if index := startAt / maxResults; index < len(pages) {
+		return pages[index], &model.ResponseScheme{}, nil
+	}
+
+	return &model.UserMembershipPageScheme{}, &model.ResponseScheme{}, nil
+}
+
+func TestAccessReviewService_Review(t *testing.T) {
+
+	jiraUser := &fakeJiraUser{groups: map[string][]string{
+		"account-1": {"jira-software-users", "Developers"},
+		"account-2": {"site-admins"},
+	}}
+
+	jiraRole := &fakeJiraRole{roles: []*model.ApplicationRoleScheme{
+		{Key: "jira-software", Name: "Jira Software", Groups: []string{"jira-software-users", "site-admins"}},
+		{Key: "jira-core", Name: "Jira Work Management", Groups: []string{"developers"}},
+	}}
+
+	confluenceUser := &fakeConfluenceUser{pages: map[string][]*model.UserMembershipPageScheme{
+		"account-1": {
+			{
+				Results: []*model.SpaceGroupScheme{{Name: "developers", ID: "group-1"}},
+				Links:   &model.LinkScheme{Next: "/wiki/rest/api/user/memberof?start=200"},
+			},
+			{
+				Results: []*model.SpaceGroupScheme{{Name: "confluence-users", ID: "group-2"}},
+			},
+		},
+	}}
+
+	t.Run("when the groups are merged across the sources", func(t *testing.T) {
+
+		reviewService, err := NewAccessReviewService(jiraUser, jiraRole, confluenceUser)
+		assert.NoError(t, err)
+
+		report, err := reviewService.Review(context.Background(), []string{"account-2", "account-1", "account-2", ""},
+			&model.AccessReviewOptionsScheme{Concurrency: 2})
+		assert.NoError(t, err)
+		assert.Empty(t, report.Errors)
+
+		assert.Len(t, report.Users, 2)
+		assert.Equal(t, "account-2", report.Users[0].AccountID)
+		assert.Equal(t, "account-1", report.Users[1].AccountID)
+
+		user := report.Users[1]
+		assert.Equal(t, []*model.AccessReviewGroupScheme{
+			{Name: "confluence-users", ID: "group-2", Sources: []string{model.AccessReviewSourceConfluence}},
+			{Name: "Developers", ID: "group-1", Sources: []string{model.AccessReviewSourceJira, model.AccessReviewSourceConfluence}},
+			{Name: "jira-software-users", Sources: []string{model.AccessReviewSourceJira}},
+		}, user.Groups)
+
+		assert.Equal(t, []*model.AccessReviewApplicationRoleScheme{
+			{Key: "jira-core", Name: "Jira Work Management", Groups: []string{"Developers"}},
+			{Key: "jira-software", Name: "Jira Software", Groups: []string{"jira-software-users"}},
+		}, user.ApplicationRoles)
+	})
+
+	t.Run("when a source is unavailable", func(t *testing.T) {
+
+		unavailable := &fakeConfluenceUser{err: errors.New("confluence: service unavailable")}
+
+		reviewService, err := NewAccessReviewService(jiraUser, &fakeJiraRole{err: errors.New("jira: forbidden")}, unavailable)
+		assert.NoError(t, err)
+
+		report, err := reviewService.Review(context.Background(), []string{"account-1", "account-2"}, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, report.Users, 2)
+		assert.Len(t, report.Users[0].Groups, 2)
+		assert.Empty(t, report.Users[0].ApplicationRoles)
+
+		assert.Len(t, report.Errors, 3)
+		assert.Equal(t, model.AccessReviewSourceJiraApplicationRoles, report.Errors[0].Source)
+		assert.Equal(t, "account-1", report.Errors[1].AccountID)
+		assert.Equal(t, model.AccessReviewSourceConfluence, report.Errors[1].Source)
+		assert.Equal(t, "account-2", report.Errors[2].AccountID)
+	})
+
+	t.Run("when the context is cancelled", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		reviewService, err := NewAccessReviewService(jiraUser, nil, nil)
+		assert.NoError(t, err)
+
+		_, err = reviewService.Review(ctx, []string{"account-1"}, nil)
+		assert.EqualError(t, err, context.Canceled.Error())
+	})
+
+	t.Run("when the account ids are not provided", func(t *testing.T) {
+
+		reviewService, err := NewAccessReviewService(jiraUser, nil, nil)
+		assert.NoError(t, err)
+
+		_, err = reviewService.Review(context.Background(), []string{""}, nil)
+		assert.EqualError(t, err, model.ErrNoAccountSliceError.Error())
+	})
+
+	t.Run("when no user service is provided", func(t *testing.T) {
+
+		_, err := NewAccessReviewService(nil, jiraRole, nil)
+		assert.EqualError(t, err, model.ErrNoAccessReviewSourceError.Error())
+	})
+}
@@ -0,0 +1,330 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"strconv"
+	"strings"
+)
+
+const (
+	fieldAuditPageSize      = 50
+	fieldAuditPositionFirst = "First"
+)
+
+// NewFieldAuditService returns a service comparing the field configurations and the screens with a desired spec.
+//
+// The services are taken from a Jira client already authenticated by the caller, e.g. client.Issue.Field.Configuration.Item,
+// client.Screen.Tab and client.Screen.Tab.Field. A nil service can't audit its part of the spec, but at least the field
+// configuration items or the screen services must be set.
+func NewFieldAuditService(items jira.FieldConfigItemConnector, tabs jira.ScreenTabConnector, tabFields jira.ScreenTabFieldConnector) (*FieldAuditService, error) {
+
+	if items == nil && (tabs == nil || tabFields == nil) {
+		return nil, model.ErrNoFieldAuditSourceError
+	}
+
+	return &FieldAuditService{
+		items:     items,
+		tabs:      tabs,
+		tabFields: tabFields,
+	}, nil
+}
+
+type FieldAuditService struct {
+	items     jira.FieldConfigItemConnector
+	tabs      jira.ScreenTabConnector
+	tabFields jira.ScreenTabFieldConnector
+}
+
+// Audit reads the live field configurations and screens of the spec and returns their drifts from the spec.
+//
+// When the options ask for a plan, the report also contains the API calls reconciling the drifts, the plan is a dry run,
+// no call is sent. The first failed request stops the audit, a partial report would hide drifts.
+func (f *FieldAuditService) Audit(ctx context.Context, spec *model.FieldAuditSpecScheme, options *model.FieldAuditOptionsScheme) (*model.FieldAuditReportScheme, error) {
+
+	if spec == nil || (len(spec.FieldConfigurations) == 0 && len(spec.Screens) == 0) {
+		return nil, model.ErrNoFieldAuditSpecError
+	}
+
+	if len(spec.FieldConfigurations) != 0 && f.items == nil {
+		return nil, fmt.Errorf("%w: the field configurations need the field configuration item service", model.ErrNoFieldAuditSourceError)
+	}
+
+	if len(spec.Screens) != 0 && (f.tabs == nil || f.tabFields == nil) {
+		return nil, fmt.Errorf("%w: the screens need the screen tab and the screen tab field services", model.ErrNoFieldAuditSourceError)
+	}
+
+	report := &model.FieldAuditReportScheme{}
+	plan := options != nil && options.Plan
+
+	for _, configuration := range spec.FieldConfigurations {
+		if err := f.auditFieldConfiguration(ctx, configuration, report, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, screen := range spec.Screens {
+		if err := f.auditScreen(ctx, screen, report, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// auditFieldConfiguration compares the items of the fields of the spec, the drifted items are updated by a single call.
+func (f *FieldAuditService) auditFieldConfiguration(ctx context.Context, spec *model.FieldConfigurationSpecScheme, report *model.FieldAuditReportScheme, plan bool) error {
+
+	live, err := f.fieldConfigurationItems(ctx, spec.ID)
+	if err != nil {
+		return err
+	}
+
+	drift := func(kind, fieldID, want, got string) {
+		report.Drifts = append(report.Drifts, &model.FieldAuditDriftScheme{
+			Kind:                 kind,
+			FieldConfigurationID: spec.ID,
+			FieldID:              fieldID,
+			Want:                 want,
+			Got:                  got,
+		})
+	}
+
+	var updates []*model.FieldConfigurationItemScheme
+	for _, want := range spec.Fields {
+
+		got, ok := live[want.ID]
+		if !ok {
+			drift(model.FieldAuditDriftMissingField, want.ID, want.ID, "")
+			continue
+		}
+
+		drifts := len(report.Drifts)
+
+		if want.IsRequired != got.IsRequired {
+			drift(model.FieldAuditDriftRequired, want.ID, strconv.FormatBool(want.IsRequired), strconv.FormatBool(got.IsRequired))
+		}
+
+		if want.IsHidden != got.IsHidden {
+			drift(model.FieldAuditDriftHidden, want.ID, strconv.FormatBool(want.IsHidden), strconv.FormatBool(got.IsHidden))
+		}
+
+		if want.Renderer != "" && want.Renderer != got.Renderer {
+			drift(model.FieldAuditDriftRenderer, want.ID, want.Renderer, got.Renderer)
+		}
+
+		if len(report.Drifts) == drifts {
+			continue
+		}
+
+		renderer := want.Renderer
+		if renderer == "" {
+			renderer = got.Renderer
+		}
+
+		// The flags are always sent, the false flags are omitted otherwise and the update wouldn't unset them
+		updates = append(updates, &model.FieldConfigurationItemScheme{
+			ID:             want.ID,
+			IsHidden:       want.IsHidden,
+			IsRequired:     want.IsRequired,
+			Description:    got.Description,
+			Renderer:       renderer,
+			ExplicitFields: []string{"isHidden", "isRequired"},
+		})
+	}
+
+	if plan && len(updates) != 0 {
+		report.Plan = append(report.Plan, &model.FieldAuditStepScheme{
+			Operation:            model.FieldAuditStepUpdateItems,
+			FieldConfigurationID: spec.ID,
+			Items:                &model.UpdateFieldConfigurationItemPayloadScheme{FieldConfigurationItems: updates},
+		})
+	}
+
+	return nil
+}
+
+// fieldConfigurationItems returns every item of the field configuration, mapped by field id.
+func (f *FieldAuditService) fieldConfigurationItems(ctx context.Context, id int) (map[string]*model.FieldConfigurationItemScheme, error) {
+
+	items := make(map[string]*model.FieldConfigurationItemScheme)
+
+	for startAt := 0; ; {
+
+		page, _, err := f.items.Gets(ctx, id, startAt, fieldAuditPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range page.Values {
+			items[item.ID] = item
+		}
+
+		startAt += len(page.Values)
+
+		if page.IsLast || len(page.Values) == 0 || (page.Total != 0 && startAt >= page.Total) {
+			return items, nil
+		}
+	}
+}
+
+// auditScreen compares the tabs of the spec with the live tabs of the screen.
+//
+// The plan removes the fields first, a field can only be on a tab of the screen, then creates the tabs, adds the fields
+// at the end of their tab and finally moves the fields of the tabs not on the spec order.
+func (f *FieldAuditService) auditScreen(ctx context.Context, spec *model.ScreenSpecScheme, report *model.FieldAuditReportScheme, plan bool) error {
+
+	tabs, _, err := f.tabs.Gets(ctx, spec.ID, "")
+	if err != nil {
+		return err
+	}
+
+	var (
+		tabsByName  = make(map[string]*model.ScreenTabScheme, len(tabs))
+		tabFields   = make(map[int][]string, len(tabs))
+		fieldTabs   = make(map[string]*model.ScreenTabScheme)
+		wantedTabs  = make(map[string]string)
+		removals    []*model.FieldAuditStepScheme
+		creations   []*model.FieldAuditStepScheme
+		additions   []*model.FieldAuditStepScheme
+		movements   []*model.FieldAuditStepScheme
+		screenDrift = func(kind, tab, fieldID, want, got string) {
+			report.Drifts = append(report.Drifts, &model.FieldAuditDriftScheme{
+				Kind:     kind,
+				ScreenID: spec.ID,
+				Tab:      tab,
+				FieldID:  fieldID,
+				Want:     want,
+				Got:      got,
+			})
+		}
+	)
+
+	for _, tab := range tabs {
+
+		fields, _, err := f.tabFields.Gets(ctx, spec.ID, tab.ID)
+		if err != nil {
+			return err
+		}
+
+		tabsByName[tab.Name] = tab
+		for _, field := range fields {
+			tabFields[tab.ID] = append(tabFields[tab.ID], field.ID)
+			fieldTabs[field.ID] = tab
+		}
+	}
+
+	for _, tab := range spec.Tabs {
+		for _, fieldID := range tab.Fields {
+			wantedTabs[fieldID] = tab.Name
+		}
+	}
+
+	for _, want := range spec.Tabs {
+
+		tab, ok := tabsByName[want.Name]
+		if !ok {
+			screenDrift(model.FieldAuditDriftMissingTab, want.Name, "", want.Name, "")
+			creations = append(creations, &model.FieldAuditStepScheme{Operation: model.FieldAuditStepCreateTab, ScreenID: spec.ID, TabName: want.Name})
+			tab = &model.ScreenTabScheme{Name: want.Name}
+		}
+
+		// The fields staying on the tab keep their live order, the added fields are appended on the spec order
+		var order []string
+		for _, fieldID := range tabFields[tab.ID] {
+
+			if wantedTabs[fieldID] == want.Name {
+				order = append(order, fieldID)
+				continue
+			}
+
+			if wantedTabs[fieldID] == "" {
+				screenDrift(model.FieldAuditDriftExtraField, want.Name, fieldID, "", want.Name)
+				removals = append(removals, &model.FieldAuditStepScheme{Operation: model.FieldAuditStepRemoveTabField, ScreenID: spec.ID,
+					TabID: tab.ID, TabName: tab.Name, FieldID: fieldID})
+			}
+		}
+
+		if live := strings.Join(order, ","); live != strings.Join(present(want.Fields, order), ",") {
+			screenDrift(model.FieldAuditDriftWrongPosition, want.Name, "", strings.Join(present(want.Fields, order), ","), live)
+		}
+
+		for _, fieldID := range want.Fields {
+
+			current, onScreen := fieldTabs[fieldID]
+			switch {
+			case !onScreen:
+				screenDrift(model.FieldAuditDriftMissingOnTab, want.Name, fieldID, want.Name, "")
+
+			case current.Name != want.Name:
+				screenDrift(model.FieldAuditDriftWrongTab, want.Name, fieldID, want.Name, current.Name)
+				removals = append(removals, &model.FieldAuditStepScheme{Operation: model.FieldAuditStepRemoveTabField, ScreenID: spec.ID,
+					TabID: current.ID, TabName: current.Name, FieldID: fieldID})
+
+			default:
+				continue
+			}
+
+			additions = append(additions, &model.FieldAuditStepScheme{Operation: model.FieldAuditStepAddTabField, ScreenID: spec.ID,
+				TabID: tab.ID, TabName: want.Name, FieldID: fieldID})
+			order = append(order, fieldID)
+		}
+
+		movements = append(movements, fieldMoves(spec.ID, tab, want.Fields, order)...)
+	}
+
+	if plan {
+		report.Plan = append(report.Plan, removals...)
+		report.Plan = append(report.Plan, creations...)
+		report.Plan = append(report.Plan, additions...)
+		report.Plan = append(report.Plan, movements...)
+	}
+
+	return nil
+}
+
+// fieldMoves returns the moves ordering the fields of the tab like the spec, from the first misplaced field on.
+func fieldMoves(screenID int, tab *model.ScreenTabScheme, want, got []string) []*model.FieldAuditStepScheme {
+
+	first := 0
+	for first < len(want) && first < len(got) && want[first] == got[first] {
+		first++
+	}
+
+	var moves []*model.FieldAuditStepScheme
+	for index := first; index < len(want); index++ {
+
+		move := &model.FieldAuditStepScheme{Operation: model.FieldAuditStepMoveTabField, ScreenID: screenID,
+			TabID: tab.ID, TabName: tab.Name, FieldID: want[index]}
+
+		if index == 0 {
+			move.Position = fieldAuditPositionFirst
+		} else {
+			move.After = want[index-1]
+		}
+
+		moves = append(moves, move)
+	}
+
+	return moves
+}
+
+// present returns the fields of the slice contained by the other slice, on the order of the first slice.
+func present(fields, others []string) []string {
+
+	contained := make(map[string]bool, len(others))
+	for _, other := range others {
+		contained[other] = true
+	}
+
+	var filtered []string
+	for _, field := range fields {
+		if contained[field] {
+			filtered = append(filtered, field)
+		}
+	}
+
+	return filtered
+}
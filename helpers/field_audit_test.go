@@ -0,0 +1,218 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeFieldConfigItems struct {
+	jira.FieldConfigItemConnector
+	pages map[int][]*model.FieldConfigurationItemPageScheme
+	err   error
+}
+
+func (f *fakeFieldConfigItems) Gets(ctx context.Context, id, startAt, maxResults int) (*model.FieldConfigurationItemPageScheme, *model.ResponseScheme, error) {
+
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	pages := f.pages[id]
+	if index := startAt / 2; index < len(pages) {
+		return pages[index], &model.ResponseScheme{}, nil
+	}
+
+	return &model.FieldConfigurationItemPageScheme{}, &model.ResponseScheme{}, nil
+}
+
+type fakeScreenTabs struct {
+	jira.ScreenTabConnector
+	tabs map[int][]*model.ScreenTabScheme
+}
+
+func (f *fakeScreenTabs) Gets(ctx context.Context, screenId int, projectKey string) ([]*model.ScreenTabScheme, *model.ResponseScheme, error) {
+	return f.tabs[screenId], &model.ResponseScheme{}, nil
+}
+
+type fakeScreenTabFields struct {
+	jira.ScreenTabFieldConnector
+	fields map[int][]string
+	err    error
+}
+
+func (f *fakeScreenTabFields) Gets(ctx context.Context, screenId, tabId int) ([]*model.ScreenTabFieldScheme, *model.ResponseScheme, error) {
+
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	var fields []*model.ScreenTabFieldScheme
+	for _, id := range f.fields[tabId] {
+		fields = append(fields, &model.ScreenTabFieldScheme{ID: id})
+	}
+
+	return fields, &model.ResponseScheme{}, nil
+}
+
+func TestFieldAuditService_Audit(t *testing.T) {
+
+	items := &fakeFieldConfigItems{pages: map[int][]*model.FieldConfigurationItemPageScheme{
+		10000: {
+			{Total: 3, Values: []*model.FieldConfigurationItemScheme{
+				{ID: "summary", IsRequired: true, Renderer: "text-renderer"},
+				{ID: "description", Description: "The description", Renderer: "wiki-renderer"},
+			}},
+			{Total: 3, IsLast: true, Values: []*model.FieldConfigurationItemScheme{
+				{ID: "customfield_10001", IsHidden: true},
+			}},
+		},
+	}}
+
+	tabs := &fakeScreenTabs{tabs: map[int][]*model.ScreenTabScheme{
+		1: {{ID: 10, Name: "Field Tab"}, {ID: 11, Name: "Details"}},
+	}}
+
+	tabFields := &fakeScreenTabFields{fields: map[int][]string{
+		10: {"description", "summary", "labels", "customfield_10001"},
+		11: {"priority"},
+	}}
+
+	spec := &model.FieldAuditSpecScheme{
+		FieldConfigurations: []*model.FieldConfigurationSpecScheme{{
+			ID: 10000,
+			Fields: []*model.FieldConfigurationItemScheme{
+				{ID: "summary", IsRequired: true},
+				{ID: "description", IsRequired: true, Renderer: "atlassian-wiki-renderer"},
+				{ID: "customfield_10001"},
+				{ID: "customfield_10002"},
+			},
+		}},
+		Screens: []*model.ScreenSpecScheme{{
+			ID: 1,
+			Tabs: []*model.ScreenTabSpecScheme{
+				{Name: "Field Tab", Fields: []string{"summary", "description", "priority"}},
+				{Name: "Planning", Fields: []string{"customfield_10001"}},
+			},
+		}},
+	}
+
+	t.Run("when the live state drifts from the spec", func(t *testing.T) {
+
+		audit, err := NewFieldAuditService(items, tabs, tabFields)
+		assert.NoError(t, err)
+
+		report, err := audit.Audit(context.Background(), spec, &model.FieldAuditOptionsScheme{Plan: true})
+		assert.NoError(t, err)
+		assert.True(t, report.HasDrifts())
+
+		assert.Equal(t, []*model.FieldAuditDriftScheme{
+			{Kind: model.FieldAuditDriftRequired, FieldConfigurationID: 10000, FieldID: "description", Want: "true", Got: "false"},
+			{Kind: model.FieldAuditDriftRenderer, FieldConfigurationID: 10000, FieldID: "description", Want: "atlassian-wiki-renderer", Got: "wiki-renderer"},
+			{Kind: model.FieldAuditDriftHidden, FieldConfigurationID: 10000, FieldID: "customfield_10001", Want: "false", Got: "true"},
+			{Kind: model.FieldAuditDriftMissingField, FieldConfigurationID: 10000, FieldID: "customfield_10002", Want: "customfield_10002"},
+			{Kind: model.FieldAuditDriftExtraField, ScreenID: 1, Tab: "Field Tab", FieldID: "labels", Got: "Field Tab"},
+			{Kind: model.FieldAuditDriftWrongPosition, ScreenID: 1, Tab: "Field Tab", Want: "summary,description", Got: "description,summary"},
+			{Kind: model.FieldAuditDriftWrongTab, ScreenID: 1, Tab: "Field Tab", FieldID: "priority", Want: "Field Tab", Got: "Details"},
+			{Kind: model.FieldAuditDriftMissingTab, ScreenID: 1, Tab: "Planning", Want: "Planning"},
+			{Kind: model.FieldAuditDriftWrongTab, ScreenID: 1, Tab: "Planning", FieldID: "customfield_10001", Want: "Planning", Got: "Field Tab"},
+		}, report.Drifts)
+
+		assert.Equal(t, []*model.FieldAuditStepScheme{
+			{
+				Operation:            model.FieldAuditStepUpdateItems,
+				FieldConfigurationID: 10000,
+				Items: &model.UpdateFieldConfigurationItemPayloadScheme{FieldConfigurationItems: []*model.FieldConfigurationItemScheme{
+					{ID: "description", IsRequired: true, Description: "The description", Renderer: "atlassian-wiki-renderer",
+						ExplicitFields: []string{"isHidden", "isRequired"}},
+					{ID: "customfield_10001", ExplicitFields: []string{"isHidden", "isRequired"}},
+				}},
+			},
+			{Operation: model.FieldAuditStepRemoveTabField, ScreenID: 1, TabID: 10, TabName: "Field Tab", FieldID: "labels"},
+			{Operation: model.FieldAuditStepRemoveTabField, ScreenID: 1, TabID: 11, TabName: "Details", FieldID: "priority"},
+			{Operation: model.FieldAuditStepRemoveTabField, ScreenID: 1, TabID: 10, TabName: "Field Tab", FieldID: "customfield_10001"},
+			{Operation: model.FieldAuditStepCreateTab, ScreenID: 1, TabName: "Planning"},
+			{Operation: model.FieldAuditStepAddTabField, ScreenID: 1, TabID: 10, TabName: "Field Tab", FieldID: "priority"},
+			{Operation: model.FieldAuditStepAddTabField, ScreenID: 1, TabName: "Planning", FieldID: "customfield_10001"},
+			{Operation: model.FieldAuditStepMoveTabField, ScreenID: 1, TabID: 10, TabName: "Field Tab", FieldID: "summary", Position: "First"},
+			{Operation: model.FieldAuditStepMoveTabField, ScreenID: 1, TabID: 10, TabName: "Field Tab", FieldID: "description", After: "summary"},
+			{Operation: model.FieldAuditStepMoveTabField, ScreenID: 1, TabID: 10, TabName: "Field Tab", FieldID: "priority", After: "description"},
+		}, report.Plan)
+	})
+
+	t.Run("when the plan unsets a flag", func(t *testing.T) {
+
+		audit, err := NewFieldAuditService(items, tabs, tabFields)
+		assert.NoError(t, err)
+
+		report, err := audit.Audit(context.Background(), spec, &model.FieldAuditOptionsScheme{Plan: true})
+		assert.NoError(t, err)
+
+		// The hidden flag of customfield_10001 is unset, so the false value is sent
+		data, err := json.Marshal(report.Plan[0].Items)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"fieldConfigurationItems":[
+			{"id":"description","isHidden":false,"isRequired":true,"description":"The description","renderer":"atlassian-wiki-renderer"},
+			{"id":"customfield_10001","isHidden":false,"isRequired":false}]}`, string(data))
+	})
+
+	t.Run("when the plan is not requested", func(t *testing.T) {
+
+		audit, err := NewFieldAuditService(items, tabs, tabFields)
+		assert.NoError(t, err)
+
+		report, err := audit.Audit(context.Background(), spec, nil)
+		assert.NoError(t, err)
+		assert.Len(t, report.Drifts, 9)
+		assert.Empty(t, report.Plan)
+	})
+
+	t.Run("when the live state matches the spec", func(t *testing.T) {
+
+		audit, err := NewFieldAuditService(items, tabs, tabFields)
+		assert.NoError(t, err)
+
+		report, err := audit.Audit(context.Background(), &model.FieldAuditSpecScheme{
+			FieldConfigurations: []*model.FieldConfigurationSpecScheme{{
+				ID:     10000,
+				Fields: []*model.FieldConfigurationItemScheme{{ID: "summary", IsRequired: true, Renderer: "text-renderer"}},
+			}},
+			Screens: []*model.ScreenSpecScheme{{
+				ID:   1,
+				Tabs: []*model.ScreenTabSpecScheme{{Name: "Details", Fields: []string{"priority"}}},
+			}},
+		}, &model.FieldAuditOptionsScheme{Plan: true})
+
+		assert.NoError(t, err)
+		assert.False(t, report.HasDrifts())
+		assert.Empty(t, report.Plan)
+	})
+
+	t.Run("when a request fails", func(t *testing.T) {
+
+		audit, err := NewFieldAuditService(items, tabs, &fakeScreenTabFields{err: errors.New("error, request failed")})
+		assert.NoError(t, err)
+
+		_, err = audit.Audit(context.Background(), spec, nil)
+		assert.EqualError(t, err, "error, request failed")
+	})
+
+	t.Run("when the parameters are not valid", func(t *testing.T) {
+
+		_, err := NewFieldAuditService(nil, tabs, nil)
+		assert.EqualError(t, err, model.ErrNoFieldAuditSourceError.Error())
+
+		audit, err := NewFieldAuditService(items, nil, nil)
+		assert.NoError(t, err)
+
+		_, err = audit.Audit(context.Background(), nil, nil)
+		assert.EqualError(t, err, model.ErrNoFieldAuditSpecError.Error())
+
+		_, err = audit.Audit(context.Background(), spec, nil)
+		assert.True(t, errors.Is(err, model.ErrNoFieldAuditSourceError))
+	})
+}
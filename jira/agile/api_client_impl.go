@@ -50,21 +50,68 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	buildService, err := internal.NewBuildService(client, "0.1")
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentService, err := internal.NewDeploymentService(client, "0.1")
+	if err != nil {
+		return nil, err
+	}
+
+	reportService, err := internal.NewReportService(client, "1.0")
+	if err != nil {
+		return nil, err
+	}
+
 	client.Board = boardService
 	client.Epic = epicService
 	client.Sprint = sprintService
+	client.Build = buildService
+	client.Deployment = deploymentService
+	client.Report = reportService
 	client.Auth = internal.NewAuthenticationService(client)
 
 	return client, nil
 }
 
 type Client struct {
-	HTTP   common.HttpClient
-	Site   *url.URL
-	Auth   common.Authentication
-	Board  *internal.BoardService
-	Epic   *internal.EpicService
-	Sprint *internal.SprintService
+	HTTP       common.HttpClient
+	Site       *url.URL
+	Auth       common.Authentication
+	Board      *internal.BoardService
+	Epic       *internal.EpicService
+	Sprint     *internal.SprintService
+	Build      *internal.BuildService
+	Deployment *internal.DeploymentService
+	Report     *internal.ReportService
+
+	internalAPIs bool
+}
+
+// EnableInternalAPIs opts in to the undocumented Jira APIs, e.g. the GreenHopper sprint report and velocity charts.
+//
+// These APIs are not part of the public REST API, they can change without notice.
+func (c *Client) EnableInternalAPIs() {
+	c.internalAPIs = true
+}
+
+// HasInternalAPIs reports whether the client opted in to the undocumented Jira APIs.
+func (c *Client) HasInternalAPIs() bool {
+	return c.internalAPIs
+}
+
+// NewOperationRequest creates the request of a service method, the request context is named with the operation,
+// prefixed with agile., see models.WithOperation.
+func (c *Client) NewOperationRequest(ctx context.Context, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+	return c.NewRequest(models.WithOperation(ctx, "agile."+operation), method, apiEndpoint, payload)
+}
+
+// NewOperationFormRequest creates the form request of a service method, the request context is named with the operation,
+// prefixed with agile., see models.WithOperation.
+func (c *Client) NewOperationFormRequest(ctx context.Context, operation, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
+	return c.NewFormRequest(models.WithOperation(ctx, "agile."+operation), method, apiEndpoint, contentType, payload)
 }
 
 func (c *Client) NewFormRequest(ctx context.Context, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
@@ -115,10 +162,11 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 func (c *Client) TransformTheHTTPResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
 
 	responseTransformed := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Operation: models.OperationFromContext(response.Request.Context()),
 	}
 
 	responseAsBytes, err := ioutil.ReadAll(response.Body)
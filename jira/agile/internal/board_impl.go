@@ -254,7 +254,7 @@ func (i *internalBoardImpl) Get(ctx context.Context, boardID int) (*model.BoardS
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v", i.version, boardID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -277,7 +277,7 @@ func (i *internalBoardImpl) Create(ctx context.Context, payload *model.BoardPayl
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -303,7 +303,7 @@ func (i *internalBoardImpl) Filter(ctx context.Context, filterID, startAt, maxRe
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/filter/%v?%v", i.version, filterID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.filter", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -346,7 +346,7 @@ func (i *internalBoardImpl) Backlog(ctx context.Context, boardID int, opts *mode
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/backlog?%v", i.version, boardID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.backlog", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -368,7 +368,7 @@ func (i *internalBoardImpl) Configuration(ctx context.Context, boardID int) (*mo
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/configuration", i.version, boardID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.configuration", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -395,7 +395,7 @@ func (i *internalBoardImpl) Epics(ctx context.Context, boardID, startAt, maxResu
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/epic?%v", i.version, boardID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.epics", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -440,7 +440,7 @@ func (i *internalBoardImpl) IssuesWithoutEpic(ctx context.Context, boardID int,
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/epic/none/issue?%v", i.version, boardID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.issuesWithoutEpic", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -489,7 +489,7 @@ func (i *internalBoardImpl) IssuesByEpic(ctx context.Context, boardID, epicID in
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/epic/%v/issue?%v", i.version, boardID, epicID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.issuesByEpic", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -535,7 +535,7 @@ func (i *internalBoardImpl) Issues(ctx context.Context, boardID int, opts *model
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/issue?%v", i.version, boardID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.issues", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -562,7 +562,7 @@ func (i *internalBoardImpl) Move(ctx context.Context, boardID int, payload *mode
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/issue", i.version, boardID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.move", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -587,7 +587,7 @@ func (i *internalBoardImpl) Projects(ctx context.Context, boardID, startAt, maxR
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/project?%v", i.version, boardID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.projects", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -614,7 +614,7 @@ func (i *internalBoardImpl) Sprints(ctx context.Context, boardID, startAt, maxRe
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/sprint?%v", i.version, boardID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.sprints", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -663,7 +663,7 @@ func (i *internalBoardImpl) IssuesBySprint(ctx context.Context, boardID, sprintI
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/sprint/%v/issue?%v", i.version, boardID, sprintID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.issuesBySprint", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -690,7 +690,7 @@ func (i *internalBoardImpl) Versions(ctx context.Context, boardID, startAt, maxR
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v/version?%v", i.version, boardID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.versions", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -712,7 +712,7 @@ func (i *internalBoardImpl) Delete(ctx context.Context, boardID int) (*model.Res
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board/%v", i.version, boardID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -776,7 +776,7 @@ func (i *internalBoardImpl) Gets(ctx context.Context, opts *model.GetBoardsOptio
 
 	endpoint := fmt.Sprintf("rest/agile/%v/board?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "board.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/agile"
+	"net/http"
+	"net/url"
+)
+
+func NewBuildService(client service.Client, version string) (*BuildService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &BuildService{
+		internalClient: &internalBuildImpl{c: client, version: version},
+	}, nil
+}
+
+// BuildService submits the build information of the CI/CD tools, the requests must be authenticated as an
+// OAuth or Connect app, e.g. using an HTTP client that adds the app bearer token.
+type BuildService struct {
+	internalClient agile.BuildConnector
+}
+
+// Submit updates or inserts the builds, the builds are associated with the issues using their issue keys.
+//
+// The properties are stored with the builds, they can be used to delete the builds later.
+//
+// The builds rejected and the unknown issue keys are returned on the response, the request succeeds anyway.
+//
+// POST /rest/builds/0.1/bulk
+//
+// TODO: the documentation needs to be created
+func (b *BuildService) Submit(ctx context.Context, builds []*model.BuildInfoScheme, properties map[string]string) (*model.BuildBulkResponseScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Submit(ctx, builds, properties)
+}
+
+// DeleteByProperties deletes the builds with all the properties provided, the deletion is processed asynchronously.
+//
+// DELETE /rest/builds/0.1/bulkByProperties
+//
+// TODO: the documentation needs to be created
+func (b *BuildService) DeleteByProperties(ctx context.Context, properties map[string]string) (*model.ResponseScheme, error) {
+	return b.internalClient.DeleteByProperties(ctx, properties)
+}
+
+type internalBuildImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalBuildImpl) Submit(ctx context.Context, builds []*model.BuildInfoScheme, properties map[string]string) (*model.BuildBulkResponseScheme, *model.ResponseScheme, error) {
+
+	if len(builds) == 0 {
+		return nil, nil, model.ErrNoBuildsError
+	}
+
+	payload := &model.BuildBulkPayloadScheme{
+		Properties: properties,
+		Builds:     builds,
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/builds/%v/bulk", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "build.submit", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.BuildBulkResponseScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalBuildImpl) DeleteByProperties(ctx context.Context, properties map[string]string) (*model.ResponseScheme, error) {
+	return deleteByProperties(ctx, i.c, "build.deleteByProperties", fmt.Sprintf("rest/builds/%v/bulkByProperties", i.version), properties)
+}
+
+// deleteByProperties deletes the development tool entities, e.g. the builds, with all the properties provided.
+func deleteByProperties(ctx context.Context, client service.Client, operation, endpoint string, properties map[string]string) (*model.ResponseScheme, error) {
+
+	if len(properties) == 0 {
+		return nil, model.ErrNoEntityPropertiesError
+	}
+
+	params := url.Values{}
+	for key, value := range properties {
+		params.Add(key, value)
+	}
+
+	request, err := service.NewOperationRequest(ctx, client, operation, http.MethodDelete, fmt.Sprintf("%v?%v", endpoint, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Call(request, nil)
+}
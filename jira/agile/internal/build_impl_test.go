@@ -0,0 +1,240 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func Test_BuildService_Submit(t *testing.T) {
+
+	buildsMocked := []*model.BuildInfoScheme{
+		{
+			SchemaVersion:        "1.0",
+			PipelineID:           "deploy-api",
+			BuildNumber:          16,
+			UpdateSequenceNumber: 1523494301448,
+			DisplayName:          "Deploy API #16",
+			URL:                  "https://ci.example.com/deploy-api/16",
+			State:                model.BuildStateSuccessful,
+			LastUpdated:          "2018-01-20T23:27:25.000Z",
+			IssueKeys:            []string{"KP-1"},
+			TestInfo:             &model.BuildTestInfoScheme{TotalNumber: 150, NumberPassed: 145, NumberFailed: 5},
+		},
+	}
+
+	propertiesMocked := map[string]string{"accountId": "account-123"}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx        context.Context
+		builds     []*model.BuildInfoScheme
+		properties map[string]string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				builds:     buildsMocked,
+				properties: propertiesMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.BuildBulkPayloadScheme{Properties: propertiesMocked, Builds: buildsMocked}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/builds/0.1/bulk",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BuildBulkResponseScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:    context.Background(),
+				builds: buildsMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.BuildBulkPayloadScheme{Builds: buildsMocked}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/builds/0.1/bulk",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("unable to create the http request"))
+
+				fields.c = client
+			},
+			Err:     errors.New("unable to create the http request"),
+			wantErr: true,
+		},
+
+		{
+			name: "when the builds are not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			Err:     model.ErrNoBuildsError,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			buildService, err := NewBuildService(testCase.fields.c, "0.1")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := buildService.Submit(testCase.args.ctx, testCase.args.builds, testCase.args.properties)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_BuildService_DeleteByProperties(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx        context.Context
+		properties map[string]string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				properties: map[string]string{"repoId": "repo-123", "accountId": "account-123"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/builds/0.1/bulkByProperties?accountId=account-123&repoId=repo-123",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the properties are not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			Err:     model.ErrNoEntityPropertiesError,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			buildService, err := NewBuildService(testCase.fields.c, "0.1")
+			assert.NoError(t, err)
+
+			gotResponse, err := buildService.DeleteByProperties(testCase.args.ctx, testCase.args.properties)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/agile"
+	"net/http"
+)
+
+func NewDeploymentService(client service.Client, version string) (*DeploymentService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &DeploymentService{
+		internalClient: &internalDeploymentImpl{c: client, version: version},
+	}, nil
+}
+
+// DeploymentService submits the deployment information of the CI/CD tools, the requests must be authenticated as an
+// OAuth or Connect app, e.g. using an HTTP client that adds the app bearer token.
+type DeploymentService struct {
+	internalClient agile.DeploymentConnector
+}
+
+// Submit updates or inserts the deployments, the deployments are associated with the issues using the associations.
+//
+// The properties are stored with the deployments, they can be used to delete the deployments later.
+//
+// The deployments rejected and the unknown issue keys are returned on the response, the request succeeds anyway.
+//
+// POST /rest/deployments/0.1/bulk
+//
+// TODO: the documentation needs to be created
+func (d *DeploymentService) Submit(ctx context.Context, deployments []*model.DeploymentInfoScheme, properties map[string]string) (*model.DeploymentBulkResponseScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Submit(ctx, deployments, properties)
+}
+
+// DeleteByProperties deletes the deployments with all the properties provided, the deletion is processed asynchronously.
+//
+// DELETE /rest/deployments/0.1/bulkByProperties
+//
+// TODO: the documentation needs to be created
+func (d *DeploymentService) DeleteByProperties(ctx context.Context, properties map[string]string) (*model.ResponseScheme, error) {
+	return d.internalClient.DeleteByProperties(ctx, properties)
+}
+
+type internalDeploymentImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalDeploymentImpl) Submit(ctx context.Context, deployments []*model.DeploymentInfoScheme, properties map[string]string) (*model.DeploymentBulkResponseScheme, *model.ResponseScheme, error) {
+
+	if len(deployments) == 0 {
+		return nil, nil, model.ErrNoDeploymentsError
+	}
+
+	payload := &model.DeploymentBulkPayloadScheme{
+		Properties:  properties,
+		Deployments: deployments,
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/deployments/%v/bulk", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "deployment.submit", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.DeploymentBulkResponseScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalDeploymentImpl) DeleteByProperties(ctx context.Context, properties map[string]string) (*model.ResponseScheme, error) {
+	return deleteByProperties(ctx, i.c, "deployment.deleteByProperties", fmt.Sprintf("rest/deployments/%v/bulkByProperties", i.version), properties)
+}
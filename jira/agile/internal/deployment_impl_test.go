@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+func Test_DeploymentService_Submit(t *testing.T) {
+
+	deploymentsMocked := []*model.DeploymentInfoScheme{
+		{
+			SchemaVersion:            "1.0",
+			DeploymentSequenceNumber: 100,
+			UpdateSequenceNumber:     1,
+			Associations: []*model.DeploymentAssociationScheme{
+				{AssociationType: model.DeploymentAssociationIssueKeys, Values: []string{"KP-1", "KP-2"}},
+			},
+			DisplayName: "Deployment number 16 of Data Depot",
+			URL:         "https://ci.example.com/data-depot/deployments/16",
+			LastUpdated: "2018-01-20T23:27:25.000Z",
+			State:       model.DeploymentStateSuccessful,
+			Pipeline:    &model.DeploymentPipelineScheme{ID: "e9c906a7", DisplayName: "Data Depot Deployment", URL: "https://ci.example.com/data-depot"},
+			Environment: &model.DeploymentEnvironmentScheme{ID: "8ec94d72", DisplayName: "US East", Type: model.DeploymentEnvironmentProduction},
+		},
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx         context.Context
+		deployments []*model.DeploymentInfoScheme
+		properties  map[string]string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.DeploymentBulkResponseScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:         context.Background(),
+				deployments: deploymentsMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.DeploymentBulkPayloadScheme{Deployments: deploymentsMocked}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/deployments/0.1/bulk",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DeploymentBulkResponseScheme{}).
+					Run(func(args mock.Arguments) {
+						result := args.Get(1).(*model.DeploymentBulkResponseScheme)
+						result.RejectedDeployments = []*model.RejectedDeploymentScheme{
+							{
+								Key:    &model.DeploymentKeyScheme{PipelineID: "e9c906a7", EnvironmentID: "8ec94d72", DeploymentSequenceNumber: 100},
+								Errors: []*model.DevOpsErrorScheme{{Message: "The deployment state is invalid"}},
+							},
+						}
+						result.UnknownIssueKeys = []string{"KP-2"}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.DeploymentBulkResponseScheme{
+				RejectedDeployments: []*model.RejectedDeploymentScheme{
+					{
+						Key:    &model.DeploymentKeyScheme{PipelineID: "e9c906a7", EnvironmentID: "8ec94d72", DeploymentSequenceNumber: 100},
+						Errors: []*model.DevOpsErrorScheme{{Message: "The deployment state is invalid"}},
+					},
+				},
+				UnknownIssueKeys: []string{"KP-2"},
+			},
+		},
+
+		{
+			name: "when the api cannot be executed",
+			args: args{
+				ctx:         context.Background(),
+				deployments: deploymentsMocked,
+				properties:  map[string]string{"accountId": "account-123"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.DeploymentBulkPayloadScheme{Properties: map[string]string{"accountId": "account-123"}, Deployments: deploymentsMocked}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/deployments/0.1/bulk",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DeploymentBulkResponseScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, unable to execute the http call"))
+
+				fields.c = client
+			},
+			Err:     errors.New("error, unable to execute the http call"),
+			wantErr: true,
+		},
+
+		{
+			name: "when the deployments are not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			Err:     model.ErrNoDeploymentsError,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			deploymentService, err := NewDeploymentService(testCase.fields.c, "0.1")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := deploymentService.Submit(testCase.args.ctx, testCase.args.deployments, testCase.args.properties)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
+func Test_DeploymentService_DeleteByProperties(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx        context.Context
+		properties map[string]string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				properties: map[string]string{"accountId": "account-123"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/deployments/0.1/bulkByProperties?accountId=account-123",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				properties: map[string]string{"accountId": "account-123"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/deployments/0.1/bulkByProperties?accountId=account-123",
+					nil).
+					Return(&http.Request{}, errors.New("unable to create the http request"))
+
+				fields.c = client
+			},
+			Err:     errors.New("unable to create the http request"),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			deploymentService, err := NewDeploymentService(testCase.fields.c, "0.1")
+			assert.NoError(t, err)
+
+			gotResponse, err := deploymentService.DeleteByProperties(testCase.args.ctx, testCase.args.properties)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
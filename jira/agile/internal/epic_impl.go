@@ -84,7 +84,7 @@ func (i *internalEpicImpl) Get(ctx context.Context, epicIdOrKey string) (*model.
 
 	endpoint := fmt.Sprintf("rest/agile/%v/epic/%v", i.version, epicIdOrKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "epic.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -127,7 +127,7 @@ func (i *internalEpicImpl) Issues(ctx context.Context, epicIdOrKey string, opts
 
 	endpoint := fmt.Sprintf("rest/agile/%v/epic/%v/issue?%v", i.version, epicIdOrKey, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "epic.issues", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -154,7 +154,7 @@ func (i *internalEpicImpl) Move(ctx context.Context, epicIdOrKey string, issues
 
 	endpoint := fmt.Sprintf("rest/agile/%v/epic/%v/issue", i.version, epicIdOrKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "epic.move", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/agile"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// internalAPIsClient is implemented by the clients able to opt in to the undocumented Jira APIs.
+type internalAPIsClient interface {
+	HasInternalAPIs() bool
+}
+
+func NewReportService(client service.Client, version string) (*ReportService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &ReportService{
+		internalClient: &internalReportImpl{c: client, version: version},
+	}, nil
+}
+
+type ReportService struct {
+	internalClient agile.ReportConnector
+}
+
+// SprintReport returns the completed, not completed and punted issues of a sprint with their estimate sums.
+//
+// This endpoint is undocumented, the client internal APIs needs to be enabled.
+//
+// The GreenHopper endpoints are used by the Jira boards, they are not part of the public REST API and can change without notice.
+//
+// GET /rest/greenhopper/1.0/rapid/charts/sprintreport
+//
+// TODO: the documentation needs to be created
+func (r *ReportService) SprintReport(ctx context.Context, boardID, sprintID int) (*model.SprintReportScheme, *model.ResponseScheme, error) {
+	return r.internalClient.SprintReport(ctx, boardID, sprintID)
+}
+
+// Velocity returns the commitment and the completed estimate of the closed sprints of a board.
+//
+// This endpoint is undocumented, the client internal APIs needs to be enabled.
+//
+// The GreenHopper endpoints are used by the Jira boards, they are not part of the public REST API and can change without notice.
+//
+// GET /rest/greenhopper/1.0/rapid/charts/velocity.json
+//
+// TODO: the documentation needs to be created
+func (r *ReportService) Velocity(ctx context.Context, boardID int) (*model.BoardVelocityScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Velocity(ctx, boardID)
+}
+
+type internalReportImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalReportImpl) SprintReport(ctx context.Context, boardID, sprintID int) (*model.SprintReportScheme, *model.ResponseScheme, error) {
+
+	if !i.internalAPIsEnabled() {
+		return nil, nil, model.ErrInternalAPIsNotEnabledError
+	}
+
+	if boardID == 0 {
+		return nil, nil, model.ErrNoBoardIDError
+	}
+
+	if sprintID == 0 {
+		return nil, nil, model.ErrNoSprintIDError
+	}
+
+	params := url.Values{}
+	params.Add("rapidViewId", strconv.Itoa(boardID))
+	params.Add("sprintId", strconv.Itoa(sprintID))
+
+	endpoint := fmt.Sprintf("rest/greenhopper/%v/rapid/charts/sprintreport?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "report.sprintReport", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(model.SprintReportScheme)
+	response, err := i.c.Call(request, report)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return report, response, nil
+}
+
+func (i *internalReportImpl) Velocity(ctx context.Context, boardID int) (*model.BoardVelocityScheme, *model.ResponseScheme, error) {
+
+	if !i.internalAPIsEnabled() {
+		return nil, nil, model.ErrInternalAPIsNotEnabledError
+	}
+
+	if boardID == 0 {
+		return nil, nil, model.ErrNoBoardIDError
+	}
+
+	params := url.Values{}
+	params.Add("rapidViewId", strconv.Itoa(boardID))
+
+	endpoint := fmt.Sprintf("rest/greenhopper/%v/rapid/charts/velocity.json?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "report.velocity", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The chart returns the sprints and their estimates on separate attributes, the estimates are keyed by sprint id
+	chart := struct {
+		Sprints             []*model.SprintReportSprintScheme `json:"sprints"`
+		VelocityStatEntries map[string]struct {
+			Estimated *model.SprintReportSumScheme `json:"estimated"`
+			Completed *model.SprintReportSumScheme `json:"completed"`
+		} `json:"velocityStatEntries"`
+	}{}
+
+	response, err := i.c.Call(request, &chart)
+	if err != nil {
+		return nil, response, err
+	}
+
+	velocity := &model.BoardVelocityScheme{}
+	for _, sprint := range chart.Sprints {
+
+		sprintVelocity := &model.SprintVelocityScheme{
+			ID:       sprint.ID,
+			Sequence: sprint.Sequence,
+			Name:     sprint.Name,
+			State:    sprint.State,
+			Goal:     sprint.Goal,
+		}
+
+		if entry, ok := chart.VelocityStatEntries[strconv.Itoa(sprint.ID)]; ok {
+
+			if entry.Estimated != nil {
+				sprintVelocity.Commitment = entry.Estimated.Value
+			}
+
+			if entry.Completed != nil {
+				sprintVelocity.Completed = entry.Completed.Value
+			}
+		}
+
+		velocity.Sprints = append(velocity.Sprints, sprintVelocity)
+	}
+
+	return velocity, response, nil
+}
+
+func (i *internalReportImpl) internalAPIsEnabled() bool {
+
+	client, ok := i.c.(internalAPIsClient)
+	return ok && client.HasInternalAPIs()
+}
@@ -0,0 +1,235 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+// internalAPIsMockClient is a mocked client opted in to the undocumented Jira APIs.
+type internalAPIsMockClient struct {
+	*mocks.Client
+}
+
+func (c *internalAPIsMockClient) HasInternalAPIs() bool {
+	return true
+}
+
+func Test_internalReportImpl_SprintReport(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx               context.Context
+		boardID, sprintID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:      context.Background(),
+				boardID:  4,
+				sprintID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=4&sprintId=12",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SprintReportScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = &internalAPIsMockClient{Client: client}
+			},
+		},
+
+		{
+			name:   "when the internal apis are not enabled",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:      context.Background(),
+				boardID:  4,
+				sprintID: 12,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrInternalAPIsNotEnabledError,
+		},
+
+		{
+			name:   "when the board id is not provided",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:      context.Background(),
+				sprintID: 12,
+			},
+			on: func(fields *fields) {
+				fields.c = &internalAPIsMockClient{Client: mocks.NewClient(t)}
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardIDError,
+		},
+
+		{
+			name:   "when the sprint id is not provided",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:     context.Background(),
+				boardID: 4,
+			},
+			on: func(fields *fields) {
+				fields.c = &internalAPIsMockClient{Client: mocks.NewClient(t)}
+			},
+			wantErr: true,
+			Err:     model.ErrNoSprintIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:      context.Background(),
+				boardID:  4,
+				sprintID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=4&sprintId=12",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = &internalAPIsMockClient{Client: client}
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			reportService, err := NewReportService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := reportService.SprintReport(testCase.args.ctx, testCase.args.boardID, testCase.args.sprintID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalReportImpl_Velocity(t *testing.T) {
+
+	t.Run("when the sprints are joined with their estimates", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/greenhopper/1.0/rapid/charts/velocity.json?rapidViewId=4",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+
+				chart := []byte(`{
+				  "sprints": [
+				    {"id": 12, "sequence": 12, "name": "Sprint 12", "state": "CLOSED", "goal": "Release the importer"},
+				    {"id": 13, "sequence": 13, "name": "Sprint 13", "state": "CLOSED"}
+				  ],
+				  "velocityStatEntries": {
+				    "12": {"estimated": {"value": 21.0, "text": "21.0"}, "completed": {"value": 18.0, "text": "18.0"}}
+				  }
+				}`)
+
+				assert.NoError(t, json.Unmarshal(chart, args.Get(1)))
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		reportService, err := NewReportService(&internalAPIsMockClient{Client: client}, "1.0")
+		assert.NoError(t, err)
+
+		velocity, _, err := reportService.Velocity(context.Background(), 4)
+		assert.NoError(t, err)
+
+		assert.Equal(t, &model.BoardVelocityScheme{
+			Sprints: []*model.SprintVelocityScheme{
+				{ID: 12, Sequence: 12, Name: "Sprint 12", State: "CLOSED", Goal: "Release the importer", Commitment: 21, Completed: 18},
+				{ID: 13, Sequence: 13, Name: "Sprint 13", State: "CLOSED"},
+			},
+		}, velocity)
+	})
+
+	t.Run("when the internal apis are not enabled", func(t *testing.T) {
+
+		reportService, err := NewReportService(mocks.NewClient(t), "1.0")
+		assert.NoError(t, err)
+
+		_, _, err = reportService.Velocity(context.Background(), 4)
+		assert.Equal(t, model.ErrInternalAPIsNotEnabledError, err)
+	})
+
+	t.Run("when the board id is not provided", func(t *testing.T) {
+
+		reportService, err := NewReportService(&internalAPIsMockClient{Client: mocks.NewClient(t)}, "1.0")
+		assert.NoError(t, err)
+
+		_, _, err = reportService.Velocity(context.Background(), 0)
+		assert.Equal(t, model.ErrNoBoardIDError, err)
+	})
+}
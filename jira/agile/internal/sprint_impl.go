@@ -150,7 +150,7 @@ func (i *internalSprintImpl) Move(ctx context.Context, sprintID int, payload *mo
 
 	endpoint := fmt.Sprintf("/rest/agile/%v/sprint/%v/issue", i.version, sprintID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.move", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +166,7 @@ func (i *internalSprintImpl) Get(ctx context.Context, sprintID int) (*model.Spri
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v", i.version, sprintID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +189,7 @@ func (i *internalSprintImpl) Create(ctx context.Context, payload *model.SprintPa
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -216,7 +216,7 @@ func (i *internalSprintImpl) Update(ctx context.Context, sprintID int, payload *
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v", i.version, sprintID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -243,7 +243,7 @@ func (i *internalSprintImpl) Path(ctx context.Context, sprintID int, payload *mo
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v", i.version, sprintID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.path", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -265,7 +265,7 @@ func (i *internalSprintImpl) Delete(ctx context.Context, sprintID int) (*model.R
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v", i.version, sprintID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +304,7 @@ func (i *internalSprintImpl) Issues(ctx context.Context, sprintID int, opts *mod
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v/issue?%v", i.version, sprintID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.issues", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -335,7 +335,7 @@ func (i *internalSprintImpl) Start(ctx context.Context, sprintID int) (*model.Re
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v", i.version, sprintID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.start", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -360,7 +360,7 @@ func (i *internalSprintImpl) Close(ctx context.Context, sprintID int) (*model.Re
 
 	endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v", i.version, sprintID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "sprint.close", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
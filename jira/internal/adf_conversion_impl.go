@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+)
+
+// adfConversionBatchSize is the number of bodies sent per request, it keeps the request bodies small
+const adfConversionBatchSize = 50
+
+func NewADFConversionService(client service.Client, version string) (*ADFConversionService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &ADFConversionService{
+		internalClient: &internalADFConversionImpl{c: client, version: version},
+	}, nil
+}
+
+type ADFConversionService struct {
+	internalClient jira.ADFConversionConnector
+}
+
+// WikiToADF converts wiki markup bodies to ADF documents, the bodies are sent in batches.
+//
+// A result is returned per body, on the order of the bodies. A body that can't be converted has the result error set,
+// a failed batch stops the conversion and returns the results of the previous batches with the error.
+//
+// POST /rest/api/3/adf/convert
+//
+// TODO: the documentation needs to be created
+func (a *ADFConversionService) WikiToADF(ctx context.Context, items []string) ([]*model.ADFConversionResultScheme, *model.ResponseScheme, error) {
+	return a.internalClient.WikiToADF(ctx, items)
+}
+
+// ADFToWiki converts ADF documents to wiki markup bodies, the documents are sent in batches.
+//
+// A result is returned per document, on the order of the documents. A document that can't be converted has the result
+// error set, a failed batch stops the conversion and returns the results of the previous batches with the error.
+//
+// POST /rest/api/3/adf/convert
+//
+// TODO: the documentation needs to be created
+func (a *ADFConversionService) ADFToWiki(ctx context.Context, items []*model.CommentNodeScheme) ([]*model.ADFConversionResultScheme, *model.ResponseScheme, error) {
+	return a.internalClient.ADFToWiki(ctx, items)
+}
+
+type internalADFConversionImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalADFConversionImpl) WikiToADF(ctx context.Context, items []string) ([]*model.ADFConversionResultScheme, *model.ResponseScheme, error) {
+
+	values := make([]interface{}, len(items))
+	for index, item := range items {
+		values[index] = item
+	}
+
+	return i.convert(ctx, "adfConversion.wikiToADF", model.ADFConversionWiki, model.ADFConversionADF, values, func(result *model.ADFConversionResultScheme, value json.RawMessage) error {
+		result.ADF = new(model.CommentNodeScheme)
+		return json.Unmarshal(value, result.ADF)
+	})
+}
+
+func (i *internalADFConversionImpl) ADFToWiki(ctx context.Context, items []*model.CommentNodeScheme) ([]*model.ADFConversionResultScheme, *model.ResponseScheme, error) {
+
+	values := make([]interface{}, len(items))
+	for index, item := range items {
+		values[index] = item
+	}
+
+	return i.convert(ctx, "adfConversion.adfToWiki", model.ADFConversionADF, model.ADFConversionWiki, values, func(result *model.ADFConversionResultScheme, value json.RawMessage) error {
+		return json.Unmarshal(value, &result.Wiki)
+	})
+}
+
+type adfConversionPayload struct {
+	From   string        `json:"from"`
+	To     string        `json:"to"`
+	Values []interface{} `json:"values"`
+}
+
+type adfConversionPage struct {
+	Results []*struct {
+		Value json.RawMessage `json:"value,omitempty"`
+		Error string          `json:"error,omitempty"`
+	} `json:"results"`
+}
+
+// convert sends the values in batches, the results of each batch are mapped back to the index of their value.
+// The results of the converted batches are returned with the error of a failed batch.
+func (i *internalADFConversionImpl) convert(ctx context.Context, operation, from, to string, values []interface{},
+	decode func(result *model.ADFConversionResultScheme, value json.RawMessage) error) ([]*model.ADFConversionResultScheme, *model.ResponseScheme, error) {
+
+	if len(values) == 0 {
+		return nil, nil, model.ErrNoADFConversionItemsError
+	}
+
+	// The conversion is only available on the ADF API, so the v2 clients use the v3 endpoint
+	endpoint := "rest/api/3/adf/convert"
+
+	var (
+		results  = make([]*model.ADFConversionResultScheme, 0, len(values))
+		response *model.ResponseScheme
+	)
+
+	for start := 0; start < len(values); start += adfConversionBatchSize {
+
+		end := start + adfConversionBatchSize
+		if end > len(values) {
+			end = len(values)
+		}
+
+		reader, err := i.c.TransformStructToReader(&adfConversionPayload{From: from, To: to, Values: values[start:end]})
+		if err != nil {
+			return results, response, err
+		}
+
+		request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodPost, endpoint, reader)
+		if err != nil {
+			return results, response, err
+		}
+
+		page := new(adfConversionPage)
+		response, err = i.c.Call(request, page)
+		if err != nil {
+			return results, response, err
+		}
+
+		if len(page.Results) != end-start {
+			return results, response, fmt.Errorf("%w: %v results for %v bodies", model.ErrADFConversionResultsError, len(page.Results), end-start)
+		}
+
+		for offset, converted := range page.Results {
+
+			result := &model.ADFConversionResultScheme{Index: start + offset}
+
+			switch {
+			case converted == nil:
+				result.Err = model.ErrADFConversionError
+			case converted.Error != "":
+				result.Err = fmt.Errorf("%w: %v", model.ErrADFConversionError, converted.Error)
+			default:
+				if err := decode(result, converted.Value); err != nil {
+					result.Err = fmt.Errorf("%w: %v", model.ErrADFConversionError, err)
+				}
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	return results, response, nil
+}
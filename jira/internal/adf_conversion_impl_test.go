@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// mockADFConversion mocks a conversion batch, the batch is matched by its first value
+func mockADFConversion(client *mocks.Client, from string, first interface{}, size int, results string, err error) {
+
+	request := &http.Request{RequestURI: fmt.Sprint(first)}
+
+	client.On("TransformStructToReader",
+		mock.MatchedBy(func(payload *adfConversionPayload) bool {
+			return payload.From == from && len(payload.Values) == size && fmt.Sprint(payload.Values[0]) == fmt.Sprint(first)
+		})).
+		Return(bytes.NewReader([]byte(request.RequestURI)), nil).Once()
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"rest/api/3/adf/convert",
+		bytes.NewReader([]byte(request.RequestURI))).
+		Return(request, nil).Once()
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			if results == "" {
+				return
+			}
+
+			if err := json.Unmarshal([]byte(results), args.Get(1)); err != nil {
+				panic(err)
+			}
+		}).
+		Return(&model.ResponseScheme{}, err).Once()
+}
+
+func Test_internalADFConversionImpl_WikiToADF(t *testing.T) {
+
+	t.Run("when the bodies are converted in batches", func(t *testing.T) {
+
+		// 51 bodies are sent on two batches, the second body can't be converted
+		items := make([]string, 51)
+		for index := range items {
+			items[index] = fmt.Sprintf("body %v", index)
+		}
+
+		var firstBatch []string
+		for index := 0; index < 50; index++ {
+
+			switch index {
+			case 1:
+				firstBatch = append(firstBatch, `{"error":"unexpected macro"}`)
+			default:
+				firstBatch = append(firstBatch, fmt.Sprintf(`{"value":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"body %v"}]}]}}`, index))
+			}
+		}
+
+		client := mocks.NewClient(t)
+		mockADFConversion(client, model.ADFConversionWiki, "body 0", 50, fmt.Sprintf(`{"results":[%v]}`, strings.Join(firstBatch, ",")), nil)
+		mockADFConversion(client, model.ADFConversionWiki, "body 50", 1,
+			`{"results":[{"value":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"body 50"}]}]}}]}`, nil)
+
+		conversionService, err := NewADFConversionService(client, "2")
+		assert.NoError(t, err)
+
+		results, response, err := conversionService.WikiToADF(context.Background(), items)
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+
+		assert.Len(t, results, 51)
+		for index, result := range results {
+			assert.Equal(t, index, result.Index)
+		}
+
+		assert.Equal(t, "body 0", results[0].ADF.Content[0].Content[0].Text)
+		assert.Nil(t, results[0].Err)
+
+		assert.Nil(t, results[1].ADF)
+		assert.True(t, errors.Is(results[1].Err, model.ErrADFConversionError))
+		assert.EqualError(t, results[1].Err, "jira: the body cannot be converted: unexpected macro")
+
+		assert.Equal(t, "body 50", results[50].ADF.Content[0].Content[0].Text)
+	})
+
+	t.Run("when the second batch fails", func(t *testing.T) {
+
+		items := make([]string, 51)
+		for index := range items {
+			items[index] = fmt.Sprintf("body %v", index)
+		}
+
+		firstBatch := make([]string, 50)
+		for index := range firstBatch {
+			firstBatch[index] = fmt.Sprintf(`{"value":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"body %v"}]}]}}`, index)
+		}
+
+		client := mocks.NewClient(t)
+		mockADFConversion(client, model.ADFConversionWiki, "body 0", 50, fmt.Sprintf(`{"results":[%v]}`, strings.Join(firstBatch, ",")), nil)
+		mockADFConversion(client, model.ADFConversionWiki, "body 50", 1, "", errors.New("error, request failed"))
+
+		conversionService, err := NewADFConversionService(client, "3")
+		assert.NoError(t, err)
+
+		// The results of the first batch are kept
+		results, _, err := conversionService.WikiToADF(context.Background(), items)
+		assert.EqualError(t, err, "error, request failed")
+		assert.Len(t, results, 50)
+		assert.Equal(t, "body 49", results[49].ADF.Content[0].Content[0].Text)
+	})
+
+	t.Run("when the results don't match the bodies", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockADFConversion(client, model.ADFConversionWiki, "h1. Title", 2, `{"results":[{"value":{"type":"doc"}}]}`, nil)
+
+		conversionService, err := NewADFConversionService(client, "3")
+		assert.NoError(t, err)
+
+		_, _, err = conversionService.WikiToADF(context.Background(), []string{"h1. Title", "*bold*"})
+		assert.True(t, errors.Is(err, model.ErrADFConversionResultsError))
+	})
+
+	t.Run("when the http call cannot be executed", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockADFConversion(client, model.ADFConversionWiki, "h1. Title", 1, "", errors.New("error, request failed"))
+
+		conversionService, err := NewADFConversionService(client, "3")
+		assert.NoError(t, err)
+
+		_, _, err = conversionService.WikiToADF(context.Background(), []string{"h1. Title"})
+		assert.EqualError(t, err, "error, request failed")
+	})
+
+	t.Run("when the bodies are not provided", func(t *testing.T) {
+
+		conversionService, err := NewADFConversionService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = conversionService.WikiToADF(context.Background(), nil)
+		assert.EqualError(t, err, model.ErrNoADFConversionItemsError.Error())
+	})
+
+	t.Run("when the api version is not provided", func(t *testing.T) {
+
+		_, err := NewADFConversionService(nil, "")
+		assert.EqualError(t, err, model.ErrNoVersionProvided.Error())
+	})
+}
+
+func Test_internalADFConversionImpl_ADFToWiki(t *testing.T) {
+
+	document := &model.CommentNodeScheme{Version: 1, Type: "doc", Content: []*model.CommentNodeScheme{
+		{Type: "paragraph", Content: []*model.CommentNodeScheme{{Type: "text", Text: "bold", Marks: []*model.MarkScheme{{Type: "strong"}}}}},
+	}}
+
+	client := mocks.NewClient(t)
+	mockADFConversion(client, model.ADFConversionADF, document, 2, `{"results":[{"value":"*bold*"},{"error":"invalid document"}]}`, nil)
+
+	conversionService, err := NewADFConversionService(client, "3")
+	assert.NoError(t, err)
+
+	results, _, err := conversionService.ADFToWiki(context.Background(), []*model.CommentNodeScheme{document, {Type: "unknown"}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*model.ADFConversionResultScheme{
+		{Index: 0, Wiki: "*bold*"},
+		{Index: 1, Err: fmt.Errorf("%w: %v", model.ErrADFConversionError, "invalid document")},
+	}, results)
+}
@@ -53,7 +53,7 @@ func (i *internalApplicationRoleImpl) Gets(ctx context.Context) ([]*model.Applic
 
 	endpoint := fmt.Sprintf("rest/api/%v/applicationrole", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "role.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -75,7 +75,7 @@ func (i *internalApplicationRoleImpl) Get(ctx context.Context, key string) (*mod
 
 	endpoint := fmt.Sprintf("rest/api/%v/applicationrole/%v", i.version, key)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "role.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// attachmentCopyMaxRedirects is the maximum number of redirects followed to download an attachment
+const attachmentCopyMaxRedirects = 5
+
+func (i *internalIssueAttachmentServiceImpl) Copy(ctx context.Context, source service.StreamClient, sourceAttachmentID, targetIssueKeyOrID string) (
+	*model.AttachmentScheme, *model.ResponseScheme, error) {
+
+	if source == nil {
+		return nil, nil, model.ErrNoSourceClientError
+	}
+
+	if sourceAttachmentID == "" {
+		return nil, nil, model.ErrNoAttachmentIDError
+	}
+
+	if targetIssueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	sourceAttachment := &internalIssueAttachmentServiceImpl{c: source, version: i.version}
+
+	metadata, response, err := sourceAttachment.Metadata(ctx, sourceAttachmentID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	settings, response, err := i.Settings(ctx)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if !settings.Enabled {
+		return nil, response, model.ErrAttachmentsDisabledError
+	}
+
+	if settings.UploadLimit > 0 && metadata.Size > settings.UploadLimit {
+		return nil, response, fmt.Errorf("%w: %v bytes", model.ErrAttachmentTooLargeError, metadata.Size)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/attachment/content/%v", i.version, sourceAttachmentID)
+
+	content, response, err := streamAttachment(ctx, source, "issue.attachment.copy", endpoint)
+	if err != nil {
+		return nil, response, err
+	}
+	defer content.Close()
+
+	attachments, response, err := i.uploadAttachment(ctx, "issue.attachment.copy", targetIssueKeyOrID, metadata.Filename, content)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if len(attachments) == 0 {
+		return nil, response, nil
+	}
+
+	return attachments[0], response, nil
+}
+
+// streamAttachment requests the content of an attachment or a thumbnail, following the redirects to the media service.
+//
+// The redirects are followed by the HTTP clients by default, the clients not following them return the redirect,
+// the location is then requested without the client authorization header, as it's on a different origin.
+func streamAttachment(ctx context.Context, source service.StreamClient, operation, endpoint string) (io.ReadCloser, *model.ResponseScheme, error) {
+
+	request, err := service.NewOperationRequest(ctx, source, operation, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for redirects := 0; ; redirects++ {
+
+		response, err := source.Stream(request)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		transformed := &model.ResponseScheme{
+			Response:  response,
+			Code:      response.StatusCode,
+			Endpoint:  request.URL.String(),
+			Method:    request.Method,
+			Operation: model.OperationFromContext(request.Context()),
+		}
+
+		if response.StatusCode >= 200 && response.StatusCode < 300 {
+			return response.Body, transformed, nil
+		}
+
+		location := redirectLocation(transformed)
+
+		// The body of the error responses is kept on the response bytes
+		body, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		transformed.Bytes.Write(body)
+
+		if location == "" || redirects >= attachmentCopyMaxRedirects {
+			return nil, transformed, model.ErrInvalidStatusCodeError
+		}
+
+		// The redirect keeps the context of the request, so it keeps its operation
+		request, err = http.NewRequestWithContext(request.Context(), http.MethodGet, location, nil)
+		if err != nil {
+			return nil, transformed, err
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// streamClientMock is a source client returning the stream responses on their order
+type streamClientMock struct {
+	*mocks.Client
+	responses []*http.Response
+	requests  []*http.Request
+}
+
+func (s *streamClientMock) Stream(request *http.Request) (*http.Response, error) {
+
+	s.requests = append(s.requests, request)
+
+	response := s.responses[0]
+	s.responses = s.responses[1:]
+	response.Request = request
+
+	return response, nil
+}
+
+// mockCopySource mocks the metadata and the content request of the 10000 attachment
+func mockCopySource(t *testing.T, size int, responses ...*http.Response) *streamClientMock {
+
+	client := mocks.NewClient(t)
+
+	metadataRequest := &http.Request{RequestURI: "metadata"}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/attachment/10000",
+		nil).
+		Return(metadataRequest, nil)
+
+	client.On("Call",
+		metadataRequest,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.AttachmentMetadataScheme) = model.AttachmentMetadataScheme{Filename: "report.txt", Size: size}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	if len(responses) != 0 {
+
+		contentURL, _ := url.Parse("https://source.atlassian.net/rest/api/3/attachment/content/10000")
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/attachment/content/10000",
+			nil).
+			Return(&http.Request{Method: http.MethodGet, URL: contentURL, Header: http.Header{"Authorization": {"Basic source"}}}, nil)
+	}
+
+	return &streamClientMock{Client: client, responses: responses}
+}
+
+// mockCopySettings mocks the attachment settings of the target site
+func mockCopySettings(client *mocks.Client, settings model.AttachmentSettingScheme) {
+
+	settingsRequest := &http.Request{RequestURI: "settings"}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/attachment/meta",
+		nil).
+		Return(settingsRequest, nil)
+
+	client.On("Call",
+		settingsRequest,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.AttachmentSettingScheme) = settings
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+func Test_internalIssueAttachmentServiceImpl_Copy(t *testing.T) {
+
+	t.Run("when the attachment is streamed through the media redirect", func(t *testing.T) {
+
+		source := mockCopySource(t, 11,
+			&http.Response{
+				StatusCode: http.StatusSeeOther,
+				Header:     http.Header{"Location": {"https://api.media.atlassian.com/file/abc/binary?token=media-token"}},
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+			},
+			&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader("hello world")),
+			})
+
+		target := mocks.NewClient(t)
+		mockCopySettings(target, model.AttachmentSettingScheme{Enabled: true, UploadLimit: 1024})
+
+		var contentType string
+		var form io.Reader
+		uploadRequest := &http.Request{RequestURI: "upload"}
+
+		target.On("NewFormRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/DUMMY-2/attachments",
+			mock.Anything,
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				contentType, form = args.String(3), args.Get(4).(io.Reader)
+			}).
+			Return(uploadRequest, nil)
+
+		var uploadedName, uploadedContent string
+		target.On("Call",
+			uploadRequest,
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+
+				_, params, _ := mime.ParseMediaType(contentType)
+				part, err := multipart.NewReader(form, params["boundary"]).NextPart()
+				assert.NoError(t, err)
+
+				content, _ := ioutil.ReadAll(part)
+				uploadedName, uploadedContent = part.FileName(), string(content)
+
+				*args.Get(1).(*[]*model.AttachmentScheme) = []*model.AttachmentScheme{{ID: "20000", Filename: part.FileName()}}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		newService, err := NewIssueAttachmentService(target, "3")
+		assert.NoError(t, err)
+
+		attachment, _, err := newService.Copy(context.Background(), source, "10000", "DUMMY-2")
+		assert.NoError(t, err)
+
+		assert.Equal(t, "20000", attachment.ID)
+		assert.Equal(t, "report.txt", uploadedName)
+		assert.Equal(t, "hello world", uploadedContent)
+
+		// The media service location is requested without the authorization header of the source
+		assert.Len(t, source.requests, 2)
+		assert.Equal(t, "api.media.atlassian.com", source.requests[1].URL.Host)
+		assert.Empty(t, source.requests[1].Header.Get("Authorization"))
+	})
+
+	t.Run("when the download fails", func(t *testing.T) {
+
+		source := mockCopySource(t, 11, &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"errorMessages":["The attachment does not exist"]}`)),
+		})
+
+		target := mocks.NewClient(t)
+		mockCopySettings(target, model.AttachmentSettingScheme{Enabled: true})
+
+		newService, err := NewIssueAttachmentService(target, "3")
+		assert.NoError(t, err)
+
+		_, response, err := newService.Copy(context.Background(), source, "10000", "DUMMY-2")
+		assert.Equal(t, model.ErrInvalidStatusCodeError, err)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.Contains(t, response.Bytes.String(), "The attachment does not exist")
+	})
+
+	testCases := []struct {
+		name     string
+		settings model.AttachmentSettingScheme
+		Err      error
+	}{
+		{
+			name:     "when the attachment exceeds the upload limit",
+			settings: model.AttachmentSettingScheme{Enabled: true, UploadLimit: 10},
+			Err:      model.ErrAttachmentTooLargeError,
+		},
+
+		{
+			name:     "when the attachments are disabled",
+			settings: model.AttachmentSettingScheme{},
+			Err:      model.ErrAttachmentsDisabledError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			source := mockCopySource(t, 11)
+
+			target := mocks.NewClient(t)
+			mockCopySettings(target, testCase.settings)
+
+			newService, err := NewIssueAttachmentService(target, "3")
+			assert.NoError(t, err)
+
+			_, _, err = newService.Copy(context.Background(), source, "10000", "DUMMY-2")
+			assert.True(t, errors.Is(err, testCase.Err))
+		})
+	}
+
+	t.Run("when the parameters are not provided", func(t *testing.T) {
+
+		newService, err := NewIssueAttachmentService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.Copy(context.Background(), nil, "10000", "DUMMY-2")
+		assert.Equal(t, model.ErrNoSourceClientError, err)
+
+		var source service.StreamClient = &streamClientMock{}
+
+		_, _, err = newService.Copy(context.Background(), source, "", "DUMMY-2")
+		assert.Equal(t, model.ErrNoAttachmentIDError, err)
+
+		_, _, err = newService.Copy(context.Background(), source, "10000", "")
+		assert.Equal(t, model.ErrNoIssueKeyOrIDError, err)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -69,8 +70,25 @@ func (i *IssueAttachmentService) Human(ctx context.Context, attachmentId string)
 	return i.internalClient.Human(ctx, attachmentId)
 }
 
+// Raw returns the metadata for the contents of an attachment, if it is an archive.
+//
+// For example, if the attachment is a ZIP archive, then information about the files in the archive is returned.
+//
+// Unlike Human, the sizes are returned in bytes and the attachment metadata isn't returned.
+//
+// GET /rest/api/{2-3}/attachment/{id}/expand/raw
+//
+// Experimental Endpoint
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#get-contents-metadata-for-an-expanded-attachment
+func (i *IssueAttachmentService) Raw(ctx context.Context, attachmentId string) (*model.AttachmentRawMetadataScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Raw(ctx, attachmentId)
+}
+
 // Add adds one attachment to an issue. Attachments are posted as multipart/form-data (RFC 1867).
 //
+// The file is streamed while the request is sent, it's never loaded into memory in full.
+//
 // POST /rest/api/{2-3}/issue/{issueIdOrKey}/attachments
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#add-attachment
@@ -78,15 +96,52 @@ func (i *IssueAttachmentService) Add(ctx context.Context, issueKeyOrId, fileName
 	return i.internalClient.Add(ctx, issueKeyOrId, fileName, file)
 }
 
-// Download returns the contents of an attachment. A Range header can be set to define a range of bytes within the attachment to download.
+// Download writes the contents of an attachment into the writer.
+//
+// The contents are streamed into the writer, the redirect to the media service is followed when redirect is true.
 //
-// See the HTTP Range header standard for details.
+// The Content-Type and the Content-Length headers are available on the response.
 //
 // GET /rest/api/{2-3}/attachment/content/{id}
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#download-attachment
-func (i *IssueAttachmentService) Download(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error) {
-	return i.internalClient.Download(ctx, attachmentID, redirect)
+func (i *IssueAttachmentService) Download(ctx context.Context, attachmentID string, redirect bool, w io.Writer) (*model.ResponseScheme, error) {
+	return i.internalClient.Download(ctx, attachmentID, redirect, w)
+}
+
+// DownloadThumbnail writes the thumbnail of an attachment into the writer, the redirect to the media service is followed.
+//
+// GET /rest/api/{2-3}/attachment/thumbnail/{id}
+//
+// TODO: the documentation needs to be created
+func (i *IssueAttachmentService) DownloadThumbnail(ctx context.Context, attachmentID string, options *model.AttachmentThumbnailOptionsScheme, w io.Writer) (*model.ResponseScheme, error) {
+	return i.internalClient.DownloadThumbnail(ctx, attachmentID, options, w)
+}
+
+// Thumbnail returns the thumbnail of an attachment, it's read into memory by DownloadThumbnail.
+//
+// The redirect to the media service is followed without the authorization header.
+//
+// GET /rest/api/{2-3}/attachment/thumbnail/{id}
+//
+// TODO: the documentation needs to be created
+func (i *IssueAttachmentService) Thumbnail(ctx context.Context, attachmentID string, options *model.AttachmentThumbnailOptionsScheme) (*model.AttachmentThumbnailScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Thumbnail(ctx, attachmentID, options)
+}
+
+// Copy copies an attachment of another issue or site to an issue, the source client is the client of the site
+// of the attachment, e.g. a client with other credentials.
+//
+// The attachment is streamed from the download into the multipart upload without buffering the file,
+// the filename is preserved and the new attachment is returned.
+//
+// The upload limit and the attachment settings of the target site are checked before the download.
+//
+// GET /rest/api/{2-3}/attachment/content/{id}
+//
+// POST /rest/api/{2-3}/issue/{issueIdOrKey}/attachments
+func (i *IssueAttachmentService) Copy(ctx context.Context, source service.StreamClient, sourceAttachmentID, targetIssueKeyOrID string) (*model.AttachmentScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Copy(ctx, source, sourceAttachmentID, targetIssueKeyOrID)
 }
 
 type internalIssueAttachmentServiceImpl struct {
@@ -94,12 +149,16 @@ type internalIssueAttachmentServiceImpl struct {
 	version string
 }
 
-func (i *internalIssueAttachmentServiceImpl) Download(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error) {
+func (i *internalIssueAttachmentServiceImpl) Download(ctx context.Context, attachmentID string, redirect bool, w io.Writer) (*model.ResponseScheme, error) {
 
 	if attachmentID == "" {
 		return nil, model.ErrNoAttachmentIDError
 	}
 
+	if w == nil {
+		return nil, model.ErrNoAttachmentWriterError
+	}
+
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("rest/api/%v/attachment/content/%v", i.version, attachmentID))
 
@@ -111,19 +170,143 @@ func (i *internalIssueAttachmentServiceImpl) Download(ctx context.Context, attac
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	return i.download(ctx, "issue.attachment.download", endpoint.String(), w)
+}
+
+func (i *internalIssueAttachmentServiceImpl) DownloadThumbnail(ctx context.Context, attachmentID string, options *model.AttachmentThumbnailOptionsScheme, w io.Writer) (*model.ResponseScheme, error) {
+
+	if attachmentID == "" {
+		return nil, model.ErrNoAttachmentIDError
+	}
+
+	if w == nil {
+		return nil, model.ErrNoAttachmentWriterError
+	}
+
+	return i.download(ctx, "issue.attachment.downloadThumbnail", i.thumbnailEndpoint(attachmentID, options), w)
+}
+
+// download streams the content of the endpoint into the writer, the clients not implementing service.StreamClient
+// read the content into the response bytes before it's written.
+func (i *internalIssueAttachmentServiceImpl) download(ctx context.Context, operation, endpoint string, w io.Writer) (*model.ResponseScheme, error) {
+
+	if streamer, ok := i.c.(service.StreamClient); ok {
+
+		content, response, err := streamAttachment(ctx, streamer, operation, endpoint)
+		if err != nil {
+			return response, err
+		}
+		defer content.Close()
+
+		_, err = io.Copy(w, content)
+		return response, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return i.c.Call(request, nil)
+	response, err := i.c.Call(request, nil)
+
+	// The HTTP clients not following the redirects return the media service location instead of the content,
+	// the location is on a different origin, so it's requested without the client authorization header.
+	if location := redirectLocation(response); err != nil && location != "" {
+
+		request, err = http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return response, err
+		}
+
+		response, err = i.c.Call(request, nil)
+	}
+
+	if err != nil {
+		return response, err
+	}
+
+	_, err = w.Write(response.Bytes.Bytes())
+	return response, err
+}
+
+func (i *internalIssueAttachmentServiceImpl) Thumbnail(ctx context.Context, attachmentID string, options *model.AttachmentThumbnailOptionsScheme) (*model.AttachmentThumbnailScheme, *model.ResponseScheme, error) {
+
+	var content bytes.Buffer
+	response, err := i.DownloadThumbnail(ctx, attachmentID, options, &content)
+	if err != nil {
+		return nil, response, err
+	}
+
+	thumbnail := &model.AttachmentThumbnailScheme{Content: content.Bytes()}
+
+	if response != nil && response.Response != nil {
+		thumbnail.ContentType = response.Header.Get("Content-Type")
+	}
+
+	if thumbnail.ContentType == "" {
+		thumbnail.ContentType = http.DetectContentType(thumbnail.Content)
+	}
+
+	return thumbnail, response, nil
+}
+
+// thumbnailEndpoint returns the endpoint of the thumbnail of the attachment, sized by the options.
+func (i *internalIssueAttachmentServiceImpl) thumbnailEndpoint(attachmentID string, options *model.AttachmentThumbnailOptionsScheme) string {
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/attachment/thumbnail/%v", i.version, attachmentID))
+
+	if options != nil {
+
+		params := url.Values{}
+
+		if options.Width > 0 {
+			params.Add("width", strconv.Itoa(options.Width))
+		}
+
+		if options.Height > 0 {
+			params.Add("height", strconv.Itoa(options.Height))
+		}
+
+		if options.FallbackToDefault {
+			params.Add("fallbackToDefault", "true")
+		}
+
+		if len(params) != 0 {
+			endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+		}
+	}
+
+	return endpoint.String()
+}
+
+// redirectLocation returns the location of the redirect response, or an empty string if the response isn't a redirect.
+func redirectLocation(response *model.ResponseScheme) string {
+
+	if response == nil || response.Response == nil {
+		return ""
+	}
+
+	switch response.Code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		return ""
+	}
+
+	// The relative locations are resolved against the request URL
+	location, err := response.Location()
+	if err != nil {
+		return ""
+	}
+
+	return location.String()
 }
 
 func (i *internalIssueAttachmentServiceImpl) Settings(ctx context.Context) (*model.AttachmentSettingScheme, *model.ResponseScheme, error) {
 
 	endpoint := fmt.Sprintf("rest/api/%v/attachment/meta", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.attachment.settings", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -145,7 +328,7 @@ func (i *internalIssueAttachmentServiceImpl) Metadata(ctx context.Context, attac
 
 	endpoint := fmt.Sprintf("rest/api/%v/attachment/%v", i.version, attachmentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.attachment.metadata", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -167,7 +350,7 @@ func (i *internalIssueAttachmentServiceImpl) Delete(ctx context.Context, attachm
 
 	endpoint := fmt.Sprintf("rest/api/%v/attachment/%v", i.version, attachmentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.attachment.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +366,7 @@ func (i *internalIssueAttachmentServiceImpl) Human(ctx context.Context, attachme
 
 	endpoint := fmt.Sprintf("rest/api/%v/attachment/%v/expand/human", i.version, attachmentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.attachment.human", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -197,6 +380,28 @@ func (i *internalIssueAttachmentServiceImpl) Human(ctx context.Context, attachme
 	return metadata, response, nil
 }
 
+func (i *internalIssueAttachmentServiceImpl) Raw(ctx context.Context, attachmentId string) (*model.AttachmentRawMetadataScheme, *model.ResponseScheme, error) {
+
+	if attachmentId == "" {
+		return nil, nil, model.ErrNoAttachmentIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/attachment/%v/expand/raw", i.version, attachmentId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.attachment.raw", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := new(model.AttachmentRawMetadataScheme)
+	response, err := i.c.Call(request, metadata)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return metadata, response, nil
+}
+
 func (i *internalIssueAttachmentServiceImpl) Add(ctx context.Context, issueKeyOrId, fileName string, file io.Reader) ([]*model.AttachmentScheme, *model.ResponseScheme, error) {
 
 	if issueKeyOrId == "" {
@@ -211,30 +416,48 @@ func (i *internalIssueAttachmentServiceImpl) Add(ctx context.Context, issueKeyOr
 		return nil, nil, model.ErrNoReaderError
 	}
 
-	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/attachments", i.version, issueKeyOrId)
+	return i.uploadAttachment(ctx, "issue.attachment.add", issueKeyOrId, fileName, file)
+}
 
-	reader := &bytes.Buffer{}
-	writer := multipart.NewWriter(reader)
+// uploadAttachment streams the content into the multipart upload, the content is not buffered.
+// The upload returns once the content is no longer read, so the caller can close it right after.
+func (i *internalIssueAttachmentServiceImpl) uploadAttachment(ctx context.Context, operation, issueKeyOrID, fileName string, content io.Reader) (
+	[]*model.AttachmentScheme, *model.ResponseScheme, error) {
 
-	attachment, err := writer.CreateFormFile("file", fileName)
-	if err != nil {
-		return nil, nil, err
-	}
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
 
-	_, err = io.Copy(attachment, file)
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/attachments", i.version, issueKeyOrID)
+
+	request, err := service.NewOperationFormRequest(ctx, i.c, operation, http.MethodPost, endpoint, form.FormDataContentType(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	writer.Close()
+	copied := make(chan struct{})
+	go func() {
+		defer close(copied)
 
-	request, err := i.c.NewFormRequest(ctx, http.MethodPost, endpoint, writer.FormDataContentType(), reader)
-	if err != nil {
-		return nil, nil, err
-	}
+		part, err := form.CreateFormFile("file", fileName)
+		if err == nil {
+			_, err = io.Copy(part, content)
+		}
+
+		if err == nil {
+			err = form.Close()
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	// Closing the reader stops the copy when the upload returns before reading the whole form
+	defer func() {
+		reader.Close()
+		<-copied
+	}()
 
 	var attachments []*model.AttachmentScheme
-	response, err := i.c.Call(request, attachments)
+	response, err := i.c.Call(request, &attachments)
 	if err != nil {
 		return nil, response, err
 	}
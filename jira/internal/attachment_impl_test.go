@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
@@ -436,6 +438,156 @@ func Test_internalIssueAttachmentServiceImpl_Human(t *testing.T) {
 	}
 }
 
+func Test_internalIssueAttachmentServiceImpl_Raw(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		attachmentId string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "1110",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/attachment/1110/expand/raw",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AttachmentRawMetadataScheme{}).
+					Run(func(args mock.Arguments) {
+						metadata := args.Get(1).(*model.AttachmentRawMetadataScheme)
+						assert.NoError(t, json.Unmarshal([]byte(`{"entries":[{"entryIndex":0,"abbreviatedName":"MG00N067.JPG","mediaType":"image/jpeg","name":"MG00N067.JPG","size":2594}],"totalEntryCount":1}`), metadata))
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "1110",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/1110/expand/raw",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AttachmentRawMetadataScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+		},
+
+		{
+			name:   "when the attachment id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "1110",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/attachment/1110/expand/raw",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := attachmentService.Raw(testCase.args.ctx, testCase.args.attachmentId)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
 func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
 
 	type fields struct {
@@ -635,7 +787,7 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 
 				client.On("Call",
 					&http.Request{},
-					[]*model.AttachmentScheme(nil)).
+					new([]*model.AttachmentScheme)).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -665,7 +817,7 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 
 				client.On("Call",
 					&http.Request{},
-					[]*model.AttachmentScheme(nil)).
+					new([]*model.AttachmentScheme)).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -782,6 +934,7 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 		ctx          context.Context
 		attachmentId string
 		redirect     bool
+		w            io.Writer
 	}
 
 	testCases := []struct {
@@ -799,6 +952,7 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 				ctx:          context.TODO(),
 				attachmentId: "1110",
 				redirect:     false,
+				w:            &bytes.Buffer{},
 			},
 			on: func(fields *fields) {
 
@@ -827,6 +981,7 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 				ctx:          context.TODO(),
 				attachmentId: "1110",
 				redirect:     true,
+				w:            &bytes.Buffer{},
 			},
 			on: func(fields *fields) {
 
@@ -849,12 +1004,24 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 			},
 		},
 
+		{
+			name:   "when the writer is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.TODO(),
+				attachmentId: "1110",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentWriterError,
+		},
+
 		{
 			name:   "when the attachment id is not provided",
 			fields: fields{version: "2"},
 			args: args{
 				ctx:          context.TODO(),
 				attachmentId: "",
+				w:            &bytes.Buffer{},
 			},
 			on: func(fields *fields) {
 				fields.c = mocks.NewClient(t)
@@ -870,6 +1037,7 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 				ctx:          context.TODO(),
 				attachmentId: "1110",
 				redirect:     true,
+				w:            &bytes.Buffer{},
 			},
 			on: func(fields *fields) {
 
@@ -900,7 +1068,7 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
 			assert.NoError(t, err)
 
-			gotResponse, err := attachmentService.Download(testCase.args.ctx, testCase.args.attachmentId, testCase.args.redirect)
+			gotResponse, err := attachmentService.Download(testCase.args.ctx, testCase.args.attachmentId, testCase.args.redirect, testCase.args.w)
 
 			if testCase.wantErr {
 
@@ -920,6 +1088,203 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 	}
 }
 
+func Test_internalIssueAttachmentServiceImpl_Thumbnail(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		attachmentId string
+		options      *model.AttachmentThumbnailOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.AttachmentThumbnailScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "1110",
+				options: &model.AttachmentThumbnailOptionsScheme{
+					Width:             200,
+					Height:            100,
+					FallbackToDefault: true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/thumbnail/1110?fallbackToDefault=true&height=100&width=200",
+					nil).
+					Return(&http.Request{}, nil)
+
+				response := &model.ResponseScheme{
+					Response: &http.Response{Header: http.Header{"Content-Type": []string{"image/png"}}},
+					Code:     http.StatusOK,
+				}
+				response.Bytes.WriteString("thumbnail")
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(response, nil)
+
+				fields.c = client
+			},
+			want: &model.AttachmentThumbnailScheme{ContentType: "image/png", Content: []byte("thumbnail")},
+		},
+
+		{
+			name:   "when the api version is v2 and the redirect is not followed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "1110",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/attachment/thumbnail/1110",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{
+						Response: &http.Response{Header: http.Header{"Location": []string{"https://api.media.atlassian.com/file/1110/image"}}},
+						Code:     http.StatusSeeOther,
+					}, model.ErrInvalidStatusCodeError)
+
+				response := &model.ResponseScheme{Response: &http.Response{}, Code: http.StatusOK}
+				response.Bytes.WriteString("thumbnail")
+
+				client.On("Call",
+					mock.MatchedBy(func(request *http.Request) bool {
+						return request.URL.String() == "https://api.media.atlassian.com/file/1110/image" &&
+							request.Header.Get("Authorization") == ""
+					}),
+					nil).
+					Return(response, nil)
+
+				fields.c = client
+			},
+			want: &model.AttachmentThumbnailScheme{ContentType: "text/plain; charset=utf-8", Content: []byte("thumbnail")},
+		},
+
+		{
+			name:   "when the attachment id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentIDError,
+		},
+
+		{
+			name:   "when the thumbnail is not found",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "1110",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/thumbnail/1110",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{Response: &http.Response{}, Code: http.StatusNotFound}, model.ErrInvalidStatusCodeError)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidStatusCodeError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: "1110",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/thumbnail/1110",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := attachmentService.Thumbnail(testCase.args.ctx, testCase.args.attachmentId, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
 func TestNewIssueAttachmentService(t *testing.T) {
 
 	type args struct {
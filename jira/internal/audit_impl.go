@@ -70,7 +70,7 @@ func (i *internalAuditRecordImpl) Get(ctx context.Context, options *model.AuditR
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "audit.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+)
+
+// The defaults of the bulk helpers processing the issues matching a JQL query, e.g. the user migrations or the bulk moves
+const (
+	defaultBulkConcurrency    = 5
+	defaultBulkMaxConcurrency = 20
+	defaultBulkPageSize       = 50
+	defaultBulkMaxRetries     = 3
+)
+
+// newBulkController pins the concurrency when it's set, otherwise the concurrency adapts to the rate limit of the site.
+func newBulkController(concurrency int) *throttle.Controller {
+
+	if concurrency > 0 {
+		return throttle.NewFixed(concurrency)
+	}
+
+	return throttle.NewAdaptive(defaultBulkConcurrency, defaultBulkMaxConcurrency)
+}
+
+// callWithController retries the throttled calls as throttle.Retry does, every attempt takes a slot of the controller.
+func callWithController(ctx context.Context, controller *throttle.Controller, maxRetries int, call func() (*model.ResponseScheme, error)) (*model.ResponseScheme, error) {
+
+	return throttle.Retry(ctx, maxRetries, func() (*model.ResponseScheme, error) {
+
+		release, err := controller.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := call()
+
+		var (
+			code   int
+			header http.Header
+		)
+
+		if response != nil {
+			code = response.Code
+
+			if response.Response != nil {
+				header = response.Header
+			}
+		}
+
+		release(code, header)
+
+		return response, err
+	})
+}
+
+// searchIssueKeys returns the keys of the issues matching the JQL query.
+//
+// The keys are collected before processing the issues, the processed issues may no longer match the query and would
+// shift the search pages. The query is sent on the body, so the long queries don't exceed the URL length.
+func searchIssueKeys(ctx context.Context, search jira.SearchADFConnector, jql string) ([]string, *model.ResponseScheme, error) {
+
+	var issueKeys []string
+
+	for startAt := 0; ; {
+
+		var page *model.IssueSearchScheme
+		response, err := throttle.Retry(ctx, defaultBulkMaxRetries, func() (response *model.ResponseScheme, err error) {
+			page, response, err = search.Post(ctx, jql, []string{"id"}, nil, startAt, defaultBulkPageSize, "")
+			return response, err
+		})
+
+		if err != nil {
+			return nil, response, err
+		}
+
+		for _, issue := range page.Issues {
+			issueKeys = append(issueKeys, issue.Key)
+		}
+
+		startAt += len(page.Issues)
+
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return issueKeys, response, nil
+		}
+	}
+}
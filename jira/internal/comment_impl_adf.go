@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CommentADFService struct {
@@ -43,6 +44,15 @@ func (c *CommentADFService) Get(ctx context.Context, issueKeyOrId, commentId str
 	return c.internalClient.Get(ctx, issueKeyOrId, commentId)
 }
 
+// List returns the comments by their ids, the comments of the issues the user can't see are not returned.
+//
+// POST /rest/api/{2-3}/comment/list
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (c *CommentADFService) List(ctx context.Context, commentIDs []int, expand []string) (*model.IssueCommentListScheme, *model.ResponseScheme, error) {
+	return c.internalClient.List(ctx, commentIDs, expand)
+}
+
 // Add adds a comment to an issue.
 //
 // POST /rest/api/{2-3}/issue/{issueIdOrKey}/comment
@@ -52,6 +62,30 @@ func (c *CommentADFService) Add(ctx context.Context, issueKeyOrId string, payloa
 	return c.internalClient.Add(ctx, issueKeyOrId, payload, expand)
 }
 
+// Update updates a comment, the users watching the issue are notified when notify is true
+// and the context doesn't suppress the notifications.
+//
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/comment/{id}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (c *CommentADFService) Update(ctx context.Context, issueKeyOrId, commentId string, notify bool, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Update(ctx, issueKeyOrId, commentId, notify, payload, expand)
+}
+
+// Stream pages through the comments of an issue, calling the visitor for each comment created or updated since the date,
+// all the comments are streamed when the date is zero.
+//
+// The comments can only be ordered by their creation date: created, +created or -created, -created by default.
+//
+// Every comment is read whatever the order, so the comments created before the date and edited since are streamed.
+//
+// The next page is requested once the visitor returns, so a slow visitor holds the stream back.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/comment
+func (c *CommentADFService) Stream(ctx context.Context, issueKeyOrId, orderBy string, since time.Time, visitor model.IssueCommentVisitorFunc) (*model.ResponseScheme, error) {
+	return c.internalClient.Stream(ctx, issueKeyOrId, orderBy, since, visitor)
+}
+
 type internalAdfCommentImpl struct {
 	c       service.Client
 	version string
@@ -69,7 +103,7 @@ func (i *internalAdfCommentImpl) Delete(ctx context.Context, issueKeyOrId, comme
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment/%v", i.version, issueKeyOrId, commentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +131,7 @@ func (i *internalAdfCommentImpl) Gets(ctx context.Context, issueKeyOrId, orderBy
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment?%v", i.version, issueKeyOrId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -123,7 +157,7 @@ func (i *internalAdfCommentImpl) Get(ctx context.Context, issueKeyOrId, commentI
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment/%v", i.version, issueKeyOrId, commentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -137,6 +171,43 @@ func (i *internalAdfCommentImpl) Get(ctx context.Context, issueKeyOrId, commentI
 	return comment, response, nil
 }
 
+func (i *internalAdfCommentImpl) List(ctx context.Context, commentIDs []int, expand []string) (*model.IssueCommentListScheme, *model.ResponseScheme, error) {
+
+	if len(commentIDs) == 0 {
+		return nil, nil, model.ErrNoCommentIDsError
+	}
+
+	params := url.Values{}
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/comment/list", i.version))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	reader, err := i.c.TransformStructToReader(&commentListPayload{IDs: commentIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.list", http.MethodPost, endpoint.String(), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments := new(model.IssueCommentListScheme)
+	response, err := i.c.Call(request, comments)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comments, response, nil
+}
+
 func (i *internalAdfCommentImpl) Add(ctx context.Context, issueKeyOrId string, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
 
 	if issueKeyOrId == "" {
@@ -160,7 +231,7 @@ func (i *internalAdfCommentImpl) Add(ctx context.Context, issueKeyOrId string, p
 		return nil, nil, err
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.add", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -173,3 +244,46 @@ func (i *internalAdfCommentImpl) Add(ctx context.Context, issueKeyOrId string, p
 
 	return comment, response, nil
 }
+
+func (i *internalAdfCommentImpl) Update(ctx context.Context, issueKeyOrId, commentId string, notify bool, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if commentId == "" {
+		return nil, nil, model.ErrNoCommentIDError
+	}
+
+	params := url.Values{}
+	params.Add("notifyUsers", fmt.Sprintf("%v", notifyUsers(ctx, notify)))
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment/%v?%v", i.version, issueKeyOrId, commentId, params.Encode())
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.update", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.IssueCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+// commentListPayload is the payload of the comment list endpoint, shared by the ADF and the rich text comments.
+type commentListPayload struct {
+	IDs []int `json:"ids"`
+}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CommentRichTextService struct {
@@ -43,6 +44,15 @@ func (c *CommentRichTextService) Get(ctx context.Context, issueKeyOrId, commentI
 	return c.internalClient.Get(ctx, issueKeyOrId, commentId)
 }
 
+// List returns the comments by their ids, the comments of the issues the user can't see are not returned.
+//
+// POST /rest/api/{2-3}/comment/list
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (c *CommentRichTextService) List(ctx context.Context, commentIDs []int, expand []string) (*model.IssueCommentListSchemeV2, *model.ResponseScheme, error) {
+	return c.internalClient.List(ctx, commentIDs, expand)
+}
+
 // Add adds a comment to an issue.
 //
 // POST /rest/api/{2-3}/issue/{issueIdOrKey}/comment
@@ -52,6 +62,30 @@ func (c *CommentRichTextService) Add(ctx context.Context, issueKeyOrId string, p
 	return c.internalClient.Add(ctx, issueKeyOrId, payload, expand)
 }
 
+// Update updates a comment, the users watching the issue are notified when notify is true
+// and the context doesn't suppress the notifications.
+//
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/comment/{id}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (c *CommentRichTextService) Update(ctx context.Context, issueKeyOrId, commentId string, notify bool, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error) {
+	return c.internalClient.Update(ctx, issueKeyOrId, commentId, notify, payload, expand)
+}
+
+// Stream pages through the comments of an issue, calling the visitor for each comment created or updated since the date,
+// all the comments are streamed when the date is zero.
+//
+// The comments can only be ordered by their creation date: created, +created or -created, -created by default.
+//
+// Every comment is read whatever the order, so the comments created before the date and edited since are streamed.
+//
+// The next page is requested once the visitor returns, so a slow visitor holds the stream back.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/comment
+func (c *CommentRichTextService) Stream(ctx context.Context, issueKeyOrId, orderBy string, since time.Time, visitor model.IssueCommentVisitorFuncV2) (*model.ResponseScheme, error) {
+	return c.internalClient.Stream(ctx, issueKeyOrId, orderBy, since, visitor)
+}
+
 type internalRichTextCommentImpl struct {
 	c       service.Client
 	version string
@@ -69,7 +103,7 @@ func (i *internalRichTextCommentImpl) Delete(ctx context.Context, issueKeyOrId,
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment/%v", i.version, issueKeyOrId, commentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +131,7 @@ func (i *internalRichTextCommentImpl) Gets(ctx context.Context, issueKeyOrId, or
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment?%v", i.version, issueKeyOrId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -123,7 +157,7 @@ func (i *internalRichTextCommentImpl) Get(ctx context.Context, issueKeyOrId, com
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment/%v", i.version, issueKeyOrId, commentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -137,6 +171,43 @@ func (i *internalRichTextCommentImpl) Get(ctx context.Context, issueKeyOrId, com
 	return comment, response, nil
 }
 
+func (i *internalRichTextCommentImpl) List(ctx context.Context, commentIDs []int, expand []string) (*model.IssueCommentListSchemeV2, *model.ResponseScheme, error) {
+
+	if len(commentIDs) == 0 {
+		return nil, nil, model.ErrNoCommentIDsError
+	}
+
+	params := url.Values{}
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/comment/list", i.version))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	reader, err := i.c.TransformStructToReader(&commentListPayload{IDs: commentIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.list", http.MethodPost, endpoint.String(), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments := new(model.IssueCommentListSchemeV2)
+	response, err := i.c.Call(request, comments)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comments, response, nil
+}
+
 func (i *internalRichTextCommentImpl) Add(ctx context.Context, issueKeyOrId string, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error) {
 
 	if issueKeyOrId == "" {
@@ -160,7 +231,45 @@ func (i *internalRichTextCommentImpl) Add(ctx context.Context, issueKeyOrId stri
 		return nil, nil, err
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.add", http.MethodPost, endpoint.String(), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.IssueCommentSchemeV2)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+func (i *internalRichTextCommentImpl) Update(ctx context.Context, issueKeyOrId, commentId string, notify bool, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if commentId == "" {
+		return nil, nil, model.ErrNoCommentIDError
+	}
+
+	params := url.Values{}
+	params.Add("notifyUsers", fmt.Sprintf("%v", notifyUsers(ctx, notify)))
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment/%v?%v", i.version, issueKeyOrId, commentId, params.Encode())
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.comment.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"time"
+)
+
+// commentStreamPageSize is the page size used to stream the comments of an issue
+const commentStreamPageSize = 100
+
+func (i *internalAdfCommentImpl) Stream(ctx context.Context, issueKeyOrId, orderBy string, since time.Time, visitor model.IssueCommentVisitorFunc) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if visitor == nil {
+		return nil, model.ErrNoCommentVisitorError
+	}
+
+	orderBy, err := commentStreamOrder(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for startAt := 0; ; {
+
+		page, response, err := i.Gets(ctx, issueKeyOrId, orderBy, nil, startAt, commentStreamPageSize)
+		if err != nil {
+			return response, err
+		}
+
+		for _, comment := range page.Comments {
+
+			// Every comment is read, the comments created before the date can be edited since
+			emit, err := commentStreamFilter(comment.Created, comment.Updated, since)
+			if err != nil {
+				return response, err
+			}
+
+			if !emit {
+				continue
+			}
+
+			if err := visitor(comment); err != nil {
+				return response, err
+			}
+		}
+
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			return response, nil
+		}
+	}
+}
+
+func (i *internalRichTextCommentImpl) Stream(ctx context.Context, issueKeyOrId, orderBy string, since time.Time, visitor model.IssueCommentVisitorFuncV2) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if visitor == nil {
+		return nil, model.ErrNoCommentVisitorError
+	}
+
+	orderBy, err := commentStreamOrder(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for startAt := 0; ; {
+
+		page, response, err := i.Gets(ctx, issueKeyOrId, orderBy, nil, startAt, commentStreamPageSize)
+		if err != nil {
+			return response, err
+		}
+
+		for _, comment := range page.Comments {
+
+			// Every comment is read, the comments created before the date can be edited since
+			emit, err := commentStreamFilter(comment.Created, comment.Updated, since)
+			if err != nil {
+				return response, err
+			}
+
+			if !emit {
+				continue
+			}
+
+			if err := visitor(comment); err != nil {
+				return response, err
+			}
+		}
+
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			return response, nil
+		}
+	}
+}
+
+// commentStreamOrder validates the order of the comments, the comments are only sortable by their creation date,
+// the descending order is used by default.
+func commentStreamOrder(orderBy string) (string, error) {
+
+	switch orderBy {
+	case "", "-created":
+		return "-created", nil
+	case "created", "+created":
+		return "+created", nil
+	default:
+		return "", model.ErrInvalidCommentOrderError
+	}
+}
+
+// commentStreamFilter returns whether a comment was created or updated since the date.
+// The comments are filtered by their update date, so the edited comments are emitted.
+func commentStreamFilter(created, updated string, since time.Time) (bool, error) {
+
+	if since.IsZero() {
+		return true, nil
+	}
+
+	createdAt, err := time.Parse(model.DateFormatJira, created)
+	if err != nil {
+		return false, err
+	}
+
+	latest := createdAt
+	if updated != "" {
+
+		updatedAt, err := time.Parse(model.DateFormatJira, updated)
+		if err != nil {
+			return false, err
+		}
+
+		if updatedAt.After(latest) {
+			latest = updatedAt
+		}
+	}
+
+	return !latest.Before(since), nil
+}
@@ -0,0 +1,295 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// mockCommentPage mocks a page of the comments of the DUMMY-3 issue, the comments are set by the fill function
+func mockCommentPage(client *mocks.Client, version, orderBy string, startAt string, fill func(args mock.Arguments)) {
+
+	request := &http.Request{RequestURI: orderBy + "-" + startAt}
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodGet,
+		"rest/api/"+version+"/issue/DUMMY-3/comment?maxResults=100&orderBy="+orderBy+"&startAt="+startAt,
+		nil).
+		Return(request, nil)
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(fill).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+func Test_internalAdfCommentImpl_Stream(t *testing.T) {
+
+	since := time.Date(2023, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	descendingPage := func(args mock.Arguments) {
+		*args.Get(1).(*model.IssueCommentPageScheme) = model.IssueCommentPageScheme{
+			Total: 4,
+			Comments: []*model.IssueCommentScheme{
+				{ID: "4", Created: "2023-03-16T10:00:00.000+0000"},
+				{ID: "3", Created: "2023-03-14T10:00:00.000+0000"},
+				{ID: "2", Created: "2023-03-10T10:00:00.000+0000", Updated: "2023-03-15T10:00:00.000+0000"},
+				{ID: "1", Created: "2023-03-09T10:00:00.000+0000"},
+			},
+		}
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrId string
+		orderBy      string
+		since        time.Time
+		visitorErr   error
+		noVisitor    bool
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantVisited []string
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name: "when the descending order streams the edited comments",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-3",
+				since:        since,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockCommentPage(client, "3", "-created", "0", descendingPage)
+
+				fields.c = client
+			},
+			wantVisited: []string{"4", "3", "2"},
+		},
+
+		{
+			name: "when the comment edited since the date is on the last page",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-3",
+				orderBy:      "-created",
+				since:        since,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockCommentPage(client, "3", "-created", "0", func(args mock.Arguments) {
+					*args.Get(1).(*model.IssueCommentPageScheme) = model.IssueCommentPageScheme{
+						Total: 3,
+						Comments: []*model.IssueCommentScheme{
+							{ID: "3", Created: "2023-03-16T10:00:00.000+0000"},
+							{ID: "2", Created: "2023-03-09T10:00:00.000+0000"},
+						},
+					}
+				})
+
+				mockCommentPage(client, "3", "-created", "2", func(args mock.Arguments) {
+					*args.Get(1).(*model.IssueCommentPageScheme) = model.IssueCommentPageScheme{
+						Total: 3,
+						Comments: []*model.IssueCommentScheme{
+							{ID: "1", Created: "2023-01-02T10:00:00.000+0000", Updated: "2023-03-20T10:00:00.000+0000"},
+						},
+					}
+				})
+
+				fields.c = client
+			},
+			wantVisited: []string{"3", "1"},
+		},
+
+		{
+			name: "when the ascending order streams the edited comments",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-3",
+				orderBy:      "created",
+				since:        since,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockCommentPage(client, "3", "%2Bcreated", "0", func(args mock.Arguments) {
+					*args.Get(1).(*model.IssueCommentPageScheme) = model.IssueCommentPageScheme{
+						Total: 3,
+						Comments: []*model.IssueCommentScheme{
+							{ID: "1", Created: "2023-03-09T10:00:00.000+0000"},
+							{ID: "2", Created: "2023-03-10T10:00:00.000+0000", Updated: "2023-03-15T10:00:00.000+0000"},
+						},
+					}
+				})
+
+				mockCommentPage(client, "3", "%2Bcreated", "2", func(args mock.Arguments) {
+					*args.Get(1).(*model.IssueCommentPageScheme) = model.IssueCommentPageScheme{
+						Total:    3,
+						Comments: []*model.IssueCommentScheme{{ID: "3", Created: "2023-03-14T10:00:00.000+0000"}},
+					}
+				})
+
+				fields.c = client
+			},
+			wantVisited: []string{"2", "3"},
+		},
+
+		{
+			name: "when the date is zero",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-3",
+				orderBy:      "-created",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockCommentPage(client, "3", "-created", "0", descendingPage)
+
+				fields.c = client
+			},
+			wantVisited: []string{"4", "3", "2", "1"},
+		},
+
+		{
+			name: "when the visitor returns an error",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-3",
+				since:        since,
+				visitorErr:   errors.New("error, unable to store the comment"),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockCommentPage(client, "3", "-created", "0", descendingPage)
+
+				fields.c = client
+			},
+			wantVisited: []string{"4"},
+			wantErr:     true,
+			Err:         errors.New("error, unable to store the comment"),
+		},
+
+		{
+			name: "when the order is not supported",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-3",
+				orderBy:      "-updated",
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidCommentOrderError,
+		},
+
+		{
+			name: "when the visitor is not provided",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-3",
+				noVisitor:    true,
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommentVisitorError,
+		},
+
+		{
+			name: "when the issue key or id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, _, err := NewCommentService(testCase.fields.c, "3")
+			assert.NoError(t, err)
+
+			var visited []string
+			visitor := func(comment *model.IssueCommentScheme) error {
+				visited = append(visited, comment.ID)
+				return testCase.args.visitorErr
+			}
+
+			if testCase.args.noVisitor {
+				visitor = nil
+			}
+
+			_, err = newService.Stream(testCase.args.ctx, testCase.args.issueKeyOrId, testCase.args.orderBy, testCase.args.since, visitor)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.wantVisited, visited)
+		})
+	}
+}
+
+func Test_internalRichTextCommentImpl_Stream(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	mockCommentPage(client, "2", "-created", "0", func(args mock.Arguments) {
+		*args.Get(1).(*model.IssueCommentPageSchemeV2) = model.IssueCommentPageSchemeV2{
+			Total: 3,
+			Comments: []*model.IssueCommentSchemeV2{
+				{ID: "3", Created: "2023-03-16T10:00:00.000+0000"},
+				{ID: "2", Created: "2023-03-09T10:00:00.000+0000"},
+				{ID: "1", Created: "2023-01-02T10:00:00.000+0000", Updated: "2023-03-20T10:00:00.000+0000"},
+			},
+		}
+	})
+
+	_, newService, err := NewCommentService(client, "2")
+	assert.NoError(t, err)
+
+	var visited []string
+	_, err = newService.Stream(context.Background(), "DUMMY-3", "", time.Date(2023, 3, 14, 0, 0, 0, 0, time.UTC),
+		func(comment *model.IssueCommentSchemeV2) error {
+			visited = append(visited, comment.ID)
+			return nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "1"}, visited)
+}
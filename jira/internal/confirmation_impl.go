@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"sync"
+	"time"
+)
+
+const (
+	defaultConfirmationValidity = 5 * time.Minute
+
+	confirmVersionDeletion     = "version-deletion"
+	confirmProjectDeletion     = "project-deletion"
+	confirmFilterShareDeletion = "filter-share-deletion"
+)
+
+// protectedModeClient is implemented by the clients able to opt in to the protected mode.
+type protectedModeClient interface {
+	Confirmations() *ConfirmationRegistry
+}
+
+// NewConfirmationRegistry returns the registry of the confirmation tokens issued by the previews of the destructive
+// methods, the tokens are valid for the validity duration, 5 minutes when it's not positive.
+func NewConfirmationRegistry(validity time.Duration) *ConfirmationRegistry {
+
+	if validity <= 0 {
+		validity = defaultConfirmationValidity
+	}
+
+	return &ConfirmationRegistry{
+		validity: validity,
+		now:      time.Now,
+		tokens:   make(map[string]*confirmation),
+	}
+}
+
+// ConfirmationRegistry holds the confirmation tokens of a client on protected mode, it's safe for concurrent use.
+type ConfirmationRegistry struct {
+	validity time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]*confirmation
+}
+
+// confirmation is the operation confirmed by a token, the targets are the identifiers accepted for the same
+// resource, e.g. the key and the id of a project.
+type confirmation struct {
+	operation string
+	targets   []string
+	expiresAt time.Time
+}
+
+// issue returns a new token confirming the operation on the targets.
+func (c *ConfirmationRegistry) issue(operation string, targets ...string) (*model.ConfirmationScheme, error) {
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+
+	token := hex.EncodeToString(random)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+
+	// The expired tokens are dropped when a new token is issued, so the registry doesn't grow with the unused previews
+	for issued, confirmation := range c.tokens {
+		if !now.Before(confirmation.expiresAt) {
+			delete(c.tokens, issued)
+		}
+	}
+
+	expiresAt := now.Add(c.validity)
+	c.tokens[token] = &confirmation{operation: operation, targets: targets, expiresAt: expiresAt}
+
+	return &model.ConfirmationScheme{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// confirm consumes the token of the context, the token must confirm the operation on the target and be unexpired.
+//
+// The token is consumed before the operation is sent, so a failed operation needs a new preview.
+func (c *ConfirmationRegistry) confirm(ctx context.Context, operation, target string) error {
+
+	token := model.ConfirmationToken(ctx)
+	if token == "" {
+		return model.ErrConfirmationRequiredError
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	issued, ok := c.tokens[token]
+	if !ok || issued.operation != operation || !c.now().Before(issued.expiresAt) {
+		return model.ErrInvalidConfirmationError
+	}
+
+	for _, issuedTarget := range issued.targets {
+
+		if issuedTarget == target {
+			delete(c.tokens, token)
+			return nil
+		}
+	}
+
+	return model.ErrInvalidConfirmationError
+}
+
+// confirmationRegistry returns the confirmation registry of the client, nil when the protected mode is disabled.
+func confirmationRegistry(client service.Client) *ConfirmationRegistry {
+
+	if protected, ok := client.(protectedModeClient); ok {
+		return protected.Confirmations()
+	}
+
+	return nil
+}
+
+// issueConfirmation returns a token confirming the operation, nil when the protected mode is disabled.
+func issueConfirmation(client service.Client, operation string, targets ...string) (*model.ConfirmationScheme, error) {
+
+	registry := confirmationRegistry(client)
+	if registry == nil {
+		return nil, nil
+	}
+
+	return registry.issue(operation, targets...)
+}
+
+// checkConfirmation checks the context confirms the operation, it always passes when the protected mode is disabled.
+func checkConfirmation(ctx context.Context, client service.Client, operation, target string) error {
+
+	registry := confirmationRegistry(client)
+	if registry == nil {
+		return nil
+	}
+
+	return registry.confirm(ctx, operation, target)
+}
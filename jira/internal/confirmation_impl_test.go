@@ -0,0 +1,257 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// protectedMockClient is a mocked client opted in to the protected mode.
+type protectedMockClient struct {
+	*mocks.Client
+	registry *ConfirmationRegistry
+}
+
+func (c *protectedMockClient) Confirmations() *ConfirmationRegistry {
+	return c.registry
+}
+
+func Test_ConfirmationRegistry(t *testing.T) {
+
+	t.Run("when the token confirms the operation", func(t *testing.T) {
+
+		registry := NewConfirmationRegistry(0)
+
+		confirmation, err := registry.issue(confirmProjectDeletion, "KP", "10000")
+		assert.NoError(t, err)
+		assert.Len(t, confirmation.Token, 32)
+		assert.WithinDuration(t, time.Now().Add(defaultConfirmationValidity), confirmation.ExpiresAt, time.Second)
+
+		ctx := model.WithConfirmation(context.Background(), confirmation.Token)
+		assert.NoError(t, registry.confirm(ctx, confirmProjectDeletion, "10000"))
+
+		// The token is used once
+		assert.Equal(t, model.ErrInvalidConfirmationError, registry.confirm(ctx, confirmProjectDeletion, "10000"))
+	})
+
+	t.Run("when the token is not provided", func(t *testing.T) {
+
+		registry := NewConfirmationRegistry(time.Minute)
+		assert.Equal(t, model.ErrConfirmationRequiredError, registry.confirm(context.Background(), confirmProjectDeletion, "KP"))
+	})
+
+	t.Run("when the token is issued for another target or operation", func(t *testing.T) {
+
+		registry := NewConfirmationRegistry(time.Minute)
+
+		confirmation, err := registry.issue(confirmProjectDeletion, "KP")
+		assert.NoError(t, err)
+
+		ctx := model.WithConfirmation(context.Background(), confirmation.Token)
+		assert.Equal(t, model.ErrInvalidConfirmationError, registry.confirm(ctx, confirmProjectDeletion, "DUMMY"))
+		assert.Equal(t, model.ErrInvalidConfirmationError, registry.confirm(ctx, confirmVersionDeletion, "KP"))
+
+		// The rejected attempts don't consume the token
+		assert.NoError(t, registry.confirm(ctx, confirmProjectDeletion, "KP"))
+	})
+
+	t.Run("when the token is expired", func(t *testing.T) {
+
+		now := time.Date(2022, 5, 2, 10, 0, 0, 0, time.UTC)
+
+		registry := NewConfirmationRegistry(time.Minute)
+		registry.now = func() time.Time { return now }
+
+		expired, err := registry.issue(confirmVersionDeletion, "10391")
+		assert.NoError(t, err)
+
+		now = now.Add(time.Minute)
+		assert.Equal(t, model.ErrInvalidConfirmationError,
+			registry.confirm(model.WithConfirmation(context.Background(), expired.Token), confirmVersionDeletion, "10391"))
+
+		// The expired tokens are dropped when a new token is issued
+		_, err = registry.issue(confirmVersionDeletion, "10392")
+		assert.NoError(t, err)
+		assert.Len(t, registry.tokens, 1)
+	})
+
+	t.Run("when the protected mode is disabled", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		confirmation, err := issueConfirmation(client, confirmVersionDeletion, "10391")
+		assert.NoError(t, err)
+		assert.Nil(t, confirmation)
+
+		assert.NoError(t, checkConfirmation(context.Background(), client, confirmVersionDeletion, "10391"))
+		assert.NoError(t, checkConfirmation(context.Background(), &protectedMockClient{Client: client}, confirmVersionDeletion, "10391"))
+	})
+}
+
+func Test_internalProjectVersionImpl_PreviewDelete(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/version/10391/relatedIssueCounts",
+		nil).
+		Return(&http.Request{}, nil).Once()
+
+	client.On("Call",
+		&http.Request{},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.VersionIssueCountsScheme).IssuesFixedCount = 12
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+
+	protected := &protectedMockClient{Client: client, registry: NewConfirmationRegistry(time.Minute)}
+
+	versionService, err := NewProjectVersionService(protected, "3")
+	assert.NoError(t, err)
+
+	// The merge is refused until the deletion is previewed
+	_, err = versionService.Merge(context.Background(), "10391", "10392")
+	assert.Equal(t, model.ErrConfirmationRequiredError, err)
+
+	preview, _, err := versionService.PreviewDelete(context.Background(), "10391")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, preview.Counts.IssuesFixedCount)
+	assert.NotNil(t, preview.Confirmation)
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodPut,
+		"rest/api/3/version/10391/mergeto/10392",
+		nil).
+		Return(&http.Request{Method: http.MethodPut}, nil).Once()
+
+	client.On("Call",
+		&http.Request{Method: http.MethodPut},
+		nil).
+		Return(&model.ResponseScheme{}, nil).Once()
+
+	ctx := model.WithConfirmation(context.Background(), preview.Confirmation.Token)
+
+	_, err = versionService.Merge(ctx, "10391", "10392")
+	assert.NoError(t, err)
+
+	// The token was consumed by the merge
+	_, err = versionService.Merge(ctx, "10391", "10392")
+	assert.Equal(t, model.ErrInvalidConfirmationError, err)
+}
+
+func Test_internalProjectImpl_PreviewDelete(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/2/project/KP",
+		nil).
+		Return(&http.Request{}, nil).Once()
+
+	client.On("Call",
+		&http.Request{},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			project := args.Get(1).(*model.ProjectScheme)
+			project.ID, project.Key = "10000", "KP"
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+
+	client.On("TransformStructToReader",
+		&struct {
+			JQL string `json:"jql"`
+		}{JQL: `project = "KP"`}).
+		Return(bytes.NewReader(nil), nil).Once()
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"rest/api/2/search/approximate-count",
+		bytes.NewReader(nil)).
+		Return(&http.Request{Method: http.MethodPost}, nil).Once()
+
+	client.On("Call",
+		&http.Request{Method: http.MethodPost},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			if err := json.Unmarshal([]byte(`{"count":42}`), args.Get(1)); err != nil {
+				panic(err)
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+
+	protected := &protectedMockClient{Client: client, registry: NewConfirmationRegistry(time.Minute)}
+
+	projectService, err := NewProjectService(protected, "2", &ProjectChildServices{})
+	assert.NoError(t, err)
+
+	preview, _, err := projectService.PreviewDelete(context.Background(), "KP")
+	assert.NoError(t, err)
+	assert.Equal(t, "10000", preview.Project.ID)
+	assert.Equal(t, 42, preview.Issues)
+
+	// The token issued for the key confirms the deletion by the id
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodPost,
+		"rest/api/2/project/10000/delete",
+		nil).
+		Return(&http.Request{Method: http.MethodDelete}, nil).Once()
+
+	client.On("Call",
+		&http.Request{Method: http.MethodDelete},
+		mock.Anything).
+		Return(&model.ResponseScheme{}, nil).Once()
+
+	_, _, err = projectService.DeleteAsynchronously(model.WithConfirmation(context.Background(), preview.Confirmation.Token), "10000")
+	assert.NoError(t, err)
+
+	_, err = projectService.Delete(context.Background(), "KP", false)
+	assert.Equal(t, model.ErrConfirmationRequiredError, err)
+}
+
+func Test_internalFilterShareImpl_PreviewDelete(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/filter/10001/permission/10000",
+		nil).
+		Return(&http.Request{}, nil).Once()
+
+	client.On("Call",
+		&http.Request{},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.SharePermissionScheme).Type = "global"
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+
+	protected := &protectedMockClient{Client: client, registry: NewConfirmationRegistry(time.Minute)}
+
+	shareService, err := NewFilterShareService(protected, "3", nil)
+	assert.NoError(t, err)
+
+	preview, _, err := shareService.PreviewDelete(context.Background(), 10001, 10000)
+	assert.NoError(t, err)
+	assert.Equal(t, "global", preview.Permission.Type)
+
+	// The token confirms the deletion of the previewed permission only
+	_, err = shareService.Delete(model.WithConfirmation(context.Background(), preview.Confirmation.Token), 10001, 10001)
+	assert.Equal(t, model.ErrInvalidConfirmationError, err)
+}
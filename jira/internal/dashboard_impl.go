@@ -113,7 +113,7 @@ func (i *internalDashboardImpl) Gets(ctx context.Context, startAt, maxResults in
 
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "dashboard.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -136,7 +136,7 @@ func (i *internalDashboardImpl) Create(ctx context.Context, payload *model.Dashb
 
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "dashboard.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -163,15 +163,23 @@ func (i *internalDashboardImpl) Search(ctx context.Context, options *model.Dashb
 		}
 
 		if len(options.DashboardName) != 0 {
-			params.Add("dashboardName", options.OwnerAccountID)
+			params.Add("dashboardName", options.DashboardName)
+		}
+
+		if options.GroupPermissionName != "" && options.GroupPermissionID != "" {
+			return nil, nil, model.ErrGroupNameAndIDError
 		}
 
 		if len(options.GroupPermissionName) != 0 {
-			params.Add("groupname", options.OwnerAccountID)
+			params.Add("groupname", options.GroupPermissionName)
+		}
+
+		if len(options.GroupPermissionID) != 0 {
+			params.Add("groupId", options.GroupPermissionID)
 		}
 
 		if len(options.OrderBy) != 0 {
-			params.Add("orderBy", options.OwnerAccountID)
+			params.Add("orderBy", options.OrderBy)
 		}
 
 		if len(options.Expand) != 0 {
@@ -181,7 +189,7 @@ func (i *internalDashboardImpl) Search(ctx context.Context, options *model.Dashb
 
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard/search?%s", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "dashboard.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -203,7 +211,7 @@ func (i *internalDashboardImpl) Get(ctx context.Context, dashboardId string) (*m
 
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v", i.version, dashboardId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "dashboard.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -225,7 +233,7 @@ func (i *internalDashboardImpl) Delete(ctx context.Context, dashboardId string)
 
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v", i.version, dashboardId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "dashboard.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +259,7 @@ func (i *internalDashboardImpl) Copy(ctx context.Context, dashboardId string, pa
 
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v/copy", i.version, dashboardId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "dashboard.copy", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -278,7 +286,7 @@ func (i *internalDashboardImpl) Update(ctx context.Context, dashboardId string,
 
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v", i.version, dashboardId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "dashboard.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
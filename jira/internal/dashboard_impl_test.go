@@ -705,6 +705,49 @@ func TestDashboardService_Search(t *testing.T) {
 		wantErr bool
 		Err     error
 	}{
+		{
+			name:   "when the group permission id is provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.DashboardSearchOptionsScheme{GroupPermissionID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/dashboard/search?groupId=276f955c-63d7-42c8-9520-92d01dca0625&maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DashboardSearchPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the group permission name and id are provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.DashboardSearchOptionsScheme{
+					GroupPermissionName: "jira-users",
+					GroupPermissionID:   "276f955c-63d7-42c8-9520-92d01dca0625",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrGroupNameAndIDError,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
@@ -727,7 +770,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					nil).
 					Return(&http.Request{}, nil)
 
@@ -763,7 +806,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/3/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/3/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					nil).
 					Return(&http.Request{}, nil)
 
@@ -799,7 +842,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					nil).
 					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
@@ -831,7 +874,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					nil).
 					Return(&http.Request{}, nil)
 
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+	"net/url"
+)
+
+// internalAPIsClient is implemented by the clients able to opt in to the undocumented Jira APIs.
+type internalAPIsClient interface {
+	HasInternalAPIs() bool
+}
+
+func NewDevelopmentService(client service.Client, version string) (*DevelopmentService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &DevelopmentService{
+		internalClient: &internalDevelopmentImpl{c: client, version: version},
+	}, nil
+}
+
+type DevelopmentService struct {
+	internalClient jira.DevelopmentConnector
+}
+
+// Get returns the development information of an issue, e.g. the branches, pull requests and repositories.
+//
+// The applicationType is the source control provider, e.g. "GitHub", "bitbucket" or "stash".
+//
+// The dataType is the kind of development information, e.g. "branch", "pullrequest" or "repository".
+//
+// This endpoint is undocumented, the client internal APIs needs to be enabled.
+//
+// GET /rest/dev-status/1.0/issue/detail
+//
+// TODO: the documentation needs to be created
+func (d *DevelopmentService) Get(ctx context.Context, issueID, applicationType, dataType string) (*model.DevelopmentDetailPageScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Get(ctx, issueID, applicationType, dataType)
+}
+
+// Summary returns the summary of the development information of an issue.
+//
+// This endpoint is undocumented, the client internal APIs needs to be enabled.
+//
+// GET /rest/dev-status/1.0/issue/summary
+//
+// TODO: the documentation needs to be created
+func (d *DevelopmentService) Summary(ctx context.Context, issueID string) (*model.DevelopmentSummaryPageScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Summary(ctx, issueID)
+}
+
+type internalDevelopmentImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalDevelopmentImpl) Get(ctx context.Context, issueID, applicationType, dataType string) (*model.DevelopmentDetailPageScheme, *model.ResponseScheme, error) {
+
+	if !i.internalAPIsEnabled() {
+		return nil, nil, model.ErrInternalAPIsNotEnabledError
+	}
+
+	if issueID == "" {
+		return nil, nil, model.ErrNoIssueIDError
+	}
+
+	if applicationType == "" {
+		return nil, nil, model.ErrNoApplicationTypeError
+	}
+
+	if dataType == "" {
+		return nil, nil, model.ErrNoDevelopmentDataTypeError
+	}
+
+	params := url.Values{}
+	params.Add("issueId", issueID)
+	params.Add("applicationType", applicationType)
+	params.Add("dataType", dataType)
+
+	endpoint := fmt.Sprintf("rest/dev-status/%v/issue/detail?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.development.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	detail := new(model.DevelopmentDetailPageScheme)
+	response, err := i.c.Call(request, detail)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return detail, response, nil
+}
+
+func (i *internalDevelopmentImpl) Summary(ctx context.Context, issueID string) (*model.DevelopmentSummaryPageScheme, *model.ResponseScheme, error) {
+
+	if !i.internalAPIsEnabled() {
+		return nil, nil, model.ErrInternalAPIsNotEnabledError
+	}
+
+	if issueID == "" {
+		return nil, nil, model.ErrNoIssueIDError
+	}
+
+	params := url.Values{}
+	params.Add("issueId", issueID)
+
+	endpoint := fmt.Sprintf("rest/dev-status/%v/issue/summary?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.development.summary", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary := new(model.DevelopmentSummaryPageScheme)
+	response, err := i.c.Call(request, summary)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return summary, response, nil
+}
+
+func (i *internalDevelopmentImpl) internalAPIsEnabled() bool {
+
+	client, ok := i.c.(internalAPIsClient)
+	return ok && client.HasInternalAPIs()
+}
@@ -0,0 +1,320 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+// internalAPIsMockClient is a mocked client opted in to the undocumented Jira APIs.
+type internalAPIsMockClient struct {
+	*mocks.Client
+}
+
+func (c *internalAPIsMockClient) HasInternalAPIs() bool {
+	return true
+}
+
+func Test_internalDevelopmentImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                                context.Context
+		issueID, applicationType, dataType string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:             context.Background(),
+				issueID:         "10001",
+				applicationType: "GitHub",
+				dataType:        "pullrequest",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/dev-status/1.0/issue/detail?applicationType=GitHub&dataType=pullrequest&issueId=10001",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DevelopmentDetailPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = &internalAPIsMockClient{Client: client}
+			},
+		},
+
+		{
+			name:   "when the internal apis are not enabled",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:             context.Background(),
+				issueID:         "10001",
+				applicationType: "GitHub",
+				dataType:        "pullrequest",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrInternalAPIsNotEnabledError,
+		},
+
+		{
+			name:   "when the issue id is not provided",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = &internalAPIsMockClient{Client: mocks.NewClient(t)}
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueIDError,
+		},
+
+		{
+			name:   "when the application type is not provided",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:     context.Background(),
+				issueID: "10001",
+			},
+			on: func(fields *fields) {
+				fields.c = &internalAPIsMockClient{Client: mocks.NewClient(t)}
+			},
+			wantErr: true,
+			Err:     model.ErrNoApplicationTypeError,
+		},
+
+		{
+			name:   "when the data type is not provided",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:             context.Background(),
+				issueID:         "10001",
+				applicationType: "GitHub",
+			},
+			on: func(fields *fields) {
+				fields.c = &internalAPIsMockClient{Client: mocks.NewClient(t)}
+			},
+			wantErr: true,
+			Err:     model.ErrNoDevelopmentDataTypeError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:             context.Background(),
+				issueID:         "10001",
+				applicationType: "GitHub",
+				dataType:        "pullrequest",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/dev-status/1.0/issue/detail?applicationType=GitHub&dataType=pullrequest&issueId=10001",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = &internalAPIsMockClient{Client: client}
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			developmentService, err := NewDevelopmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := developmentService.Get(testCase.args.ctx, testCase.args.issueID,
+				testCase.args.applicationType, testCase.args.dataType)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalDevelopmentImpl_Summary(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		issueID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:     context.Background(),
+				issueID: "10001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/dev-status/1.0/issue/summary?issueId=10001",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DevelopmentSummaryPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = &internalAPIsMockClient{Client: client}
+			},
+		},
+
+		{
+			name:   "when the internal apis are not enabled",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:     context.Background(),
+				issueID: "10001",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrInternalAPIsNotEnabledError,
+		},
+
+		{
+			name:   "when the issue id is not provided",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = &internalAPIsMockClient{Client: mocks.NewClient(t)}
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueIDError,
+		},
+
+		{
+			name:   "when the call fails",
+			fields: fields{version: "1.0"},
+			args: args{
+				ctx:     context.Background(),
+				issueID: "10001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/dev-status/1.0/issue/summary?issueId=10001",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DevelopmentSummaryPageScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusNotFound}, model.ErrInvalidStatusCodeError)
+
+				fields.c = &internalAPIsMockClient{Client: client}
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidStatusCodeError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			developmentService, err := NewDevelopmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := developmentService.Summary(testCase.args.ctx, testCase.args.issueID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
@@ -92,7 +92,7 @@ func (i *internalIssueFieldConfigServiceImpl) Gets(ctx context.Context, ids []in
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfiguration?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -127,7 +127,7 @@ func (i *internalIssueFieldConfigServiceImpl) Create(ctx context.Context, name,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfiguration", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -166,7 +166,7 @@ func (i *internalIssueFieldConfigServiceImpl) Update(ctx context.Context, id int
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfiguration/%v", i.version, id)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +182,7 @@ func (i *internalIssueFieldConfigServiceImpl) Delete(ctx context.Context, id int
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfiguration/%v", i.version, id)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +64,7 @@ func (i *internalIssueFieldConfigItemServiceImpl) Gets(ctx context.Context, id,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfiguration/%v/fields?%v", i.version, id, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.item.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -91,7 +91,7 @@ func (i *internalIssueFieldConfigItemServiceImpl) Update(ctx context.Context, id
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfiguration/%v/fields", i.version, id)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.item.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +146,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Gets(ctx context.Context, id
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,7 +181,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Create(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -207,7 +207,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Mapping(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme/mapping?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.mapping", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -233,7 +233,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Project(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme/project?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.project", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -256,7 +256,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Assign(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme/project", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.assign", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -289,7 +289,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Update(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme/%v", i.version, schemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -305,7 +305,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Delete(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme/%v", i.version, schemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +326,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Link(ctx context.Context, sc
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme/%v/mapping", i.version, schemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.link", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -353,7 +353,7 @@ func (i *internalIssueFieldConfigSchemeServiceImpl) Unlink(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfigurationscheme/%v/mapping/delete", i.version, schemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.configuration.scheme.unlink", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +186,7 @@ func (i *internalIssueFieldContextServiceImpl) Gets(ctx context.Context, fieldId
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context?%v", i.version, fieldId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -213,7 +213,7 @@ func (i *internalIssueFieldContextServiceImpl) Create(ctx context.Context, field
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context", i.version, fieldId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -243,7 +243,7 @@ func (i *internalIssueFieldContextServiceImpl) GetDefaultValues(ctx context.Cont
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/defaultValue?%s", i.version, fieldId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.getDefaultValues", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -270,7 +270,7 @@ func (i *internalIssueFieldContextServiceImpl) SetDefaultValue(ctx context.Conte
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/defaultValue", i.version, fieldId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.setDefaultValue", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +294,7 @@ func (i *internalIssueFieldContextServiceImpl) IssueTypesContext(ctx context.Con
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/issuetypemapping?%v", i.version, fieldId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.issueTypesContext", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -324,7 +324,7 @@ func (i *internalIssueFieldContextServiceImpl) ProjectsContext(ctx context.Conte
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/projectmapping?%v", i.version, fieldId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.projectsContext", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -363,7 +363,7 @@ func (i *internalIssueFieldContextServiceImpl) Update(ctx context.Context, field
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -383,7 +383,7 @@ func (i *internalIssueFieldContextServiceImpl) Delete(ctx context.Context, field
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -414,7 +414,7 @@ func (i *internalIssueFieldContextServiceImpl) AddIssueTypes(ctx context.Context
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/issuetype", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.addIssueTypes", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +445,7 @@ func (i *internalIssueFieldContextServiceImpl) RemoveIssueTypes(ctx context.Cont
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/issuetype/remove", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.removeIssueTypes", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -476,7 +476,7 @@ func (i *internalIssueFieldContextServiceImpl) Link(ctx context.Context, fieldId
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/project", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.link", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -507,7 +507,7 @@ func (i *internalIssueFieldContextServiceImpl) UnLink(ctx context.Context, field
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/project/remove", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.unLink", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
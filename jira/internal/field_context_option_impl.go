@@ -108,7 +108,7 @@ func (i *internalIssueFieldContextOptionServiceImpl) Gets(ctx context.Context, f
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/option?%v", i.version, fieldId, contextId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.option.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -135,7 +135,7 @@ func (i *internalIssueFieldContextOptionServiceImpl) Create(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/option", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.option.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -162,7 +162,7 @@ func (i *internalIssueFieldContextOptionServiceImpl) Update(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/option", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.option.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -192,7 +192,7 @@ func (i *internalIssueFieldContextOptionServiceImpl) Delete(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/option/%v", i.version, fieldId, contextId, optionId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.option.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +217,7 @@ func (i *internalIssueFieldContextOptionServiceImpl) Order(ctx context.Context,
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/option/move", i.version, fieldId, contextId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.context.option.order", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -13,7 +13,7 @@ import (
 )
 
 func NewIssueFieldService(client service.Client, version string, configuration *IssueFieldConfigService, context *IssueFieldContextService,
-	trash *IssueFieldTrashService) (*IssueFieldService, error) {
+	trash *IssueFieldTrashService, option *IssueFieldOptionService) (*IssueFieldService, error) {
 
 	if version == "" {
 		return nil, model.ErrNoVersionProvided
@@ -24,6 +24,7 @@ func NewIssueFieldService(client service.Client, version string, configuration *
 		Configuration:  configuration,
 		Context:        context,
 		Trash:          trash,
+		Option:         option,
 	}, nil
 }
 
@@ -32,6 +33,7 @@ type IssueFieldService struct {
 	Configuration  *IssueFieldConfigService
 	Context        *IssueFieldContextService
 	Trash          *IssueFieldTrashService
+	Option         *IssueFieldOptionService
 }
 
 // Gets returns system and custom issue fields according to the following rules:
@@ -63,6 +65,8 @@ func (i *IssueFieldService) Create(ctx context.Context, payload *model.CustomFie
 
 // Search returns a paginated list of fields for Classic Jira projects.
 //
+// The options AcceptLanguage pins the locale of the field names returned.
+//
 // GET /rest/api/{2-3}/field/search
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/fields#get-fields-paginated
@@ -81,6 +85,40 @@ func (i *IssueFieldService) Delete(ctx context.Context, fieldId string) (*model.
 	return i.internalClient.Delete(ctx, fieldId)
 }
 
+// AddToDefaultScreen adds a field to the default tab of the default screen.
+//
+// The custom fields aren't visible on the issues until they're added to a screen.
+//
+// POST /rest/api/{2-3}/screens/addToDefault/{fieldId}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/screens#add-field-to-default-screen
+func (i *IssueFieldService) AddToDefaultScreen(ctx context.Context, fieldId string) (*model.ResponseScheme, error) {
+	return i.internalClient.AddToDefaultScreen(ctx, fieldId)
+}
+
+// ScreensForField returns a paginated list of the screens a field is used in.
+//
+// Use the "tab" expand to include the screen tabs the field is used in.
+//
+// GET /rest/api/{2-3}/field/{fieldId}/screens
+//
+// https://docs.go-atlassian.io/jira-software-cloud/screens#get-screens-for-a-field
+func (i *IssueFieldService) ScreensForField(ctx context.Context, fieldId string, startAt, maxResults int, expand []string) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.ScreensForField(ctx, fieldId, startAt, maxResults, expand)
+}
+
+// ResolveByName returns the field matching the name, whatever the locale of the user.
+//
+// The fields are matched, in order, on the exact untranslated name, on the exact localized name,
+// then on both names ignoring the case.
+//
+// GET /rest/api/{2-3}/field
+//
+// TODO: the documentation needs to be created
+func (i *IssueFieldService) ResolveByName(ctx context.Context, name string) (*model.IssueFieldScheme, *model.ResponseScheme, error) {
+	return i.internalClient.ResolveByName(ctx, name)
+}
+
 type internalIssueFieldServiceImpl struct {
 	c       service.Client
 	version string
@@ -90,7 +128,7 @@ func (i *internalIssueFieldServiceImpl) Gets(ctx context.Context) ([]*model.Issu
 
 	endpoint := fmt.Sprintf("rest/api/%v/field", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -113,7 +151,7 @@ func (i *internalIssueFieldServiceImpl) Create(ctx context.Context, payload *mod
 
 	endpoint := fmt.Sprintf("rest/api/%v/field", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -158,11 +196,15 @@ func (i *internalIssueFieldServiceImpl) Search(ctx context.Context, options *mod
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if options != nil && options.AcceptLanguage != "" {
+		request.Header.Set("Accept-Language", options.AcceptLanguage)
+	}
+
 	page := new(model.FieldSearchPageScheme)
 	response, err := i.c.Call(request, page)
 	if err != nil {
@@ -180,7 +222,7 @@ func (i *internalIssueFieldServiceImpl) Delete(ctx context.Context, fieldId stri
 
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v", i.version, fieldId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,3 +235,46 @@ func (i *internalIssueFieldServiceImpl) Delete(ctx context.Context, fieldId stri
 
 	return task, response, nil
 }
+
+func (i *internalIssueFieldServiceImpl) AddToDefaultScreen(ctx context.Context, fieldId string) (*model.ResponseScheme, error) {
+	screen := &internalScreenImpl{c: i.c, version: i.version}
+	return screen.AddToDefault(ctx, fieldId)
+}
+
+func (i *internalIssueFieldServiceImpl) ScreensForField(ctx context.Context, fieldId string, startAt, maxResults int, expand []string) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error) {
+	screen := &internalScreenImpl{c: i.c, version: i.version}
+	return screen.Fields(ctx, fieldId, startAt, maxResults, expand)
+}
+
+func (i *internalIssueFieldServiceImpl) ResolveByName(ctx context.Context, name string) (*model.IssueFieldScheme, *model.ResponseScheme, error) {
+
+	if name == "" {
+		return nil, nil, model.ErrNoFieldNameError
+	}
+
+	fields, response, err := i.Gets(ctx)
+	if err != nil {
+		return nil, response, err
+	}
+
+	entities := make([]namedEntity, len(fields))
+	for position, field := range fields {
+		entities[position] = namedEntity{id: field.ID, name: field.Name, untranslatedName: field.UntranslatedName}
+	}
+
+	positions := matchName(name, entities)
+
+	switch len(positions) {
+	case 0:
+		return nil, response, &model.NameResolutionError{Err: model.ErrIssueFieldNameNotFoundError, Name: name}
+	case 1:
+		return fields[positions[0]], response, nil
+	}
+
+	var candidates []string
+	for _, position := range positions {
+		candidates = append(candidates, fields[position].ID)
+	}
+
+	return nil, response, &model.NameResolutionError{Err: model.ErrAmbiguousIssueFieldNameError, Name: name, Candidates: candidates}
+}
@@ -122,7 +122,7 @@ func Test_internalIssueFieldServiceImpl_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil)
+			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := fieldService.Gets(testCase.args.ctx)
@@ -322,7 +322,7 @@ func Test_internalIssueFieldServiceImpl_Create(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil)
+			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := fieldService.Create(testCase.args.ctx, testCase.args.payload)
@@ -367,6 +367,43 @@ func Test_internalIssueFieldServiceImpl_Search(t *testing.T) {
 		wantErr bool
 		Err     error
 	}{
+		{
+			name:   "when the accept language is set",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.FieldSearchOptionsScheme{
+					Query:          "query-sample",
+					AcceptLanguage: "fr-FR",
+				},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/field/search?maxResults=50&query=query-sample&startAt=0",
+					nil).
+					Return(&http.Request{Header: http.Header{}}, nil)
+
+				client.On("Call",
+					mock.MatchedBy(func(request *http.Request) bool {
+						return request.Header.Get("Accept-Language") == "fr-FR"
+					}),
+					&model.FieldSearchPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
 		{
 			name:   "when the api version is v3",
 			fields: fields{version: "3"},
@@ -508,7 +545,7 @@ func Test_internalIssueFieldServiceImpl_Search(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil)
+			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := fieldService.Search(testCase.args.ctx, testCase.args.options,
@@ -657,7 +694,7 @@ func Test_internalIssueFieldServiceImpl_Delete(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil)
+			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := fieldService.Delete(testCase.args.ctx, testCase.args.fieldId)
@@ -681,6 +718,303 @@ func Test_internalIssueFieldServiceImpl_Delete(t *testing.T) {
 	}
 }
 
+func Test_internalIssueFieldServiceImpl_AddToDefaultScreen(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		fieldId string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				fieldId: "customfield_10005",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/screens/addToDefault/customfield_10005",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				fieldId: "customfield_10005",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/screens/addToDefault/customfield_10005",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the field id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoFieldIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				fieldId: "customfield_10005",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/screens/addToDefault/customfield_10005",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil, nil)
+			assert.NoError(t, err)
+
+			gotResponse, err := fieldService.AddToDefaultScreen(testCase.args.ctx, testCase.args.fieldId)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueFieldServiceImpl_ScreensForField(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                 context.Context
+		fieldId             string
+		startAt, maxResults int
+		expand              []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				fieldId:    "customfield_10005",
+				startAt:    0,
+				maxResults: 50,
+				expand:     []string{"tab"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/field/customfield_10005/screens?expand=tab&maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ScreenFieldPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				fieldId:    "customfield_10005",
+				startAt:    0,
+				maxResults: 50,
+				expand:     []string{"tab"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/field/customfield_10005/screens?expand=tab&maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ScreenFieldPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the field id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoFieldIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				fieldId:    "customfield_10005",
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/field/customfield_10005/screens?maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := fieldService.ScreensForField(testCase.args.ctx, testCase.args.fieldId,
+				testCase.args.startAt, testCase.args.maxResults, testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
 func Test_NewIssueFieldService(t *testing.T) {
 
 	type args struct {
@@ -715,7 +1049,7 @@ func Test_NewIssueFieldService(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			got, err := NewIssueFieldService(testCase.args.client, testCase.args.version, nil, nil, nil)
+			got, err := NewIssueFieldService(testCase.args.client, testCase.args.version, nil, nil, nil, nil)
 
 			if testCase.wantErr {
 
@@ -733,3 +1067,80 @@ func Test_NewIssueFieldService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalIssueFieldServiceImpl_ResolveByName(t *testing.T) {
+
+	issueFields := []*model.IssueFieldScheme{
+		{ID: "summary", Name: "Résumé"},
+		{ID: "customfield_10010", Name: "Équipe", UntranslatedName: "Team"},
+		{ID: "customfield_10020", Name: "Team", UntranslatedName: "Squad"},
+		{ID: "customfield_10030", Name: "Sprint", UntranslatedName: "Sprint"},
+		{ID: "customfield_10040", Name: "sprint", UntranslatedName: "Legacy Sprint"},
+	}
+
+	testCases := []struct {
+		name    string
+		field   string
+		wantID  string
+		wantErr error
+	}{
+		{name: "when the untranslated name matches before the localized name", field: "Team", wantID: "customfield_10010"},
+		{name: "when the localized name matches", field: "Résumé", wantID: "summary"},
+		{name: "when the exact name matches before the names ignoring the case", field: "sprint", wantID: "customfield_10040"},
+		{name: "when the name matches ignoring the case", field: "squad", wantID: "customfield_10020"},
+		{name: "when the name matches several fields ignoring the case", field: "SPRINT", wantErr: model.ErrAmbiguousIssueFieldNameError},
+		{name: "when the name matches no field", field: "Story Points", wantErr: model.ErrIssueFieldNameNotFoundError},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewClient(t)
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodGet,
+				"rest/api/3/field",
+				nil).
+				Return(&http.Request{}, nil)
+
+			client.On("Call",
+				&http.Request{},
+				mock.Anything).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*[]*model.IssueFieldScheme) = issueFields
+				}).
+				Return(&model.ResponseScheme{}, nil)
+
+			fieldService, err := NewIssueFieldService(client, "3", nil, nil, nil, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := fieldService.ResolveByName(context.Background(), testCase.field)
+			assert.NotNil(t, gotResponse)
+
+			if testCase.wantErr != nil {
+
+				assert.ErrorIs(t, err, testCase.wantErr)
+
+				var resolutionErr *model.NameResolutionError
+				if assert.True(t, errors.As(err, &resolutionErr)) && errors.Is(err, model.ErrAmbiguousIssueFieldNameError) {
+					assert.Equal(t, []string{"customfield_10030", "customfield_10040"}, resolutionErr.Candidates)
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantID, gotResult.ID)
+		})
+	}
+
+	t.Run("when the name is not provided", func(t *testing.T) {
+
+		fieldService, err := NewIssueFieldService(nil, "3", nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		_, _, err = fieldService.ResolveByName(context.Background(), "")
+		assert.ErrorIs(t, err, model.ErrNoFieldNameError)
+	})
+}
@@ -0,0 +1,274 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func NewIssueFieldOptionService(client service.Client, version string) (*IssueFieldOptionService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &IssueFieldOptionService{
+		internalClient: &internalIssueFieldOptionServiceImpl{c: client, version: version},
+	}, nil
+}
+
+type IssueFieldOptionService struct {
+	internalClient jira.FieldOptionConnector
+}
+
+// Gets returns a paginated list of all the options of a select list issue field.
+//
+// GET /rest/api/{2-3}/field/{fieldKey}/option
+//
+// TODO: the documentation needs to be created
+func (i *IssueFieldOptionService) Gets(ctx context.Context, fieldKey string, startAt, maxResults int) (*model.IssueFieldOptionPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx, fieldKey, startAt, maxResults)
+}
+
+// Get returns an option from a select list issue field.
+//
+// GET /rest/api/{2-3}/field/{fieldKey}/option/{optionId}
+//
+// TODO: the documentation needs to be created
+func (i *IssueFieldOptionService) Get(ctx context.Context, fieldKey string, optionID int) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Get(ctx, fieldKey, optionID)
+}
+
+// Create creates an option for a select list issue field.
+//
+// Note that this operation only works for issue field select list options added by Connect apps.
+//
+// POST /rest/api/{2-3}/field/{fieldKey}/option
+//
+// TODO: the documentation needs to be created
+func (i *IssueFieldOptionService) Create(ctx context.Context, fieldKey string, payload *model.IssueFieldOptionPayloadScheme) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Create(ctx, fieldKey, payload)
+}
+
+// Update updates or creates an option for a select list issue field.
+//
+// PUT /rest/api/{2-3}/field/{fieldKey}/option/{optionId}
+//
+// TODO: the documentation needs to be created
+func (i *IssueFieldOptionService) Update(ctx context.Context, fieldKey string, optionID int, payload *model.IssueFieldOptionPayloadScheme) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Update(ctx, fieldKey, optionID, payload)
+}
+
+// Delete deletes an option from a select list issue field.
+//
+// DELETE /rest/api/{2-3}/field/{fieldKey}/option/{optionId}
+//
+// TODO: the documentation needs to be created
+func (i *IssueFieldOptionService) Delete(ctx context.Context, fieldKey string, optionID int) (*model.ResponseScheme, error) {
+	return i.internalClient.Delete(ctx, fieldKey, optionID)
+}
+
+// Replace deselects an issue-field select-list option from all issues where it is selected,
+// a different option can be selected to replace the deselected option.
+//
+// The update can also be limited to a smaller set of issues by using a JQL query.
+//
+// This is an asynchronous operation, use the Task service to follow the returned task.
+//
+// DELETE /rest/api/{2-3}/field/{fieldKey}/option/{optionId}/issue
+//
+// TODO: the documentation needs to be created
+func (i *IssueFieldOptionService) Replace(ctx context.Context, fieldKey string, optionID, replaceWith int, jql string) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Replace(ctx, fieldKey, optionID, replaceWith, jql)
+}
+
+type internalIssueFieldOptionServiceImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalIssueFieldOptionServiceImpl) Gets(ctx context.Context, fieldKey string, startAt, maxResults int) (*model.IssueFieldOptionPageScheme, *model.ResponseScheme, error) {
+
+	if fieldKey == "" {
+		return nil, nil, model.ErrNoFieldKeyError
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/field/%v/option?%v", i.version, fieldKey, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.option.gets", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueFieldOptionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueFieldOptionServiceImpl) Get(ctx context.Context, fieldKey string, optionID int) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error) {
+
+	if fieldKey == "" {
+		return nil, nil, model.ErrNoFieldKeyError
+	}
+
+	if optionID == 0 {
+		return nil, nil, model.ErrNoFieldOptionIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/field/%v/option/%v", i.version, fieldKey, optionID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.option.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	option := new(model.IssueFieldOptionScheme)
+	response, err := i.c.Call(request, option)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return option, response, nil
+}
+
+func (i *internalIssueFieldOptionServiceImpl) Create(ctx context.Context, fieldKey string, payload *model.IssueFieldOptionPayloadScheme) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error) {
+
+	if fieldKey == "" {
+		return nil, nil, model.ErrNoFieldKeyError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/field/%v/option", i.version, fieldKey)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.option.create", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	option := new(model.IssueFieldOptionScheme)
+	response, err := i.c.Call(request, option)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return option, response, nil
+}
+
+func (i *internalIssueFieldOptionServiceImpl) Update(ctx context.Context, fieldKey string, optionID int, payload *model.IssueFieldOptionPayloadScheme) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error) {
+
+	if fieldKey == "" {
+		return nil, nil, model.ErrNoFieldKeyError
+	}
+
+	if optionID == 0 {
+		return nil, nil, model.ErrNoFieldOptionIDError
+	}
+
+	if payload == nil {
+		return nil, nil, model.ErrNilPayloadError
+	}
+
+	// The option id is required on the request body as well
+	reader, err := i.c.TransformStructToReader(&model.IssueFieldOptionScheme{
+		ID:         optionID,
+		Value:      payload.Value,
+		Properties: payload.Properties,
+		Config:     payload.Config,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/field/%v/option/%v", i.version, fieldKey, optionID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.option.update", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	option := new(model.IssueFieldOptionScheme)
+	response, err := i.c.Call(request, option)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return option, response, nil
+}
+
+func (i *internalIssueFieldOptionServiceImpl) Delete(ctx context.Context, fieldKey string, optionID int) (*model.ResponseScheme, error) {
+
+	if fieldKey == "" {
+		return nil, model.ErrNoFieldKeyError
+	}
+
+	if optionID == 0 {
+		return nil, model.ErrNoFieldOptionIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/field/%v/option/%v", i.version, fieldKey, optionID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.option.delete", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueFieldOptionServiceImpl) Replace(ctx context.Context, fieldKey string, optionID, replaceWith int, jql string) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if fieldKey == "" {
+		return nil, nil, model.ErrNoFieldKeyError
+	}
+
+	if optionID == 0 {
+		return nil, nil, model.ErrNoFieldOptionIDError
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/field/%v/option/%v/issue", i.version, fieldKey, optionID))
+
+	params := url.Values{}
+	if replaceWith != 0 {
+		params.Add("replaceWith", strconv.Itoa(replaceWith))
+	}
+
+	if jql != "" {
+		params.Add("jql", jql)
+	}
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.field.option.replace", http.MethodDelete, endpoint.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.TaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
@@ -76,7 +76,7 @@ func (i *internalFilterServiceImpl) Create(ctx context.Context, payload *model.F
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,7 +93,7 @@ func (i *internalFilterServiceImpl) Create(ctx context.Context, payload *model.F
 func (i *internalFilterServiceImpl) Favorite(ctx context.Context) ([]*model.FilterScheme, *model.ResponseScheme, error) {
 	endpoint := fmt.Sprintf("rest/api/%v/filter/favourite", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.favorite", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,7 +117,7 @@ func (i *internalFilterServiceImpl) My(ctx context.Context, favorites bool, expa
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/my?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.my", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -150,10 +150,18 @@ func (i *internalFilterServiceImpl) Search(ctx context.Context, options *model.F
 			params.Add("accountId", options.AccountID)
 		}
 
+		if options.Group != "" && options.GroupID != "" {
+			return nil, nil, model.ErrGroupNameAndIDError
+		}
+
 		if options.Group != "" {
 			params.Add("groupname", options.Group)
 		}
 
+		if options.GroupID != "" {
+			params.Add("groupId", options.GroupID)
+		}
+
 		if options.ProjectID != 0 {
 			params.Add("projectId", strconv.Itoa(options.ProjectID))
 		}
@@ -169,7 +177,7 @@ func (i *internalFilterServiceImpl) Search(ctx context.Context, options *model.F
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -200,7 +208,7 @@ func (i *internalFilterServiceImpl) Get(ctx context.Context, filterId int, expan
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -227,7 +235,7 @@ func (i *internalFilterServiceImpl) Update(ctx context.Context, filterId int, pa
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v", i.version, filterId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -248,7 +256,7 @@ func (i *internalFilterServiceImpl) Delete(ctx context.Context, filterId int) (*
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v", i.version, filterId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -283,7 +291,7 @@ func (i *internalFilterServiceImpl) Change(ctx context.Context, filterId int, ac
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/owner", i.version, filterId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.change", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
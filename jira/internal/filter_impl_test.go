@@ -472,6 +472,46 @@ func TestFilterService_Search(t *testing.T) {
 		wantErr bool
 		Err     error
 	}{
+		{
+			name:   "when the group id is provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				options:    &model.FilterSearchOptionScheme{GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/filter/search?groupId=276f955c-63d7-42c8-9520-92d01dca0625&maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FilterSearchPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the group name and the group id are provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.FilterSearchOptionScheme{Group: "jira-users", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+			},
+			wantErr: true,
+			Err:     model.ErrGroupNameAndIDError,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
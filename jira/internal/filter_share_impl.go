@@ -9,14 +9,16 @@ import (
 	"net/http"
 )
 
-func NewFilterShareService(client service.Client, version string) (*FilterShareService, error) {
+// NewFilterShareService creates the filter sharing service, the group service resolves the ids of the group
+// shares that only contain the group name, the group name is sent when it's nil.
+func NewFilterShareService(client service.Client, version string, group jira.GroupConnector) (*FilterShareService, error) {
 
 	if version == "" {
 		return nil, model.ErrNoVersionProvided
 	}
 
 	return &FilterShareService{
-		internalClient: &internalFilterShareImpl{c: client, version: version},
+		internalClient: &internalFilterShareImpl{c: client, version: version, group: group},
 	}, nil
 }
 
@@ -61,6 +63,8 @@ func (f *FilterShareService) Gets(ctx context.Context, filterId int) ([]*model.S
 //
 // it will overwrite all share permissions for the filter.
 //
+// The group shares that only contain the group name are sent with the group id resolved by the group service.
+//
 // POST /rest/api/{2-3}/filter/{id}/permission
 //
 // https://docs.go-atlassian.io/jira-software-cloud/filters/sharing#add-share-permission
@@ -83,6 +87,8 @@ func (f *FilterShareService) Get(ctx context.Context, filterId, permissionId int
 
 // Delete deletes a share permission from a filter.
 //
+// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+//
 // DELETE /rest/api/{2-3}/filter/{id}/permission/{permissionId}
 //
 // https://docs.go-atlassian.io/jira-software-cloud/filters/sharing#delete-share-permission
@@ -90,16 +96,27 @@ func (f *FilterShareService) Delete(ctx context.Context, filterId, permissionId
 	return f.internalClient.Delete(ctx, filterId, permissionId)
 }
 
+// PreviewDelete returns the share permission removed from a filter, and the token confirming the deletion
+// when the client is on protected mode.
+//
+// GET /rest/api/{2-3}/filter/{id}/permission/{permissionId}
+//
+// TODO: the documentation needs to be created
+func (f *FilterShareService) PreviewDelete(ctx context.Context, filterId, permissionId int) (*model.FilterShareDeletionPreviewScheme, *model.ResponseScheme, error) {
+	return f.internalClient.PreviewDelete(ctx, filterId, permissionId)
+}
+
 type internalFilterShareImpl struct {
 	c       service.Client
 	version string
+	group   jira.GroupConnector
 }
 
 func (i *internalFilterShareImpl) Scope(ctx context.Context) (*model.ShareFilterScopeScheme, *model.ResponseScheme, error) {
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/defaultShareScope", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.share.scope", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +139,7 @@ func (i *internalFilterShareImpl) SetScope(ctx context.Context, scope string) (*
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/defaultShareScope", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.share.setScope", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -138,13 +155,13 @@ func (i *internalFilterShareImpl) Gets(ctx context.Context, filterId int) ([]*mo
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/permission", i.version, filterId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.share.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	var filters []*model.SharePermissionScheme
-	response, err := i.c.Call(request, filters)
+	response, err := i.c.Call(request, &filters)
 	if err != nil {
 		return nil, response, err
 	}
@@ -158,6 +175,25 @@ func (i *internalFilterShareImpl) Add(ctx context.Context, filterId int, payload
 		return nil, nil, model.ErrNoFilterIDError
 	}
 
+	if payload != nil {
+		if err := validateFilterSharePayload(payload); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// The groupname parameter is deprecated, the group id is sent instead when the group can be resolved
+	if payload != nil && payload.Type == model.FilterShareTypeGroup && payload.GroupID == "" && i.group != nil {
+
+		groupID, response, err := i.group.ResolveID(ctx, payload.GroupName)
+		if err != nil {
+			return nil, response, err
+		}
+
+		resolved := *payload
+		resolved.GroupName, resolved.GroupID = "", groupID
+		payload = &resolved
+	}
+
 	reader, err := i.c.TransformStructToReader(payload)
 	if err != nil {
 		return nil, nil, err
@@ -165,13 +201,13 @@ func (i *internalFilterShareImpl) Add(ctx context.Context, filterId int, payload
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/permission", i.version, filterId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.share.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	var permissions []*model.SharePermissionScheme
-	response, err := i.c.Call(request, permissions)
+	response, err := i.c.Call(request, &permissions)
 	if err != nil {
 		return nil, response, err
 	}
@@ -191,7 +227,7 @@ func (i *internalFilterShareImpl) Get(ctx context.Context, filterId, permissionI
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/permission/%v", i.version, filterId, permissionId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.share.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -215,12 +251,107 @@ func (i *internalFilterShareImpl) Delete(ctx context.Context, filterId, permissi
 		return nil, model.ErrNoPermissionGrantIDError
 	}
 
+	if err := checkConfirmation(ctx, i.c, confirmFilterShareDeletion, fmt.Sprintf("%v/%v", filterId, permissionId)); err != nil {
+		return nil, err
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/permission/%v", i.version, filterId, permissionId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "filter.share.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalFilterShareImpl) PreviewDelete(ctx context.Context, filterId, permissionId int) (*model.FilterShareDeletionPreviewScheme, *model.ResponseScheme, error) {
+
+	permission, response, err := i.Get(ctx, filterId, permissionId)
+	if err != nil {
+		return nil, response, err
+	}
+
+	confirmation, err := issueConfirmation(i.c, confirmFilterShareDeletion, fmt.Sprintf("%v/%v", filterId, permissionId))
+	if err != nil {
+		return nil, response, err
+	}
+
+	return &model.FilterShareDeletionPreviewScheme{Permission: permission, Confirmation: confirmation}, response, nil
+}
+
+// validateFilterSharePayload checks the payload contains the fields required by the share type,
+// and doesn't contain fields that belong to other share types.
+func validateFilterSharePayload(payload *model.PermissionFilterPayloadScheme) error {
+
+	var (
+		hasAccount = payload.AccountID != ""
+		hasGroup   = payload.GroupID != "" || payload.GroupName != ""
+		hasProject = payload.ProjectID != ""
+		hasRole    = payload.ProjectRoleID != ""
+	)
+
+	switch payload.Type {
+	case "":
+		return model.ErrNoFilterShareTypeError
+
+	case model.FilterShareTypeUser:
+
+		if !hasAccount {
+			return model.ErrNoAccountIDError
+		}
+
+		if hasGroup || hasProject || hasRole {
+			return model.ErrFilterShareFieldsMismatchError
+		}
+
+	case model.FilterShareTypeGroup:
+
+		if !hasGroup {
+			return model.ErrNoGroupIDError
+		}
+
+		if payload.GroupID != "" && payload.GroupName != "" {
+			return model.ErrGroupNameAndIDError
+		}
+
+		if hasAccount || hasProject || hasRole {
+			return model.ErrFilterShareFieldsMismatchError
+		}
+
+	case model.FilterShareTypeProject:
+
+		if !hasProject {
+			return model.ErrNoProjectIDError
+		}
+
+		if hasAccount || hasGroup || hasRole {
+			return model.ErrFilterShareFieldsMismatchError
+		}
+
+	case model.FilterShareTypeProjectRole:
+
+		if !hasProject {
+			return model.ErrNoProjectIDError
+		}
+
+		if !hasRole {
+			return model.ErrNoProjectRoleIDError
+		}
+
+		if hasAccount || hasGroup {
+			return model.ErrFilterShareFieldsMismatchError
+		}
+
+	case model.FilterShareTypeGlobal, model.FilterShareTypeAuthenticated:
+
+		if hasAccount || hasGroup || hasProject || hasRole {
+			return model.ErrFilterShareFieldsMismatchError
+		}
+
+	default:
+		return model.ErrInvalidFilterShareTypeError
+	}
+
+	return nil
+}
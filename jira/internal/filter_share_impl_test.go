@@ -3,11 +3,13 @@ package internal
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -113,7 +115,7 @@ func TestFilterShareService_Scope(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version)
+			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := shareService.Scope(testCase.args.ctx)
@@ -137,6 +139,258 @@ func TestFilterShareService_Scope(t *testing.T) {
 	}
 }
 
+func TestFilterShareService_ResponsesAreDecoded(t *testing.T) {
+
+	newClient := func(endpoint, method, body string) *mocks.Client {
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil).
+			Maybe()
+
+		client.On("NewRequest",
+			context.Background(),
+			method,
+			endpoint,
+			mock.Anything).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				// The structure must be a pointer, otherwise the response can't be decoded
+				assert.NoError(t, json.Unmarshal([]byte(body), args.Get(1)))
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		return client
+	}
+
+	permissions := `[{"id":10000,"type":"group","group":{"name":"jira-administrators"}}]`
+
+	t.Run("when the default share scope is returned", func(t *testing.T) {
+
+		shareService, err := NewFilterShareService(newClient("rest/api/3/filter/defaultShareScope", http.MethodGet, `{"scope":"GLOBAL"}`), "3", nil)
+		assert.NoError(t, err)
+
+		scope, _, err := shareService.Scope(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "GLOBAL", scope.Scope)
+	})
+
+	t.Run("when the share permissions are returned", func(t *testing.T) {
+
+		shareService, err := NewFilterShareService(newClient("rest/api/3/filter/10001/permission", http.MethodGet, permissions), "3", nil)
+		assert.NoError(t, err)
+
+		gotPermissions, _, err := shareService.Gets(context.Background(), 10001)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(gotPermissions))
+		assert.Equal(t, "jira-administrators", gotPermissions[0].Group.Name)
+	})
+
+	t.Run("when the share permissions are returned after adding a permission", func(t *testing.T) {
+
+		shareService, err := NewFilterShareService(newClient("rest/api/3/filter/10001/permission", http.MethodPost, permissions), "3", nil)
+		assert.NoError(t, err)
+
+		payload := &model.PermissionFilterPayloadScheme{Type: model.FilterShareTypeGroup, GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"}
+
+		gotPermissions, _, err := shareService.Add(context.Background(), 10001, payload)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(gotPermissions))
+	})
+}
+
+func TestFilterShareService_Add_GroupName(t *testing.T) {
+
+	newGroupService := func() *GroupService {
+
+		client := mocks.NewClient(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/group/bulk?groupName=jira-users&maxResults=50&startAt=0",
+			nil).
+			Return(&http.Request{}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{},
+			&model.BulkGroupScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.BulkGroupScheme).Values = []*model.GroupDetailScheme{
+					{Name: "jira-users", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		groupService, err := NewGroupService(client, "3")
+		assert.NoError(t, err)
+
+		return groupService
+	}
+
+	newClient := func(want *model.PermissionFilterPayloadScheme) *mocks.Client {
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			want).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/filter/10001/permission",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			mock.Anything).
+			Return(&model.ResponseScheme{}, nil)
+
+		return client
+	}
+
+	t.Run("when the group name is resolved to the group id", func(t *testing.T) {
+
+		want := &model.PermissionFilterPayloadScheme{Type: model.FilterShareTypeGroup, GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"}
+
+		shareService, err := NewFilterShareService(newClient(want), "3", newGroupService())
+		assert.NoError(t, err)
+
+		payload := &model.PermissionFilterPayloadScheme{Type: model.FilterShareTypeGroup, GroupName: "jira-users"}
+
+		_, _, err = shareService.Add(context.Background(), 10001, payload)
+		assert.NoError(t, err)
+
+		// The payload of the caller is not modified
+		assert.Equal(t, "jira-users", payload.GroupName)
+		assert.Equal(t, "", payload.GroupID)
+	})
+
+	t.Run("when the group service is not set", func(t *testing.T) {
+
+		want := &model.PermissionFilterPayloadScheme{Type: model.FilterShareTypeGroup, GroupName: "jira-users"}
+
+		shareService, err := NewFilterShareService(newClient(want), "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = shareService.Add(context.Background(), 10001, &model.PermissionFilterPayloadScheme{Type: model.FilterShareTypeGroup, GroupName: "jira-users"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("when the group name and the group id are provided", func(t *testing.T) {
+
+		shareService, err := NewFilterShareService(nil, "3", nil)
+		assert.NoError(t, err)
+
+		payload := &model.PermissionFilterPayloadScheme{
+			Type:      model.FilterShareTypeGroup,
+			GroupName: "jira-users",
+			GroupID:   "276f955c-63d7-42c8-9520-92d01dca0625",
+		}
+
+		_, _, err = shareService.Add(context.Background(), 10001, payload)
+		assert.EqualError(t, err, model.ErrGroupNameAndIDError.Error())
+	})
+}
+
+func Test_validateFilterSharePayload(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		payload *model.PermissionFilterPayloadScheme
+		Err     error
+	}{
+		{
+			name:    "when the user share contains the account id",
+			payload: &model.PermissionFilterPayloadScheme{Type: "user", AccountID: "5b10a2844c20165700ede21g", Rights: model.FilterShareRightsView | model.FilterShareRightsEdit},
+		},
+		{
+			name:    "when the user share doesn't contain the account id",
+			payload: &model.PermissionFilterPayloadScheme{Type: "user"},
+			Err:     model.ErrNoAccountIDError,
+		},
+		{
+			name:    "when the user share contains a group",
+			payload: &model.PermissionFilterPayloadScheme{Type: "user", AccountID: "5b10a2844c20165700ede21g", GroupName: "jira-users"},
+			Err:     model.ErrFilterShareFieldsMismatchError,
+		},
+		{
+			name:    "when the group share contains the group id",
+			payload: &model.PermissionFilterPayloadScheme{Type: "group", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+		},
+		{
+			name:    "when the group share doesn't contain the group",
+			payload: &model.PermissionFilterPayloadScheme{Type: "group"},
+			Err:     model.ErrNoGroupIDError,
+		},
+		{
+			name:    "when the group share contains the group name and the group id",
+			payload: &model.PermissionFilterPayloadScheme{Type: "group", GroupName: "jira-users", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+			Err:     model.ErrGroupNameAndIDError,
+		},
+		{
+			name:    "when the project share contains the project id",
+			payload: &model.PermissionFilterPayloadScheme{Type: "project", ProjectID: "10000"},
+		},
+		{
+			name:    "when the project share contains a role",
+			payload: &model.PermissionFilterPayloadScheme{Type: "project", ProjectID: "10000", ProjectRoleID: "10002"},
+			Err:     model.ErrFilterShareFieldsMismatchError,
+		},
+		{
+			name:    "when the project role share contains the project and role",
+			payload: &model.PermissionFilterPayloadScheme{Type: "projectRole", ProjectID: "10000", ProjectRoleID: "10002"},
+		},
+		{
+			name:    "when the project role share doesn't contain the role",
+			payload: &model.PermissionFilterPayloadScheme{Type: "projectRole", ProjectID: "10000"},
+			Err:     model.ErrNoProjectRoleIDError,
+		},
+		{
+			name:    "when the global share contains a project",
+			payload: &model.PermissionFilterPayloadScheme{Type: "global", ProjectID: "10000"},
+			Err:     model.ErrFilterShareFieldsMismatchError,
+		},
+		{
+			name:    "when the authenticated share is valid",
+			payload: &model.PermissionFilterPayloadScheme{Type: "authenticated"},
+		},
+		{
+			name:    "when the share type is not provided",
+			payload: &model.PermissionFilterPayloadScheme{},
+			Err:     model.ErrNoFilterShareTypeError,
+		},
+		{
+			name:    "when the share type is invalid",
+			payload: &model.PermissionFilterPayloadScheme{Type: "everyone"},
+			Err:     model.ErrInvalidFilterShareTypeError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := validateFilterSharePayload(testCase.payload)
+
+			if testCase.Err != nil {
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestFilterShareService_SetScope(t *testing.T) {
 
 	type fields struct {
@@ -254,7 +508,7 @@ func TestFilterShareService_SetScope(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version)
+			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResponse, err := shareService.SetScope(testCase.args.ctx, testCase.args.scope)
@@ -316,7 +570,7 @@ func TestFilterShareService_Gets(t *testing.T) {
 
 				client.On("Call",
 					&http.Request{},
-					[]*model.SharePermissionScheme(nil)).
+					mock.Anything).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -343,7 +597,7 @@ func TestFilterShareService_Gets(t *testing.T) {
 
 				client.On("Call",
 					&http.Request{},
-					[]*model.SharePermissionScheme(nil)).
+					mock.Anything).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -394,7 +648,7 @@ func TestFilterShareService_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version)
+			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := shareService.Gets(testCase.args.ctx, testCase.args.filterId)
@@ -467,7 +721,7 @@ func TestFilterShareService_Add(t *testing.T) {
 
 				client.On("Call",
 					&http.Request{},
-					[]*model.SharePermissionScheme(nil)).
+					mock.Anything).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -502,7 +756,7 @@ func TestFilterShareService_Add(t *testing.T) {
 
 				client.On("Call",
 					&http.Request{},
-					[]*model.SharePermissionScheme(nil)).
+					mock.Anything).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -588,7 +842,7 @@ func TestFilterShareService_Add(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version)
+			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := shareService.Add(testCase.args.ctx, testCase.args.filterId, testCase.args.payload)
@@ -750,7 +1004,7 @@ func TestFilterShareService_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version)
+			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := shareService.Get(testCase.args.ctx, testCase.args.filterId, testCase.args.permissionId)
@@ -912,7 +1166,7 @@ func TestFilterShareService_Delete(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version)
+			shareService, err := NewFilterShareService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResponse, err := shareService.Delete(testCase.args.ctx, testCase.args.filterId, testCase.args.permissionId)
@@ -968,7 +1222,7 @@ func Test_NewFilterShareService(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			got, err := NewFilterShareService(testCase.args.client, testCase.args.version)
+			got, err := NewFilterShareService(testCase.args.client, testCase.args.version, nil)
 
 			if testCase.wantErr {
 
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 )
 
 func NewGroupService(client service.Client, version string) (*GroupService, error) {
@@ -71,6 +73,53 @@ func (g *GroupService) Remove(ctx context.Context, groupName, accountId string)
 	return g.internalClient.Remove(ctx, groupName, accountId)
 }
 
+// DeleteByID deletes a group by its id.
+//
+// DELETE /rest/api/{2-3}/group
+//
+// https://docs.go-atlassian.io/jira-software-cloud/groups#remove-group
+func (g *GroupService) DeleteByID(ctx context.Context, groupID string) (*model.ResponseScheme, error) {
+	return g.internalClient.DeleteByID(ctx, groupID)
+}
+
+// MembersByID returns a paginated list of all users in a group, the group is identified by its id.
+//
+// GET /rest/api/{2-3}/group/member
+//
+// https://docs.go-atlassian.io/jira-software-cloud/groups#get-users-from-groups
+func (g *GroupService) MembersByID(ctx context.Context, groupID string, inactive bool, startAt, maxResults int) (*model.GroupMemberPageScheme, *model.ResponseScheme, error) {
+	return g.internalClient.MembersByID(ctx, groupID, inactive, startAt, maxResults)
+}
+
+// AddByID adds a user to a group, the group is identified by its id.
+//
+// POST /rest/api/{2-3}/group/user
+//
+// https://docs.go-atlassian.io/jira-software-cloud/groups#add-user-to-group
+func (g *GroupService) AddByID(ctx context.Context, groupID, accountId string) (*model.GroupScheme, *model.ResponseScheme, error) {
+	return g.internalClient.AddByID(ctx, groupID, accountId)
+}
+
+// RemoveByID removes a user from a group, the group is identified by its id.
+//
+// DELETE /rest/api/{2-3}/group/user
+//
+// https://docs.go-atlassian.io/jira-software-cloud/groups#remove-user-from-group
+func (g *GroupService) RemoveByID(ctx context.Context, groupID, accountId string) (*model.ResponseScheme, error) {
+	return g.internalClient.RemoveByID(ctx, groupID, accountId)
+}
+
+// ResolveID returns the id of the group with the name, the name is matched ignoring the case.
+//
+// The ids resolved are cached, the services that only receive a group name use it to send the group id.
+//
+// GET /rest/api/{2-3}/group/bulk
+//
+// TODO: the documentation needs to be created
+func (g *GroupService) ResolveID(ctx context.Context, groupName string) (string, *model.ResponseScheme, error) {
+	return g.internalClient.ResolveID(ctx, groupName)
+}
+
 // Create creates a group.
 //
 // POST /rest/api/{2-3}/group
@@ -83,6 +132,10 @@ func (g *GroupService) Create(ctx context.Context, groupName string) (*model.Gro
 type internalGroupServiceImpl struct {
 	c       service.Client
 	version string
+
+	// ids caches the group ids resolved by name, the keys are the lower case names
+	mu  sync.RWMutex
+	ids map[string]string
 }
 
 func (i *internalGroupServiceImpl) Create(ctx context.Context, groupName string) (*model.GroupScheme, *model.ResponseScheme, error) {
@@ -104,7 +157,7 @@ func (i *internalGroupServiceImpl) Create(ctx context.Context, groupName string)
 
 	endpoint := fmt.Sprintf("rest/api/%v/group", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "group.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -124,12 +177,27 @@ func (i *internalGroupServiceImpl) Delete(ctx context.Context, groupName string)
 		return nil, model.ErrNoGroupNameError
 	}
 
+	return i.delete(ctx, "group.delete", "groupname", groupName)
+}
+
+func (i *internalGroupServiceImpl) DeleteByID(ctx context.Context, groupID string) (*model.ResponseScheme, error) {
+
+	if groupID == "" {
+		return nil, model.ErrNoGroupIDError
+	}
+
+	return i.delete(ctx, "group.deleteByID", "groupId", groupID)
+}
+
+// delete deletes the group identified by the parameter, the groupname or groupId parameters are mutually exclusive.
+func (i *internalGroupServiceImpl) delete(ctx context.Context, operation, parameter, value string) (*model.ResponseScheme, error) {
+
 	params := url.Values{}
-	params.Add("groupname", groupName)
+	params.Add(parameter, value)
 
 	endpoint := fmt.Sprintf("rest/api/%v/group?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +224,7 @@ func (i *internalGroupServiceImpl) Bulk(ctx context.Context, options *model.Grou
 
 	endpoint := fmt.Sprintf("rest/api/%v/group/bulk?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "group.bulk", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -176,15 +244,29 @@ func (i *internalGroupServiceImpl) Members(ctx context.Context, groupName string
 		return nil, nil, model.ErrNoGroupNameError
 	}
 
+	return i.members(ctx, "group.members", "groupname", groupName, inactive, startAt, maxResults)
+}
+
+func (i *internalGroupServiceImpl) MembersByID(ctx context.Context, groupID string, inactive bool, startAt, maxResults int) (*model.GroupMemberPageScheme, *model.ResponseScheme, error) {
+
+	if groupID == "" {
+		return nil, nil, model.ErrNoGroupIDError
+	}
+
+	return i.members(ctx, "group.membersByID", "groupId", groupID, inactive, startAt, maxResults)
+}
+
+func (i *internalGroupServiceImpl) members(ctx context.Context, operation, parameter, value string, inactive bool, startAt, maxResults int) (*model.GroupMemberPageScheme, *model.ResponseScheme, error) {
+
 	params := url.Values{}
 	params.Add("startAt", strconv.Itoa(startAt))
 	params.Add("maxResults", strconv.Itoa(maxResults))
-	params.Add("groupname", groupName)
+	params.Add(parameter, value)
 	params.Add("includeInactiveUsers", fmt.Sprintf("%v", inactive))
 
 	endpoint := fmt.Sprintf("rest/api/%v/group/member?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -204,6 +286,20 @@ func (i *internalGroupServiceImpl) Add(ctx context.Context, groupName, accountId
 		return nil, nil, model.ErrNoGroupNameError
 	}
 
+	return i.add(ctx, "group.add", "groupname", groupName, accountId)
+}
+
+func (i *internalGroupServiceImpl) AddByID(ctx context.Context, groupID, accountId string) (*model.GroupScheme, *model.ResponseScheme, error) {
+
+	if groupID == "" {
+		return nil, nil, model.ErrNoGroupIDError
+	}
+
+	return i.add(ctx, "group.addByID", "groupId", groupID, accountId)
+}
+
+func (i *internalGroupServiceImpl) add(ctx context.Context, operation, parameter, value, accountId string) (*model.GroupScheme, *model.ResponseScheme, error) {
+
 	if accountId == "" {
 		return nil, nil, model.ErrNoAccountIDError
 	}
@@ -220,10 +316,10 @@ func (i *internalGroupServiceImpl) Add(ctx context.Context, groupName, accountId
 	}
 
 	params := url.Values{}
-	params.Add("groupname", groupName)
+	params.Add(parameter, value)
 	endpoint := fmt.Sprintf("rest/api/%v/group/user?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -243,19 +339,75 @@ func (i *internalGroupServiceImpl) Remove(ctx context.Context, groupName, accoun
 		return nil, model.ErrNoGroupNameError
 	}
 
+	return i.remove(ctx, "group.remove", "groupname", groupName, accountId)
+}
+
+func (i *internalGroupServiceImpl) RemoveByID(ctx context.Context, groupID, accountId string) (*model.ResponseScheme, error) {
+
+	if groupID == "" {
+		return nil, model.ErrNoGroupIDError
+	}
+
+	return i.remove(ctx, "group.removeByID", "groupId", groupID, accountId)
+}
+
+func (i *internalGroupServiceImpl) remove(ctx context.Context, operation, parameter, value, accountId string) (*model.ResponseScheme, error) {
+
 	if accountId == "" {
 		return nil, model.ErrNoAccountIDError
 	}
 
 	params := url.Values{}
-	params.Add("groupname", groupName)
+	params.Add(parameter, value)
 	params.Add("accountId", accountId)
 	endpoint := fmt.Sprintf("rest/api/%v/group/user?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalGroupServiceImpl) ResolveID(ctx context.Context, groupName string) (string, *model.ResponseScheme, error) {
+
+	if groupName == "" {
+		return "", nil, model.ErrNoGroupNameError
+	}
+
+	key := strings.ToLower(groupName)
+
+	i.mu.RLock()
+	groupID, cached := i.ids[key]
+	i.mu.RUnlock()
+
+	if cached {
+		return groupID, nil, nil
+	}
+
+	options := &model.GroupBulkOptionsScheme{GroupNames: []string{groupName}}
+
+	page, response, err := i.Bulk(ctx, options, 0, 50)
+	if err != nil {
+		return "", response, err
+	}
+
+	for _, group := range page.Values {
+
+		if !strings.EqualFold(group.Name, groupName) || group.GroupID == "" {
+			continue
+		}
+
+		i.mu.Lock()
+		if i.ids == nil {
+			i.ids = make(map[string]string)
+		}
+		i.ids[key] = group.GroupID
+		i.mu.Unlock()
+
+		return group.GroupID, response, nil
+	}
+
+	return "", response, fmt.Errorf("%w: %v", model.ErrGroupNameNotFoundError, groupName)
+}
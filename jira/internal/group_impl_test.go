@@ -8,6 +8,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -1012,3 +1013,148 @@ func Test_NewGroupService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalGroupServiceImpl_ByID(t *testing.T) {
+
+	const groupID = "276f955c-63d7-42c8-9520-92d01dca0625"
+
+	newClient := func(method, endpoint string) *mocks.Client {
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil).
+			Maybe()
+
+		client.On("NewRequest",
+			context.Background(),
+			method,
+			endpoint,
+			mock.Anything).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			mock.Anything).
+			Return(&model.ResponseScheme{}, nil)
+
+		return client
+	}
+
+	t.Run("when the group is deleted by id", func(t *testing.T) {
+
+		groupService, err := NewGroupService(newClient(http.MethodDelete, "rest/api/3/group?groupId="+groupID), "3")
+		assert.NoError(t, err)
+
+		_, err = groupService.DeleteByID(context.Background(), groupID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("when the members are returned by id", func(t *testing.T) {
+
+		groupService, err := NewGroupService(newClient(http.MethodGet,
+			"rest/api/3/group/member?groupId="+groupID+"&includeInactiveUsers=true&maxResults=50&startAt=0"), "3")
+		assert.NoError(t, err)
+
+		_, _, err = groupService.MembersByID(context.Background(), groupID, true, 0, 50)
+		assert.NoError(t, err)
+	})
+
+	t.Run("when the user is added by id", func(t *testing.T) {
+
+		groupService, err := NewGroupService(newClient(http.MethodPost, "rest/api/3/group/user?groupId="+groupID), "3")
+		assert.NoError(t, err)
+
+		_, _, err = groupService.AddByID(context.Background(), groupID, "5b86be50b8e3cb5895860d6d")
+		assert.NoError(t, err)
+	})
+
+	t.Run("when the user is removed by id", func(t *testing.T) {
+
+		groupService, err := NewGroupService(newClient(http.MethodDelete,
+			"rest/api/3/group/user?accountId=5b86be50b8e3cb5895860d6d&groupId="+groupID), "3")
+		assert.NoError(t, err)
+
+		_, err = groupService.RemoveByID(context.Background(), groupID, "5b86be50b8e3cb5895860d6d")
+		assert.NoError(t, err)
+	})
+
+	t.Run("when the group id is not provided", func(t *testing.T) {
+
+		groupService, err := NewGroupService(nil, "3")
+		assert.NoError(t, err)
+
+		_, err = groupService.DeleteByID(context.Background(), "")
+		assert.EqualError(t, err, model.ErrNoGroupIDError.Error())
+
+		_, _, err = groupService.MembersByID(context.Background(), "", false, 0, 50)
+		assert.EqualError(t, err, model.ErrNoGroupIDError.Error())
+
+		_, _, err = groupService.AddByID(context.Background(), "", "5b86be50b8e3cb5895860d6d")
+		assert.EqualError(t, err, model.ErrNoGroupIDError.Error())
+
+		_, err = groupService.RemoveByID(context.Background(), "", "5b86be50b8e3cb5895860d6d")
+		assert.EqualError(t, err, model.ErrNoGroupIDError.Error())
+	})
+}
+
+func Test_internalGroupServiceImpl_ResolveID(t *testing.T) {
+
+	newClient := func(groups ...*model.GroupDetailScheme) *mocks.Client {
+
+		client := mocks.NewClient(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/group/bulk?groupName=Jira-Users&maxResults=50&startAt=0",
+			nil).
+			Return(&http.Request{}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{},
+			&model.BulkGroupScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.BulkGroupScheme).Values = groups
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		return client
+	}
+
+	t.Run("when the group id is resolved and cached", func(t *testing.T) {
+
+		groupService, err := NewGroupService(newClient(
+			&model.GroupDetailScheme{Name: "jira-users-eu", GroupID: "9d8bb2b0-1d5e-4a1c-a5a8-5b5b4c0b1a11"},
+			&model.GroupDetailScheme{Name: "jira-users", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"}), "3")
+		assert.NoError(t, err)
+
+		for attempt := 0; attempt < 2; attempt++ {
+
+			groupID, _, err := groupService.ResolveID(context.Background(), "Jira-Users")
+			assert.NoError(t, err)
+			assert.Equal(t, "276f955c-63d7-42c8-9520-92d01dca0625", groupID)
+		}
+	})
+
+	t.Run("when the group name is not found", func(t *testing.T) {
+
+		groupService, err := NewGroupService(newClient(&model.GroupDetailScheme{Name: "jira-users-eu", GroupID: "9d8bb2b0"}), "3")
+		assert.NoError(t, err)
+
+		_, _, err = groupService.ResolveID(context.Background(), "Jira-Users")
+		assert.ErrorIs(t, err, model.ErrGroupNameNotFoundError)
+	})
+
+	t.Run("when the group name is not provided", func(t *testing.T) {
+
+		groupService, err := NewGroupService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = groupService.ResolveID(context.Background(), "")
+		assert.EqualError(t, err, model.ErrNoGroupNameError.Error())
+	})
+}
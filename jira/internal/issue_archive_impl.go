@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"net/http"
+)
+
+// issueArchivalMaxIssues is the maximum number of issues accepted by the archive and the unarchive endpoints
+const issueArchivalMaxIssues = 1000
+
+// archiveIssues archives or restores the issues, the limit of issues is validated before the request is sent.
+func archiveIssues(ctx context.Context, client service.Client, version, operation, endpoint string, issueKeysOrIDs []string) (
+	*model.IssueArchivalSyncResponseScheme, *model.ResponseScheme, error) {
+
+	if len(issueKeysOrIDs) == 0 {
+		return nil, nil, model.ErrNoIssuesSliceError
+	}
+
+	if len(issueKeysOrIDs) > issueArchivalMaxIssues {
+		return nil, nil, fmt.Errorf("%w: %v issues", model.ErrIssueArchivalLimitError, len(issueKeysOrIDs))
+	}
+
+	payload := struct {
+		IssueIdsOrKeys []string `json:"issueIdsOrKeys"`
+	}{
+		IssueIdsOrKeys: issueKeysOrIDs,
+	}
+
+	reader, err := client.TransformStructToReader(&payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, client, operation, http.MethodPut, fmt.Sprintf("rest/api/%v/%v", version, endpoint), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.IssueArchivalSyncResponseScheme)
+	response, err := client.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func archiveIssuesByJQL(ctx context.Context, client service.Client, version, jql string) (string, *model.ResponseScheme, error) {
+
+	if jql == "" {
+		return "", nil, model.ErrNoJQLError
+	}
+
+	payload := struct {
+		JQL string `json:"jql"`
+	}{
+		JQL: jql,
+	}
+
+	reader, err := client.TransformStructToReader(&payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/archive", version)
+
+	request, err := service.NewOperationRequest(ctx, client, "issue.archiveByJQL", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The archival is an asynchronous task, the response is the URL of the task
+	var task string
+	response, err := client.Call(request, &task)
+	if err != nil {
+		return "", response, err
+	}
+
+	return task, response, nil
+}
+
+func exportArchivedIssues(ctx context.Context, client service.Client, version string, payload *model.IssueArchivalExportPayloadScheme) (
+	*model.IssueArchivalExportScheme, *model.ResponseScheme, error) {
+
+	reader, err := client.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issues/archive/export", version)
+
+	request, err := service.NewOperationRequest(ctx, client, "issue.exportArchived", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	export := new(model.IssueArchivalExportScheme)
+	response, err := client.Call(request, export)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return export, response, nil
+}
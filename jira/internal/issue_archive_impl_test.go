@@ -0,0 +1,182 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+func Test_archiveIssues(t *testing.T) {
+
+	t.Run("when the issues are archived", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"rest/api/3/issue/archive",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.IssueArchivalSyncResponseScheme{}).
+			Run(func(args mock.Arguments) {
+				result := args.Get(1).(*model.IssueArchivalSyncResponseScheme)
+				result.NumberOfIssuesUpdated = 1
+				result.Errors = &model.IssueArchivalErrorsScheme{
+					IssueIsSubtask: &model.IssueArchivalErrorScheme{Count: 1, IssueIdsOrKeys: []string{"KP-2"}, Message: "Subtasks can't be archived"},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		result, _, err := issueService.Archive(context.Background(), []string{"KP-1", "KP-2"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, result.NumberOfIssuesUpdated)
+		assert.Equal(t, []string{"KP-2"}, result.Errors.IssueIsSubtask.IssueIdsOrKeys)
+
+		client.AssertCalled(t, "TransformStructToReader", &struct {
+			IssueIdsOrKeys []string `json:"issueIdsOrKeys"`
+		}{IssueIdsOrKeys: []string{"KP-1", "KP-2"}})
+	})
+
+	t.Run("when the issues are unarchived", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"rest/api/3/issue/unarchive",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.IssueArchivalSyncResponseScheme{}).
+			Return(&model.ResponseScheme{}, errors.New("error, request failed"))
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = issueService.Unarchive(context.Background(), []string{"KP-1"})
+		assert.EqualError(t, err, "error, request failed")
+	})
+
+	t.Run("when the issues are not set", func(t *testing.T) {
+
+		_, issueService, err := NewIssueService(nil, "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = issueService.Archive(context.Background(), nil)
+		assert.Equal(t, model.ErrNoIssuesSliceError, err)
+	})
+
+	t.Run("when the issues exceed the limit", func(t *testing.T) {
+
+		_, issueService, err := NewIssueService(nil, "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = issueService.Unarchive(context.Background(), make([]string, issueArchivalMaxIssues+1))
+		assert.True(t, errors.Is(err, model.ErrIssueArchivalLimitError))
+	})
+}
+
+func Test_archiveIssuesByJQL(t *testing.T) {
+
+	t.Run("when the archival task is created", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/archive",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{}, nil)
+
+		var task string
+		client.On("Call",
+			&http.Request{},
+			&task).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*string) = "https://ctreminiom.atlassian.net/rest/api/3/task/10641"
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		task, _, err = issueService.ArchiveByJQL(context.Background(), "project = KP AND resolution = Done")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://ctreminiom.atlassian.net/rest/api/3/task/10641", task)
+	})
+
+	t.Run("when the jql is not set", func(t *testing.T) {
+
+		_, issueService, err := NewIssueService(nil, "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = issueService.ArchiveByJQL(context.Background(), "")
+		assert.Equal(t, model.ErrNoJQLError, err)
+	})
+}
+
+func Test_exportArchivedIssues(t *testing.T) {
+
+	payload := &model.IssueArchivalExportPayloadScheme{
+		ArchivedDateRange: &model.IssueArchivalExportRangeScheme{DateAfter: "2023-01-01", DateBefore: "2023-01-31"},
+		Projects:          []string{"KP"},
+	}
+
+	client := mocks.NewClient(t)
+
+	client.On("TransformStructToReader",
+		payload).
+		Return(bytes.NewReader([]byte{}), nil)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPut,
+		"rest/api/3/issues/archive/export",
+		bytes.NewReader([]byte{})).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		&model.IssueArchivalExportScheme{}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.IssueArchivalExportScheme).TaskID = "10990"
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	_, issueService, err := NewIssueService(client, "3", nil)
+	assert.NoError(t, err)
+
+	export, _, err := issueService.ExportArchived(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "10990", export.TaskID)
+}
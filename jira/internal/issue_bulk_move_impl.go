@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"github.com/ctreminiom/go-atlassian/service"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// bulkMoveIssues transitions the issues matching the JQL query, the transition is resolved on every issue
+// because the transition ids and names differ across workflows.
+func bulkMoveIssues(ctx context.Context, client service.Client, version, jql, transitionNameOrID string, fields map[string]interface{},
+	concurrency int, dryRun bool, processedKeys []string) (*model.IssueBulkMoveReportScheme, *model.ResponseScheme, error) {
+
+	if jql == "" {
+		return nil, nil, model.ErrNoJQLError
+	}
+
+	if transitionNameOrID == "" {
+		return nil, nil, model.ErrNoTransitionIDError
+	}
+
+	search := &internalSearchADFImpl{c: client, version: version}
+
+	searchedKeys, response, err := searchIssueKeys(ctx, search, jql)
+	if err != nil {
+		return nil, response, err
+	}
+
+	report := &model.IssueBulkMoveReportScheme{DryRun: dryRun, Processed: append([]string(nil), processedKeys...)}
+
+	processed := make(map[string]bool, len(processedKeys))
+	for _, issueKey := range processedKeys {
+		processed[strings.ToUpper(issueKey)] = true
+	}
+
+	var issueKeys []string
+	for _, issueKey := range searchedKeys {
+		if !processed[strings.ToUpper(issueKey)] {
+			issueKeys = append(issueKeys, issueKey)
+		}
+	}
+
+	controller := newBulkController(concurrency)
+
+	mover := &issueMover{c: client, version: version, controller: controller, transitionNameOrID: transitionNameOrID, fields: fields, dryRun: dryRun}
+
+	results := make([]*model.IssueBulkMoveResultScheme, len(issueKeys))
+	positions := make(chan int)
+
+	var workers sync.WaitGroup
+	for worker := 0; worker < controller.Max(); worker++ {
+
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+
+			for position := range positions {
+				results[position] = mover.move(ctx, issueKeys[position])
+			}
+		}()
+	}
+
+feed:
+	for position := range issueKeys {
+
+		select {
+		case <-ctx.Done():
+			break feed
+		case positions <- position:
+		}
+	}
+
+	close(positions)
+	workers.Wait()
+
+	for position, result := range results {
+
+		switch {
+		// The issues not sent to the workers before the context was cancelled
+		case result == nil:
+			report.Failed = append(report.Failed, &model.IssueBulkMoveResultScheme{IssueKey: issueKeys[position], Err: ctx.Err()})
+		case result.Err != nil:
+			report.Failed = append(report.Failed, result)
+		case result.Reason != "":
+			report.Skipped = append(report.Skipped, result)
+		default:
+			report.Succeeded = append(report.Succeeded, result.IssueKey)
+
+			if !dryRun {
+				report.Processed = append(report.Processed, result.IssueKey)
+			}
+		}
+	}
+
+	return report, response, ctx.Err()
+}
+
+type issueMover struct {
+	c                  service.Client
+	version            string
+	controller         *throttle.Controller
+	transitionNameOrID string
+	fields             map[string]interface{}
+	dryRun             bool
+}
+
+// move resolves the transition of an issue matching the transition id or name, ignoring the case, and applies it.
+func (i *issueMover) move(ctx context.Context, issueKey string) *model.IssueBulkMoveResultScheme {
+
+	result := &model.IssueBulkMoveResultScheme{IssueKey: issueKey}
+
+	var transitions *model.IssueTransitionsScheme
+	_, result.Err = callWithController(ctx, i.controller, defaultBulkMaxRetries, func() (response *model.ResponseScheme, err error) {
+		transitions, response, err = getTransitions(ctx, i.c, i.version, issueKey, nil)
+		return response, err
+	})
+
+	if result.Err != nil {
+		return result
+	}
+
+	var transitionID string
+	for _, transition := range transitions.Transitions {
+
+		if transition.ID == i.transitionNameOrID || strings.EqualFold(transition.Name, i.transitionNameOrID) {
+			transitionID = transition.ID
+			break
+		}
+	}
+
+	if transitionID == "" {
+		result.Reason = fmt.Sprintf("no transition matches %q", i.transitionNameOrID)
+		return result
+	}
+
+	if i.dryRun {
+		return result
+	}
+
+	payload := map[string]interface{}{"transition": map[string]interface{}{"id": transitionID}}
+	if len(i.fields) != 0 {
+		payload["fields"] = i.fields
+	}
+
+	_, result.Err = callWithController(ctx, i.controller, defaultBulkMaxRetries, func() (*model.ResponseScheme, error) {
+
+		reader, err := i.c.TransformStructToReader(&payload)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := fmt.Sprintf("rest/api/%v/issue/%v/transitions", i.version, issueKey)
+
+		request, err := service.NewOperationRequest(ctx, i.c, "issue.bulkMove", http.MethodPost, endpoint, reader)
+		if err != nil {
+			return nil, err
+		}
+
+		return i.c.Call(request, nil)
+	})
+
+	return result
+}
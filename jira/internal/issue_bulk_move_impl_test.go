@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+// mockBulkMoveTransitions mocks the transitions available on an issue
+func mockBulkMoveTransitions(client *mocks.Client, issueKey string, transitions ...*model.IssueTransitionScheme) {
+
+	request := &http.Request{RequestURI: "transitions-" + issueKey}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/issue/"+issueKey+"/transitions",
+		nil).
+		Return(request, nil)
+
+	client.On("Call",
+		request,
+		&model.IssueTransitionsScheme{}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.IssueTransitionsScheme).Transitions = transitions
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+func Test_bulkMoveIssues(t *testing.T) {
+
+	jql := "project = KP"
+	fields := map[string]interface{}{"resolution": map[string]interface{}{"name": "Done"}}
+
+	t.Run("when the issues are transitioned", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		mockMigrationSearch(client, jql, "KP-1", "KP-2", "KP-3", "KP-4")
+
+		mockBulkMoveTransitions(client, "KP-1", &model.IssueTransitionScheme{ID: "11", Name: "To Do"}, &model.IssueTransitionScheme{ID: "31", Name: "done"})
+		mockBulkMoveTransitions(client, "KP-2", &model.IssueTransitionScheme{ID: "11", Name: "To Do"})
+		mockBulkMoveTransitions(client, "KP-3", &model.IssueTransitionScheme{ID: "41", Name: "Done"})
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		for _, issueKey := range []string{"KP-1", "KP-3"} {
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodPost,
+				"rest/api/3/issue/"+issueKey+"/transitions",
+				bytes.NewReader([]byte{})).
+				Return(&http.Request{RequestURI: "move-" + issueKey}, nil)
+		}
+
+		client.On("Call",
+			&http.Request{RequestURI: "move-KP-1"},
+			nil).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("Call",
+			&http.Request{RequestURI: "move-KP-3"},
+			nil).
+			Return(&model.ResponseScheme{}, errors.New("error, the resolution field is not on the transition screen"))
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		report, _, err := issueService.BulkMove(context.Background(), "project = KP", "Done", fields, 2, false, []string{"kp-4"})
+		assert.NoError(t, err)
+
+		assert.False(t, report.DryRun)
+		assert.Equal(t, []string{"KP-1"}, report.Succeeded)
+		assert.Equal(t, []string{"kp-4", "KP-1"}, report.Processed)
+
+		assert.Equal(t, []*model.IssueBulkMoveResultScheme{{IssueKey: "KP-2", Reason: `no transition matches "Done"`}}, report.Skipped)
+		assert.Equal(t, []*model.IssueBulkMoveResultScheme{{IssueKey: "KP-3", Err: errors.New("error, the resolution field is not on the transition screen")}},
+			report.Failed)
+
+		client.AssertCalled(t, "TransformStructToReader", &map[string]interface{}{
+			"transition": map[string]interface{}{"id": "31"},
+			"fields":     fields,
+		})
+	})
+
+	t.Run("when the bulk transition is a dry run", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		mockMigrationSearch(client, jql, "KP-1")
+		mockBulkMoveTransitions(client, "KP-1", &model.IssueTransitionScheme{ID: "31", Name: "Done"})
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		report, _, err := issueService.BulkMove(context.Background(), "project = KP", "31", nil, 0, true, nil)
+		assert.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []string{"KP-1"}, report.Succeeded)
+		assert.Empty(t, report.Processed)
+	})
+
+	t.Run("when the parameters are not set", func(t *testing.T) {
+
+		_, issueService, err := NewIssueService(nil, "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = issueService.BulkMove(context.Background(), "", "Done", nil, 0, false, nil)
+		assert.Equal(t, model.ErrNoJQLError, err)
+
+		_, _, err = issueService.BulkMove(context.Background(), "project = KP", "", nil, 0, false, nil)
+		assert.Equal(t, model.ErrNoTransitionIDError, err)
+	})
+}
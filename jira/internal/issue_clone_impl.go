@@ -0,0 +1,398 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// cloneIssue clones an issue and, when the options enable them, its subtasks, issue links and attachments.
+//
+// The issues are created first, so the links between the cloned issues can point to the clones.
+func cloneIssue(ctx context.Context, client service.Client, version, issueKeyOrId string, options *model.IssueCloneOptionsScheme) (
+	*model.IssueCloneResultScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if options == nil {
+		options = &model.IssueCloneOptionsScheme{}
+	}
+
+	cloner := &issueCloner{
+		c:          client,
+		version:    version,
+		options:    options,
+		attachment: &internalIssueAttachmentServiceImpl{c: client, version: version},
+		result:     &model.IssueCloneResultScheme{Issues: make(map[string]string)},
+	}
+
+	return cloner.clone(ctx, issueKeyOrId)
+}
+
+type issueCloner struct {
+	c          service.Client
+	version    string
+	options    *model.IssueCloneOptionsScheme
+	attachment *internalIssueAttachmentServiceImpl
+	result     *model.IssueCloneResultScheme
+
+	// sources stores the source issues in creation order, the links and attachments are cloned from them
+	sources []*clonedIssueScheme
+}
+
+type clonedIssueScheme struct {
+	Key    string                 `json:"key"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+func (i *issueCloner) clone(ctx context.Context, issueKeyOrId string) (*model.IssueCloneResultScheme, *model.ResponseScheme, error) {
+
+	source, response, err := i.get(ctx, issueKeyOrId)
+	if err != nil {
+		return nil, response, err
+	}
+
+	created, response, err := i.create(ctx, source, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	i.result.IssueKey = created.Key
+
+	if i.options.Subtasks {
+
+		for _, subtask := range valuesOf(source.Fields["subtasks"]) {
+
+			if err := ctx.Err(); err != nil {
+				return i.result, response, err
+			}
+
+			subtaskKey := stringAttribute(subtask, "key")
+
+			subtaskSource, subtaskResponse, err := i.get(ctx, subtaskKey)
+			if err == nil {
+				_, subtaskResponse, err = i.create(ctx, subtaskSource, map[string]interface{}{"key": created.Key})
+			}
+
+			if subtaskResponse != nil {
+				response = subtaskResponse
+			}
+
+			if err != nil {
+				i.fail(subtaskKey, model.IssueCloneSubtaskStep, "", err)
+			}
+		}
+	}
+
+	if i.options.Links {
+
+		if lastResponse, err := i.cloneLinks(ctx); err != nil {
+			return i.result, lastResponse, err
+		} else if lastResponse != nil {
+			response = lastResponse
+		}
+	}
+
+	if i.options.Attachments {
+
+		if lastResponse, err := i.cloneAttachments(ctx); err != nil {
+			return i.result, lastResponse, err
+		} else if lastResponse != nil {
+			response = lastResponse
+		}
+	}
+
+	return i.result, response, nil
+}
+
+// fields returns the fields copied to the clones, the project, issue type and summary are always required.
+func (i *issueCloner) fields() []string {
+
+	fields := i.options.Fields
+	if len(fields) == 0 {
+		fields = model.IssueCloneDefaultFields
+	}
+
+	return appendMissing(fields, "project", "issuetype", "summary")
+}
+
+func (i *issueCloner) get(ctx context.Context, issueKeyOrId string) (*clonedIssueScheme, *model.ResponseScheme, error) {
+
+	fields := i.fields()
+
+	if i.options.Subtasks {
+		fields = appendMissing(fields, "subtasks")
+	}
+
+	if i.options.Links {
+		fields = appendMissing(fields, "issuelinks")
+	}
+
+	if i.options.Attachments {
+		fields = appendMissing(fields, "attachment")
+	}
+
+	params := url.Values{}
+	params.Add("fields", strings.Join(fields, ","))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", i.version, issueKeyOrId, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.clone", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(clonedIssueScheme)
+	response, err := i.c.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
+
+// create creates the clone of an issue, the parent is replaced when the issue is a cloned subtask.
+func (i *issueCloner) create(ctx context.Context, source *clonedIssueScheme, parent map[string]interface{}) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+
+	fields := make(map[string]interface{})
+	for _, field := range i.fields() {
+
+		if value, ok := source.Fields[field]; ok && value != nil {
+			fields[field] = cloneFieldValue(value)
+		}
+	}
+
+	if summary, ok := source.Fields["summary"].(string); ok {
+		fields["summary"] = i.options.SummaryPrefix + summary
+	}
+
+	if parent != nil {
+		fields["parent"] = parent
+	}
+
+	reader, err := i.c.TransformStructToReader(&map[string]interface{}{"fields": fields})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.clone", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.IssueResponseScheme)
+	response, err := i.c.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	i.result.Issues[source.Key] = issue.Key
+	i.sources = append(i.sources, source)
+
+	return issue, response, nil
+}
+
+// cloneLinks re-creates the issue links of the cloned issues, the links between two cloned issues are created once.
+func (i *issueCloner) cloneLinks(ctx context.Context) (*model.ResponseScheme, error) {
+
+	var response *model.ResponseScheme
+	processed := make(map[string]bool)
+
+	for _, source := range i.sources {
+
+		for _, link := range valuesOf(source.Fields["issuelinks"]) {
+
+			linkID := stringAttribute(link, "id")
+			if processed[linkID] {
+				continue
+			}
+
+			if err := ctx.Err(); err != nil {
+				return response, err
+			}
+
+			processed[linkID] = true
+
+			// The issue links only contain the other issue, the source issue is the opposite side of the link
+			inwardKey, outwardKey := i.result.Issues[source.Key], stringAttribute(link["outwardIssue"], "key")
+			if outwardKey == "" {
+				inwardKey, outwardKey = stringAttribute(link["inwardIssue"], "key"), i.result.Issues[source.Key]
+			}
+
+			if cloneKey, ok := i.result.Issues[inwardKey]; ok {
+				inwardKey = cloneKey
+			}
+
+			if cloneKey, ok := i.result.Issues[outwardKey]; ok {
+				outwardKey = cloneKey
+			}
+
+			payload := map[string]interface{}{
+				"type":         map[string]interface{}{"name": stringAttribute(link["type"], "name")},
+				"inwardIssue":  map[string]interface{}{"key": inwardKey},
+				"outwardIssue": map[string]interface{}{"key": outwardKey},
+			}
+
+			linkResponse, err := i.post(ctx, fmt.Sprintf("rest/api/%v/issueLink", i.version), &payload)
+			if linkResponse != nil {
+				response = linkResponse
+			}
+
+			if err != nil {
+				i.fail(source.Key, model.IssueCloneLinkStep, linkID, err)
+				continue
+			}
+
+			i.result.Links++
+		}
+	}
+
+	return response, nil
+}
+
+// cloneAttachments downloads the attachments of the cloned issues and uploads them to the clones.
+func (i *issueCloner) cloneAttachments(ctx context.Context) (*model.ResponseScheme, error) {
+
+	var response *model.ResponseScheme
+	for _, source := range i.sources {
+
+		for _, value := range valuesOf(source.Fields["attachment"]) {
+
+			if err := ctx.Err(); err != nil {
+				return response, err
+			}
+
+			attachmentID, fileName := stringAttribute(value, "id"), stringAttribute(value, "filename")
+
+			var content bytes.Buffer
+			attachmentResponse, err := i.attachment.Download(ctx, attachmentID, true, &content)
+			if err == nil {
+				_, attachmentResponse, err = i.attachment.Add(ctx, i.result.Issues[source.Key], fileName, &content)
+			}
+
+			if attachmentResponse != nil {
+				response = attachmentResponse
+			}
+
+			if err != nil {
+				i.fail(source.Key, model.IssueCloneAttachmentStep, attachmentID, err)
+				continue
+			}
+
+			i.result.Attachments++
+		}
+	}
+
+	return response, nil
+}
+
+func (i *issueCloner) post(ctx context.Context, endpoint string, payload interface{}) (*model.ResponseScheme, error) {
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.clone", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *issueCloner) fail(issueKey, step, id string, err error) {
+	i.result.Failures = append(i.result.Failures, &model.IssueCloneFailureScheme{IssueKey: issueKey, Step: step, ID: id, Err: err})
+}
+
+// cloneFieldValue returns the value accepted by the create issue endpoint, the objects returned by the
+// issue endpoint (e.g. the issue type or the components) are replaced by their ids.
+func cloneFieldValue(value interface{}) interface{} {
+
+	switch value := value.(type) {
+	case []interface{}:
+
+		values := make([]interface{}, len(value))
+		for index, element := range value {
+			values[index] = cloneFieldValue(element)
+		}
+
+		return values
+
+	case map[string]interface{}:
+
+		if accountID, ok := value["accountId"]; ok {
+			return map[string]interface{}{"accountId": accountID}
+		}
+
+		if id, ok := value["id"]; ok {
+
+			reference := map[string]interface{}{"id": id}
+			if child, ok := value["child"]; ok {
+				reference["child"] = cloneFieldValue(child)
+			}
+
+			return reference
+		}
+
+		// The values without id, e.g. the ADF documents, are sent as they're returned
+		return value
+	}
+
+	return value
+}
+
+func valuesOf(value interface{}) []map[string]interface{} {
+
+	elements, _ := value.([]interface{})
+
+	values := make([]map[string]interface{}, 0, len(elements))
+	for _, element := range elements {
+
+		if object, ok := element.(map[string]interface{}); ok {
+			values = append(values, object)
+		}
+	}
+
+	return values
+}
+
+func stringAttribute(value interface{}, name string) string {
+
+	object, ok := value.(map[string]interface{})
+	if !ok || object[name] == nil {
+		return ""
+	}
+
+	return fmt.Sprint(object[name])
+}
+
+func appendMissing(values []string, missing ...string) []string {
+
+	result := append([]string{}, values...)
+	for _, value := range missing {
+
+		found := false
+		for _, existing := range result {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
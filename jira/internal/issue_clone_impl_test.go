@@ -0,0 +1,300 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// cloneSourceEndpoint returns the endpoint used to read the source issues with the default fields.
+func cloneSourceEndpoint(issueKey string, fields ...string) string {
+
+	fields = append(append([]string{}, model.IssueCloneDefaultFields...), fields...)
+	params := url.Values{"fields": {strings.Join(fields, ",")}}
+
+	return fmt.Sprintf("rest/api/3/issue/%v?%v", issueKey, params.Encode())
+}
+
+// mockCloneSource mocks the read of a source issue, the issue is decoded from the JSON body.
+func mockCloneSource(client *mocks.Client, endpoint, body string) {
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodGet,
+		endpoint,
+		nil).
+		Return(&http.Request{RequestURI: endpoint}, nil)
+
+	client.On("Call",
+		&http.Request{RequestURI: endpoint},
+		&clonedIssueScheme{}).
+		Run(func(args mock.Arguments) {
+			_ = json.Unmarshal([]byte(body), args.Get(1))
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+// mockClonePost mocks a POST request whose payload contains the fragment, the requests are told apart by the fragment.
+func mockClonePost(client *mocks.Client, endpoint, fragment string, target interface{}, run func(mock.Arguments), err error) {
+
+	reader := strings.NewReader(fragment)
+
+	client.On("TransformStructToReader",
+		mock.MatchedBy(func(payload interface{}) bool {
+			encoded, _ := json.Marshal(payload)
+			return strings.Contains(string(encoded), fragment)
+		})).
+		Return(reader, nil)
+
+	client.On("NewRequest",
+		mock.Anything,
+		http.MethodPost,
+		endpoint,
+		reader).
+		Return(&http.Request{RequestURI: fragment}, nil)
+
+	call := client.On("Call",
+		&http.Request{RequestURI: fragment},
+		target)
+
+	if run != nil {
+		call.Run(run)
+	}
+
+	call.Return(&model.ResponseScheme{}, err)
+}
+
+func createdIssue(issueKey string) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		args.Get(1).(*model.IssueResponseScheme).Key = issueKey
+	}
+}
+
+func Test_internalIssueADFServiceImpl_Clone(t *testing.T) {
+
+	const rootBody = `{"key":"KP-1","fields":{
+		"summary":"Login fails","project":{"id":"10000","key":"KP","self":"https://ctreminiom.atlassian.net"},
+		"issuetype":{"id":"10001","name":"Task"},"assignee":{"accountId":"5b86be50b8e3cb5895860d6d","displayName":"Carlos"},
+		"labels":["triage"],"description":{"type":"doc","version":1,"content":[]},
+		"subtasks":[{"id":"10002","key":"KP-2"}],
+		"issuelinks":[
+			{"id":"10","type":{"name":"Blocks"},"outwardIssue":{"key":"KP-3"}},
+			{"id":"20","type":{"name":"Relates"},"inwardIssue":{"key":"KP-2"}}
+		],
+		"attachment":[{"id":"900","filename":"trace.log"}]}}`
+
+	const subtaskBody = `{"key":"KP-2","fields":{
+		"summary":"Fix the session cookie","project":{"id":"10000"},"issuetype":{"id":"10003"},"parent":{"id":"10001","key":"KP-1"},
+		"issuelinks":[{"id":"20","type":{"name":"Relates"},"outwardIssue":{"key":"KP-1"}}]}}`
+
+	allOptions := &model.IssueCloneOptionsScheme{SummaryPrefix: "CLONE - ", Subtasks: true, Links: true, Attachments: true}
+
+	// The context is cancelled once the first issue is created
+	cancellableCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrId string
+		options      *model.IssueCloneOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueCloneResultScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the issue is cloned with the subtasks, links and attachments",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "KP-1",
+				options:      allOptions,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockCloneSource(client, cloneSourceEndpoint("KP-1", "subtasks", "issuelinks", "attachment"), rootBody)
+				mockCloneSource(client, cloneSourceEndpoint("KP-2", "subtasks", "issuelinks", "attachment"), subtaskBody)
+
+				mockClonePost(client, "rest/api/3/issue",
+					`"assignee":{"accountId":"5b86be50b8e3cb5895860d6d"},"description":{"content":[],"type":"doc","version":1},"issuetype":{"id":"10001"},"labels":["triage"],"project":{"id":"10000"},"summary":"CLONE - Login fails"`,
+					&model.IssueResponseScheme{}, createdIssue("KP-10"), nil)
+
+				mockClonePost(client, "rest/api/3/issue",
+					`"issuetype":{"id":"10003"},"parent":{"key":"KP-10"},"project":{"id":"10000"},"summary":"CLONE - Fix the session cookie"`,
+					&model.IssueResponseScheme{}, createdIssue("KP-11"), nil)
+
+				mockClonePost(client, "rest/api/3/issueLink",
+					`{"inwardIssue":{"key":"KP-10"},"outwardIssue":{"key":"KP-3"},"type":{"name":"Blocks"}}`,
+					nil, nil, nil)
+
+				mockClonePost(client, "rest/api/3/issueLink",
+					`{"inwardIssue":{"key":"KP-11"},"outwardIssue":{"key":"KP-10"},"type":{"name":"Relates"}}`,
+					nil, nil, nil)
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodGet,
+					"rest/api/3/attachment/content/900",
+					nil).
+					Return(&http.Request{RequestURI: "download"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "download"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewFormRequest",
+					mock.Anything,
+					http.MethodPost,
+					"rest/api/3/issue/KP-10/attachments",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			want: &model.IssueCloneResultScheme{
+				IssueKey: "KP-10",
+				Issues:   map[string]string{"KP-1": "KP-10", "KP-2": "KP-11"},
+				Links:    2,
+				Failures: []*model.IssueCloneFailureScheme{
+					{IssueKey: "KP-1", Step: model.IssueCloneAttachmentStep, ID: "900", Err: errors.New("error, unable to create the http request")},
+				},
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the context is cancelled after the issue is created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          cancellableCtx,
+				issueKeyOrId: "KP-1",
+				options:      allOptions,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockCloneSource(client, cloneSourceEndpoint("KP-1", "subtasks", "issuelinks", "attachment"), rootBody)
+
+				mockClonePost(client, "rest/api/3/issue", `"summary":"CLONE - Login fails"`, &model.IssueResponseScheme{},
+					func(args mock.Arguments) {
+						createdIssue("KP-10")(args)
+						cancel()
+					}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueCloneResultScheme{
+				IssueKey: "KP-10",
+				Issues:   map[string]string{"KP-1": "KP-10"},
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name:   "when the source issue cannot be read",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "KP-1",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodGet,
+					cloneSourceEndpoint("KP-1"),
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			_, issueService, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.Clone(testCase.args.ctx, testCase.args.issueKeyOrId, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+				assert.Equal(t, testCase.want, gotResult)
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+
+		})
+	}
+}
+
+func Test_cloneFieldValue(t *testing.T) {
+
+	value := map[string]interface{}{
+		"self":  "https://ctreminiom.atlassian.net/rest/api/3/customFieldOption/10000",
+		"value": "Europe",
+		"id":    "10000",
+		"child": map[string]interface{}{"id": "10001", "value": "Spain"},
+	}
+
+	assert.Equal(t, map[string]interface{}{"id": "10000", "child": map[string]interface{}{"id": "10001"}}, cloneFieldValue(value))
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": "1"}, "label"}, cloneFieldValue([]interface{}{map[string]interface{}{"id": "1", "name": "v1"}, "label"}))
+	assert.Equal(t, 3.0, cloneFieldValue(3.0))
+}
@@ -0,0 +1,164 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+)
+
+// issueExtras contains the extras added to a new issue, the comment is added through the format of the API version.
+type issueExtras struct {
+	attachments []*model.IssueCreateAttachmentScheme
+	links       []*model.IssueCreateLinkScheme
+	watchers    []string
+	comment     func(ctx context.Context, issueKey string) (*model.ResponseScheme, error)
+}
+
+// createCompleteIssue creates an issue and adds the extras in order: attachments, links, watchers and the comment.
+//
+// The new issue is never rolled back, the extras that can't be added are reported on the result failures.
+func createCompleteIssue(ctx context.Context, client service.Client, version string,
+	create func() (*model.IssueResponseScheme, *model.ResponseScheme, error), extras *issueExtras) (
+	*model.IssueCreateCompleteResultScheme, *model.ResponseScheme, error) {
+
+	issue, response, err := create()
+	if err != nil {
+		return nil, response, err
+	}
+
+	creator := &issueExtrasCreator{
+		issueKey:   issue.Key,
+		attachment: &internalIssueAttachmentServiceImpl{c: client, version: version},
+		link:       &internalLinkRichTextServiceImpl{c: client, version: version},
+		watcher:    &internalWatcherImpl{c: client, version: version},
+		result:     &model.IssueCreateCompleteResultScheme{Issue: issue},
+	}
+
+	for _, step := range creator.steps(extras) {
+
+		if err := ctx.Err(); err != nil {
+			return creator.result, response, err
+		}
+
+		stepResponse, err := step.add(ctx)
+		if stepResponse != nil {
+			response = stepResponse
+		}
+
+		creator.record(step.name, step.target, err)
+	}
+
+	return creator.result, response, nil
+}
+
+type issueExtrasCreator struct {
+	issueKey   string
+	attachment *internalIssueAttachmentServiceImpl
+	link       *internalLinkRichTextServiceImpl
+	watcher    *internalWatcherImpl
+	result     *model.IssueCreateCompleteResultScheme
+}
+
+type issueExtraStep struct {
+	name   string
+	target string
+	add    func(ctx context.Context) (*model.ResponseScheme, error)
+}
+
+// steps returns the steps of the extras in the order they're added to the issue.
+func (i *issueExtrasCreator) steps(extras *issueExtras) []*issueExtraStep {
+
+	if extras == nil {
+		return nil
+	}
+
+	var steps []*issueExtraStep
+
+	for _, attachment := range extras.attachments {
+
+		if attachment == nil {
+			continue
+		}
+
+		attachment := attachment
+		steps = append(steps, &issueExtraStep{
+			name:   model.IssueCreateAttachmentStep,
+			target: attachment.Name,
+			add: func(ctx context.Context) (*model.ResponseScheme, error) {
+				_, response, err := i.attachment.Add(ctx, i.issueKey, attachment.Name, attachment.Reader)
+				return response, err
+			},
+		})
+	}
+
+	for _, link := range extras.links {
+
+		if link == nil {
+			continue
+		}
+
+		link := link
+		steps = append(steps, &issueExtraStep{
+			name:   model.IssueCreateLinkStep,
+			target: link.OutwardKey,
+			add: func(ctx context.Context) (*model.ResponseScheme, error) {
+				return i.addLink(ctx, link)
+			},
+		})
+	}
+
+	for _, accountID := range extras.watchers {
+
+		accountID := accountID
+		steps = append(steps, &issueExtraStep{
+			name:   model.IssueCreateWatcherStep,
+			target: accountID,
+			add: func(ctx context.Context) (*model.ResponseScheme, error) {
+				return i.watcher.Add(ctx, i.issueKey, accountID)
+			},
+		})
+	}
+
+	if extras.comment != nil {
+
+		steps = append(steps, &issueExtraStep{
+			name: model.IssueCreateCommentStep,
+			add: func(ctx context.Context) (*model.ResponseScheme, error) {
+				return extras.comment(ctx, i.issueKey)
+			},
+		})
+	}
+
+	return steps
+}
+
+func (i *issueExtrasCreator) addLink(ctx context.Context, link *model.IssueCreateLinkScheme) (*model.ResponseScheme, error) {
+
+	if link.TypeName == "" {
+		return nil, model.ErrNoLinkTypeNameError
+	}
+
+	if link.OutwardKey == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	payload := &model.LinkPayloadSchemeV2{
+		Type:         &model.LinkTypeScheme{Name: link.TypeName},
+		InwardIssue:  &model.LinkedIssueScheme{Key: i.issueKey},
+		OutwardIssue: &model.LinkedIssueScheme{Key: link.OutwardKey},
+	}
+
+	return i.link.Create(ctx, payload)
+}
+
+func (i *issueExtrasCreator) record(step, target string, err error) {
+
+	scheme := &model.IssueCreateStepScheme{Step: step, Target: target, Err: err}
+
+	if err != nil {
+		i.result.Failures = append(i.result.Failures, scheme)
+		return
+	}
+
+	i.result.Succeeded = append(i.result.Succeeded, scheme)
+}
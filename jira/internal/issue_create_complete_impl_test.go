@@ -0,0 +1,231 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func Test_internalIssueADFServiceImpl_CreateComplete(t *testing.T) {
+
+	payload := &model.IssueScheme{
+		Fields: &model.IssueFieldsScheme{
+			Summary:   "Login fails",
+			Project:   &model.ProjectScheme{ID: "10000"},
+			IssueType: &model.IssueTypeScheme{Name: "Task"},
+		},
+	}
+
+	comment := &model.CommentPayloadScheme{
+		Body: &model.CommentNodeScheme{
+			Version: 1,
+			Type:    "doc",
+			Content: []*model.CommentNodeScheme{
+				{Type: "paragraph", Content: []*model.CommentNodeScheme{{Type: "text", Text: "Imported from the legacy tracker"}}},
+			},
+		},
+	}
+
+	extras := &model.IssueCreateExtrasScheme{
+		Attachments: []*model.IssueCreateAttachmentScheme{{Name: "trace.log", Reader: strings.NewReader("stack trace")}},
+		Links: []*model.IssueCreateLinkScheme{
+			{TypeName: "Blocks", OutwardKey: "KP-3"},
+			{OutwardKey: "KP-4"},
+		},
+		Watchers: []string{"5b86be50b8e3cb5895860d6d"},
+		Comment:  comment,
+	}
+
+	// The context is cancelled once the issue is created
+	cancellableCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		payload      *model.IssueScheme
+		customFields *model.CustomFields
+		extras       *model.IssueCreateExtrasScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueCreateCompleteResultScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the issue is created with the extras",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payload,
+				extras:  extras,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockClonePost(client, "rest/api/3/issue", `"summary":"Login fails"`, &model.IssueResponseScheme{}, createdIssue("KP-10"), nil)
+
+				client.On("NewFormRequest",
+					mock.Anything,
+					http.MethodPost,
+					"rest/api/3/issue/KP-10/attachments",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{RequestURI: "attachment"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "attachment"},
+					new([]*model.AttachmentScheme)).
+					Return(&model.ResponseScheme{}, nil)
+
+				mockClonePost(client, "rest/api/3/issueLink",
+					`{"inwardIssue":{"key":"KP-10"},"outwardIssue":{"key":"KP-3"},"type":{"name":"Blocks"}}`,
+					nil, nil, errors.New("error, the issue KP-3 does not exist"))
+
+				mockClonePost(client, "rest/api/3/issue/KP-10/watchers", `"5b86be50b8e3cb5895860d6d"`, nil, nil, nil)
+
+				mockClonePost(client, "rest/api/3/issue/KP-10/comment", `"text":"Imported from the legacy tracker"`,
+					&model.IssueCommentScheme{}, nil, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueCreateCompleteResultScheme{
+				Issue: &model.IssueResponseScheme{Key: "KP-10"},
+				Succeeded: []*model.IssueCreateStepScheme{
+					{Step: model.IssueCreateAttachmentStep, Target: "trace.log"},
+					{Step: model.IssueCreateWatcherStep, Target: "5b86be50b8e3cb5895860d6d"},
+					{Step: model.IssueCreateCommentStep},
+				},
+				Failures: []*model.IssueCreateStepScheme{
+					{Step: model.IssueCreateLinkStep, Target: "KP-3", Err: errors.New("error, the issue KP-3 does not exist")},
+					{Step: model.IssueCreateLinkStep, Target: "KP-4", Err: model.ErrNoLinkTypeNameError},
+				},
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the context is cancelled after the issue is created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     cancellableCtx,
+				payload: payload,
+				extras:  extras,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockClonePost(client, "rest/api/3/issue", `"summary":"Login fails"`, &model.IssueResponseScheme{},
+					func(args mock.Arguments) {
+						createdIssue("KP-10")(args)
+						cancel()
+					}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueCreateCompleteResultScheme{
+				Issue: &model.IssueResponseScheme{Key: "KP-10"},
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name:   "when the issue cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payload,
+				extras:  extras,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockClonePost(client, "rest/api/3/issue", `"summary":"Login fails"`, &model.IssueResponseScheme{}, nil,
+					errors.New("error, the project does not exist"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, the project does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			_, issueService, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.CreateComplete(testCase.args.ctx, testCase.args.payload,
+				testCase.args.customFields, testCase.args.extras)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+				assert.Equal(t, testCase.want, gotResult)
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+				assert.Equal(t, testCase.want.Failed(), gotResult.Failed())
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_CreateComplete(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	mockClonePost(client, "rest/api/2/issue", `"summary":"Login fails"`, &model.IssueResponseScheme{}, createdIssue("KP-10"), nil)
+
+	mockClonePost(client, "rest/api/2/issue/KP-10/comment", `"body":"Imported from the legacy tracker"`,
+		&model.IssueCommentSchemeV2{}, nil, nil)
+
+	issueService, _, err := NewIssueService(client, "2", nil)
+	assert.NoError(t, err)
+
+	payload := &model.IssueSchemeV2{Fields: &model.IssueFieldsSchemeV2{Summary: "Login fails"}}
+	extras := &model.IssueCreateExtrasSchemeV2{Comment: &model.CommentPayloadSchemeV2{Body: "Imported from the legacy tracker"}}
+
+	gotResult, _, err := issueService.CreateComplete(context.Background(), payload, nil, extras)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &model.IssueCreateCompleteResultScheme{
+		Issue:     &model.IssueResponseScheme{Key: "KP-10"},
+		Succeeded: []*model.IssueCreateStepScheme{{Step: model.IssueCreateCommentStep}},
+	}, gotResult)
+	assert.False(t, gotResult.Failed())
+}
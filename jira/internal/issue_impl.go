@@ -2,24 +2,36 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
 )
 
+// issueBulkFetchMaxIssues is the maximum number of issues accepted by the bulk fetch endpoint
+const issueBulkFetchMaxIssues = 100
+
+// issueBulkCreateMaxIssues is the maximum number of issues accepted by the bulk create endpoint
+const issueBulkCreateMaxIssues = 50
+
 type IssueServices struct {
 	Attachment      *IssueAttachmentService
 	CommentRT       *CommentRichTextService
 	CommentADF      *CommentADFService
+	Development     *DevelopmentService
 	Field           *IssueFieldService
 	Label           *LabelService
 	LinkRT          *LinkRichTextService
 	LinkADF         *LinkADFService
 	Metadata        *MetadataService
 	Priority        *PriorityService
+	Property        *IssuePropertyService
 	Resolution      *ResolutionService
+	SecurityLevel   *IssueSecurityLevelService
 	SearchRT        *SearchRichTextService
 	SearchADF       *SearchADFService
 	Type            *TypeService
@@ -53,12 +65,15 @@ func NewIssueService(client service.Client, version string, services *IssueServi
 
 		adfService.Attachment = services.Attachment
 		adfService.Comment = services.CommentADF
+		adfService.Development = services.Development
 		adfService.Field = services.Field
 		adfService.Label = services.Label
 		adfService.Link = services.LinkADF
 		adfService.Metadata = services.Metadata
 		adfService.Priority = services.Priority
+		adfService.Property = services.Property
 		adfService.Resolution = services.Resolution
+		adfService.SecurityLevel = services.SecurityLevel
 		adfService.Search = services.SearchADF
 		adfService.Type = services.Type
 		adfService.Vote = services.Vote
@@ -67,12 +82,15 @@ func NewIssueService(client service.Client, version string, services *IssueServi
 
 		richTextService.Comment = services.CommentRT
 		richTextService.Attachment = services.Attachment
+		richTextService.Development = services.Development
 		richTextService.Field = services.Field
 		richTextService.Label = services.Label
 		richTextService.Link = services.LinkRT
 		richTextService.Metadata = services.Metadata
 		richTextService.Priority = services.Priority
+		richTextService.Property = services.Property
 		richTextService.Resolution = services.Resolution
+		richTextService.SecurityLevel = services.SecurityLevel
 		richTextService.Search = services.SearchRT
 		richTextService.Type = services.Type
 		richTextService.Vote = services.Vote
@@ -95,7 +113,7 @@ func deleteIssue(ctx context.Context, client service.Client, version, issueKeyOr
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", version, issueKeyOrId, params.Encode())
 
-	request, err := client.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, client, "issue.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +144,7 @@ func assignIssue(ctx context.Context, client service.Client, version, issueKeyOr
 
 	endpoint := fmt.Sprintf("/rest/api/%v/issue/%v/assignee", version, issueKeyOrId)
 
-	request, err := client.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, client, "issue.assign", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +159,10 @@ func sendNotification(ctx context.Context, client service.Client, version, issue
 		return nil, model.ErrNoIssueKeyOrIDError
 	}
 
+	if options == nil || !options.To.HasRecipients() {
+		return nil, model.ErrNoIssueNotifyRecipientsError
+	}
+
 	reader, err := client.TransformStructToReader(options)
 	if err != nil {
 		return nil, err
@@ -148,7 +170,7 @@ func sendNotification(ctx context.Context, client service.Client, version, issue
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/notify", version, issueKeyOrId)
 
-	request, err := client.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, client, "issue.notify", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -156,15 +178,22 @@ func sendNotification(ctx context.Context, client service.Client, version, issue
 	return client.Call(request, nil)
 }
 
-func getTransitions(ctx context.Context, client service.Client, version, issueKeyOrId string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
+func getTransitions(ctx context.Context, client service.Client, version, issueKeyOrId string, expand []string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
 
 	if issueKeyOrId == "" {
 		return nil, nil, model.ErrNoIssueKeyOrIDError
 	}
 
-	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/transitions", version, issueKeyOrId)
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issue/%v/transitions", version, issueKeyOrId))
 
-	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	if len(expand) != 0 {
+		params := url.Values{}
+		params.Add("expand", strings.Join(expand, ","))
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := service.NewOperationRequest(ctx, client, "issue.transitions", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -177,3 +206,156 @@ func getTransitions(ctx context.Context, client service.Client, version, issueKe
 
 	return transitions, response, nil
 }
+
+// createIssuesInBulk creates the issues in batches, the failed element numbers of the batch errors are mapped
+// to the positions of the issues on the caller payload.
+func createIssuesInBulk(ctx context.Context, client service.Client, version string, issues []map[string]interface{}, positions []int) (
+	*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+
+	if len(issues) == 0 {
+		return nil, nil, model.ErrNoIssuesSliceError
+	}
+
+	var (
+		result   = new(model.IssueBulkResponseScheme)
+		response *model.ResponseScheme
+	)
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/bulk", version)
+
+	for start := 0; start < len(issues); start += issueBulkCreateMaxIssues {
+
+		end := start + issueBulkCreateMaxIssues
+		if end > len(issues) {
+			end = len(issues)
+		}
+
+		var bulkPayload = map[string]interface{}{}
+		bulkPayload["issueUpdates"] = issues[start:end]
+
+		reader, err := client.TransformStructToReader(&bulkPayload)
+		if err != nil {
+			return result, response, err
+		}
+
+		request, err := service.NewOperationRequest(ctx, client, "issue.creates", http.MethodPost, endpoint, reader)
+		if err != nil {
+			return result, response, err
+		}
+
+		batch := new(model.IssueBulkResponseScheme)
+		response, err = client.Call(request, batch)
+		if err != nil && !decodeFailedIssueBatch(response, batch) {
+			return result, response, err
+		}
+
+		result.Issues = append(result.Issues, batch.Issues...)
+
+		for _, batchError := range batch.Errors {
+
+			if batchError == nil {
+				continue
+			}
+
+			if element := start + batchError.FailedElementNumber; element >= 0 && element < len(positions) {
+				batchError.FailedElementNumber = positions[element]
+			}
+
+			result.Errors = append(result.Errors, batchError)
+		}
+	}
+
+	return result, response, nil
+}
+
+// decodeFailedIssueBatch decodes the element errors of a batch rejected with a 400 status code, Jira rejects the batch
+// when every issue of the batch is invalid, the element errors are then only available on the response body.
+func decodeFailedIssueBatch(response *model.ResponseScheme, batch *model.IssueBulkResponseScheme) bool {
+
+	if response == nil || response.Code != http.StatusBadRequest {
+		return false
+	}
+
+	if err := json.Unmarshal(response.Bytes.Bytes(), batch); err != nil {
+		return false
+	}
+
+	return len(batch.Errors) != 0
+}
+
+func newIssueBulkFetchRequest(ctx context.Context, client service.Client, version string, payload *model.IssueBulkFetchPayloadScheme) (*http.Request, error) {
+
+	reader, err := client.TransformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/bulkfetch", version)
+
+	return service.NewOperationRequest(ctx, client, "issue.bulkFetch", http.MethodPost, endpoint, reader)
+}
+
+func chunkIssueKeysOrIDs(issueKeysOrIDs []string, size int) [][]string {
+
+	var chunks [][]string
+	for size < len(issueKeysOrIDs) {
+		issueKeysOrIDs, chunks = issueKeysOrIDs[size:], append(chunks, issueKeysOrIDs[:size])
+	}
+
+	return append(chunks, issueKeysOrIDs)
+}
+
+// issueKeysOrIDsPositions maps the requested issue keys and ids to their position on the request,
+// the issue keys are case-insensitive, so they're stored on upper case.
+func issueKeysOrIDsPositions(issueKeysOrIDs []string) map[string]int {
+
+	positions := make(map[string]int, len(issueKeysOrIDs))
+	for position, issueKeyOrID := range issueKeysOrIDs {
+
+		issueKeyOrID = strings.ToUpper(issueKeyOrID)
+		if _, ok := positions[issueKeyOrID]; !ok {
+			positions[issueKeyOrID] = position
+		}
+	}
+
+	return positions
+}
+
+// issueBulkFetchPosition returns the requested position of an issue, the issues not requested by their current
+// id or key (e.g. moved issues) are placed at the end.
+func issueBulkFetchPosition(positions map[string]int, issueID, issueKey string) int {
+
+	if position, ok := positions[strings.ToUpper(issueKey)]; ok {
+		return position
+	}
+
+	if position, ok := positions[issueID]; ok {
+		return position
+	}
+
+	return len(positions)
+}
+
+// checkUpdateConflicts rejects the edit payloads setting a field on the fields and on the update operations,
+// Jira rejects them with a 400 response.
+func checkUpdateConflicts(payload map[string]interface{}) error {
+
+	fields, _ := payload["fields"].(map[string]interface{})
+	operations, _ := payload["update"].(map[string]interface{})
+
+	var conflicts []string
+	for fieldID := range operations {
+
+		if _, ok := fields[fieldID]; ok {
+			conflicts = append(conflicts, fieldID)
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	sort.Strings(conflicts)
+
+	return fmt.Errorf("%w: %v", model.ErrIssueUpdateConflictError, strings.Join(conflicts, ", "))
+}
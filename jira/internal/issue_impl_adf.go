@@ -2,7 +2,6 @@ package internal
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
@@ -11,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 )
 
@@ -18,12 +18,15 @@ type IssueADFService struct {
 	internalClient jira.IssueADFConnector
 	Attachment     *IssueAttachmentService
 	Comment        *CommentADFService
+	Development    *DevelopmentService
 	Field          *IssueFieldService
 	Label          *LabelService
 	Link           *LinkADFService
 	Metadata       *MetadataService
 	Priority       *PriorityService
+	Property       *IssuePropertyService
 	Resolution     *ResolutionService
+	SecurityLevel  *IssueSecurityLevelService
 	Search         *SearchADFService
 	Type           *TypeService
 	Vote           *VoteService
@@ -79,11 +82,96 @@ func (i *IssueADFService) Notify(ctx context.Context, issueKeyOrId string, optio
 //
 // given its status, the response will return any empty transitions list.
 //
+// Expand the transitions.fields to get the fields settable on the screen of each transition.
+//
 // GET /rest/api/{2-3}/issue/{issueIdOrKey}/transitions
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues#get-transitions
-func (i *IssueADFService) Transitions(ctx context.Context, issueKeyOrId string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
-	return i.internalClient.Transitions(ctx, issueKeyOrId)
+func (i *IssueADFService) Transitions(ctx context.Context, issueKeyOrId string, expand []string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Transitions(ctx, issueKeyOrId, expand)
+}
+
+// Clone creates a copy of an issue, the subtasks, issue links and attachments are cloned when the options enable them.
+//
+// The REST API doesn't clone issues atomically, the parts that can't be cloned are reported on the result
+// failures while the new issues are kept.
+//
+// The cancellation of the context is checked between the steps, the result of the steps completed is returned with the error.
+//
+// TODO: the documentation needs to be created
+func (i *IssueADFService) Clone(ctx context.Context, issueKeyOrId string, options *model.IssueCloneOptionsScheme) (*model.IssueCloneResultScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Clone(ctx, issueKeyOrId, options)
+}
+
+// BulkMove transitions the issues matching a JQL query, the transition is matched by id or by name, ignoring the case,
+// against the transitions available on every issue, the issues without a matching transition are skipped.
+//
+// The issue keys are collected before the transitions, the transitioned issues no longer match the query.
+//
+// The fields are set on every transition, the issues on the already processed keys are not transitioned again,
+// pass the report Processed keys to resume a bulk transition.
+//
+// The dry run resolves the transitions without transitioning the issues, the rate limited requests are retried.
+//
+// A positive concurrency pins the requests in flight, otherwise it starts at 5 and adapts to the rate limit of the site.
+//
+// TODO: the documentation needs to be created
+func (i *IssueADFService) BulkMove(ctx context.Context, jql, transitionNameOrID string, fields map[string]interface{}, concurrency int, dryRun bool,
+	processedKeys []string) (*model.IssueBulkMoveReportScheme, *model.ResponseScheme, error) {
+	return i.internalClient.BulkMove(ctx, jql, transitionNameOrID, fields, concurrency, dryRun, processedKeys)
+}
+
+// TimeInStatus returns the status intervals of an issue, rebuilt from the issue creation date and its changelog,
+// and the total duration of every status.
+//
+// The initial status is the origin of the first status change, the last interval is open until now,
+// or until the resolution date when the options StopAtResolution is set.
+//
+// The intervals are measured on the business hours calendar of the options, the wall-clock time is used by default.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/changelog
+//
+// TODO: the documentation needs to be created
+func (i *IssueADFService) TimeInStatus(ctx context.Context, issueKeyOrId string, options *model.TimeInStatusOptionsScheme) (*model.IssueTimeInStatusScheme, *model.ResponseScheme, error) {
+	return i.internalClient.TimeInStatus(ctx, issueKeyOrId, options)
+}
+
+// Archive archives up to 1000 issues in a single call, the issues not archived are listed on the errors of the result.
+//
+// PUT /rest/api/{2-3}/issue/archive
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssueADFService) Archive(ctx context.Context, issueKeysOrIDs []string) (*model.IssueArchivalSyncResponseScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Archive(ctx, issueKeysOrIDs)
+}
+
+// ArchiveByJQL starts the archival of the issues returned by the JQL query, the archival is asynchronous,
+// the returned string is the URL of the task tracking its progress.
+//
+// POST /rest/api/{2-3}/issue/archive
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssueADFService) ArchiveByJQL(ctx context.Context, jql string) (string, *model.ResponseScheme, error) {
+	return i.internalClient.ArchiveByJQL(ctx, jql)
+}
+
+// Unarchive restores up to 1000 archived issues in a single call, the issues not restored are listed on the errors of the result.
+//
+// PUT /rest/api/{2-3}/issue/unarchive
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssueADFService) Unarchive(ctx context.Context, issueKeysOrIDs []string) (*model.IssueArchivalSyncResponseScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Unarchive(ctx, issueKeysOrIDs)
+}
+
+// ExportArchived starts the export of the archived issues matching the filters of the payload, the export
+// is asynchronous and its file is emailed to the user who started it.
+//
+// PUT /rest/api/{2-3}/issues/archive/export
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssueADFService) ExportArchived(ctx context.Context, payload *model.IssueArchivalExportPayloadScheme) (*model.IssueArchivalExportScheme, *model.ResponseScheme, error) {
+	return i.internalClient.ExportArchived(ctx, payload)
 }
 
 // Create creates an issue or, where the option to create subtasks is enabled in Jira, a subtask.
@@ -95,12 +183,28 @@ func (i *IssueADFService) Create(ctx context.Context, payload *model.IssueScheme
 	return i.internalClient.Create(ctx, payload, customFields)
 }
 
+// CreateComplete creates an issue and adds the extras in order: attachments, links, watchers and the initial comment.
+//
+// The new issue is never rolled back, the extras that can't be added are reported on the result failures.
+//
+// The cancellation of the context is checked between the extras, the result of the extras added is returned with the error.
+//
+// TODO: the documentation needs to be created
+func (i *IssueADFService) CreateComplete(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields, extras *model.IssueCreateExtrasScheme) (
+	*model.IssueCreateCompleteResultScheme, *model.ResponseScheme, error) {
+	return i.internalClient.CreateComplete(ctx, payload, customFields, extras)
+}
+
 // Creates issues and, where the option to create subtasks is enabled in Jira, subtasks.
 //
 // 1.Creates upto 50 issues and, where the option to create subtasks is enabled in Jira, subtasks.
 //
 // 2.Transitions may be applied, to move the issues or subtasks to a workflow step other than the default start step, and issue properties set.
 //
+// The issues are created in batches of 50, the failed element numbers of the errors are the positions of the issues on the payload.
+//
+// A failed batch stops the creation, the issues created by the previous batches are returned with the error.
+//
 // POST /rest/api/{2-3}/issue/bulk
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-create-issue
@@ -129,6 +233,8 @@ func (i *IssueADFService) Get(ctx context.Context, issueKeyOrId string, fields,
 //
 // The edits to the issue's fields are defined using update and fields
 //
+// The users are notified when notify is true and the context doesn't suppress the notifications.
+//
 // PUT /rest/api/{2-3}/issue/{issueIdOrKey}
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues#edit-issue
@@ -147,6 +253,20 @@ func (i *IssueADFService) Move(ctx context.Context, issueKeyOrId, transitionId s
 	return i.internalClient.Move(ctx, issueKeyOrId, transitionId, options)
 }
 
+// BulkFetch returns the details for a set of requested issues.
+//
+// The issues are fetched in batches of up to 100 issues, the results are merged following the order of the requested issues.
+//
+// The issues that could not be fetched are returned on the issue errors.
+//
+// POST /rest/api/{2-3}/issue/bulkfetch
+//
+// TODO: the documentation needs to be created
+func (i *IssueADFService) BulkFetch(ctx context.Context, issueKeysOrIDs, fields, expand, properties []string, fieldsByKeys bool) (*model.IssueBulkFetchSchemeV3,
+	*model.ResponseScheme, error) {
+	return i.internalClient.BulkFetch(ctx, issueKeysOrIDs, fields, expand, properties, fieldsByKeys)
+}
+
 type internalIssueADFServiceImpl struct {
 	c       service.Client
 	version string
@@ -164,8 +284,37 @@ func (i *internalIssueADFServiceImpl) Notify(ctx context.Context, issueKeyOrId s
 	return sendNotification(ctx, i.c, i.version, issueKeyOrId, options)
 }
 
-func (i *internalIssueADFServiceImpl) Transitions(ctx context.Context, issueKeyOrId string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
-	return getTransitions(ctx, i.c, i.version, issueKeyOrId)
+func (i *internalIssueADFServiceImpl) Transitions(ctx context.Context, issueKeyOrId string, expand []string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
+	return getTransitions(ctx, i.c, i.version, issueKeyOrId, expand)
+}
+
+func (i *internalIssueADFServiceImpl) Clone(ctx context.Context, issueKeyOrId string, options *model.IssueCloneOptionsScheme) (*model.IssueCloneResultScheme, *model.ResponseScheme, error) {
+	return cloneIssue(ctx, i.c, i.version, issueKeyOrId, options)
+}
+
+func (i *internalIssueADFServiceImpl) BulkMove(ctx context.Context, jql, transitionNameOrID string, fields map[string]interface{}, concurrency int, dryRun bool,
+	processedKeys []string) (*model.IssueBulkMoveReportScheme, *model.ResponseScheme, error) {
+	return bulkMoveIssues(ctx, i.c, i.version, jql, transitionNameOrID, fields, concurrency, dryRun, processedKeys)
+}
+
+func (i *internalIssueADFServiceImpl) TimeInStatus(ctx context.Context, issueKeyOrId string, options *model.TimeInStatusOptionsScheme) (*model.IssueTimeInStatusScheme, *model.ResponseScheme, error) {
+	return issueTimeInStatus(ctx, i.c, i.version, issueKeyOrId, options)
+}
+
+func (i *internalIssueADFServiceImpl) Archive(ctx context.Context, issueKeysOrIDs []string) (*model.IssueArchivalSyncResponseScheme, *model.ResponseScheme, error) {
+	return archiveIssues(ctx, i.c, i.version, "issue.archive", "issue/archive", issueKeysOrIDs)
+}
+
+func (i *internalIssueADFServiceImpl) ArchiveByJQL(ctx context.Context, jql string) (string, *model.ResponseScheme, error) {
+	return archiveIssuesByJQL(ctx, i.c, i.version, jql)
+}
+
+func (i *internalIssueADFServiceImpl) Unarchive(ctx context.Context, issueKeysOrIDs []string) (*model.IssueArchivalSyncResponseScheme, *model.ResponseScheme, error) {
+	return archiveIssues(ctx, i.c, i.version, "issue.unarchive", "issue/unarchive", issueKeysOrIDs)
+}
+
+func (i *internalIssueADFServiceImpl) ExportArchived(ctx context.Context, payload *model.IssueArchivalExportPayloadScheme) (*model.IssueArchivalExportScheme, *model.ResponseScheme, error) {
+	return exportArchivedIssues(ctx, i.c, i.version, payload)
 }
 
 func (i *internalIssueADFServiceImpl) Create(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
@@ -195,7 +344,7 @@ func (i *internalIssueADFServiceImpl) Create(ctx context.Context, payload *model
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -209,17 +358,43 @@ func (i *internalIssueADFServiceImpl) Create(ctx context.Context, payload *model
 	return issue, response, nil
 }
 
+func (i *internalIssueADFServiceImpl) CreateComplete(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields, extras *model.IssueCreateExtrasScheme) (
+	*model.IssueCreateCompleteResultScheme, *model.ResponseScheme, error) {
+
+	create := func() (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+		return i.Create(ctx, payload, customFields)
+	}
+
+	if extras == nil {
+		return createCompleteIssue(ctx, i.c, i.version, create, nil)
+	}
+
+	steps := &issueExtras{attachments: extras.Attachments, links: extras.Links, watchers: extras.Watchers}
+
+	if extras.Comment != nil {
+		steps.comment = func(ctx context.Context, issueKey string) (*model.ResponseScheme, error) {
+			_, response, err := (&internalAdfCommentImpl{c: i.c, version: i.version}).Add(ctx, issueKey, extras.Comment, nil)
+			return response, err
+		}
+	}
+
+	return createCompleteIssue(ctx, i.c, i.version, create, steps)
+}
+
 func (i *internalIssueADFServiceImpl) Creates(ctx context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
 
 	if len(payload) == 0 {
-		return nil, nil, errors.New("error, please provide a valid []*IssueBulkScheme slice of pointers")
-		// TODO: The errors when the bulk creates does not contains values needs to be parsed and moved to the model package
+		return nil, nil, model.ErrNoIssuesSliceError
 	}
 
-	var issuePayloads []map[string]interface{}
-	for _, newIssue := range payload {
+	var (
+		issuePayloads []map[string]interface{}
+		positions     []int
+	)
 
-		if newIssue.Payload == nil {
+	for position, newIssue := range payload {
+
+		if newIssue == nil || newIssue.Payload == nil {
 			continue
 		}
 
@@ -229,30 +404,10 @@ func (i *internalIssueADFServiceImpl) Creates(ctx context.Context, payload []*mo
 		}
 
 		issuePayloads = append(issuePayloads, issuePayload)
+		positions = append(positions, position)
 	}
 
-	var bulkPayload = map[string]interface{}{}
-	bulkPayload["issueUpdates"] = issuePayloads
-
-	reader, err := i.c.TransformStructToReader(&bulkPayload)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	endpoint := fmt.Sprintf("rest/api/%v/issue/bulk", i.version)
-
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	issues := new(model.IssueBulkResponseScheme)
-	response, err := i.c.Call(request, issues)
-	if err != nil {
-		return nil, response, err
-	}
-
-	return issues, response, nil
+	return createIssuesInBulk(ctx, i.c, i.version, issuePayloads, positions)
 }
 
 func (i *internalIssueADFServiceImpl) Get(ctx context.Context, issueKeyOrId string, fields, expand []string) (*model.IssueScheme, *model.ResponseScheme, error) {
@@ -278,7 +433,7 @@ func (i *internalIssueADFServiceImpl) Get(ctx context.Context, issueKeyOrId stri
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -299,7 +454,7 @@ func (i *internalIssueADFServiceImpl) Update(ctx context.Context, issueKeyOrId s
 	}
 
 	params := url.Values{}
-	params.Add("notifyUsers", fmt.Sprintf("%v", notify))
+	params.Add("notifyUsers", fmt.Sprintf("%v", notifyUsers(ctx, notify)))
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", i.version, issueKeyOrId, params.Encode())
 
 	var reader io.Reader
@@ -331,6 +486,10 @@ func (i *internalIssueADFServiceImpl) Update(ctx context.Context, issueKeyOrId s
 			return nil, err
 		}
 
+		if err := checkUpdateConflicts(payloadUpdated); err != nil {
+			return nil, err
+		}
+
 		reader, err = i.c.TransformStructToReader(&payloadUpdated)
 		if err != nil {
 			return nil, err
@@ -359,13 +518,17 @@ func (i *internalIssueADFServiceImpl) Update(ctx context.Context, issueKeyOrId s
 			return nil, err
 		}
 
+		if err := checkUpdateConflicts(payloadUpdated); err != nil {
+			return nil, err
+		}
+
 		reader, err = i.c.TransformStructToReader(&payloadUpdated)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -386,6 +549,11 @@ func (i *internalIssueADFServiceImpl) Move(ctx context.Context, issueKeyOrId, tr
 	payloadUpdated := make(map[string]interface{})
 	payloadUpdated["transition"] = map[string]interface{}{"id": transitionId}
 
+	// The custom fields and the operations are merged into the fields, e.g. a transition only adding a comment
+	if options != nil && options.Fields == nil && (options.CustomFields != nil || options.Operations != nil) {
+		options = &model.IssueMoveOptionsV3{Fields: &model.IssueScheme{}, CustomFields: options.CustomFields, Operations: options.Operations}
+	}
+
 	var reader io.Reader
 	var err error
 
@@ -453,6 +621,24 @@ func (i *internalIssueADFServiceImpl) Move(ctx context.Context, issueKeyOrId, tr
 				return nil, err
 			}
 		}
+
+		// Executed when only the fields are provided
+		if options.CustomFields == nil && options.Operations == nil {
+
+			payloadWithFields, err := options.Fields.ToMap()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := mergo.Map(&payloadWithFields, &payloadUpdated, mergo.WithOverride); err != nil {
+				return nil, err
+			}
+
+			reader, err = i.c.TransformStructToReader(&payloadWithFields)
+			if err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		reader, err = i.c.TransformStructToReader(&payloadUpdated)
 		if err != nil {
@@ -462,10 +648,54 @@ func (i *internalIssueADFServiceImpl) Move(ctx context.Context, issueKeyOrId, tr
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/transitions", i.version, issueKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.move", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalIssueADFServiceImpl) BulkFetch(ctx context.Context, issueKeysOrIDs, fields, expand, properties []string, fieldsByKeys bool) (*model.IssueBulkFetchSchemeV3,
+	*model.ResponseScheme, error) {
+
+	if len(issueKeysOrIDs) == 0 {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	var (
+		result   = new(model.IssueBulkFetchSchemeV3)
+		response *model.ResponseScheme
+	)
+
+	for _, chunk := range chunkIssueKeysOrIDs(issueKeysOrIDs, issueBulkFetchMaxIssues) {
+
+		request, err := newIssueBulkFetchRequest(ctx, i.c, i.version, &model.IssueBulkFetchPayloadScheme{
+			Expand:         expand,
+			Fields:         fields,
+			FieldsByKeys:   fieldsByKeys,
+			IssueIdsOrKeys: chunk,
+			Properties:     properties,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		page := new(model.IssueBulkFetchSchemeV3)
+		response, err = i.c.Call(request, page)
+		if err != nil {
+			return nil, response, err
+		}
+
+		result.Issues = append(result.Issues, page.Issues...)
+		result.IssueErrors = append(result.IssueErrors, page.IssueErrors...)
+	}
+
+	positions := issueKeysOrIDsPositions(issueKeysOrIDs)
+	sort.SliceStable(result.Issues, func(x, y int) bool {
+		return issueBulkFetchPosition(positions, result.Issues[x].ID, result.Issues[x].Key) <
+			issueBulkFetchPosition(positions, result.Issues[y].ID, result.Issues[y].Key)
+	})
+
+	return result, response, nil
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -359,6 +361,24 @@ func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
 			Err:     model.ErrNoIssueKeyOrIDError,
 		},
 
+		{
+			name:   "when the recipients are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-1",
+				options: &model.IssueNotifyOptionsScheme{
+					Subject: "SUBJECT EMAIL EXAMPLE",
+					To:      &model.IssueNotifyToScheme{},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueNotifyRecipientsError,
+		},
+
 		{
 			name:   "when the request method cannot be created",
 			fields: fields{version: "2"},
@@ -443,6 +463,7 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 	type args struct {
 		ctx          context.Context
 		issueKeyOrId string
+		expand       []string
 	}
 
 	testCases := []struct {
@@ -480,6 +501,34 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 			},
 		},
 
+		{
+			name:   "when the transition fields are expanded",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-1",
+				expand:       []string{"transitions.fields"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1/transitions?expand=transitions.fields",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueTransitionsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
 		{
 			name:   "when the issue issue key or id is not provided",
 			fields: fields{version: "2"},
@@ -529,7 +578,7 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResult, gotResponse, err := issueService.Transitions(testCase.args.ctx, testCase.args.issueKeyOrId)
+			gotResult, gotResponse, err := issueService.Transitions(testCase.args.ctx, testCase.args.issueKeyOrId, testCase.args.expand)
 
 			if testCase.wantErr {
 
@@ -856,7 +905,7 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 				fields.c = mocks.NewClient(t)
 			},
 			wantErr: true,
-			Err:     errors.New("error, please provide a valid []*IssueBulkScheme slice of pointers"),
+			Err:     model.ErrNoIssuesSliceError,
 		},
 
 		{
@@ -1119,6 +1168,11 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	labelOperations := &model.UpdateOperations{}
+	if err := labelOperations.AddLabel("triaged"); err != nil {
+		t.Fatal(err)
+	}
+
 	type fields struct {
 		c       service.Client
 		version string
@@ -1190,6 +1244,78 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 			},
 		},
 
+		{
+			name:   "when only the fields are provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-1",
+				transitionId: "10001",
+				options:      &model.IssueMoveOptionsV2{Fields: &model.IssueSchemeV2{Fields: &model.IssueFieldsSchemeV2{Assignee: &model.UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"}}}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&map[string]interface{}{
+						"fields": map[string]interface{}{
+							"assignee": map[string]interface{}{"accountId": "5b10ac8d82e05b22cc7d4ef5"}},
+						"transition": map[string]interface{}{"id": "10001"}}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when only the operations are provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-1",
+				transitionId: "10001",
+				options:      &model.IssueMoveOptionsV2{Operations: labelOperations},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&map[string]interface{}{
+						"update": map[string]interface{}{
+							"labels": []map[string]interface{}{{"add": "triaged"}}},
+						"transition": map[string]interface{}{"id": "10001"}}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
 		{
 			name:   "when the operations are not provided",
 			fields: fields{version: "2"},
@@ -1546,6 +1672,28 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 			},
 		},
 
+		{
+			name:   "when the same field is set on the fields and the update operations",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-1",
+				notify:       true,
+				payload: &model.IssueSchemeV2{
+					Fields: &model.IssueFieldsSchemeV2{
+						Summary: "New summary test",
+						Labels:  []string{"backend"},
+					},
+				},
+				operations: operations,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     fmt.Errorf("%w: labels", model.ErrIssueUpdateConflictError),
+		},
+
 		{
 			name:   "when the issue key or id is not provided",
 			fields: fields{version: "2"},
@@ -1770,3 +1918,200 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalRichTextServiceImpl_BulkFetch(t *testing.T) {
+
+	var issueKeys []string
+	for index := 1; index <= 150; index++ {
+		issueKeys = append(issueKeys, fmt.Sprintf("KP-%v", index))
+	}
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                        context.Context
+		issueKeysOrIDs             []string
+		fields, expand, properties []string
+		fieldsByKeys               bool
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueBulkFetchSchemeV2
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1", "10002", "KP-3"},
+				fields:         []string{"summary", "status"},
+				expand:         []string{"names"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.IssueBulkFetchPayloadScheme{
+						Expand:         []string{"names"},
+						Fields:         []string{"summary", "status"},
+						IssueIdsOrKeys: []string{"KP-1", "10002", "KP-3"},
+					}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/bulkfetch",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueBulkFetchSchemeV2{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueBulkFetchSchemeV2)
+						page.Issues = []*model.IssueSchemeV2{{ID: "10002", Key: "KP-2"}, {ID: "10001", Key: "KP-1"}}
+						page.IssueErrors = []*model.IssueBulkFetchErrorScheme{{ID: "KP-3", ErrorMessage: "Issue does not exist"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueBulkFetchSchemeV2{
+				Issues:      []*model.IssueSchemeV2{{ID: "10001", Key: "KP-1"}, {ID: "10002", Key: "KP-2"}},
+				IssueErrors: []*model.IssueBulkFetchErrorScheme{{ID: "KP-3", ErrorMessage: "Issue does not exist"}},
+			},
+		},
+
+		{
+			name:   "when more than 100 issues are requested",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: issueKeys,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.IssueBulkFetchPayloadScheme{IssueIdsOrKeys: issueKeys[:100]}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("TransformStructToReader",
+					&model.IssueBulkFetchPayloadScheme{IssueIdsOrKeys: issueKeys[100:]}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/bulkfetch",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueBulkFetchSchemeV2{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueBulkFetchSchemeV2)
+						page.Issues = []*model.IssueSchemeV2{{ID: "10150", Key: "KP-150"}}
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueBulkFetchSchemeV2{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueBulkFetchSchemeV2)
+						page.Issues = []*model.IssueSchemeV2{{ID: "10001", Key: "KP-1"}}
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				fields.c = client
+			},
+			want: &model.IssueBulkFetchSchemeV2{
+				Issues: []*model.IssueSchemeV2{{ID: "10001", Key: "KP-1"}, {ID: "10150", Key: "KP-150"}},
+			},
+		},
+
+		{
+			name:   "when the issue keys or ids are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.IssueBulkFetchPayloadScheme{IssueIdsOrKeys: []string{"KP-1"}}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/bulkfetch",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.BulkFetch(testCase.args.ctx, testCase.args.issueKeysOrIDs, testCase.args.fields,
+				testCase.args.expand, testCase.args.properties, testCase.args.fieldsByKeys)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
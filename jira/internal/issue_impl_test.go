@@ -1,9 +1,16 @@
 package internal
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
 	"testing"
 )
 
@@ -60,3 +67,116 @@ func TestNewIssueService(t *testing.T) {
 		})
 	}
 }
+
+func Test_createIssuesInBulk(t *testing.T) {
+
+	customFields := &model.CustomFields{}
+	if err := customFields.Number("customfield_10042", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	// 52 issues with a nil payload on the second position are sent on a batch of 50 and a batch of 1
+	payload := make([]*model.IssueBulkSchemeV3, 52)
+	for index := range payload {
+
+		if index == 1 {
+			continue
+		}
+
+		payload[index] = &model.IssueBulkSchemeV3{
+			Payload:      &model.IssueScheme{Fields: &model.IssueFieldsScheme{Summary: fmt.Sprintf("Issue %v", index)}},
+			CustomFields: customFields,
+		}
+	}
+
+	client := mocks.NewClient(t)
+
+	// The first batch is rejected with a 400 status code, the element errors are only on the response body
+	for _, batch := range []struct {
+		size     int
+		first    string
+		results  string
+		rejected bool
+	}{
+		{size: 50, first: "Issue 0", rejected: true, results: `{"issues":[],
+			"errors":[{"status":400,"failedElementNumber":1,"elementErrors":{"errorMessages":["summary is too long"]}}]}`},
+		{size: 1, first: "Issue 51", results: `{"issues":[{"id":"10051","key":"KP-51"}],
+			"errors":[{"status":400,"failedElementNumber":0}]}`},
+	} {
+
+		batch := batch
+		request := &http.Request{RequestURI: batch.first}
+
+		client.On("TransformStructToReader",
+			mock.MatchedBy(func(bulkPayload *map[string]interface{}) bool {
+				issues := (*bulkPayload)["issueUpdates"].([]map[string]interface{})
+				return len(issues) == batch.size && issues[0]["fields"].(map[string]interface{})["summary"] == batch.first
+			})).
+			Return(bytes.NewReader([]byte(batch.first)), nil).Once()
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/bulk",
+			bytes.NewReader([]byte(batch.first))).
+			Return(request, nil).Once()
+
+		if batch.rejected {
+
+			response := &model.ResponseScheme{Code: http.StatusBadRequest}
+			response.Bytes.WriteString(batch.results)
+
+			client.On("Call",
+				request,
+				mock.Anything).
+				Return(response, model.ErrInvalidStatusCodeError).Once()
+
+			continue
+		}
+
+		client.On("Call",
+			request,
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				if err := json.Unmarshal([]byte(batch.results), args.Get(1)); err != nil {
+					panic(err)
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+	}
+
+	_, issueService, err := NewIssueService(client, "3", nil)
+	assert.NoError(t, err)
+
+	result, _, err := issueService.Creates(context.Background(), payload)
+	assert.NoError(t, err)
+
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, "KP-51", result.Issues[0].Key)
+
+	// The failed element numbers are the positions on the payload, the nil payload is skipped
+	assert.Len(t, result.Errors, 2)
+	assert.Equal(t, 2, result.Errors[0].FailedElementNumber)
+	assert.Equal(t, []string{"summary is too long"}, result.Errors[0].ElementErrors.ErrorMessages)
+	assert.Equal(t, 51, result.Errors[1].FailedElementNumber)
+}
+
+func Test_decodeFailedIssueBatch(t *testing.T) {
+
+	rejected := &model.ResponseScheme{Code: http.StatusBadRequest}
+	rejected.Bytes.WriteString(`{"errors":[{"status":400,"failedElementNumber":0}]}`)
+
+	withoutErrors := &model.ResponseScheme{Code: http.StatusBadRequest}
+	withoutErrors.Bytes.WriteString(`{"errorMessages":["the request is not valid"]}`)
+
+	unauthorized := &model.ResponseScheme{Code: http.StatusUnauthorized}
+	unauthorized.Bytes.WriteString(`{"errors":[{"status":400,"failedElementNumber":0}]}`)
+
+	batch := new(model.IssueBulkResponseScheme)
+	assert.True(t, decodeFailedIssueBatch(rejected, batch))
+	assert.Len(t, batch.Errors, 1)
+
+	assert.False(t, decodeFailedIssueBatch(withoutErrors, new(model.IssueBulkResponseScheme)))
+	assert.False(t, decodeFailedIssueBatch(unauthorized, new(model.IssueBulkResponseScheme)))
+	assert.False(t, decodeFailedIssueBatch(nil, new(model.IssueBulkResponseScheme)))
+}
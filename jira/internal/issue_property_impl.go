@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+)
+
+func NewIssuePropertyService(client service.Client, version string) (*IssuePropertyService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &IssuePropertyService{
+		internalClient: &internalIssuePropertyImpl{c: client, version: version},
+	}, nil
+}
+
+type IssuePropertyService struct {
+	internalClient jira.IssuePropertyConnector
+}
+
+// Keys returns the keys of the properties of an issue.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/properties
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssuePropertyService) Keys(ctx context.Context, issueKeyOrId string) (*model.IssuePropertyKeysScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Keys(ctx, issueKeyOrId)
+}
+
+// Get returns the key and the raw value of an issue property.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/properties/{propertyKey}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssuePropertyService) Get(ctx context.Context, issueKeyOrId, propertyKey string) (*model.IssuePropertyScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Get(ctx, issueKeyOrId, propertyKey)
+}
+
+// Set sets the value of an issue property, the value can be any value marshalable to JSON, e.g. a json.RawMessage.
+//
+// The value must be a valid, non-empty JSON blob. The maximum length is 32768 characters.
+//
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/properties/{propertyKey}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssuePropertyService) Set(ctx context.Context, issueKeyOrId, propertyKey string, value interface{}) (*model.ResponseScheme, error) {
+	return i.internalClient.Set(ctx, issueKeyOrId, propertyKey, value)
+}
+
+// Delete deletes an issue property.
+//
+// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/properties/{propertyKey}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssuePropertyService) Delete(ctx context.Context, issueKeyOrId, propertyKey string) (*model.ResponseScheme, error) {
+	return i.internalClient.Delete(ctx, issueKeyOrId, propertyKey)
+}
+
+// BulkSet sets the value of a property on the issues selected by the filter.
+//
+// The update is an asynchronous task, the response redirects to the task.
+//
+// PUT /rest/api/{2-3}/issue/properties/{propertyKey}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (i *IssuePropertyService) BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkPayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.BulkSet(ctx, propertyKey, payload)
+}
+
+type internalIssuePropertyImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalIssuePropertyImpl) Keys(ctx context.Context, issueKeyOrId string) (*model.IssuePropertyKeysScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/properties", i.version, issueKeyOrId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.property.keys", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(model.IssuePropertyKeysScheme)
+	response, err := i.c.Call(request, keys)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return keys, response, nil
+}
+
+func (i *internalIssuePropertyImpl) Get(ctx context.Context, issueKeyOrId, propertyKey string) (*model.IssuePropertyScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if propertyKey == "" {
+		return nil, nil, model.ErrNoPropertyKeyError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/properties/%v", i.version, issueKeyOrId, propertyKey)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.property.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(model.IssuePropertyScheme)
+	response, err := i.c.Call(request, property)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return property, response, nil
+}
+
+func (i *internalIssuePropertyImpl) Set(ctx context.Context, issueKeyOrId, propertyKey string, value interface{}) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if propertyKey == "" {
+		return nil, model.ErrNoPropertyKeyError
+	}
+
+	reader, err := i.c.TransformStructToReader(value)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/properties/%v", i.version, issueKeyOrId, propertyKey)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.property.set", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssuePropertyImpl) Delete(ctx context.Context, issueKeyOrId, propertyKey string) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if propertyKey == "" {
+		return nil, model.ErrNoPropertyKeyError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/properties/%v", i.version, issueKeyOrId, propertyKey)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.property.delete", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssuePropertyImpl) BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkPayloadScheme) (*model.ResponseScheme, error) {
+
+	if propertyKey == "" {
+		return nil, model.ErrNoPropertyKeyError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/properties/%v", i.version, propertyKey)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.property.bulkSet", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
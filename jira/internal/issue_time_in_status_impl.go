@@ -0,0 +1,213 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// issueChangelogPageSize is the maximum number of changelogs returned by the changelog endpoint
+const issueChangelogPageSize = 100
+
+// issueTimeInStatus reconstructs the status intervals of an issue from its creation date and the status changes of its changelog.
+func issueTimeInStatus(ctx context.Context, client service.Client, version, issueKeyOrId string, options *model.TimeInStatusOptionsScheme) (
+	*model.IssueTimeInStatusScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if options == nil {
+		options = &model.TimeInStatusOptionsScheme{}
+	}
+
+	issue, response, err := getTimeInStatusIssue(ctx, client, version, issueKeyOrId)
+	if err != nil {
+		return nil, response, err
+	}
+
+	histories, response, err := getIssueChangelogs(ctx, client, version, issueKeyOrId)
+	if err != nil {
+		return nil, response, err
+	}
+
+	createdAt, err := time.Parse(model.DateFormatJira, issue.Fields.Created)
+	if err != nil {
+		return nil, response, err
+	}
+
+	end, resolved := options.Now, false
+	if end.IsZero() {
+		end = time.Now()
+	}
+
+	if options.StopAtResolution && issue.Fields.Resolutiondate != "" {
+
+		resolvedAt, err := time.Parse(model.DateFormatJira, issue.Fields.Resolutiondate)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if resolvedAt.Before(end) {
+			end, resolved = resolvedAt, true
+		}
+	}
+
+	changes, err := issueStatusChanges(histories)
+	if err != nil {
+		return nil, response, err
+	}
+
+	// The initial status is the origin of the first status change, the issues never transitioned keep their current status
+	statusID, status := issue.Fields.Status.ID, issue.Fields.Status.Name
+	if len(changes) != 0 {
+		statusID, status = changes[0].item.From, changes[0].item.FromString
+	}
+
+	result := &model.IssueTimeInStatusScheme{IssueKey: issue.Key, Totals: make(map[string]time.Duration)}
+
+	addInterval := func(from, to time.Time, open bool) {
+
+		if to.Before(from) {
+			to = from
+		}
+
+		interval := &model.IssueStatusIntervalScheme{
+			StatusID: statusID,
+			Status:   status,
+			From:     from,
+			To:       to,
+			Duration: options.BusinessHours.Duration(from, to),
+			Open:     open,
+		}
+
+		result.Intervals = append(result.Intervals, interval)
+		result.Totals[status] += interval.Duration
+	}
+
+	from := createdAt
+	for _, change := range changes {
+
+		// The changes after the end of the open interval, e.g. after the resolution, are not measured
+		if change.at.After(end) {
+			break
+		}
+
+		addInterval(from, change.at, false)
+
+		// The issues moved between workflows record the status mapping as a regular status change,
+		// the destination status of every change is used so the intervals stay contiguous.
+		statusID, status, from = change.item.To, change.item.ToString, change.at
+	}
+
+	// The interval cut at the resolution date is closed, the issue stays on the status but it's not measured
+	addInterval(from, end, !resolved)
+
+	return result, response, nil
+}
+
+type issueStatusChange struct {
+	at   time.Time
+	item *model.IssueChangelogHistoryItemScheme
+}
+
+// issueStatusChanges returns the status changes of the changelog sorted by date.
+func issueStatusChanges(histories []*model.IssueChangelogHistoryScheme) ([]*issueStatusChange, error) {
+
+	var changes []*issueStatusChange
+	for _, history := range histories {
+
+		for _, item := range history.Items {
+
+			if item.FieldID != "status" && (item.FieldID != "" || item.Field != "status") {
+				continue
+			}
+
+			at, err := time.Parse(model.DateFormatJira, history.Created)
+			if err != nil {
+				return nil, err
+			}
+
+			changes = append(changes, &issueStatusChange{at: at, item: item})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].at.Before(changes[j].at)
+	})
+
+	return changes, nil
+}
+
+type timeInStatusIssueScheme struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Created        string              `json:"created"`
+		Resolutiondate string              `json:"resolutiondate"`
+		Status         *model.StatusScheme `json:"status"`
+	} `json:"fields"`
+}
+
+func getTimeInStatusIssue(ctx context.Context, client service.Client, version, issueKeyOrId string) (*timeInStatusIssueScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("fields", "created,resolutiondate,status")
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", version, issueKeyOrId, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, client, "issue.timeInStatus", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(timeInStatusIssueScheme)
+	response, err := client.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if issue.Fields.Status == nil {
+		issue.Fields.Status = &model.StatusScheme{}
+	}
+
+	return issue, response, nil
+}
+
+// getIssueChangelogs returns the full changelog of an issue.
+func getIssueChangelogs(ctx context.Context, client service.Client, version, issueKeyOrId string) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error) {
+
+	var histories []*model.IssueChangelogHistoryScheme
+
+	for startAt := 0; ; {
+
+		params := url.Values{}
+		params.Add("startAt", strconv.Itoa(startAt))
+		params.Add("maxResults", strconv.Itoa(issueChangelogPageSize))
+
+		endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog?%v", version, issueKeyOrId, params.Encode())
+
+		request, err := service.NewOperationRequest(ctx, client, "issue.timeInStatus", http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		page := new(model.IssueChangelogPageScheme)
+		response, err := client.Call(request, page)
+		if err != nil {
+			return nil, response, err
+		}
+
+		histories = append(histories, page.Values...)
+		startAt += len(page.Values)
+
+		if len(page.Values) == 0 || page.IsLast || startAt >= page.Total {
+			return histories, response, nil
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// mockTimeInStatusIssue mocks the KP-1 issue, created on Monday 2023-01-02 at 09:00 UTC, and its changelog pages
+func mockTimeInStatusIssue(client *mocks.Client, status *model.StatusScheme, resolutionDate string, pages ...*model.IssueChangelogPageScheme) {
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/issue/KP-1?fields=created%2Cresolutiondate%2Cstatus",
+		nil).
+		Return(&http.Request{RequestURI: "issue"}, nil)
+
+	client.On("Call",
+		&http.Request{RequestURI: "issue"},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+
+			issue := args.Get(1).(*timeInStatusIssueScheme)
+			issue.Key = "KP-1"
+			issue.Fields.Created = "2023-01-02T09:00:00.000+0000"
+			issue.Fields.Resolutiondate = resolutionDate
+			issue.Fields.Status = status
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	startAt := 0
+	for position, page := range pages {
+
+		page := page
+		request := &http.Request{RequestURI: "changelog-" + strconv.Itoa(position)}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/KP-1/changelog?maxResults=100&startAt="+strconv.Itoa(startAt),
+			nil).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			&model.IssueChangelogPageScheme{}).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.IssueChangelogPageScheme) = *page
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		startAt += len(page.Values)
+	}
+}
+
+func Test_issueTimeInStatus(t *testing.T) {
+
+	done := &model.StatusScheme{ID: "10001", Name: "Done"}
+
+	statusItem := func(from, fromString, to, toString string) *model.IssueChangelogHistoryItemScheme {
+		return &model.IssueChangelogHistoryItemScheme{Field: "status", Fieldtype: "jira", FieldID: "status", From: from, FromString: fromString, To: to, ToString: toString}
+	}
+
+	// The issue is moved from the KP project to the OPS project on Tuesday, the In Progress status is mapped to In Review
+	changelog := []*model.IssueChangelogPageScheme{
+		{
+			PageMeta: model.PageMeta{Total: 3},
+			Values: []*model.IssueChangelogHistoryScheme{
+				{Created: "2023-01-02T10:00:00.000+0000", Items: []*model.IssueChangelogHistoryItemScheme{
+					statusItem("1", "To Do", "3", "In Progress"),
+					{Field: "assignee", Fieldtype: "jira", FieldID: "assignee", To: "account-id"},
+				}},
+				{Created: "2023-01-03T10:00:00.000+0000", Items: []*model.IssueChangelogHistoryItemScheme{
+					{Field: "project", Fieldtype: "jira", FieldID: "project", From: "10000", FromString: "KP", To: "10001", ToString: "OPS"},
+					{Field: "Key", Fieldtype: "jira", FromString: "KP-1", ToString: "OPS-7"},
+					{Field: "Workflow", Fieldtype: "jira", FromString: "KP workflow", ToString: "OPS workflow"},
+					statusItem("3", "In Progress", "10100", "In Review"),
+				}},
+			},
+		},
+		{
+			PageMeta: model.PageMeta{StartAt: 2, Total: 3},
+			Values: []*model.IssueChangelogHistoryScheme{
+				{Created: "2023-01-04T10:00:00.000+0000", Items: []*model.IssueChangelogHistoryItemScheme{
+					statusItem("10100", "In Review", "10001", "Done"),
+				}},
+			},
+		},
+	}
+
+	date := func(day, hour int) time.Time {
+		return time.Date(2023, 1, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("when the issue is moved between workflows", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockTimeInStatusIssue(client, done, "", changelog...)
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		result, _, err := issueService.TimeInStatus(context.Background(), "KP-1", &model.TimeInStatusOptionsScheme{Now: date(5, 10)})
+		assert.NoError(t, err)
+
+		expected := []*model.IssueStatusIntervalScheme{
+			{StatusID: "1", Status: "To Do", From: date(2, 9), To: date(2, 10), Duration: time.Hour},
+			{StatusID: "3", Status: "In Progress", From: date(2, 10), To: date(3, 10), Duration: 24 * time.Hour},
+			{StatusID: "10100", Status: "In Review", From: date(3, 10), To: date(4, 10), Duration: 24 * time.Hour},
+			{StatusID: "10001", Status: "Done", From: date(4, 10), To: date(5, 10), Duration: 24 * time.Hour, Open: true},
+		}
+
+		assert.Len(t, result.Intervals, len(expected))
+		for position, interval := range result.Intervals {
+
+			assert.Equal(t, expected[position].StatusID, interval.StatusID)
+			assert.Equal(t, expected[position].Status, interval.Status)
+			assert.True(t, expected[position].From.Equal(interval.From))
+			assert.True(t, expected[position].To.Equal(interval.To))
+			assert.Equal(t, expected[position].Duration, interval.Duration)
+			assert.Equal(t, expected[position].Open, interval.Open)
+		}
+
+		assert.Equal(t, map[string]time.Duration{
+			"To Do":       time.Hour,
+			"In Progress": 24 * time.Hour,
+			"In Review":   24 * time.Hour,
+			"Done":        24 * time.Hour,
+		}, result.Totals)
+	})
+
+	t.Run("when the intervals are measured on business hours until the resolution", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockTimeInStatusIssue(client, done, "2023-01-04T12:00:00.000+0000", changelog...)
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		result, _, err := issueService.TimeInStatus(context.Background(), "KP-1", &model.TimeInStatusOptionsScheme{
+			BusinessHours:    &model.BusinessHoursScheme{Start: 9 * time.Hour, End: 17 * time.Hour},
+			StopAtResolution: true,
+			Now:              date(5, 10),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[string]time.Duration{
+			"To Do":       time.Hour,
+			"In Progress": 8 * time.Hour,
+			"In Review":   8 * time.Hour,
+			"Done":        2 * time.Hour,
+		}, result.Totals)
+
+		// The last interval is cut at the resolution date, so it's closed
+		last := result.Intervals[len(result.Intervals)-1]
+		assert.Equal(t, "Done", last.Status)
+		assert.False(t, last.Open)
+	})
+
+	t.Run("when the issue was never transitioned", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockTimeInStatusIssue(client, &model.StatusScheme{ID: "1", Name: "To Do"}, "", &model.IssueChangelogPageScheme{})
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		result, _, err := issueService.TimeInStatus(context.Background(), "KP-1", &model.TimeInStatusOptionsScheme{Now: date(3, 9)})
+		assert.NoError(t, err)
+
+		assert.Len(t, result.Intervals, 1)
+		assert.Equal(t, map[string]time.Duration{"To Do": 24 * time.Hour}, result.Totals)
+	})
+
+	t.Run("when the changelog request fails", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockTimeInStatusIssue(client, done, "")
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/KP-1/changelog?maxResults=100&startAt=0",
+			nil).
+			Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+		_, issueService, err := NewIssueService(client, "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = issueService.TimeInStatus(context.Background(), "KP-1", nil)
+		assert.EqualError(t, err, "error, unable to create the http request")
+	})
+
+	t.Run("when the issue key is not provided", func(t *testing.T) {
+
+		_, issueService, err := NewIssueService(nil, "3", nil)
+		assert.NoError(t, err)
+
+		_, _, err = issueService.TimeInStatus(context.Background(), "", nil)
+		assert.Equal(t, model.ErrNoIssueKeyOrIDError, err)
+	})
+}
@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"sync"
+)
+
+// issueUserMigrator migrates one issue from the source account to the target account,
+// the requests are sent through the controller shared by the workers.
+type issueUserMigrator func(ctx context.Context, controller *throttle.Controller, issueKey string) *model.IssueUserMigrationResultScheme
+
+// migrateIssueUser migrates the issues matching the clause for the source account within the JQL scope.
+func migrateIssueUser(ctx context.Context, search jira.SearchADFConnector, clause, fromAccountID, toAccountID, jqlScope string,
+	concurrency int, migrator issueUserMigrator) (*model.IssueUserMigrationReportScheme, *model.ResponseScheme, error) {
+
+	if fromAccountID == "" || toAccountID == "" {
+		return nil, nil, model.ErrNoAccountIDError
+	}
+
+	if fromAccountID == toAccountID {
+		return nil, nil, model.ErrSameAccountIDError
+	}
+
+	controller := newBulkController(concurrency)
+
+	jql := fmt.Sprintf("%v = %q", clause, fromAccountID)
+	if jqlScope != "" {
+		jql = fmt.Sprintf("%v AND (%v)", jql, jqlScope)
+	}
+
+	issueKeys, response, err := searchIssueKeys(ctx, search, jql)
+	if err != nil {
+		return nil, response, err
+	}
+
+	report := &model.IssueUserMigrationReportScheme{Issues: make([]*model.IssueUserMigrationResultScheme, len(issueKeys))}
+	positions := make(chan int)
+
+	var workers sync.WaitGroup
+	for worker := 0; worker < controller.Max(); worker++ {
+
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+
+			for position := range positions {
+				report.Issues[position] = migrator(ctx, controller, issueKeys[position])
+			}
+		}()
+	}
+
+feed:
+	for position := range issueKeys {
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			break feed
+		case positions <- position:
+		}
+	}
+
+	close(positions)
+	workers.Wait()
+
+	for position, result := range report.Issues {
+
+		// The issues not sent to the workers before the context was cancelled
+		if result == nil {
+			report.Issues[position] = &model.IssueUserMigrationResultScheme{IssueKey: issueKeys[position], Err: ctx.Err()}
+			continue
+		}
+
+		if result.Err == nil {
+			report.Processed = append(report.Processed, result.IssueKey)
+		}
+	}
+
+	return report, response, ctx.Err()
+}
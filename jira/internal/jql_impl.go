@@ -11,6 +11,9 @@ import (
 	"strings"
 )
 
+// jqlPersonalDataMaxQueries is the maximum number of queries accepted by the personal data cleaner endpoint
+const jqlPersonalDataMaxQueries = 100
+
 func NewJQLService(client service.Client, version string) (*JQLService, error) {
 
 	if version == "" {
@@ -37,6 +40,29 @@ func (j *JQLService) Parse(ctx context.Context, validationType string, JqlQuerie
 	return j.internalClient.Parse(ctx, validationType, JqlQueries)
 }
 
+// Sanitize sanitizes the JQL queries, the values the user can't see are replaced by their ids, e.g. a project name.
+//
+// The queries are sanitized in context of their account id, or of the current user when it's not set.
+//
+// POST /rest/api/{2-3}/jql/sanitize
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (j *JQLService) Sanitize(ctx context.Context, queries []*model.JQLQueryToSanitizeScheme) (*model.JQLSanitizedQueriesScheme, *model.ResponseScheme, error) {
+	return j.internalClient.Sanitize(ctx, queries)
+}
+
+// ConvertUserIdentifiers converts the user names and the user keys of the JQL queries to account ids.
+//
+// The queries are sent in batches of 100 queries, the limit of the endpoint, and the converted queries are merged.
+// When a batch fails, the queries converted by the previous batches are returned with the error.
+//
+// POST /rest/api/{2-3}/jql/pdcleaner
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (j *JQLService) ConvertUserIdentifiers(ctx context.Context, queries []string) (*model.JQLConvertedQueriesScheme, *model.ResponseScheme, error) {
+	return j.internalClient.ConvertUserIdentifiers(ctx, queries)
+}
+
 type internalJQLServiceImpl struct {
 	c       service.Client
 	version string
@@ -65,7 +91,7 @@ func (i *internalJQLServiceImpl) Parse(ctx context.Context, validationType strin
 		return nil, nil, err
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "jql.parse", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -78,3 +104,96 @@ func (i *internalJQLServiceImpl) Parse(ctx context.Context, validationType strin
 
 	return page, response, nil
 }
+
+func (i *internalJQLServiceImpl) Sanitize(ctx context.Context, queries []*model.JQLQueryToSanitizeScheme) (*model.JQLSanitizedQueriesScheme, *model.ResponseScheme, error) {
+
+	if len(queries) == 0 {
+		return nil, nil, model.ErrNoJQLError
+	}
+
+	payload := struct {
+		Queries []*model.JQLQueryToSanitizeScheme `json:"queries"`
+	}{
+		Queries: queries,
+	}
+
+	reader, err := i.c.TransformStructToReader(&payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("/rest/api/%v/jql/sanitize", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "jql.sanitize", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sanitized := new(model.JQLSanitizedQueriesScheme)
+	response, err := i.c.Call(request, sanitized)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return sanitized, response, nil
+}
+
+func (i *internalJQLServiceImpl) ConvertUserIdentifiers(ctx context.Context, queries []string) (*model.JQLConvertedQueriesScheme, *model.ResponseScheme, error) {
+
+	if len(queries) == 0 {
+		return nil, nil, model.ErrNoJQLError
+	}
+
+	var (
+		converted = new(model.JQLConvertedQueriesScheme)
+		response  *model.ResponseScheme
+	)
+
+	for start := 0; start < len(queries); start += jqlPersonalDataMaxQueries {
+
+		end := start + jqlPersonalDataMaxQueries
+		if end > len(queries) {
+			end = len(queries)
+		}
+
+		batch, batchResponse, err := i.convertUserIdentifiers(ctx, queries[start:end])
+		if err != nil {
+			return converted, batchResponse, err
+		}
+
+		response = batchResponse
+		converted.QueryStrings = append(converted.QueryStrings, batch.QueryStrings...)
+		converted.QueriesWithUnknownUsers = append(converted.QueriesWithUnknownUsers, batch.QueriesWithUnknownUsers...)
+	}
+
+	return converted, response, nil
+}
+
+func (i *internalJQLServiceImpl) convertUserIdentifiers(ctx context.Context, queries []string) (*model.JQLConvertedQueriesScheme, *model.ResponseScheme, error) {
+
+	payload := struct {
+		QueryStrings []string `json:"queryStrings"`
+	}{
+		QueryStrings: queries,
+	}
+
+	reader, err := i.c.TransformStructToReader(&payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("/rest/api/%v/jql/pdcleaner", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "jql.convertUserIdentifiers", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	converted := new(model.JQLConvertedQueriesScheme)
+	response, err := i.c.Call(request, converted)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return converted, response, nil
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -188,6 +190,279 @@ func Test_internalJQLServiceImpl_Parse(t *testing.T) {
 	}
 }
 
+func Test_internalJQLServiceImpl_Sanitize(t *testing.T) {
+
+	queries := []*model.JQLQueryToSanitizeScheme{
+		{Query: "project = 'Sample project'"},
+		{Query: "project = 'Sample project'", AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+	}
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		queries []*model.JQLQueryToSanitizeScheme
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:    "when the api version is v3",
+			fields:  fields{version: "3"},
+			queries: queries,
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&struct {
+						Queries []*model.JQLQueryToSanitizeScheme `json:"queries"`
+					}{Queries: queries}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"/rest/api/3/jql/sanitize",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.JQLSanitizedQueriesScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.JQLSanitizedQueriesScheme).Queries = []*model.JQLSanitizedQueryScheme{
+							{InitialQuery: "project = 'Sample project'", SanitizedQuery: "project = 12345"},
+							{
+								InitialQuery: "project = 'Sample project'",
+								AccountID:    "5b10ac8d82e05b22cc7d4ef5",
+								Errors: &model.JQLSanitizeErrorsScheme{
+									ErrorMessages: []string{"The value 'Sample project' does not exist for the field 'project'."},
+								},
+							},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:    "when the http call cannot be executed",
+			fields:  fields{version: "2"},
+			queries: queries,
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.Anything).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"/rest/api/2/jql/sanitize",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.JQLSanitizedQueriesScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, unable to connect with the Atlassian instance"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to connect with the Atlassian instance"),
+		},
+
+		{
+			name:    "when the queries are not provided",
+			fields:  fields{version: "3"},
+			wantErr: true,
+			Err:     model.ErrNoJQLError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			jqlService, err := NewJQLService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := jqlService.Sanitize(context.Background(), testCase.queries)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, "project = 12345", gotResult.Queries[0].SanitizedQuery)
+				assert.Len(t, gotResult.Queries[1].Errors.ErrorMessages, 1)
+			}
+		})
+	}
+}
+
+func Test_internalJQLServiceImpl_ConvertUserIdentifiers(t *testing.T) {
+
+	t.Run("when the queries exceed the limit of a call", func(t *testing.T) {
+
+		queries := make([]string, jqlPersonalDataMaxQueries+50)
+		for index := range queries {
+			queries[index] = fmt.Sprintf("assignee = user%v", index)
+		}
+
+		client := mocks.NewClient(t)
+
+		for index, batch := range [][]string{queries[:jqlPersonalDataMaxQueries], queries[jqlPersonalDataMaxQueries:]} {
+
+			body := []byte(fmt.Sprintf("batch-%v", index))
+
+			client.On("TransformStructToReader",
+				&struct {
+					QueryStrings []string `json:"queryStrings"`
+				}{QueryStrings: batch}).
+				Return(bytes.NewReader(body), nil)
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodPost,
+				"/rest/api/3/jql/pdcleaner",
+				bytes.NewReader(body)).
+				Return(&http.Request{RequestURI: string(body)}, nil)
+
+			converted := &model.JQLConvertedQueriesScheme{QueryStrings: make([]string, len(batch))}
+			for position := range batch {
+				converted.QueryStrings[position] = fmt.Sprintf("assignee = account%v", index*jqlPersonalDataMaxQueries+position)
+			}
+
+			if index == 1 {
+				converted.QueriesWithUnknownUsers = []*model.JQLQueryWithUnknownUsersScheme{
+					{OriginalQuery: "assignee = user149", ConvertedQuery: "assignee = unknown"},
+				}
+			}
+
+			client.On("Call",
+				&http.Request{RequestURI: string(body)},
+				&model.JQLConvertedQueriesScheme{}).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*model.JQLConvertedQueriesScheme) = *converted
+				}).
+				Return(&model.ResponseScheme{}, nil)
+		}
+
+		jqlService, err := NewJQLService(client, "3")
+		assert.NoError(t, err)
+
+		converted, _, err := jqlService.ConvertUserIdentifiers(context.Background(), queries)
+		assert.NoError(t, err)
+
+		assert.Len(t, converted.QueryStrings, len(queries))
+		assert.Equal(t, "assignee = account0", converted.QueryStrings[0])
+		assert.Equal(t, "assignee = account149", converted.QueryStrings[149])
+		assert.Len(t, converted.QueriesWithUnknownUsers, 1)
+		client.AssertNumberOfCalls(t, "Call", 2)
+	})
+
+	t.Run("when a batch fails", func(t *testing.T) {
+
+		queries := make([]string, jqlPersonalDataMaxQueries+1)
+		for index := range queries {
+			queries[index] = fmt.Sprintf("assignee = user%v", index)
+		}
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"/rest/api/3/jql/pdcleaner",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.JQLConvertedQueriesScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.JQLConvertedQueriesScheme).QueryStrings = make([]string, jqlPersonalDataMaxQueries)
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{},
+			&model.JQLConvertedQueriesScheme{}).
+			Return(&model.ResponseScheme{}, errors.New("error, unable to connect with the Atlassian instance")).
+			Once()
+
+		jqlService, err := NewJQLService(client, "3")
+		assert.NoError(t, err)
+
+		// The queries of the first batch are returned with the error
+		converted, _, err := jqlService.ConvertUserIdentifiers(context.Background(), queries)
+		assert.EqualError(t, err, "error, unable to connect with the Atlassian instance")
+		assert.Len(t, converted.QueryStrings, jqlPersonalDataMaxQueries)
+	})
+
+	t.Run("when a call cannot be executed", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		client.On("TransformStructToReader",
+			mock.Anything).
+			Return(bytes.NewReader([]byte{}), nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"/rest/api/2/jql/pdcleaner",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.JQLConvertedQueriesScheme{}).
+			Return(&model.ResponseScheme{}, errors.New("error, unable to connect with the Atlassian instance"))
+
+		jqlService, err := NewJQLService(client, "2")
+		assert.NoError(t, err)
+
+		_, _, err = jqlService.ConvertUserIdentifiers(context.Background(), []string{"assignee = admin"})
+		assert.EqualError(t, err, "error, unable to connect with the Atlassian instance")
+	})
+
+	t.Run("when the queries are not provided", func(t *testing.T) {
+
+		jqlService, err := NewJQLService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = jqlService.ConvertUserIdentifiers(context.Background(), nil)
+		assert.Equal(t, model.ErrNoJQLError, err)
+	})
+}
+
 func Test_NewJQLService(t *testing.T) {
 
 	type args struct {
@@ -48,7 +48,7 @@ func (i *internalLabelServiceImpl) Gets(ctx context.Context, startAt, maxResults
 
 	endpoint := fmt.Sprintf("rest/api/%v/label?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.label.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+)
+
+func NewLicenseService(client service.Client, version string) (*LicenseService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &LicenseService{
+		internalClient: &internalLicenseImpl{c: client, version: version},
+	}, nil
+}
+
+type LicenseService struct {
+	internalClient jira.LicenseConnector
+}
+
+// Get returns licensing information about the Jira instance, e.g. the plan of each application.
+//
+// GET /rest/api/{2-3}/instance/license
+//
+// TODO: the documentation needs to be created
+func (l *LicenseService) Get(ctx context.Context) (*model.InstanceLicenseScheme, *model.ResponseScheme, error) {
+	return l.internalClient.Get(ctx)
+}
+
+// ApproximateCount returns the total approximate number of user accounts across all the Jira licenses.
+//
+// GET /rest/api/{2-3}/license/approximateLicenseCount
+//
+// TODO: the documentation needs to be created
+func (l *LicenseService) ApproximateCount(ctx context.Context) (*model.LicenseApproximateCountScheme, *model.ResponseScheme, error) {
+	return l.internalClient.ApproximateCount(ctx)
+}
+
+// ApproximateCountByApplication returns the approximate number of user accounts of a Jira application.
+//
+// GET /rest/api/{2-3}/license/approximateLicenseCount/product/{applicationKey}
+//
+// TODO: the documentation needs to be created
+func (l *LicenseService) ApproximateCountByApplication(ctx context.Context, applicationKey string) (*model.LicenseApproximateCountScheme, *model.ResponseScheme, error) {
+	return l.internalClient.ApproximateCountByApplication(ctx, applicationKey)
+}
+
+type internalLicenseImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalLicenseImpl) Get(ctx context.Context) (*model.InstanceLicenseScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/instance/license", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "license.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	license := new(model.InstanceLicenseScheme)
+	response, err := i.c.Call(request, license)
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, application := range license.Applications {
+		application.ID = model.NormalizeApplicationKey(application.ID)
+	}
+
+	return license, response, nil
+}
+
+func (i *internalLicenseImpl) ApproximateCount(ctx context.Context) (*model.LicenseApproximateCountScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/license/approximateLicenseCount", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "license.approximateCount", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(model.LicenseApproximateCountScheme)
+	response, err := i.c.Call(request, count)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return count, response, nil
+}
+
+func (i *internalLicenseImpl) ApproximateCountByApplication(ctx context.Context, applicationKey string) (*model.LicenseApproximateCountScheme, *model.ResponseScheme, error) {
+
+	if applicationKey == "" {
+		return nil, nil, model.ErrNoApplicationKeyError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/license/approximateLicenseCount/product/%v", i.version, model.NormalizeApplicationKey(applicationKey))
+
+	request, err := service.NewOperationRequest(ctx, i.c, "license.approximateCountByApplication", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(model.LicenseApproximateCountScheme)
+	response, err := i.c.Call(request, count)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return count, response, nil
+}
@@ -5,7 +5,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/service"
 )
 
-func NewLinkService(client service.Client, version string, type_ *LinkTypeService) (*LinkADFService, *LinkRichTextService, error) {
+func NewLinkService(client service.Client, version string, type_ *LinkTypeService, remote *RemoteLinkService) (*LinkADFService, *LinkRichTextService, error) {
 
 	if version == "" {
 		return nil, nil, model.ErrNoVersionProvided
@@ -16,7 +16,8 @@ func NewLinkService(client service.Client, version string, type_ *LinkTypeServic
 			c:       client,
 			version: version,
 		},
-		Type: type_,
+		Type:   type_,
+		Remote: remote,
 	}
 
 	richTextService := &LinkRichTextService{
@@ -24,7 +25,8 @@ func NewLinkService(client service.Client, version string, type_ *LinkTypeServic
 			c:       client,
 			version: version,
 		},
-		Type: type_,
+		Type:   type_,
+		Remote: remote,
 	}
 
 	return adfService, richTextService, nil
@@ -12,6 +12,7 @@ import (
 type LinkADFService struct {
 	internalClient jira.LinkAdfIssueConnector
 	Type           *LinkTypeService
+	Remote         *RemoteLinkService
 }
 
 type internalLinkADFServiceImpl struct {
@@ -63,7 +64,7 @@ func (i *internalLinkADFServiceImpl) Get(ctx context.Context, linkId string) (*m
 
 	endpoint := fmt.Sprintf("rest/api/%v/issueLink/%v", i.version, linkId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +86,7 @@ func (i *internalLinkADFServiceImpl) Gets(ctx context.Context, issueKeyOrId stri
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?fields=issuelinks", i.version, issueKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,7 +108,7 @@ func (i *internalLinkADFServiceImpl) Delete(ctx context.Context, linkId string)
 
 	endpoint := fmt.Sprintf("rest/api/%v/issueLink/%v", i.version, linkId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +125,7 @@ func (i *internalLinkADFServiceImpl) Create(ctx context.Context, payload *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/issueLink", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
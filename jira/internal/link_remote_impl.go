@@ -0,0 +1,269 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+	"net/url"
+)
+
+func NewRemoteLinkService(client service.Client, version string) (*RemoteLinkService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &RemoteLinkService{
+		internalClient: &internalRemoteLinkImpl{c: client, version: version},
+	}, nil
+}
+
+type RemoteLinkService struct {
+	internalClient jira.RemoteLinkConnector
+}
+
+// Gets returns the remote links of an issue.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (r *RemoteLinkService) Gets(ctx context.Context, issueKeyOrId string) ([]*model.RemoteLinkScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, issueKeyOrId)
+}
+
+// Get returns a remote link of an issue.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink/{linkId}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (r *RemoteLinkService) Get(ctx context.Context, issueKeyOrId, linkId string) (*model.RemoteLinkScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, issueKeyOrId, linkId)
+}
+
+// GetByGlobalID returns the remote link of an issue with the global id.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink?globalId={globalId}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (r *RemoteLinkService) GetByGlobalID(ctx context.Context, issueKeyOrId, globalId string) (*model.RemoteLinkScheme, *model.ResponseScheme, error) {
+	return r.internalClient.GetByGlobalID(ctx, issueKeyOrId, globalId)
+}
+
+// Create creates or updates a remote link of an issue.
+//
+// When the global id of the payload matches a remote link of the issue, the link is updated and the response
+// status code is 200, otherwise the link is created and the status code is 201.
+//
+// POST /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (r *RemoteLinkService) Create(ctx context.Context, issueKeyOrId string, payload *model.RemoteLinkPayloadScheme) (*model.RemoteLinkIdentify, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, issueKeyOrId, payload)
+}
+
+// Update updates a remote link of an issue, the fields not set on the payload are removed from the link.
+//
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink/{linkId}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (r *RemoteLinkService) Update(ctx context.Context, issueKeyOrId, linkId string, payload *model.RemoteLinkPayloadScheme) (*model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, issueKeyOrId, linkId, payload)
+}
+
+// DeleteByID deletes a remote link of an issue.
+//
+// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink/{linkId}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (r *RemoteLinkService) DeleteByID(ctx context.Context, issueKeyOrId, linkId string) (*model.ResponseScheme, error) {
+	return r.internalClient.DeleteByID(ctx, issueKeyOrId, linkId)
+}
+
+// DeleteByGlobalID deletes the remote link of an issue with the global id.
+//
+// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink?globalId={globalId}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (r *RemoteLinkService) DeleteByGlobalID(ctx context.Context, issueKeyOrId, globalId string) (*model.ResponseScheme, error) {
+	return r.internalClient.DeleteByGlobalID(ctx, issueKeyOrId, globalId)
+}
+
+type internalRemoteLinkImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalRemoteLinkImpl) Gets(ctx context.Context, issueKeyOrId string) ([]*model.RemoteLinkScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink", i.version, issueKeyOrId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.remote.gets", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var links []*model.RemoteLinkScheme
+	response, err := i.c.Call(request, &links)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return links, response, nil
+}
+
+func (i *internalRemoteLinkImpl) Get(ctx context.Context, issueKeyOrId, linkId string) (*model.RemoteLinkScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if linkId == "" {
+		return nil, nil, model.ErrNoRemoteLinkIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink/%v", i.version, issueKeyOrId, linkId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.remote.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	link := new(model.RemoteLinkScheme)
+	response, err := i.c.Call(request, link)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return link, response, nil
+}
+
+func (i *internalRemoteLinkImpl) GetByGlobalID(ctx context.Context, issueKeyOrId, globalId string) (*model.RemoteLinkScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if globalId == "" {
+		return nil, nil, model.ErrNoRemoteLinkGlobalIDError
+	}
+
+	params := url.Values{}
+	params.Add("globalId", globalId)
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink?%v", i.version, issueKeyOrId, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.remote.getByGlobalID", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	link := new(model.RemoteLinkScheme)
+	response, err := i.c.Call(request, link)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return link, response, nil
+}
+
+func (i *internalRemoteLinkImpl) Create(ctx context.Context, issueKeyOrId string, payload *model.RemoteLinkPayloadScheme) (*model.RemoteLinkIdentify, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink", i.version, issueKeyOrId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.remote.create", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	link := new(model.RemoteLinkIdentify)
+	response, err := i.c.Call(request, link)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return link, response, nil
+}
+
+func (i *internalRemoteLinkImpl) Update(ctx context.Context, issueKeyOrId, linkId string, payload *model.RemoteLinkPayloadScheme) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if linkId == "" {
+		return nil, model.ErrNoRemoteLinkIDError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink/%v", i.version, issueKeyOrId, linkId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.remote.update", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalRemoteLinkImpl) DeleteByID(ctx context.Context, issueKeyOrId, linkId string) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if linkId == "" {
+		return nil, model.ErrNoRemoteLinkIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink/%v", i.version, issueKeyOrId, linkId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.remote.deleteByID", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalRemoteLinkImpl) DeleteByGlobalID(ctx context.Context, issueKeyOrId, globalId string) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if globalId == "" {
+		return nil, model.ErrNoRemoteLinkGlobalIDError
+	}
+
+	params := url.Values{}
+	params.Add("globalId", globalId)
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink?%v", i.version, issueKeyOrId, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.link.remote.deleteByGlobalID", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
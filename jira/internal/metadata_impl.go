@@ -3,12 +3,15 @@ package internal
 import (
 	"context"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/cache"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/jira"
 	"github.com/tidwall/gjson"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 func NewMetadataService(client service.Client, version string) (*MetadataService, error) {
@@ -19,11 +22,13 @@ func NewMetadataService(client service.Client, version string) (*MetadataService
 
 	return &MetadataService{
 		internalClient: &internalMetadataImpl{c: client, version: version},
+		cache:          cache.NewTTL(defaultCreateMetadataCacheTTL),
 	}, nil
 }
 
 type MetadataService struct {
 	internalClient jira.MetadataConnector
+	cache          *cache.TTL
 }
 
 // Get edit issue metadata returns the edit screen fields for an issue that are visible to and editable by the user.
@@ -37,6 +42,17 @@ func (m *MetadataService) Get(ctx context.Context, issueKeyOrId string, override
 	return m.internalClient.Get(ctx, issueKeyOrId, overrideScreenSecurity, overrideEditableFlag)
 }
 
+// GetTyped returns the edit screen fields for an issue that are visible to and editable by the user.
+//
+// The fields are decoded into their metadata, mapped by field id, instead of being returned as a raw result.
+//
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/editmeta
+//
+// TODO: the documentation needs to be created
+func (m *MetadataService) GetTyped(ctx context.Context, issueKeyOrId string, overrideScreenSecurity, overrideEditableFlag bool) (*model.IssueEditMetadataScheme, *model.ResponseScheme, error) {
+	return m.internalClient.GetTyped(ctx, issueKeyOrId, overrideScreenSecurity, overrideEditableFlag)
+}
+
 // Create returns details of projects, issue types within projects, and, when requested,
 //
 // the create screen fields for each issue type for the user.
@@ -48,6 +64,112 @@ func (m *MetadataService) Create(ctx context.Context, opts *model.IssueMetadataC
 	return m.internalClient.Create(ctx, opts)
 }
 
+// CreateTyped returns details of projects, issue types within projects, and, when requested,
+//
+// the create screen fields for each issue type for the user, decoded into their metadata.
+//
+// GET /rest/api/{2-3}/issue/createmeta
+//
+// TODO: the documentation needs to be created
+func (m *MetadataService) CreateTyped(ctx context.Context, opts *model.IssueMetadataCreateOptions) (*model.ProjectIssueCreateMetadataScheme, *model.ResponseScheme, error) {
+	return m.internalClient.CreateTyped(ctx, opts)
+}
+
+// CreateIssueTypes returns a page of issue type metadata for a specified project.
+//
+// Use the information to populate the requests in Create issue.
+//
+// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes
+//
+// TODO: the documentation needs to be created
+func (m *MetadataService) CreateIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*model.IssueTypeCreateMetadataPageScheme, *model.ResponseScheme, error) {
+	return m.internalClient.CreateIssueTypes(ctx, projectKeyOrID, startAt, maxResults)
+}
+
+// CreateIssueTypeFields returns a page of field metadata for a specified project and issue type id.
+//
+// Use the information to populate the requests in Create issue.
+//
+// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes/{issueTypeId}
+//
+// TODO: the documentation needs to be created
+func (m *MetadataService) CreateIssueTypeFields(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (*model.IssueFieldCreateMetadataPageScheme, *model.ResponseScheme, error) {
+	return m.internalClient.CreateIssueTypeFields(ctx, projectKeyOrID, issueTypeID, startAt, maxResults)
+}
+
+// ValidateCreatePayload validates an issue create payload against the create metadata of the project and issue type.
+//
+// The payload should contain the "fields" object, e.g. the map returned by the IssueScheme.MergeCustomFields method.
+//
+// The metadata is cached per project and issue type, use SetCacheTTL to change the cache duration.
+//
+// The response returned belongs to the last metadata page fetched, it's nil if the metadata was cached.
+func (m *MetadataService) ValidateCreatePayload(ctx context.Context, projectKeyOrID, issueTypeID string, payload map[string]interface{}) ([]*model.FieldViolationScheme, *model.ResponseScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, nil, model.ErrNoProjectIDOrKeyError
+	}
+
+	if issueTypeID == "" {
+		return nil, nil, model.ErrNoIssueTypeIDError
+	}
+
+	key := projectKeyOrID + "/" + issueTypeID
+
+	var response *model.ResponseScheme
+
+	var fields []*model.FieldMetadataScheme
+	if cached, isCached := m.cache.Get(key); isCached {
+		fields = cached.([]*model.FieldMetadataScheme)
+	} else {
+
+		var startAt int
+		for {
+
+			page, pageResponse, err := m.internalClient.CreateIssueTypeFields(ctx, projectKeyOrID, issueTypeID, startAt, createMetadataPageSize)
+			if err != nil {
+				return nil, pageResponse, err
+			}
+
+			response = pageResponse
+
+			pageFields := page.Fields
+			if len(pageFields) == 0 {
+				pageFields = page.Results
+			}
+
+			fields = append(fields, pageFields...)
+
+			if len(pageFields) == 0 || !page.HasNext() {
+				break
+			}
+
+			startAt = page.NextStartAt()
+		}
+
+		m.cache.Set(key, fields)
+	}
+
+	violations, err := model.ValidateCreatePayload(fields, payload)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return violations, response, nil
+}
+
+// SetCacheTTL sets the duration the create metadata used by ValidateCreatePayload is cached.
+//
+// A zero or negative duration disables the cache.
+func (m *MetadataService) SetCacheTTL(ttl time.Duration) {
+	m.cache.SetTTL(ttl)
+}
+
+const (
+	defaultCreateMetadataCacheTTL = 5 * time.Minute
+	createMetadataPageSize        = 50
+)
+
 type internalMetadataImpl struct {
 	c       service.Client
 	version string
@@ -65,7 +187,7 @@ func (i *internalMetadataImpl) Get(ctx context.Context, issueKeyOrId string, ove
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/editmeta?%v", i.version, issueKeyOrId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.metadata.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return gjson.Result{}, nil, err
 	}
@@ -78,41 +200,146 @@ func (i *internalMetadataImpl) Get(ctx context.Context, issueKeyOrId string, ove
 	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
 }
 
+func (i *internalMetadataImpl) GetTyped(ctx context.Context, issueKeyOrId string, overrideScreenSecurity, overrideEditableFlag bool) (*model.IssueEditMetadataScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	params := url.Values{}
+	params.Add("overrideEditableFlag", fmt.Sprintf("%v", overrideEditableFlag))
+	params.Add("overrideScreenSecurity", fmt.Sprintf("%v", overrideScreenSecurity))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/editmeta?%v", i.version, issueKeyOrId, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.metadata.getTyped", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := new(model.IssueEditMetadataScheme)
+	response, err := i.c.Call(request, metadata)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return metadata, response, nil
+}
+
 func (i *internalMetadataImpl) Create(ctx context.Context, opts *model.IssueMetadataCreateOptions) (gjson.Result, *model.ResponseScheme, error) {
 
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.metadata.create", http.MethodGet, i.createMetadataEndpoint(opts), nil)
+	if err != nil {
+		return gjson.Result{}, nil, err
+	}
+
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+		return gjson.Result{}, response, err
+	}
+
+	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+}
+
+func (i *internalMetadataImpl) CreateTyped(ctx context.Context, opts *model.IssueMetadataCreateOptions) (*model.ProjectIssueCreateMetadataScheme, *model.ResponseScheme, error) {
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.metadata.createTyped", http.MethodGet, i.createMetadataEndpoint(opts), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := new(model.ProjectIssueCreateMetadataScheme)
+	response, err := i.c.Call(request, metadata)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return metadata, response, nil
+}
+
+// createMetadataEndpoint returns the create metadata endpoint filtered by the options.
+func (i *internalMetadataImpl) createMetadataEndpoint(opts *model.IssueMetadataCreateOptions) string {
+
 	params := url.Values{}
 
-	for _, id := range opts.IssueTypeIDs {
-		params.Add("issuetypeIds", id)
+	if opts != nil {
+
+		for _, id := range opts.IssueTypeIDs {
+			params.Add("issuetypeIds", id)
+		}
+
+		for _, name := range opts.IssueTypeNames {
+			params.Add("issuetypeNames", name)
+		}
+
+		for _, id := range opts.ProjectIDs {
+			params.Add("projectIds", id)
+		}
+
+		for _, key := range opts.ProjectKeys {
+			params.Add("projectKeys", key)
+		}
+
+		if opts.Expand != "" {
+			params.Add("expand", opts.Expand)
+		}
+	}
+
+	return fmt.Sprintf("rest/api/%v/issue/createmeta?%v", i.version, params.Encode())
+}
+
+func (i *internalMetadataImpl) CreateIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*model.IssueTypeCreateMetadataPageScheme, *model.ResponseScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, nil, model.ErrNoProjectIDOrKeyError
 	}
 
-	for _, name := range opts.IssueTypeNames {
-		params.Add("issuetypeNames", name)
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/createmeta/%v/issuetypes?%v", i.version, projectKeyOrID, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.metadata.createIssueTypes", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for _, id := range opts.ProjectIDs {
-		params.Add("projectIds", id)
+	page := new(model.IssueTypeCreateMetadataPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
 	}
 
-	for _, key := range opts.ProjectKeys {
-		params.Add("projectKeys", key)
+	return page, response, nil
+}
+
+func (i *internalMetadataImpl) CreateIssueTypeFields(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (*model.IssueFieldCreateMetadataPageScheme, *model.ResponseScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, nil, model.ErrNoProjectIDOrKeyError
 	}
 
-	if opts.Expand != "" {
-		params.Add("expand", opts.Expand)
+	if issueTypeID == "" {
+		return nil, nil, model.ErrNoIssueTypeIDError
 	}
 
-	endpoint := fmt.Sprintf("rest/api/%v/issue/createmeta?%v", i.version, params.Encode())
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/createmeta/%v/issuetypes/%v?%v", i.version, projectKeyOrID, issueTypeID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.metadata.createIssueTypeFields", http.MethodGet, endpoint, nil)
 	if err != nil {
-		return gjson.Result{}, nil, err
+		return nil, nil, err
 	}
 
-	response, err := i.c.Call(request, nil)
+	page := new(model.IssueFieldCreateMetadataPageScheme)
+	response, err := i.c.Call(request, page)
 	if err != nil {
-		return gjson.Result{}, response, err
+		return nil, response, err
 	}
 
-	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+	return page, response, nil
 }
@@ -2,11 +2,13 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/tidwall/gjson"
 	"net/http"
 	"testing"
@@ -171,6 +173,163 @@ func Test_internalMetadataImpl_Get(t *testing.T) {
 	}
 }
 
+func Test_internalMetadataImpl_GetTyped(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                    context.Context
+		issueKeyOrId           string
+		overrideScreenSecurity bool
+		overrideEditableFlag   bool
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueEditMetadataScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:                    context.Background(),
+				issueKeyOrId:           "DUMMY-4",
+				overrideScreenSecurity: true,
+				overrideEditableFlag:   false,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-4/editmeta?overrideEditableFlag=false&overrideScreenSecurity=true",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueEditMetadataScheme{}).
+					Run(func(args mock.Arguments) {
+						metadata := args.Get(1).(*model.IssueEditMetadataScheme)
+						metadata.Fields = map[string]*model.FieldMetadataScheme{
+							"priority": {Required: true, Name: "Priority", Key: "priority", Operations: []string{"set"}},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueEditMetadataScheme{Fields: map[string]*model.FieldMetadataScheme{
+				"priority": {Required: true, Name: "Priority", Key: "priority", Operations: []string{"set"}},
+			}},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                    context.Background(),
+				issueKeyOrId:           "DUMMY-4",
+				overrideScreenSecurity: false,
+				overrideEditableFlag:   true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-4/editmeta?overrideEditableFlag=true&overrideScreenSecurity=false",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueEditMetadataScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueEditMetadataScheme{},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-4",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-4/editmeta?overrideEditableFlag=false&overrideScreenSecurity=false",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			metadataService, err := NewMetadataService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := metadataService.GetTyped(testCase.args.ctx, testCase.args.issueKeyOrId, testCase.args.overrideScreenSecurity,
+				testCase.args.overrideEditableFlag)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
 func Test_internalMetadataImpl_Create(t *testing.T) {
 
 	type fields struct {
@@ -325,6 +484,575 @@ func Test_internalMetadataImpl_Create(t *testing.T) {
 	}
 }
 
+func Test_internalMetadataImpl_CreateTyped(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx  context.Context
+		opts *model.IssueMetadataCreateOptions
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.ProjectIssueCreateMetadataScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				opts: &model.IssueMetadataCreateOptions{
+					ProjectKeys:  []string{"DUMMY"},
+					IssueTypeIDs: []string{"10001"},
+					Expand:       "projects.issuetypes.fields",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta?expand=projects.issuetypes.fields&issuetypeIds=10001&projectKeys=DUMMY",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectIssueCreateMetadataScheme{}).
+					Run(func(args mock.Arguments) {
+						metadata := args.Get(1).(*model.ProjectIssueCreateMetadataScheme)
+						metadata.Projects = []*model.ProjectCreateMetadataScheme{{Key: "DUMMY"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectIssueCreateMetadataScheme{Projects: []*model.ProjectCreateMetadataScheme{{Key: "DUMMY"}}},
+		},
+
+		{
+			name:   "when the options are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta?",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectIssueCreateMetadataScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectIssueCreateMetadataScheme{},
+		},
+
+		{
+			name:   "when the call fails",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta?",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectIssueCreateMetadataScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			metadataService, err := NewMetadataService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := metadataService.CreateTyped(testCase.args.ctx, testCase.args.opts)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
+func Test_internalMetadataImpl_CreateIssueTypes(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                 context.Context
+		projectKeyOrID      string
+		startAt, maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta/DUMMY/issuetypes?maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueTypeCreateMetadataPageScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueTypeCreateMetadataPageScheme)
+						assert.NoError(t, json.Unmarshal([]byte(`{"maxResults":50,"startAt":0,"total":1,"issueTypes":[{"id":"10001","name":"Bug","subtask":false}]}`), page))
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta/DUMMY/issuetypes?maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueTypeCreateMetadataPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKeyError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta/DUMMY/issuetypes?maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			metadataService, err := NewMetadataService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := metadataService.CreateIssueTypes(testCase.args.ctx, testCase.args.projectKeyOrID,
+				testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				if testCase.fields.version == "3" {
+					assert.Equal(t, "Bug", gotResult.IssueTypes[0].Name)
+					assert.Equal(t, 1, gotResult.Total)
+				}
+			}
+		})
+	}
+}
+
+func Test_internalMetadataImpl_CreateIssueTypeFields(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                         context.Context
+		projectKeyOrID, issueTypeID string
+		startAt, maxResults         int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				issueTypeID:    "10001",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta/DUMMY/issuetypes/10001?maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueFieldCreateMetadataPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				issueTypeID:    "10001",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta/DUMMY/issuetypes/10001?maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueFieldCreateMetadataPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKeyError,
+		},
+
+		{
+			name:   "when the issue type id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueTypeIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				issueTypeID:    "10001",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta/DUMMY/issuetypes/10001?maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			metadataService, err := NewMetadataService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := metadataService.CreateIssueTypeFields(testCase.args.ctx, testCase.args.projectKeyOrID,
+				testCase.args.issueTypeID, testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_MetadataService_ValidateCreatePayload(t *testing.T) {
+
+	payloadMocked := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"project":   map[string]interface{}{"key": "DUMMY"},
+			"issuetype": map[string]interface{}{"id": "10001"},
+			"priority":  map[string]interface{}{"name": "Urgent"},
+		},
+	}
+
+	mockPages := func(client *mocks.Client) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/createmeta/DUMMY/issuetypes/10001?maxResults=50&startAt=0",
+			nil).
+			Return(&http.Request{}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+
+				page := args.Get(1).(*model.IssueFieldCreateMetadataPageScheme)
+				page.Total = 4
+				page.MaxResults = 50
+				page.Fields = []*model.FieldMetadataScheme{
+					{FieldID: "project", Name: "Project", Required: true, Schema: &model.IssueFieldSchemaScheme{Type: "project"}},
+					{FieldID: "issuetype", Name: "Issue Type", Required: true, Schema: &model.IssueFieldSchemaScheme{Type: "issuetype"}},
+					{FieldID: "summary", Name: "Summary", Required: true, Schema: &model.IssueFieldSchemaScheme{Type: "string"}},
+					{
+						FieldID:       "priority",
+						Name:          "Priority",
+						Schema:        &model.IssueFieldSchemaScheme{Type: "priority"},
+						AllowedValues: []*model.FieldAllowedValueScheme{{ID: "1", Name: "Highest"}, {ID: "2", Name: "High"}},
+					},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	t.Run("when the metadata is fetched and cached", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockPages(client)
+
+		metadataService, err := NewMetadataService(client, "3")
+		assert.NoError(t, err)
+
+		violations, response, err := metadataService.ValidateCreatePayload(context.Background(), "DUMMY", "10001", payloadMocked)
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+
+		assert.Equal(t, []*model.FieldViolationScheme{
+			{FieldID: "summary", Code: model.FieldViolationRequired, Reason: "the field Summary is required"},
+			{FieldID: "priority", Code: model.FieldViolationAllowedValue, Reason: `the value {"name":"Urgent"} is not allowed on the field Priority`},
+		}, violations)
+
+		// The second validation uses the cached metadata
+		violations, response, err = metadataService.ValidateCreatePayload(context.Background(), "DUMMY", "10001", payloadMocked)
+		assert.NoError(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, 2, len(violations))
+	})
+
+	t.Run("when the cache is disabled", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockPages(client)
+		mockPages(client)
+
+		metadataService, err := NewMetadataService(client, "3")
+		assert.NoError(t, err)
+
+		metadataService.SetCacheTTL(0)
+
+		for index := 0; index < 2; index++ {
+			_, response, err := metadataService.ValidateCreatePayload(context.Background(), "DUMMY", "10001", payloadMocked)
+			assert.NoError(t, err)
+			assert.NotNil(t, response)
+		}
+	})
+
+	t.Run("when the project key or id is not provided", func(t *testing.T) {
+
+		metadataService, err := NewMetadataService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = metadataService.ValidateCreatePayload(context.Background(), "", "10001", payloadMocked)
+		assert.EqualError(t, err, model.ErrNoProjectIDOrKeyError.Error())
+	})
+
+	t.Run("when the issue type id is not provided", func(t *testing.T) {
+
+		metadataService, err := NewMetadataService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = metadataService.ValidateCreatePayload(context.Background(), "DUMMY", "", payloadMocked)
+		assert.EqualError(t, err, model.ErrNoIssueTypeIDError.Error())
+	})
+}
+
 func Test_NewMetadataService(t *testing.T) {
 
 	type args struct {
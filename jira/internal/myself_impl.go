@@ -53,7 +53,7 @@ func (i *internalMySelfImpl) Details(ctx context.Context, expand []string) (*mod
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "mySelf.details", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"strings"
+)
+
+// namedEntity is a status or a field matched by its names.
+type namedEntity struct {
+	id, name, untranslatedName string
+}
+
+// matchName returns the positions of the entities matching the name on the first step with a match:
+//
+// 1. The exact untranslated name, stable across the user locales.
+//
+// 2. The exact localized name.
+//
+// 3. The untranslated or the localized name, ignoring the case.
+func matchName(name string, entities []namedEntity) []int {
+
+	steps := []func(entity namedEntity) bool{
+		func(entity namedEntity) bool { return entity.untranslatedName != "" && entity.untranslatedName == name },
+		func(entity namedEntity) bool { return entity.name == name },
+		func(entity namedEntity) bool {
+			return strings.EqualFold(entity.untranslatedName, name) || strings.EqualFold(entity.name, name)
+		},
+	}
+
+	for _, matches := range steps {
+
+		var positions []int
+		for position, entity := range entities {
+
+			if matches(entity) {
+				positions = append(positions, position)
+			}
+		}
+
+		if len(positions) != 0 {
+			return positions
+		}
+	}
+
+	return nil
+}
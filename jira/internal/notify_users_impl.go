@@ -0,0 +1,12 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// notifyUsers returns the notifyUsers parameter of the endpoints that support it,
+// the notifications suppressed on the context take precedence over the value of the call.
+func notifyUsers(ctx context.Context, notify bool) bool {
+	return notify && !model.NotificationsSuppressed(ctx)
+}
@@ -0,0 +1,167 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// Test_notifyUsers_Inventory records the endpoints that honor the suppressed notifications, the endpoints without
+// a notifyUsers parameter are listed to catch the changes on the parameters sent.
+func Test_notifyUsers_Inventory(t *testing.T) {
+
+	suppressed := model.WithSuppressNotifications(context.Background())
+
+	testCases := []struct {
+		name string
+		call func(ctx context.Context, client *mocks.Client) error
+
+		// want is the notifyUsers parameter sent, empty when the endpoint doesn't accept it
+		want string
+	}{
+		{
+			name: "issue update (v3)",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				_, issueService, _ := NewIssueService(client, "3", nil)
+				_, err := issueService.Update(ctx, "KP-1", true, &model.IssueScheme{}, nil, nil)
+				return err
+			},
+			want: "false",
+		},
+		{
+			name: "issue update (v2)",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				issueService, _, _ := NewIssueService(client, "2", nil)
+				_, err := issueService.Update(ctx, "KP-1", true, &model.IssueSchemeV2{}, nil, nil)
+				return err
+			},
+			want: "false",
+		},
+		{
+			name: "comment update (v3)",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				commentService, _, _ := NewCommentService(client, "3")
+				_, _, err := commentService.Update(ctx, "KP-1", "10001", true, &model.CommentPayloadScheme{}, nil)
+				return err
+			},
+			want: "false",
+		},
+		{
+			name: "comment update (v2)",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				_, commentService, _ := NewCommentService(client, "2")
+				_, _, err := commentService.Update(ctx, "KP-1", "10001", true, &model.CommentPayloadSchemeV2{}, nil)
+				return err
+			},
+			want: "false",
+		},
+		{
+			name: "worklog add",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				worklogService, _ := NewWorklogADFService(client, "3")
+				_, _, err := worklogService.Add(ctx, "KP-1", &model.WorklogPayloadSchemeV3{TimeSpentSeconds: 60}, nil)
+				return err
+			},
+			want: "false",
+		},
+		{
+			name: "worklog update",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				worklogService, _ := NewWorklogRichTextService(client, "2")
+				_, _, err := worklogService.Update(ctx, "KP-1", "10001", &model.WorklogPayloadSchemeV2{TimeSpentSeconds: 60},
+					&model.WorklogOptionsScheme{Notify: true})
+				return err
+			},
+			want: "false",
+		},
+		{
+			name: "worklog delete",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				worklogService, _ := NewWorklogADFService(client, "3")
+				_, err := worklogService.Delete(ctx, "KP-1", "10001", nil)
+				return err
+			},
+			want: "false",
+		},
+		{
+			name: "issue assign",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				_, issueService, _ := NewIssueService(client, "3", nil)
+				_, err := issueService.Assign(ctx, "KP-1", "5b86be50b8e3cb5895860d6d")
+				return err
+			},
+		},
+		{
+			name: "issue transition",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				_, issueService, _ := NewIssueService(client, "3", nil)
+				_, err := issueService.Move(ctx, "KP-1", "31", nil)
+				return err
+			},
+		},
+		{
+			name: "issue create",
+			call: func(ctx context.Context, client *mocks.Client) error {
+				_, issueService, _ := NewIssueService(client, "3", nil)
+				_, _, err := issueService.Create(ctx, &model.IssueScheme{}, nil)
+				return err
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var endpoint string
+
+			client := mocks.NewClient(t)
+
+			client.On("TransformStructToReader",
+				mock.Anything).
+				Return(bytes.NewReader([]byte{}), nil).
+				Maybe()
+
+			client.On("NewRequest",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Run(func(args mock.Arguments) {
+					endpoint = args.String(2)
+				}).
+				Return(&http.Request{}, nil)
+
+			client.On("Call",
+				&http.Request{},
+				mock.Anything).
+				Return(&model.ResponseScheme{}, nil)
+
+			assert.NoError(t, testCase.call(suppressed, client))
+
+			query := ""
+			if index := strings.Index(endpoint, "?"); index != -1 {
+				query = endpoint[index+1:]
+			}
+
+			params, err := url.ParseQuery(query)
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.want, params.Get("notifyUsers"), endpoint)
+		})
+	}
+}
+
+func Test_notifyUsers(t *testing.T) {
+
+	assert.True(t, notifyUsers(context.Background(), true))
+	assert.False(t, notifyUsers(context.Background(), false))
+	assert.False(t, notifyUsers(model.WithSuppressNotifications(context.Background()), true))
+	assert.False(t, model.NotificationsSuppressed(context.Background()))
+}
@@ -63,7 +63,7 @@ func (i *internalPermissionImpl) Gets(ctx context.Context) ([]*model.PermissionS
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissions", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,7 +105,7 @@ func (i *internalPermissionImpl) Check(ctx context.Context, payload *model.Permi
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissions/check", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.check", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -134,7 +134,7 @@ func (i *internalPermissionImpl) Projects(ctx context.Context, permissions []str
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissions/project", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.projects", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
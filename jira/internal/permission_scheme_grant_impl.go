@@ -73,6 +73,14 @@ func (i *internalPermissionSchemeGrantImpl) Create(ctx context.Context, permissi
 		return nil, nil, model.ErrNoPermissionSchemeIDError
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayloadError
+	}
+
+	if err := payload.Holder.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	reader, err := i.c.TransformStructToReader(payload)
 	if err != nil {
 		return nil, nil, err
@@ -80,7 +88,7 @@ func (i *internalPermissionSchemeGrantImpl) Create(ctx context.Context, permissi
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme/%v/permission", i.version, permissionSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.grant.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,7 +119,7 @@ func (i *internalPermissionSchemeGrantImpl) Gets(ctx context.Context, permission
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.grant.gets", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,7 +154,7 @@ func (i *internalPermissionSchemeGrantImpl) Get(ctx context.Context, permissionS
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.grant.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,7 +180,7 @@ func (i *internalPermissionSchemeGrantImpl) Delete(ctx context.Context, permissi
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme/%v/permission/%v", i.version, permissionSchemeId, permissionGrantId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.grant.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +119,47 @@ func TestPermissionSchemeGrantService_Create(t *testing.T) {
 			Err:     model.ErrNoPermissionSchemeIDError,
 		},
 
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.TODO(),
+				permissionSchemeId: 10001,
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayloadError,
+		},
+
+		{
+			name:   "when the holder type is not valid",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.TODO(),
+				permissionSchemeId: 10001,
+				payload: &model.PermissionGrantPayloadScheme{
+					Holder:     &model.PermissionGrantHolderScheme{Type: "groups", Parameter: "scrum-masters"},
+					Permission: "EDIT_ISSUES",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidPermissionHolderTypeError,
+		},
+
+		{
+			name:   "when the holder identifier is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.TODO(),
+				permissionSchemeId: 10001,
+				payload: &model.PermissionGrantPayloadScheme{
+					Holder:     &model.PermissionGrantHolderScheme{Type: "projectRole"},
+					Permission: "EDIT_ISSUES",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionHolderParameterError,
+		},
+
 		{
 			name:   "when the http request cannot be created",
 			fields: fields{version: "2"},
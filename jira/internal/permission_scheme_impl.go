@@ -91,7 +91,7 @@ func (i *internalPermissionSchemeImpl) Gets(ctx context.Context) (*model.Permiss
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +122,7 @@ func (i *internalPermissionSchemeImpl) Get(ctx context.Context, permissionScheme
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,7 +144,7 @@ func (i *internalPermissionSchemeImpl) Delete(ctx context.Context, permissionSch
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme/%v", i.version, permissionSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +161,7 @@ func (i *internalPermissionSchemeImpl) Create(ctx context.Context, payload *mode
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -188,7 +188,7 @@ func (i *internalPermissionSchemeImpl) Update(ctx context.Context, permissionSch
 
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme/%v", i.version, permissionSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "permission.scheme.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
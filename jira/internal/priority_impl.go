@@ -51,7 +51,7 @@ func (i *internalPriorityImpl) Gets(ctx context.Context) ([]*model.PrioritySchem
 
 	endpoint := fmt.Sprintf("rest/api/%v/priority", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.priority.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,7 +73,7 @@ func (i *internalPriorityImpl) Get(ctx context.Context, priorityId string) (*mod
 
 	endpoint := fmt.Sprintf("rest/api/%v/priority/%v", i.version, priorityId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.priority.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
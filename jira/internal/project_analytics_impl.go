@@ -0,0 +1,328 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"sort"
+	"sync"
+)
+
+const (
+	defaultEngagementConcurrency = 5
+	defaultEngagementPageSize    = 50
+	defaultEngagementMaxRetries  = 3
+	userBulkMaxAccountIDs        = 50
+)
+
+func NewProjectAnalyticsService(client service.Client, version string) (*ProjectAnalyticsService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	// The search only reads the watches and votes, the rich text search decodes them on both API versions
+	return &ProjectAnalyticsService{
+		internalClient: &internalProjectAnalyticsImpl{
+			search:  &internalSearchRichTextImpl{c: client, version: version},
+			watcher: &internalWatcherImpl{c: client, version: version},
+			vote:    &internalVoteImpl{c: client, version: version},
+			user:    &internalUserImpl{c: client, version: version},
+		},
+	}, nil
+}
+
+type ProjectAnalyticsService struct {
+	internalClient jira.ProjectAnalyticsConnector
+}
+
+// Engagement returns the watchers and voters of the issues matching a JQL query, keyed by account id
+// and joined with the user active status.
+//
+// The issues are streamed from the issue search and resolved concurrently, 5 issues at a time by default.
+//
+// The rate limited requests are retried following the Retry-After header, the first error cancels the remaining requests.
+//
+// TODO: the documentation needs to be created
+func (p *ProjectAnalyticsService) Engagement(ctx context.Context, jql string, options *model.IssueEngagementOptionsScheme) (*model.IssueEngagementReportScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Engagement(ctx, jql, options)
+}
+
+type internalProjectAnalyticsImpl struct {
+	search  jira.SearchRichTextConnector
+	watcher jira.WatcherConnector
+	vote    jira.VoteConnector
+	user    jira.UserConnector
+}
+
+func (i *internalProjectAnalyticsImpl) Engagement(ctx context.Context, jql string, options *model.IssueEngagementOptionsScheme) (*model.IssueEngagementReportScheme, *model.ResponseScheme, error) {
+
+	if jql == "" {
+		return nil, nil, model.ErrNoJQLError
+	}
+
+	concurrency, pageSize, maxRetries := defaultEngagementConcurrency, defaultEngagementPageSize, defaultEngagementMaxRetries
+	if options != nil {
+
+		if options.Concurrency > 0 {
+			concurrency = options.Concurrency
+		}
+
+		if options.PageSize > 0 {
+			pageSize = options.PageSize
+		}
+
+		if options.MaxRetries > 0 {
+			maxRetries = options.MaxRetries
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	collector := newEngagementCollector(cancel)
+	issues := make(chan *model.IssueSchemeV2)
+
+	var workers sync.WaitGroup
+	for worker := 0; worker < concurrency; worker++ {
+
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+
+			for issue := range issues {
+				i.resolveEngagement(ctx, issue, maxRetries, collector)
+			}
+		}()
+	}
+
+	response := i.streamIssues(ctx, jql, pageSize, maxRetries, issues, collector)
+
+	close(issues)
+	workers.Wait()
+
+	if collector.err != nil {
+		return nil, collector.response, collector.err
+	}
+
+	// The stream and the workers stop without error when the context is canceled, the report would be truncated
+	if err := ctx.Err(); err != nil {
+		return nil, response, err
+	}
+
+	// The issues are resolved concurrently, sort the issue keys to return a stable report
+	for _, user := range collector.report.Users {
+		sort.Strings(user.Watching)
+		sort.Strings(user.Voted)
+	}
+
+	if lastResponse, err := i.joinUsers(ctx, collector.report, maxRetries); err != nil {
+		return nil, lastResponse, err
+	} else if lastResponse != nil {
+		response = lastResponse
+	}
+
+	return collector.report, response, nil
+}
+
+// streamIssues pages through the issue search, sending the issues to the workers as the pages are received.
+func (i *internalProjectAnalyticsImpl) streamIssues(ctx context.Context, jql string, pageSize, maxRetries int, issues chan<- *model.IssueSchemeV2,
+	collector *engagementCollector) *model.ResponseScheme {
+
+	var response *model.ResponseScheme
+	for startAt := 0; ; startAt += pageSize {
+
+		var page *model.IssueSearchSchemeV2
+		var err error
+
+		response, err = throttle.Retry(ctx, maxRetries, func() (response *model.ResponseScheme, err error) {
+			page, response, err = i.search.Get(ctx, jql, []string{"watches", "votes"}, nil, startAt, pageSize, "")
+			return response, err
+		})
+		if err != nil {
+			collector.fail(response, err)
+			return response
+		}
+
+		for _, issue := range page.Issues {
+
+			select {
+			case <-ctx.Done():
+				return response
+			case issues <- issue:
+			}
+		}
+
+		if len(page.Issues) == 0 || startAt+len(page.Issues) >= page.Total {
+			return response
+		}
+	}
+}
+
+// resolveEngagement fetches the watchers and voters of an issue, the issues without watchers or votes are skipped.
+func (i *internalProjectAnalyticsImpl) resolveEngagement(ctx context.Context, issue *model.IssueSchemeV2, maxRetries int, collector *engagementCollector) {
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	issueKey := issue.Key
+	if issueKey == "" {
+		issueKey = issue.ID
+	}
+
+	var watches *model.IssueWatcherScheme
+	var votes *model.IssueVoteScheme
+	if issue.Fields != nil {
+		watches, votes = issue.Fields.Watcher, issue.Fields.Votes
+	}
+
+	if watches == nil || watches.WatchCount != 0 {
+
+		response, err := throttle.Retry(ctx, maxRetries, func() (response *model.ResponseScheme, err error) {
+			watches, response, err = i.watcher.Gets(ctx, issueKey)
+			return response, err
+		})
+		if err != nil {
+			collector.fail(response, err)
+			return
+		}
+
+		for _, watcher := range watches.Watchers {
+			collector.watching(issueKey, watcher.AccountID, watcher.DisplayName, watcher.Active)
+		}
+	}
+
+	if votes == nil || votes.Votes != 0 {
+
+		response, err := throttle.Retry(ctx, maxRetries, func() (response *model.ResponseScheme, err error) {
+			votes, response, err = i.vote.Gets(ctx, issueKey)
+			return response, err
+		})
+		if err != nil {
+			collector.fail(response, err)
+			return
+		}
+
+		for _, voter := range votes.Voters {
+			collector.voted(issueKey, voter.AccountID, voter.DisplayName, voter.Active)
+		}
+	}
+
+	collector.scanned()
+}
+
+// joinUsers sets the active status of the users using the user bulk endpoint.
+func (i *internalProjectAnalyticsImpl) joinUsers(ctx context.Context, report *model.IssueEngagementReportScheme, maxRetries int) (*model.ResponseScheme, error) {
+
+	accountIDs := make([]string, 0, len(report.Users))
+	for accountID := range report.Users {
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	sort.Strings(accountIDs)
+
+	var response *model.ResponseScheme
+	for len(accountIDs) != 0 {
+
+		chunk := accountIDs
+		if len(chunk) > userBulkMaxAccountIDs {
+			chunk = chunk[:userBulkMaxAccountIDs]
+		}
+
+		accountIDs = accountIDs[len(chunk):]
+
+		for startAt := 0; ; startAt += userBulkMaxAccountIDs {
+
+			var page *model.UserSearchPageScheme
+			var err error
+
+			response, err = throttle.Retry(ctx, maxRetries, func() (response *model.ResponseScheme, err error) {
+				page, response, err = i.user.Find(ctx, chunk, startAt, userBulkMaxAccountIDs)
+				return response, err
+			})
+			if err != nil {
+				return response, err
+			}
+
+			for _, user := range page.Values {
+				if engagement, ok := report.Users[user.AccountID]; ok {
+					engagement.DisplayName = user.DisplayName
+					engagement.Active = user.Active
+				}
+			}
+
+			if page.IsLast || len(page.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	return response, nil
+}
+
+type engagementCollector struct {
+	mu       sync.Mutex
+	report   *model.IssueEngagementReportScheme
+	cancel   context.CancelFunc
+	response *model.ResponseScheme
+	err      error
+}
+
+func newEngagementCollector(cancel context.CancelFunc) *engagementCollector {
+	return &engagementCollector{
+		report: &model.IssueEngagementReportScheme{Users: make(map[string]*model.IssueEngagementUserScheme)},
+		cancel: cancel,
+	}
+}
+
+// fail stores the first error and cancels the remaining requests.
+func (e *engagementCollector) fail(response *model.ResponseScheme, err error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err == nil {
+		e.response, e.err = response, err
+		e.cancel()
+	}
+}
+
+func (e *engagementCollector) scanned() {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.report.Issues++
+}
+
+func (e *engagementCollector) watching(issueKey, accountID, displayName string, active bool) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	user := e.userLocked(accountID, displayName, active)
+	user.Watching = append(user.Watching, issueKey)
+}
+
+func (e *engagementCollector) voted(issueKey, accountID, displayName string, active bool) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	user := e.userLocked(accountID, displayName, active)
+	user.Voted = append(user.Voted, issueKey)
+}
+
+func (e *engagementCollector) userLocked(accountID, displayName string, active bool) *model.IssueEngagementUserScheme {
+
+	user, ok := e.report.Users[accountID]
+	if !ok {
+		user = &model.IssueEngagementUserScheme{AccountID: accountID, DisplayName: displayName, Active: active}
+		e.report.Users[accountID] = user
+	}
+
+	return user
+}
@@ -0,0 +1,268 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+func Test_internalProjectAnalyticsImpl_Engagement(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		jql     string
+		options *model.IssueEngagementOptionsScheme
+	}
+
+	mockSearch := func(client *mocks.Client, version string, issues []*model.IssueSchemeV2) {
+
+		client.On("NewRequest",
+			mock.Anything,
+			http.MethodGet,
+			"rest/api/"+version+"/search?fields=watches%2Cvotes&jql=project+%3D+KP&maxResults=50&startAt=0",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.IssueSearchSchemeV2{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueSearchSchemeV2)
+				page.Issues, page.Total = issues, len(issues)
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	issuesMocked := []*model.IssueSchemeV2{
+		{
+			Key: "KP-1",
+			Fields: &model.IssueFieldsSchemeV2{
+				Watcher: &model.IssueWatcherScheme{WatchCount: 2},
+				Votes:   &model.IssueVoteScheme{Votes: 1},
+			},
+		},
+		{
+			Key: "KP-2",
+			Fields: &model.IssueFieldsSchemeV2{
+				Watcher: &model.IssueWatcherScheme{WatchCount: 0},
+				Votes:   &model.IssueVoteScheme{Votes: 0},
+			},
+		},
+	}
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueEngagementReportScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				jql:     "project = KP",
+				options: &model.IssueEngagementOptionsScheme{Concurrency: 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockSearch(client, "3", issuesMocked)
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/watchers",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueWatcherScheme{}).
+					Run(func(args mock.Arguments) {
+						watchers := args.Get(1).(*model.IssueWatcherScheme)
+						watchers.Watchers = []*model.UserDetailScheme{
+							{AccountID: "account-id-1", DisplayName: "User 1"},
+							{AccountID: "account-id-2", DisplayName: "User 2"},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/votes",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueVoteScheme{}).
+					Run(func(args mock.Arguments) {
+						votes := args.Get(1).(*model.IssueVoteScheme)
+						votes.Voters = []*model.UserScheme{{AccountID: "account-id-1", DisplayName: "User 1"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodGet,
+					"rest/api/3/user/bulk?accountId=account-id-1&accountId=account-id-2&maxResults=50&startAt=0",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.UserSearchPageScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.UserSearchPageScheme)
+						page.IsLast = true
+						page.Values = []*model.UserScheme{
+							{AccountID: "account-id-1", DisplayName: "User 1", Active: true},
+							{AccountID: "account-id-2", DisplayName: "User 2", Active: false},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueEngagementReportScheme{
+				Issues: 2,
+				Users: map[string]*model.IssueEngagementUserScheme{
+					"account-id-1": {AccountID: "account-id-1", DisplayName: "User 1", Active: true, Watching: []string{"KP-1"}, Voted: []string{"KP-1"}},
+					"account-id-2": {AccountID: "account-id-2", DisplayName: "User 2", Watching: []string{"KP-1"}},
+				},
+			},
+		},
+
+		{
+			name:   "when the issue search is rate limited",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchSchemeV2{}).
+					Return(&model.ResponseScheme{
+						Response: &http.Response{Header: http.Header{"Retry-After": []string{"0"}}},
+						Code:     http.StatusTooManyRequests,
+					}, model.ErrInvalidStatusCodeError).
+					Once()
+
+				mockSearch(client, "2", nil)
+
+				fields.c = client
+			},
+			want: &model.IssueEngagementReportScheme{
+				Users: map[string]*model.IssueEngagementUserScheme{},
+			},
+		},
+
+		{
+			name:   "when the watchers cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockSearch(client, "3", issuesMocked[:1])
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/watchers",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the context is canceled",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: canceledCtx,
+				jql: "project = KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockSearch(client, "2", issuesMocked)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name:   "when the jql is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQLError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			analyticsService, err := NewProjectAnalyticsService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := analyticsService.Engagement(testCase.args.ctx, testCase.args.jql, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
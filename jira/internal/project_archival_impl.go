@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"strings"
+	"time"
+)
+
+const (
+	projectArchivalPageSize   = 50
+	projectArchivalMaxRetries = 3
+)
+
+func (i *internalProjectImpl) ArchiveInactive(ctx context.Context, inactiveSince time.Time, dryRun bool, exclude []string) (
+	*model.ProjectArchivalReportScheme, *model.ResponseScheme, error) {
+
+	if inactiveSince.IsZero() || inactiveSince.After(time.Now()) {
+		return nil, nil, model.ErrInvalidInactiveDateError
+	}
+
+	excluded := make(map[string]bool, len(exclude))
+	for _, projectKey := range exclude {
+		excluded[strings.ToUpper(projectKey)] = true
+	}
+
+	report := &model.ProjectArchivalReportScheme{DryRun: dryRun}
+
+	// The projects are listed before any archival, the archived projects would shift the search pages
+	projects, response, err := i.liveProjects(ctx)
+	if err != nil {
+		return report, response, err
+	}
+
+	for _, project := range projects {
+
+		if ctx.Err() != nil {
+			return report, response, ctx.Err()
+		}
+
+		result := &model.ProjectArchivalResultScheme{ID: project.ID, Key: project.Key, Name: project.Name}
+
+		if excluded[strings.ToUpper(project.Key)] {
+			result.Reason = "excluded"
+			report.Skipped = append(report.Skipped, result)
+			continue
+		}
+
+		// The insight can be missing, e.g. without the permission to browse the project
+		if project.Insight == nil {
+			result.Reason = "the project activity is not available"
+			report.Skipped = append(report.Skipped, result)
+			continue
+		}
+
+		// The projects without issues are kept, e.g. the projects just created
+		if project.Insight.TotalIssueCount == 0 || project.Insight.LastIssueUpdateTime == "" {
+			result.Reason = "the project has no issues"
+			report.Skipped = append(report.Skipped, result)
+			continue
+		}
+
+		lastIssueUpdate, err := time.Parse(model.DateFormatJira, project.Insight.LastIssueUpdateTime)
+		if err != nil {
+			result.Reason, result.Err = "the last issue update cannot be read", err
+			report.Failed = append(report.Failed, result)
+			continue
+		}
+
+		if !lastIssueUpdate.Before(inactiveSince) {
+			result.Reason = fmt.Sprintf("an issue was updated on %v", lastIssueUpdate.Format(time.RFC3339))
+			report.Skipped = append(report.Skipped, result)
+			continue
+		}
+
+		result.Reason = fmt.Sprintf("the last issue was updated on %v", lastIssueUpdate.Format(time.RFC3339))
+
+		if dryRun {
+			report.Archived = append(report.Archived, result)
+			continue
+		}
+
+		archiveResponse, err := throttle.Retry(ctx, projectArchivalMaxRetries, func() (*model.ResponseScheme, error) {
+			return i.Archive(ctx, project.Key)
+		})
+		if archiveResponse != nil {
+			response = archiveResponse
+		}
+
+		if err != nil {
+			result.Reason, result.Err = "the project cannot be archived", err
+			report.Failed = append(report.Failed, result)
+			continue
+		}
+
+		report.Archived = append(report.Archived, result)
+	}
+
+	return report, response, nil
+}
+
+// liveProjects pages through the project search, returning the live projects with their insight.
+func (i *internalProjectImpl) liveProjects(ctx context.Context) ([]*model.ProjectScheme, *model.ResponseScheme, error) {
+
+	var (
+		projects []*model.ProjectScheme
+		response *model.ResponseScheme
+	)
+
+	options := &model.ProjectSearchOptionsScheme{OrderBy: "key", Status: []string{"live"}, Expand: []string{"insight"}}
+
+	for startAt := 0; ; startAt += projectArchivalPageSize {
+
+		var page *model.ProjectSearchScheme
+		var err error
+
+		response, err = throttle.Retry(ctx, projectArchivalMaxRetries, func() (response *model.ResponseScheme, err error) {
+			page, response, err = i.Search(ctx, options, startAt, projectArchivalPageSize)
+			return response, err
+		})
+		if err != nil {
+			return nil, response, err
+		}
+
+		projects = append(projects, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			return projects, response, nil
+		}
+	}
+}
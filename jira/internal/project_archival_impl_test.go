@@ -0,0 +1,149 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// mockArchivalProjects mocks the live projects search, with the insight of the projects
+func mockArchivalProjects(client *mocks.Client, projects ...*model.ProjectScheme) {
+
+	request := &http.Request{RequestURI: "projects"}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/project/search?expand=insight&maxResults=50&orderBy=key&startAt=0&status=live",
+		nil).
+		Return(request, nil)
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*model.ProjectSearchScheme)
+			page.IsLast = true
+			page.Values = projects
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+// archivalProject returns a project with the insight, the insight is not set without issue count
+func archivalProject(projectKey string, totalIssueCount int, lastIssueUpdateTime string) *model.ProjectScheme {
+
+	project := &model.ProjectScheme{ID: "id-" + projectKey, Key: projectKey, Name: projectKey}
+
+	if totalIssueCount >= 0 {
+		project.Insight = &model.ProjectInsightScheme{TotalIssueCount: totalIssueCount, LastIssueUpdateTime: lastIssueUpdateTime}
+	}
+
+	return project
+}
+
+func Test_internalProjectImpl_ArchiveInactive(t *testing.T) {
+
+	cutoff := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resultKeys := func(results []*model.ProjectArchivalResultScheme) []string {
+
+		var keys []string
+		for _, result := range results {
+			keys = append(keys, result.Key)
+		}
+
+		return keys
+	}
+
+	t.Run("when the inactive projects are archived", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		mockArchivalProjects(client,
+			archivalProject("ACT", 3, "2023-02-01T10:00:00.000+0000"),
+			archivalProject("BAD", 3, "yesterday"),
+			archivalProject("EXC", 3, "2022-01-01T10:00:00.000+0000"),
+			archivalProject("HID", -1, ""),
+			archivalProject("NEW", 0, ""),
+			archivalProject("OLD", 3, "2022-06-01T10:00:00.000+0000"),
+			archivalProject("RUN", 3, "2022-06-01T10:00:00.000+0000"))
+
+		for _, projectKey := range []string{"OLD", "RUN"} {
+
+			archiveRequest := &http.Request{RequestURI: "archive-" + projectKey}
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodPost,
+				"rest/api/3/project/"+projectKey+"/archive",
+				nil).
+				Return(archiveRequest, nil)
+
+			var err error
+			if projectKey == "RUN" {
+				err = errors.New("error, request failed. Please fix the request")
+			}
+
+			client.On("Call",
+				archiveRequest,
+				nil).
+				Return(&model.ResponseScheme{}, err)
+		}
+
+		newService, err := NewProjectService(client, "3", &ProjectChildServices{})
+		assert.NoError(t, err)
+
+		report, _, err := newService.ArchiveInactive(context.Background(), cutoff, false, []string{"exc"})
+		assert.NoError(t, err)
+
+		assert.False(t, report.DryRun)
+		assert.Equal(t, []string{"OLD"}, resultKeys(report.Archived))
+		assert.Equal(t, []string{"ACT", "EXC", "HID", "NEW"}, resultKeys(report.Skipped))
+		assert.Equal(t, []string{"BAD", "RUN"}, resultKeys(report.Failed))
+
+		assert.Equal(t, "the last issue was updated on 2022-06-01T10:00:00Z", report.Archived[0].Reason)
+		assert.Equal(t, "an issue was updated on 2023-02-01T10:00:00Z", report.Skipped[0].Reason)
+		assert.Equal(t, "excluded", report.Skipped[1].Reason)
+		assert.Equal(t, "the project activity is not available", report.Skipped[2].Reason)
+		assert.Equal(t, "the project has no issues", report.Skipped[3].Reason)
+		assert.Error(t, report.Failed[0].Err)
+		assert.EqualError(t, report.Failed[1].Err, "error, request failed. Please fix the request")
+	})
+
+	t.Run("when the archival is a dry run", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		mockArchivalProjects(client,
+			archivalProject("NEW", 0, ""),
+			archivalProject("OLD", 3, "2022-06-01T10:00:00.000+0000"))
+
+		newService, err := NewProjectService(client, "3", &ProjectChildServices{})
+		assert.NoError(t, err)
+
+		report, _, err := newService.ArchiveInactive(context.Background(), cutoff, true, nil)
+		assert.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []string{"OLD"}, resultKeys(report.Archived))
+		assert.Equal(t, []string{"NEW"}, resultKeys(report.Skipped))
+	})
+
+	t.Run("when the inactivity date is not valid", func(t *testing.T) {
+
+		newService, err := NewProjectService(nil, "3", &ProjectChildServices{})
+		assert.NoError(t, err)
+
+		_, _, err = newService.ArchiveInactive(context.Background(), time.Time{}, true, nil)
+		assert.Equal(t, model.ErrInvalidInactiveDateError, err)
+
+		_, _, err = newService.ArchiveInactive(context.Background(), time.Now().Add(time.Hour), true, nil)
+		assert.Equal(t, model.ErrInvalidInactiveDateError, err)
+	})
+}
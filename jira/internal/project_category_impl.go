@@ -78,7 +78,7 @@ func (i *internalProjectCategoryImpl) Gets(ctx context.Context) ([]*model.Projec
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectCategory", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.category.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -100,7 +100,7 @@ func (i *internalProjectCategoryImpl) Get(ctx context.Context, categoryId int) (
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectCategory/%v", i.version, categoryId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.category.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -123,7 +123,7 @@ func (i *internalProjectCategoryImpl) Create(ctx context.Context, payload *model
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectCategory", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.category.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -150,7 +150,7 @@ func (i *internalProjectCategoryImpl) Update(ctx context.Context, categoryId int
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectCategory/%v", i.version, categoryId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.category.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,7 +172,7 @@ func (i *internalProjectCategoryImpl) Delete(ctx context.Context, categoryId int
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectCategory/%v", i.version, categoryId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.category.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
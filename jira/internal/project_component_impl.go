@@ -80,6 +80,16 @@ func (p *ProjectComponentService) Get(ctx context.Context, componentId string) (
 	return p.internalClient.Get(ctx, componentId)
 }
 
+// Issues returns the issues assigned to the component, running a paginated issue search,
+// the issues with a resolution are skipped when onlyUnresolved is set.
+//
+// The summary and the status of the issues are always returned, the other fields are set on the options.
+//
+// POST /rest/api/{2-3}/search
+func (p *ProjectComponentService) Issues(ctx context.Context, componentId string, onlyUnresolved bool, options *model.ProjectUsageOptionsScheme) ([]*model.ProjectUsageIssueScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Issues(ctx, componentId, onlyUnresolved, options)
+}
+
 type internalProjectComponentImpl struct {
 	c       service.Client
 	version string
@@ -94,7 +104,7 @@ func (i *internalProjectComponentImpl) Create(ctx context.Context, payload *mode
 
 	endpoint := fmt.Sprintf("rest/api/%v/component", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.component.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,7 +126,7 @@ func (i *internalProjectComponentImpl) Gets(ctx context.Context, projectIdOrKey
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/components", i.version, projectIdOrKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.component.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,7 +148,7 @@ func (i *internalProjectComponentImpl) Count(ctx context.Context, componentId st
 
 	endpoint := fmt.Sprintf("rest/api/%v/component/%v/relatedIssueCounts", i.version, componentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.component.count", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -160,7 +170,7 @@ func (i *internalProjectComponentImpl) Delete(ctx context.Context, componentId s
 
 	endpoint := fmt.Sprintf("rest/api/%v/component/%v", i.version, componentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.component.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +191,7 @@ func (i *internalProjectComponentImpl) Update(ctx context.Context, componentId s
 
 	endpoint := fmt.Sprintf("rest/api/%v/component/%v", i.version, componentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.component.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -203,7 +213,7 @@ func (i *internalProjectComponentImpl) Get(ctx context.Context, componentId stri
 
 	endpoint := fmt.Sprintf("rest/api/%v/component/%v", i.version, componentId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.component.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
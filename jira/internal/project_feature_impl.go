@@ -55,7 +55,7 @@ func (i *internalProjectFeatureImpl) Gets(ctx context.Context, projectKeyOrId st
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/features", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.feature.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,7 +92,7 @@ func (i *internalProjectFeatureImpl) Set(ctx context.Context, projectKeyOrId, fe
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/features/%v", i.version, projectKeyOrId, featureKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.feature.set", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/cache"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"github.com/tidwall/gjson"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func NewProjectFieldMapService(client service.Client, version string) (*ProjectFieldMapService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	fields := cache.NewTTL(defaultCreateMetadataCacheTTL)
+
+	return &ProjectFieldMapService{
+		internalClient: &internalProjectFieldMapImpl{
+			metadata: &internalMetadataImpl{c: client, version: version},
+			cache:    fields,
+		},
+		cache: fields,
+	}, nil
+}
+
+type ProjectFieldMapService struct {
+	internalClient jira.ProjectFieldMapConnector
+	cache          *cache.TTL
+}
+
+// Resolve maps the field display names, e.g. "Story point estimate", to the field ids of a project.
+//
+// The fields are read from the create metadata of the project issue types and cached per project,
+// use SetCacheTTL to change the cache duration.
+//
+// A *model.FieldNameResolutionError listing the candidates is returned when a display name is missing or ambiguous.
+//
+// The response returned is nil if the project fields were cached.
+//
+// TODO: the documentation needs to be created
+func (p *ProjectFieldMapService) Resolve(ctx context.Context, projectKeyOrID string, displayNames []string) (*model.ProjectFieldMapScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Resolve(ctx, projectKeyOrID, displayNames)
+}
+
+// Invalidate removes the cached fields of a project, all the projects are removed if the project is not set.
+func (p *ProjectFieldMapService) Invalidate(projectKeyOrID string) {
+	p.internalClient.Invalidate(projectKeyOrID)
+}
+
+// SetCacheTTL sets the duration the project fields are cached.
+//
+// A zero or negative duration disables the cache.
+func (p *ProjectFieldMapService) SetCacheTTL(ttl time.Duration) {
+	p.cache.SetTTL(ttl)
+}
+
+type internalProjectFieldMapImpl struct {
+	metadata jira.MetadataConnector
+	cache    *cache.TTL // The field ids keyed by the lower case display names, cached per upper case project
+}
+
+func (i *internalProjectFieldMapImpl) Resolve(ctx context.Context, projectKeyOrID string, displayNames []string) (*model.ProjectFieldMapScheme, *model.ResponseScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, nil, model.ErrNoProjectIDOrKeyError
+	}
+
+	if len(displayNames) == 0 {
+		return nil, nil, model.ErrNoFieldIDError
+	}
+
+	var response *model.ResponseScheme
+
+	fields, isCached := i.cached(projectKeyOrID)
+	if !isCached {
+
+		var err error
+		fields, response, err = i.fetch(ctx, projectKeyOrID)
+		if err != nil {
+			return nil, response, err
+		}
+
+		i.store(projectKeyOrID, fields)
+	}
+
+	fieldMap := &model.ProjectFieldMapScheme{ProjectKeyOrID: projectKeyOrID, Fields: make(map[string]string, len(displayNames))}
+	for _, displayName := range displayNames {
+
+		candidates := fields[strings.ToLower(displayName)]
+
+		switch len(candidates) {
+		case 0:
+			return nil, response, &model.FieldNameResolutionError{Err: model.ErrFieldNameNotFoundError, ProjectKeyOrID: projectKeyOrID, Name: displayName}
+		case 1:
+			fieldMap.Fields[displayName] = candidates[0]
+		default:
+			return nil, response, &model.FieldNameResolutionError{Err: model.ErrAmbiguousFieldNameError, ProjectKeyOrID: projectKeyOrID, Name: displayName,
+				Candidates: candidates}
+		}
+	}
+
+	return fieldMap, response, nil
+}
+
+func (i *internalProjectFieldMapImpl) Invalidate(projectKeyOrID string) {
+
+	if projectKeyOrID == "" {
+		i.cache.Clear()
+		return
+	}
+
+	i.cache.Delete(strings.ToUpper(projectKeyOrID))
+}
+
+// fetch reads the fields of every issue type of the project, the fields shared by several issue types are
+// returned once, so only the fields with different ids are ambiguous.
+func (i *internalProjectFieldMapImpl) fetch(ctx context.Context, projectKeyOrID string) (map[string][]string, *model.ResponseScheme, error) {
+
+	options := &model.IssueMetadataCreateOptions{Expand: "projects.issuetypes.fields"}
+	if _, err := strconv.Atoi(projectKeyOrID); err == nil {
+		options.ProjectIDs = []string{projectKeyOrID}
+	} else {
+		options.ProjectKeys = []string{projectKeyOrID}
+	}
+
+	metadata, response, err := i.metadata.Create(ctx, options)
+	if err != nil {
+		return nil, response, err
+	}
+
+	fields := make(map[string][]string)
+	seen := make(map[string]bool)
+
+	for _, project := range metadata.Get("projects").Array() {
+		for _, issueType := range project.Get("issuetypes").Array() {
+
+			issueType.Get("fields").ForEach(func(key, value gjson.Result) bool {
+
+				fieldID := value.Get("key").String()
+				if fieldID == "" {
+					fieldID = key.String()
+				}
+
+				if !seen[fieldID] {
+					seen[fieldID] = true
+
+					name := strings.ToLower(value.Get("name").String())
+					fields[name] = append(fields[name], fieldID)
+				}
+
+				return true
+			})
+		}
+	}
+
+	for _, candidates := range fields {
+		sort.Strings(candidates)
+	}
+
+	return fields, response, nil
+}
+
+func (i *internalProjectFieldMapImpl) cached(projectKeyOrID string) (map[string][]string, bool) {
+
+	fields, ok := i.cache.Get(strings.ToUpper(projectKeyOrID))
+	if !ok {
+		return nil, false
+	}
+
+	return fields.(map[string][]string), true
+}
+
+func (i *internalProjectFieldMapImpl) store(projectKeyOrID string, fields map[string][]string) {
+
+	i.cache.Set(strings.ToUpper(projectKeyOrID), fields)
+}
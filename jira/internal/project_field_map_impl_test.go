@@ -0,0 +1,177 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const projectFieldMapCreateMetadata = `{
+  "projects": [
+    {
+      "key": "KAN",
+      "issuetypes": [
+        {
+          "name": "Story",
+          "fields": {
+            "summary": {"name": "Summary", "key": "summary"},
+            "customfield_10016": {"name": "Story point estimate", "key": "customfield_10016"},
+            "customfield_10015": {"name": "Start date", "key": "customfield_10015"}
+          }
+        },
+        {
+          "name": "Task",
+          "fields": {
+            "summary": {"name": "Summary", "key": "summary"},
+            "customfield_10015": {"name": "Start date", "key": "customfield_10015"},
+            "customfield_10040": {"name": "Team", "key": "customfield_10040"},
+            "customfield_10041": {"name": "team", "key": "customfield_10041"}
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+func Test_internalProjectFieldMapImpl_Resolve(t *testing.T) {
+
+	mockCreateMetadata := func(client *mocks.Client, endpoint string, times int) {
+
+		request := &http.Request{RequestURI: endpoint}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			endpoint,
+			nil).
+			Return(request, nil).
+			Times(times)
+
+		client.On("Call",
+			request,
+			nil).
+			Return(func(*http.Request, interface{}) *model.ResponseScheme {
+				return &model.ResponseScheme{Bytes: *bytes.NewBufferString(projectFieldMapCreateMetadata)}
+			}, nil).
+			Times(times)
+	}
+
+	t.Run("when the display names are resolved and cached", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockCreateMetadata(client, "rest/api/3/issue/createmeta?expand=projects.issuetypes.fields&projectKeys=KAN", 2)
+
+		newService, err := NewProjectFieldMapService(client, "3")
+		assert.NoError(t, err)
+
+		fieldMap, response, err := newService.Resolve(context.Background(), "KAN", []string{"Story point estimate", "start date"})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.Equal(t, map[string]string{"Story point estimate": "customfield_10016", "start date": "customfield_10015"}, fieldMap.Fields)
+
+		// The fields of the project are cached
+		fieldMap, response, err = newService.Resolve(context.Background(), "kan", []string{"Summary"})
+		assert.NoError(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, map[string]string{"Summary": "summary"}, fieldMap.Fields)
+
+		newService.Invalidate("KAN")
+
+		_, response, err = newService.Resolve(context.Background(), "KAN", []string{"Summary"})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("when the cache is disabled", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockCreateMetadata(client, "rest/api/3/issue/createmeta?expand=projects.issuetypes.fields&projectKeys=KAN", 2)
+
+		newService, err := NewProjectFieldMapService(client, "3")
+		assert.NoError(t, err)
+
+		newService.SetCacheTTL(0)
+
+		for attempt := 0; attempt < 2; attempt++ {
+			_, response, err := newService.Resolve(context.Background(), "KAN", []string{"Summary"})
+			assert.NoError(t, err)
+			assert.NotNil(t, response)
+		}
+	})
+
+	t.Run("when the project is set by id", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockCreateMetadata(client, "rest/api/3/issue/createmeta?expand=projects.issuetypes.fields&projectIds=10000", 1)
+
+		newService, err := NewProjectFieldMapService(client, "3")
+		assert.NoError(t, err)
+
+		fieldMap, _, err := newService.Resolve(context.Background(), "10000", []string{"Start date"})
+		assert.NoError(t, err)
+		assert.Equal(t, "customfield_10015", fieldMap.Fields["Start date"])
+	})
+
+	t.Run("when the display name is ambiguous", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockCreateMetadata(client, "rest/api/3/issue/createmeta?expand=projects.issuetypes.fields&projectKeys=KAN", 1)
+
+		newService, err := NewProjectFieldMapService(client, "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.Resolve(context.Background(), "KAN", []string{"Team"})
+
+		var resolutionErr *model.FieldNameResolutionError
+		assert.True(t, errors.As(err, &resolutionErr))
+		assert.True(t, errors.Is(err, model.ErrAmbiguousFieldNameError))
+		assert.Equal(t, []string{"customfield_10040", "customfield_10041"}, resolutionErr.Candidates)
+	})
+
+	t.Run("when the display name is not found", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockCreateMetadata(client, "rest/api/3/issue/createmeta?expand=projects.issuetypes.fields&projectKeys=KAN", 1)
+
+		newService, err := NewProjectFieldMapService(client, "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.Resolve(context.Background(), "KAN", []string{"Sprint"})
+		assert.True(t, errors.Is(err, model.ErrFieldNameNotFoundError))
+	})
+
+	t.Run("when the create metadata request fails", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/createmeta?expand=projects.issuetypes.fields&projectKeys=KAN",
+			nil).
+			Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+		newService, err := NewProjectFieldMapService(client, "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.Resolve(context.Background(), "KAN", []string{"Team"})
+		assert.EqualError(t, err, "error, unable to create the http request")
+	})
+
+	t.Run("when the parameters are not set", func(t *testing.T) {
+
+		newService, err := NewProjectFieldMapService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.Resolve(context.Background(), "", []string{"Team"})
+		assert.Equal(t, model.ErrNoProjectIDOrKeyError, err)
+
+		_, _, err = newService.Resolve(context.Background(), "KAN", nil)
+		assert.Equal(t, model.ErrNoFieldIDError, err)
+	})
+}
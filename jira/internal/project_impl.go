@@ -8,14 +8,18 @@ import (
 	"github.com/ctreminiom/go-atlassian/service/jira"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type ProjectChildServices struct {
+	Analytics  *ProjectAnalyticsService
 	Category   *ProjectCategoryService
 	Component  *ProjectComponentService
 	Feature    *ProjectFeatureService
+	FieldMap   *ProjectFieldMapService
 	Permission *ProjectPermissionSchemeService
 	Property   *ProjectPropertyService
 	Role       *ProjectRoleService
@@ -32,9 +36,11 @@ func NewProjectService(client service.Client, version string, subServices *Proje
 
 	return &ProjectService{
 		internalClient: &internalProjectImpl{c: client, version: version},
+		Analytics:      subServices.Analytics,
 		Category:       subServices.Category,
 		Component:      subServices.Component,
 		Feature:        subServices.Feature,
+		FieldMap:       subServices.FieldMap,
 		Permission:     subServices.Permission,
 		Property:       subServices.Property,
 		Role:           subServices.Role,
@@ -46,9 +52,11 @@ func NewProjectService(client service.Client, version string, subServices *Proje
 
 type ProjectService struct {
 	internalClient jira.ProjectConnector
+	Analytics      *ProjectAnalyticsService
 	Category       *ProjectCategoryService
 	Component      *ProjectComponentService
 	Feature        *ProjectFeatureService
+	FieldMap       *ProjectFieldMapService
 	Permission     *ProjectPermissionSchemeService
 	Property       *ProjectPropertyService
 	Role           *ProjectRoleService
@@ -99,6 +107,8 @@ func (p *ProjectService) Update(ctx context.Context, projectKeyOrId string, payl
 //
 // To restore a project, use the Jira UI.
 //
+// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+//
 // DELETE /rest/api/{2-3}/project/{projectIdOrKey}
 //
 // https://docs.go-atlassian.io/jira-software-cloud/projects#delete-project
@@ -112,6 +122,8 @@ func (p *ProjectService) Delete(ctx context.Context, projectKeyOrId string, enab
 //
 // 2. asynchronous. Follow the location link in the response to determine the status of the task and use Get task to obtain subsequent updates.
 //
+// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+//
 // POST /rest/api/{2-3}/project/{projectIdOrKey}/delete
 //
 // https://docs.go-atlassian.io/jira-software-cloud/projects#delete-project-asynchronously
@@ -119,6 +131,16 @@ func (p *ProjectService) DeleteAsynchronously(ctx context.Context, projectKeyOrI
 	return p.internalClient.DeleteAsynchronously(ctx, projectKeyOrId)
 }
 
+// PreviewDelete returns the project and its approximate number of issues, and the token confirming the deletion
+// when the client is on protected mode. The token confirms the deletion by the project key or id.
+//
+// GET /rest/api/{2-3}/project/{projectIdOrKey}
+//
+// TODO: the documentation needs to be created
+func (p *ProjectService) PreviewDelete(ctx context.Context, projectKeyOrId string) (*model.ProjectDeletionPreviewScheme, *model.ResponseScheme, error) {
+	return p.internalClient.PreviewDelete(ctx, projectKeyOrId)
+}
+
 // Archive archives a project. Archived projects cannot be deleted.
 //
 // To delete an archived project, restore the project and then delete it.
@@ -161,6 +183,44 @@ func (p *ProjectService) NotificationScheme(ctx context.Context, projectKeyOrId
 	return p.internalClient.NotificationScheme(ctx, projectKeyOrId, expand)
 }
 
+// Hierarchy returns the issue type hierarchy for a next-gen project.
+//
+// The issue type hierarchy for a project consists of the subtask, base, epic and any custom levels, with the issue types at each level.
+//
+// The hierarchy endpoint is deprecated, when the site no longer serves it the hierarchy is built from the hierarchy level of the project issue types.
+//
+// GET /rest/api/{2-3}/project/{projectId}/hierarchy
+//
+// TODO: the documentation needs to be created
+func (p *ProjectService) Hierarchy(ctx context.Context, projectID int) (*model.ProjectIssueTypeHierarchyScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Hierarchy(ctx, projectID)
+}
+
+// EpicLinkField returns the field used to link the issues of a project to an epic.
+//
+// An empty field id is returned for the team-managed projects, and the company-managed projects without epic link field,
+// the issues are linked to the epic with the parent field, e.g. IssueScheme.SetEpic(epicKey, "", nil).
+//
+// GET /rest/api/{2-3}/project/{projectIdOrKey}
+//
+// TODO: the documentation needs to be created
+func (p *ProjectService) EpicLinkField(ctx context.Context, projectKeyOrId string) (string, *model.ResponseScheme, error) {
+	return p.internalClient.EpicLinkField(ctx, projectKeyOrId)
+}
+
+// ArchiveInactive archives the live projects without issues updated since the date, the excluded project keys are skipped.
+//
+// The last issue update of each project is read from the project insight, the projects without issues or without
+// insight are skipped, on a dry run the projects are only evaluated.
+//
+// The projects that couldn't be evaluated or archived are listed on the report with the error,
+// the rate limited requests are retried following the Retry-After header.
+//
+// TODO: the documentation needs to be created
+func (p *ProjectService) ArchiveInactive(ctx context.Context, inactiveSince time.Time, dryRun bool, exclude []string) (*model.ProjectArchivalReportScheme, *model.ResponseScheme, error) {
+	return p.internalClient.ArchiveInactive(ctx, inactiveSince, dryRun, exclude)
+}
+
 type internalProjectImpl struct {
 	c       service.Client
 	version string
@@ -168,6 +228,14 @@ type internalProjectImpl struct {
 
 func (i *internalProjectImpl) Create(ctx context.Context, payload *model.ProjectPayloadScheme) (*model.NewProjectCreatedScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayloadError
+	}
+
+	if err := payload.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	reader, err := i.c.TransformStructToReader(payload)
 	if err != nil {
 		return nil, nil, err
@@ -175,7 +243,7 @@ func (i *internalProjectImpl) Create(ctx context.Context, payload *model.Project
 
 	endpoint := fmt.Sprintf("rest/api/%v/project", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -244,7 +312,7 @@ func (i *internalProjectImpl) Search(ctx context.Context, options *model.Project
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -275,7 +343,7 @@ func (i *internalProjectImpl) Get(ctx context.Context, projectKeyOrId string, ex
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -295,6 +363,14 @@ func (i *internalProjectImpl) Update(ctx context.Context, projectKeyOrId string,
 		return nil, nil, model.ErrNoProjectIDOrKeyError
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayloadError
+	}
+
+	if err := payload.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	reader, err := i.c.TransformStructToReader(payload)
 	if err != nil {
 		return nil, nil, err
@@ -302,7 +378,7 @@ func (i *internalProjectImpl) Update(ctx context.Context, projectKeyOrId string,
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -322,12 +398,16 @@ func (i *internalProjectImpl) Delete(ctx context.Context, projectKeyOrId string,
 		return nil, model.ErrNoProjectIDOrKeyError
 	}
 
+	if err := checkConfirmation(ctx, i.c, confirmProjectDeletion, projectKeyOrId); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Add("enableUndo", fmt.Sprintf("%v", enableUndo))
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v?%v", i.version, projectKeyOrId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -341,9 +421,13 @@ func (i *internalProjectImpl) DeleteAsynchronously(ctx context.Context, projectK
 		return nil, nil, model.ErrNoProjectIDOrKeyError
 	}
 
+	if err := checkConfirmation(ctx, i.c, confirmProjectDeletion, projectKeyOrId); err != nil {
+		return nil, nil, err
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/delete", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.deleteAsynchronously", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -357,6 +441,31 @@ func (i *internalProjectImpl) DeleteAsynchronously(ctx context.Context, projectK
 	return task, response, nil
 }
 
+func (i *internalProjectImpl) PreviewDelete(ctx context.Context, projectKeyOrId string) (*model.ProjectDeletionPreviewScheme, *model.ResponseScheme, error) {
+
+	if projectKeyOrId == "" {
+		return nil, nil, model.ErrNoProjectIDOrKeyError
+	}
+
+	project, response, err := i.Get(ctx, projectKeyOrId, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	issues, response, err := countIssuesByJQL(ctx, i.c, i.version, fmt.Sprintf("project = %v", strconv.Quote(project.Key)))
+	if err != nil {
+		return nil, response, err
+	}
+
+	// The deletion can be confirmed by the project key or id, whichever the caller uses on Delete
+	confirmation, err := issueConfirmation(i.c, confirmProjectDeletion, projectKeyOrId, project.Key, project.ID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return &model.ProjectDeletionPreviewScheme{Project: project, Issues: issues, Confirmation: confirmation}, response, nil
+}
+
 func (i *internalProjectImpl) Archive(ctx context.Context, projectKeyOrId string) (*model.ResponseScheme, error) {
 
 	if projectKeyOrId == "" {
@@ -365,7 +474,7 @@ func (i *internalProjectImpl) Archive(ctx context.Context, projectKeyOrId string
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/archive", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.archive", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -381,7 +490,7 @@ func (i *internalProjectImpl) Restore(ctx context.Context, projectKeyOrId string
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/restore", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.restore", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -403,7 +512,7 @@ func (i *internalProjectImpl) Statuses(ctx context.Context, projectKeyOrId strin
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/statuses", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.statuses", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -434,7 +543,7 @@ func (i *internalProjectImpl) NotificationScheme(ctx context.Context, projectKey
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.notificationScheme", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -447,3 +556,118 @@ func (i *internalProjectImpl) NotificationScheme(ctx context.Context, projectKey
 
 	return notificationScheme, response, nil
 }
+
+func (i *internalProjectImpl) Hierarchy(ctx context.Context, projectID int) (*model.ProjectIssueTypeHierarchyScheme, *model.ResponseScheme, error) {
+
+	if projectID == 0 {
+		return nil, nil, model.ErrNoProjectIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/project/%v/hierarchy", i.version, projectID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.hierarchy", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hierarchy := new(model.ProjectIssueTypeHierarchyScheme)
+	response, err := i.c.Call(request, hierarchy)
+	if err != nil {
+
+		if response != nil && (response.Code == http.StatusNotFound || response.Code == http.StatusGone) {
+			return i.hierarchyFromIssueTypes(ctx, projectID)
+		}
+
+		return nil, response, err
+	}
+
+	return hierarchy, response, nil
+}
+
+// epicLinkFieldType is the custom field type of the epic link field used by the company-managed projects.
+const epicLinkFieldType = "com.pyxis.greenhopper.jira:gh-epic-link"
+
+func (i *internalProjectImpl) EpicLinkField(ctx context.Context, projectKeyOrId string) (string, *model.ResponseScheme, error) {
+
+	project, response, err := i.Get(ctx, projectKeyOrId, nil)
+	if err != nil {
+		return "", response, err
+	}
+
+	// The team-managed projects link the issues to the epic with the parent field
+	if project.Simplified || project.Style == "next-gen" {
+		return "", response, nil
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/field", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.epicLinkField", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var fields []*model.IssueFieldScheme
+	response, err = i.c.Call(request, &fields)
+	if err != nil {
+		return "", response, err
+	}
+
+	for _, field := range fields {
+
+		if field.Schema != nil && field.Schema.Custom == epicLinkFieldType {
+			return field.ID, response, nil
+		}
+	}
+
+	return "", response, nil
+}
+
+// hierarchyFromIssueTypes builds the project issue type hierarchy using the hierarchy level of the project issue types.
+func (i *internalProjectImpl) hierarchyFromIssueTypes(ctx context.Context, projectID int) (*model.ProjectIssueTypeHierarchyScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("projectId", strconv.Itoa(projectID))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuetype/project?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.hierarchy", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issueTypes []*model.IssueTypeScheme
+	response, err := i.c.Call(request, &issueTypes)
+	if err != nil {
+		return nil, response, err
+	}
+
+	levelNames := map[int]string{-1: "Subtask", 0: "Base", 1: "Epic"}
+	hierarchy := &model.ProjectIssueTypeHierarchyScheme{ProjectID: projectID}
+
+	for _, issueType := range issueTypes {
+
+		level := hierarchy.Level(issueType.HierarchyLevel)
+		if level == nil {
+			level = &model.ProjectHierarchyScheme{Level: issueType.HierarchyLevel, Name: levelNames[issueType.HierarchyLevel]}
+			hierarchy.Hierarchy = append(hierarchy.Hierarchy, level)
+		}
+
+		issueTypeID, err := strconv.Atoi(issueType.ID)
+		if err != nil {
+			return nil, response, err
+		}
+
+		level.IssueTypes = append(level.IssueTypes, &model.ProjectHierarchyIssueTypeScheme{
+			ID:       issueTypeID,
+			EntityID: issueType.EntityID,
+			Name:     issueType.Name,
+			AvatarID: issueType.AvatarID,
+		})
+	}
+
+	sort.Slice(hierarchy.Hierarchy, func(x, y int) bool {
+		return hierarchy.Hierarchy[x].Level < hierarchy.Hierarchy[y].Level
+	})
+
+	return hierarchy, response, nil
+}
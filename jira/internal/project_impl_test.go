@@ -142,6 +142,42 @@ func Test_internalProjectImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the project template doesn't belong to the project type",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.ProjectPayloadScheme{
+					Key:                "DUMMY3",
+					ProjectTemplateKey: model.SoftwareCompanyManagedScrumProjectTemplate,
+					ProjectTypeKey:     model.BusinessProjectType,
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrProjectTemplateTypeMismatchError,
+		},
+
+		{
+			name:   "when the project type is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.ProjectPayloadScheme{Key: "DUMMY3"},
+			},
+			wantErr: true,
+			Err:     model.ErrProjectTypeKeyError,
+		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayloadError,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -611,6 +647,32 @@ func Test_internalProjectImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the project template doesn't belong to the project type",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "DUMMY3",
+				payload: &model.ProjectUpdateScheme{
+					ProjectTemplateKey: model.ITSMServiceManagementProjectTemplate,
+					ProjectTypeKey:     model.SoftwareProjectType,
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrProjectTemplateTypeMismatchError,
+		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "DUMMY3",
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayloadError,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1556,3 +1618,394 @@ func Test_NewProjectService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalProjectImpl_Hierarchy(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		projectID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.ProjectIssueTypeHierarchyScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10030,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/10030/hierarchy",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectIssueTypeHierarchyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectIssueTypeHierarchyScheme{},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10030,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/project/10030/hierarchy",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectIssueTypeHierarchyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectIssueTypeHierarchyScheme{},
+		},
+
+		{
+			name:   "when the hierarchy endpoint is no longer available",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10030,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/10030/hierarchy",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectIssueTypeHierarchyScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusNotFound}, model.ErrInvalidStatusCodeError).
+					Once()
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/project?projectId=10030",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						issueTypes := args.Get(1).(*[]*model.IssueTypeScheme)
+						*issueTypes = []*model.IssueTypeScheme{
+							{ID: "10002", Name: "Initiative", HierarchyLevel: 2},
+							{ID: "10001", Name: "Epic", HierarchyLevel: 1},
+							{ID: "10003", Name: "Story", HierarchyLevel: 0},
+							{ID: "10004", Name: "Task", HierarchyLevel: 0},
+							{ID: "10005", Name: "Sub-task", HierarchyLevel: -1},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectIssueTypeHierarchyScheme{
+				ProjectID: 10030,
+				Hierarchy: []*model.ProjectHierarchyScheme{
+					{Level: -1, Name: "Subtask", IssueTypes: []*model.ProjectHierarchyIssueTypeScheme{{ID: 10005, Name: "Sub-task"}}},
+					{Level: 0, Name: "Base", IssueTypes: []*model.ProjectHierarchyIssueTypeScheme{{ID: 10003, Name: "Story"}, {ID: 10004, Name: "Task"}}},
+					{Level: 1, Name: "Epic", IssueTypes: []*model.ProjectHierarchyIssueTypeScheme{{ID: 10001, Name: "Epic"}}},
+					{Level: 2, IssueTypes: []*model.ProjectHierarchyIssueTypeScheme{{ID: 10002, Name: "Initiative"}}},
+				},
+			},
+		},
+
+		{
+			name:   "when the hierarchy endpoint returns an error",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10030,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/10030/hierarchy",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectIssueTypeHierarchyScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusForbidden}, model.ErrInvalidStatusCodeError)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidStatusCodeError,
+		},
+
+		{
+			name:   "when the project id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10030,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/10030/hierarchy",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectService(testCase.fields.c, testCase.fields.version, &ProjectChildServices{})
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Hierarchy(testCase.args.ctx, testCase.args.projectID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
+func Test_internalProjectImpl_EpicLinkField(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrId string
+	}
+
+	mockProject := func(client *mocks.Client, version string, project *model.ProjectScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/project/KP",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.ProjectScheme{}).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.ProjectScheme) = *project
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the project is company-managed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockProject(client, "3", &model.ProjectScheme{Key: "KP", Style: "classic"})
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/field",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						fields := args.Get(1).(*[]*model.IssueFieldScheme)
+						*fields = []*model.IssueFieldScheme{
+							{ID: "summary", Schema: &model.IssueFieldSchemaScheme{System: "summary"}},
+							{ID: "customfield_10014", Schema: &model.IssueFieldSchemaScheme{Custom: "com.pyxis.greenhopper.jira:gh-epic-link"}},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: "customfield_10014",
+		},
+
+		{
+			name:   "when the project is team-managed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockProject(client, "2", &model.ProjectScheme{Key: "KP", Style: "next-gen", Simplified: true})
+
+				fields.c = client
+			},
+			want: "",
+		},
+
+		{
+			name:   "when the project id or key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKeyError,
+		},
+
+		{
+			name:   "when the fields cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockProject(client, "3", &model.ProjectScheme{Key: "KP", Style: "classic"})
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/field",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectService(testCase.fields.c, testCase.fields.version, &ProjectChildServices{})
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.EpicLinkField(testCase.args.ctx, testCase.args.projectKeyOrId)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
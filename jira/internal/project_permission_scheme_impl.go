@@ -74,7 +74,7 @@ func (i *internalProjectPermissionSchemeImpl) Get(ctx context.Context, projectKe
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.permission.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,7 +107,7 @@ func (i *internalProjectPermissionSchemeImpl) Assign(ctx context.Context, projec
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/permissionscheme", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.permission.assign", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,7 +129,7 @@ func (i *internalProjectPermissionSchemeImpl) SecurityLevels(ctx context.Context
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/securitylevel", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.permission.securityLevels", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
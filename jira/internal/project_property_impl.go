@@ -79,7 +79,7 @@ func (i *internalProjectPropertyImpl) Gets(ctx context.Context, projectKeyOrId s
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/properties", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.property.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,7 +105,7 @@ func (i *internalProjectPropertyImpl) Get(ctx context.Context, projectKeyOrId, p
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/properties/%v", i.version, projectKeyOrId, propertyKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.property.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -136,7 +136,7 @@ func (i *internalProjectPropertyImpl) Set(ctx context.Context, projectKeyOrId, p
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/properties/%v", i.version, projectKeyOrId, propertyKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.property.set", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +156,7 @@ func (i *internalProjectPropertyImpl) Delete(ctx context.Context, projectKeyOrId
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/properties/%v", i.version, projectKeyOrId, propertyKey)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.property.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +35,15 @@ func (p *ProjectRoleActorService) Add(ctx context.Context, projectKeyOrId string
 	return p.internalClient.Add(ctx, projectKeyOrId, roleId, accountIds, groups)
 }
 
+// AddActors adds actors to a project role for the project, the groups are identified by their names or by their ids.
+//
+// POST /rest/api/{2-3}/project/{projectIdOrKey}/role/{id}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/projects/roles/actors#add-actors-to-project-role
+func (p *ProjectRoleActorService) AddActors(ctx context.Context, projectKeyOrId string, roleId int, payload *model.ProjectRoleActorPayloadScheme) (*model.ProjectRoleScheme, *model.ResponseScheme, error) {
+	return p.internalClient.AddActors(ctx, projectKeyOrId, roleId, payload)
+}
+
 // Delete deletes actors from a project role for the project.
 //
 // DELETE /rest/api/{2-3}/project/{projectIdOrKey}/role/{id}
@@ -44,12 +53,25 @@ func (p *ProjectRoleActorService) Delete(ctx context.Context, projectKeyOrId str
 	return p.internalClient.Delete(ctx, projectKeyOrId, roleId, accountId, group)
 }
 
+// DeleteByGroupID deletes a group from a project role for the project, the group is identified by its id.
+//
+// DELETE /rest/api/{2-3}/project/{projectIdOrKey}/role/{id}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/projects/roles/actors#delete-actors-from-project-role
+func (p *ProjectRoleActorService) DeleteByGroupID(ctx context.Context, projectKeyOrId string, roleId int, groupId string) (*model.ResponseScheme, error) {
+	return p.internalClient.DeleteByGroupID(ctx, projectKeyOrId, roleId, groupId)
+}
+
 type internalProjectRoleActorImpl struct {
 	c       service.Client
 	version string
 }
 
 func (i *internalProjectRoleActorImpl) Add(ctx context.Context, projectKeyOrId string, roleId int, accountIds, groups []string) (*model.ProjectRoleScheme, *model.ResponseScheme, error) {
+	return i.AddActors(ctx, projectKeyOrId, roleId, &model.ProjectRoleActorPayloadScheme{Groups: groups, Users: accountIds})
+}
+
+func (i *internalProjectRoleActorImpl) AddActors(ctx context.Context, projectKeyOrId string, roleId int, payload *model.ProjectRoleActorPayloadScheme) (*model.ProjectRoleScheme, *model.ResponseScheme, error) {
 
 	if projectKeyOrId == "" {
 		return nil, nil, model.ErrNoProjectIDOrKeyError
@@ -59,22 +81,23 @@ func (i *internalProjectRoleActorImpl) Add(ctx context.Context, projectKeyOrId s
 		return nil, nil, model.ErrNoProjectRoleIDError
 	}
 
-	payload := struct {
-		Group []string `json:"group,omitempty"`
-		Users []string `json:"user,omitempty"`
-	}{
-		Group: groups,
-		Users: accountIds,
+	if payload == nil {
+		payload = &model.ProjectRoleActorPayloadScheme{}
+	}
+
+	// The endpoint doesn't accept the group names and the group ids on the same request
+	if len(payload.Groups) != 0 && len(payload.GroupIDs) != 0 {
+		return nil, nil, model.ErrGroupNameAndIDError
 	}
 
-	reader, err := i.c.TransformStructToReader(&payload)
+	reader, err := i.c.TransformStructToReader(payload)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/role/%v", i.version, projectKeyOrId, roleId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.role.actor.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,32 +113,49 @@ func (i *internalProjectRoleActorImpl) Add(ctx context.Context, projectKeyOrId s
 
 func (i *internalProjectRoleActorImpl) Delete(ctx context.Context, projectKeyOrId string, roleId int, accountId, group string) (*model.ResponseScheme, error) {
 
-	if projectKeyOrId == "" {
-		return nil, model.ErrNoProjectIDOrKeyError
+	params := url.Values{}
+
+	if len(accountId) != 0 {
+		params.Add("user", accountId)
 	}
 
-	if roleId == 0 {
-		return nil, model.ErrNoProjectRoleIDError
+	if len(group) != 0 {
+		params.Add("group", group)
 	}
 
-	var endpoint strings.Builder
-	endpoint.WriteString(fmt.Sprintf("rest/api/%v/project/%v/role/%v", i.version, projectKeyOrId, roleId))
+	return i.deleteActors(ctx, projectKeyOrId, roleId, params)
+}
+
+func (i *internalProjectRoleActorImpl) DeleteByGroupID(ctx context.Context, projectKeyOrId string, roleId int, groupId string) (*model.ResponseScheme, error) {
+
+	if groupId == "" {
+		return nil, model.ErrNoGroupIDError
+	}
 
 	params := url.Values{}
+	params.Add("groupId", groupId)
 
-	if len(accountId) != 0 {
-		params.Add("user", accountId)
+	return i.deleteActors(ctx, projectKeyOrId, roleId, params)
+}
+
+func (i *internalProjectRoleActorImpl) deleteActors(ctx context.Context, projectKeyOrId string, roleId int, params url.Values) (*model.ResponseScheme, error) {
+
+	if projectKeyOrId == "" {
+		return nil, model.ErrNoProjectIDOrKeyError
 	}
 
-	if len(group) != 0 {
-		params.Add("group", group)
+	if roleId == 0 {
+		return nil, model.ErrNoProjectRoleIDError
 	}
 
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/project/%v/role/%v", i.version, projectKeyOrId, roleId))
+
 	if params.Encode() != "" {
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.role.actor.delete", http.MethodDelete, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
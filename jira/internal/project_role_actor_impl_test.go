@@ -14,10 +14,7 @@ import (
 
 func Test_internalProjectRoleActorImpl_Add(t *testing.T) {
 
-	payloadMocked := &struct {
-		Group []string "json:\"group,omitempty\""
-		Users []string "json:\"user,omitempty\""
-	}{Group: []string{"jira-users"}, Users: []string{"uuid"}}
+	payloadMocked := &model.ProjectRoleActorPayloadScheme{Groups: []string{"jira-users"}, Users: []string{"uuid"}}
 
 	type fields struct {
 		c       service.Client
@@ -362,6 +359,217 @@ func Test_internalProjectRoleActorImpl_Delete(t *testing.T) {
 	}
 }
 
+func Test_internalProjectRoleActorImpl_AddActors(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrId string
+		roleId         int
+		payload        *model.ProjectRoleActorPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the groups are identified by their ids",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "DUMMY",
+				roleId:         10001,
+				payload:        &model.ProjectRoleActorPayloadScheme{GroupIDs: []string{"276f955c-63d7-42c8-9520-92d01dca0625"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.ProjectRoleActorPayloadScheme{GroupIDs: []string{"276f955c-63d7-42c8-9520-92d01dca0625"}}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/project/DUMMY/role/10001",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectRoleScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the group names and ids are provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "DUMMY",
+				roleId:         10001,
+				payload: &model.ProjectRoleActorPayloadScheme{
+					Groups:   []string{"jira-users"},
+					GroupIDs: []string{"276f955c-63d7-42c8-9520-92d01dca0625"},
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrGroupNameAndIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectRoleActorService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.AddActors(testCase.args.ctx, testCase.args.projectKeyOrId, testCase.args.roleId,
+				testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectRoleActorImpl_DeleteByGroupID(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrId string
+		roleId         int
+		groupId        string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "DUMMY",
+				roleId:         10001,
+				groupId:        "276f955c-63d7-42c8-9520-92d01dca0625",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/project/DUMMY/role/10001?groupId=276f955c-63d7-42c8-9520-92d01dca0625",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the group id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "DUMMY",
+				roleId:         10001,
+			},
+			wantErr: true,
+			Err:     model.ErrNoGroupIDError,
+		},
+
+		{
+			name:   "when the project role id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrId: "DUMMY",
+				groupId:        "276f955c-63d7-42c8-9520-92d01dca0625",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectRoleIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectRoleActorService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := newService.DeleteByGroupID(testCase.args.ctx, testCase.args.projectKeyOrId, testCase.args.roleId,
+				testCase.args.groupId)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
 func Test_NewProjectRoleActorService(t *testing.T) {
 
 	type args struct {
@@ -88,7 +88,7 @@ func (i *internalProjectRoleImpl) Gets(ctx context.Context, projectKeyOrId strin
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/role", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.role.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,7 +133,7 @@ func (i *internalProjectRoleImpl) Get(ctx context.Context, projectKeyOrId string
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/role/%v", i.version, projectKeyOrId, roleId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.role.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,7 +155,7 @@ func (i *internalProjectRoleImpl) Details(ctx context.Context, projectKeyOrId st
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/roledetails", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.role.details", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -173,7 +173,7 @@ func (i *internalProjectRoleImpl) Global(ctx context.Context) ([]*model.ProjectR
 
 	endpoint := fmt.Sprintf("rest/api/%v/role", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.role.global", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -196,7 +196,7 @@ func (i *internalProjectRoleImpl) Create(ctx context.Context, payload *model.Pro
 
 	endpoint := fmt.Sprintf("rest/api/%v/role", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.role.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira/jql"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+)
+
+func (i *internalProjectComponentImpl) Issues(ctx context.Context, componentId string, onlyUnresolved bool, options *model.ProjectUsageOptionsScheme) (
+	[]*model.ProjectUsageIssueScheme, *model.ResponseScheme, error) {
+
+	if componentId == "" {
+		return nil, nil, model.ErrNoComponentIDError
+	}
+
+	query := jql.Equals("component", componentId)
+	if onlyUnresolved {
+		query = query.And(jql.Is("resolution", jql.Empty))
+	}
+
+	return searchUsageIssues(ctx, i.c, i.version, query.OrderBy("key"), options)
+}
+
+func (i *internalProjectVersionImpl) Issues(ctx context.Context, versionId, field string, options *model.ProjectUsageOptionsScheme) (
+	[]*model.ProjectUsageIssueScheme, *model.ResponseScheme, error) {
+
+	if versionId == "" {
+		return nil, nil, model.ErrNoVersionIDError
+	}
+
+	switch field {
+	case "":
+		field = model.ProjectVersionFixField
+	case model.ProjectVersionFixField, model.ProjectVersionAffectedField:
+	default:
+		return nil, nil, model.ErrInvalidVersionFieldError
+	}
+
+	return searchUsageIssues(ctx, i.c, i.version, jql.Equals(field, versionId).OrderBy("key"), options)
+}
+
+// searchUsageIssues pages through the issues matching the query, returning their summary, status and the projected fields.
+func searchUsageIssues(ctx context.Context, client service.Client, version string, query *jql.Query, options *model.ProjectUsageOptionsScheme) (
+	[]*model.ProjectUsageIssueScheme, *model.ResponseScheme, error) {
+
+	fields := []string{"summary", "status"}
+	searchOptions := &model.IssueSearchIntoOptionsScheme{}
+
+	if options != nil {
+		fields = append(fields, options.Fields...)
+		searchOptions.MaxResults = options.MaxResults
+		searchOptions.Limit = options.Limit
+	}
+
+	var issues []*model.ProjectUsageIssueScheme
+	response, err := searchInto(ctx, client, version, query.String(), fields, &issues, searchOptions)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issues, response, nil
+}
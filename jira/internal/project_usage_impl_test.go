@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// mockUsageSearch mocks a single page search, storing the JQL and the fields of the search payload
+func mockUsageSearch(client *mocks.Client, jql *string, fields *[]string) {
+
+	client.On("TransformStructToReader",
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			payload := reflect.ValueOf(args.Get(0)).Elem()
+			*jql = payload.FieldByName("Jql").String()
+			*fields = payload.FieldByName("Fields").Interface().([]string)
+		}).
+		Return(bytes.NewReader(nil), nil)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"rest/api/3/search",
+		bytes.NewReader(nil)).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			page := `{"startAt":0,"maxResults":50,"total":1,"issues":[
+				{"id":"10001","key":"DUMMY-1","fields":{"summary":"First","status":{"name":"Open"},"priority":{"name":"High"}}}]}`
+
+			if err := json.Unmarshal([]byte(page), args.Get(1)); err != nil {
+				panic(err)
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+func Test_internalProjectComponentImpl_Issues(t *testing.T) {
+
+	t.Run("when the unresolved issues are returned", func(t *testing.T) {
+
+		var jql string
+		var fields []string
+
+		client := mocks.NewClient(t)
+		mockUsageSearch(client, &jql, &fields)
+
+		service, err := NewProjectComponentService(client, "3")
+		assert.NoError(t, err)
+
+		issues, _, err := service.Issues(context.Background(), "10000", true, &model.ProjectUsageOptionsScheme{Fields: []string{"priority"}})
+		assert.NoError(t, err)
+
+		assert.Equal(t, `component = "10000" AND resolution IS EMPTY ORDER BY key`, jql)
+		assert.Equal(t, []string{"summary", "status", "priority"}, fields)
+
+		assert.Len(t, issues, 1)
+		assert.Equal(t, "DUMMY-1", issues[0].Key)
+		assert.Equal(t, "First", issues[0].Summary)
+		assert.Equal(t, "Open", issues[0].Status.Name)
+		assert.Equal(t, map[string]interface{}{"name": "High"}, issues[0].Fields["priority"])
+	})
+
+	t.Run("when the component id is not provided", func(t *testing.T) {
+
+		service, err := NewProjectComponentService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = service.Issues(context.Background(), "", false, nil)
+		assert.Equal(t, model.ErrNoComponentIDError, err)
+	})
+}
+
+func Test_internalProjectVersionImpl_Issues(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		field   string
+		wantJQL string
+	}{
+		{
+			name:    "when the field is not provided",
+			wantJQL: `fixVersion = "10000" ORDER BY key`,
+		},
+
+		{
+			name:    "when the affected version field is provided",
+			field:   model.ProjectVersionAffectedField,
+			wantJQL: `affectedVersion = "10000" ORDER BY key`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var jql string
+			var fields []string
+
+			client := mocks.NewClient(t)
+			mockUsageSearch(client, &jql, &fields)
+
+			service, err := NewProjectVersionService(client, "3")
+			assert.NoError(t, err)
+
+			issues, _, err := service.Issues(context.Background(), "10000", testCase.field, nil)
+			assert.NoError(t, err)
+			assert.Len(t, issues, 1)
+
+			assert.Equal(t, testCase.wantJQL, jql)
+			assert.Equal(t, []string{"summary", "status"}, fields)
+		})
+	}
+
+	t.Run("when the field is not supported", func(t *testing.T) {
+
+		service, err := NewProjectVersionService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = service.Issues(context.Background(), "10000", "customfield_10010", nil)
+		assert.Equal(t, model.ErrInvalidVersionFieldError, err)
+	})
+}
@@ -76,7 +76,7 @@ func (i *internalProjectValidatorImpl) Validate(ctx context.Context, key string)
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectvalidate/key?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.validator.validate", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,7 +101,7 @@ func (i *internalProjectValidatorImpl) Key(ctx context.Context, key string) (str
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectvalidate/validProjectKey?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.validator.key", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -125,7 +125,7 @@ func (i *internalProjectValidatorImpl) Name(ctx context.Context, name string) (s
 
 	endpoint := fmt.Sprintf("rest/api/%v/projectvalidate/validProjectName?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.validator.name", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func NewProjectVersionService(client service.Client, version string) (*ProjectVersionService, error) {
@@ -76,17 +77,53 @@ func (p *ProjectVersionService) Update(ctx context.Context, versionId string, pa
 	return p.internalClient.Update(ctx, versionId, payload)
 }
 
+// Move modifies the version's sequence within the project, which affects the display order of the versions in Jira.
+//
+// Either after, the self URL of the version placed before the version, or the position is set.
+// The position is one of First, Last, Earlier or Later.
+//
+// POST /rest/api/{2-3}/version/{id}/move
+//
+// TODO: the documentation needs to be created
+func (p *ProjectVersionService) Move(ctx context.Context, versionId, after, position string) (*model.VersionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Move(ctx, versionId, after, position)
+}
+
+// Release releases a version with today as the release date.
+//
+// The unresolved issues of the version are counted and returned, they're moved to the moveUnfixedIssuesTo version
+// when it's set, otherwise they're left on the released version.
+//
+// PUT /rest/api/{2-3}/version/{id}
+//
+// TODO: the documentation needs to be created
+func (p *ProjectVersionService) Release(ctx context.Context, versionId, moveUnfixedIssuesTo string) (*model.VersionReleaseScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Release(ctx, versionId, moveUnfixedIssuesTo)
+}
+
 // Merge merges two project versions.
 //
 // The merge is completed by deleting the version specified in id and replacing any occurrences of
 //
 // its ID in fixVersion with the version ID specified in moveIssuesTo.
 //
+// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+//
 // PUT /rest/api/{2-3}/version/{id}/mergeto/{moveIssuesTo}
 func (p *ProjectVersionService) Merge(ctx context.Context, versionId, versionMoveIssuesTo string) (*model.ResponseScheme, error) {
 	return p.internalClient.Merge(ctx, versionId, versionMoveIssuesTo)
 }
 
+// PreviewDelete returns the issue counts of a version deleted by a merge, and the token confirming the merge
+// when the client is on protected mode.
+//
+// GET /rest/api/{2-3}/version/{id}/relatedIssueCounts
+//
+// TODO: the documentation needs to be created
+func (p *ProjectVersionService) PreviewDelete(ctx context.Context, versionId string) (*model.VersionDeletionPreviewScheme, *model.ResponseScheme, error) {
+	return p.internalClient.PreviewDelete(ctx, versionId)
+}
+
 // RelatedIssueCounts returns the following counts for a version:
 //
 // 1. Number of issues where the fixVersion is set to the version.
@@ -111,6 +148,52 @@ func (p *ProjectVersionService) UnresolvedIssueCount(ctx context.Context, versio
 	return p.internalClient.UnresolvedIssueCount(ctx, versionId)
 }
 
+// RelatedWorks returns the related work items linked to a version.
+//
+// GET /rest/api/{2-3}/version/{id}/relatedwork
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (p *ProjectVersionService) RelatedWorks(ctx context.Context, versionId string) ([]*model.VersionRelatedWorkScheme, *model.ResponseScheme, error) {
+	return p.internalClient.RelatedWorks(ctx, versionId)
+}
+
+// CreateRelatedWork creates a related work item for the given version.
+//
+// POST /rest/api/{2-3}/version/{id}/relatedwork
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (p *ProjectVersionService) CreateRelatedWork(ctx context.Context, versionId string, payload *model.VersionRelatedWorkScheme) (*model.VersionRelatedWorkScheme, *model.ResponseScheme, error) {
+	return p.internalClient.CreateRelatedWork(ctx, versionId, payload)
+}
+
+// UpdateRelatedWork updates the given related work item, the payload must contain the related work id.
+//
+// PUT /rest/api/{2-3}/version/{id}/relatedwork
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (p *ProjectVersionService) UpdateRelatedWork(ctx context.Context, versionId string, payload *model.VersionRelatedWorkScheme) (*model.VersionRelatedWorkScheme, *model.ResponseScheme, error) {
+	return p.internalClient.UpdateRelatedWork(ctx, versionId, payload)
+}
+
+// DeleteRelatedWork deletes the given related work item from the given version.
+//
+// DELETE /rest/api/{2-3}/version/{versionId}/relatedwork/{relatedWorkId}
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (p *ProjectVersionService) DeleteRelatedWork(ctx context.Context, versionId, relatedWorkId string) (*model.ResponseScheme, error) {
+	return p.internalClient.DeleteRelatedWork(ctx, versionId, relatedWorkId)
+}
+
+// Issues returns the issues referencing the version on the field, fixVersion or affectedVersion,
+// running a paginated issue search. The fixVersion field is used when the field is not set.
+//
+// The summary and the status of the issues are always returned, the other fields are set on the options.
+//
+// POST /rest/api/{2-3}/search
+func (p *ProjectVersionService) Issues(ctx context.Context, versionId, field string, options *model.ProjectUsageOptionsScheme) ([]*model.ProjectUsageIssueScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Issues(ctx, versionId, field, options)
+}
+
 type internalProjectVersionImpl struct {
 	c       service.Client
 	version string
@@ -124,7 +207,7 @@ func (i *internalProjectVersionImpl) Gets(ctx context.Context, projectKeyOrId st
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/versions", i.version, projectKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,7 +252,7 @@ func (i *internalProjectVersionImpl) Search(ctx context.Context, projectKeyOrId
 
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v/version?%v", i.version, projectKeyOrId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -192,7 +275,7 @@ func (i *internalProjectVersionImpl) Create(ctx context.Context, payload *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/version", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -223,7 +306,7 @@ func (i *internalProjectVersionImpl) Get(ctx context.Context, versionId string,
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -250,7 +333,7 @@ func (i *internalProjectVersionImpl) Update(ctx context.Context, versionId strin
 
 	endpoint := fmt.Sprintf("rest/api/%v/version/%v", i.version, versionId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -264,6 +347,76 @@ func (i *internalProjectVersionImpl) Update(ctx context.Context, versionId strin
 	return version, response, nil
 }
 
+func (i *internalProjectVersionImpl) Move(ctx context.Context, versionId, after, position string) (*model.VersionScheme, *model.ResponseScheme, error) {
+
+	if versionId == "" {
+		return nil, nil, model.ErrNoVersionIDError
+	}
+
+	if (after == "") == (position == "") {
+		return nil, nil, model.ErrInvalidVersionMoveError
+	}
+
+	switch position {
+	case "", model.VersionPositionFirst, model.VersionPositionLast, model.VersionPositionEarlier, model.VersionPositionLater:
+	default:
+		return nil, nil, fmt.Errorf("%w: %v", model.ErrInvalidVersionPositionError, position)
+	}
+
+	reader, err := i.c.TransformStructToReader(&model.VersionMovePayloadScheme{After: after, Position: position})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/version/%v/move", i.version, versionId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.move", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(model.VersionScheme)
+	response, err := i.c.Call(request, version)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return version, response, nil
+}
+
+func (i *internalProjectVersionImpl) Release(ctx context.Context, versionId, moveUnfixedIssuesTo string) (*model.VersionReleaseScheme, *model.ResponseScheme, error) {
+
+	if versionId == "" {
+		return nil, nil, model.ErrNoVersionIDError
+	}
+
+	counts, response, err := i.UnresolvedIssueCount(ctx, versionId)
+	if err != nil {
+		return nil, response, err
+	}
+
+	release := &model.VersionReleaseScheme{UnresolvedIssues: counts.IssuesUnresolvedCount}
+	payload := &model.VersionPayloadScheme{Released: true, ReleaseDate: time.Now().Format("2006-01-02")}
+
+	// The update moves the unresolved issues by the self URL of the target version
+	if moveUnfixedIssuesTo != "" && counts.IssuesUnresolvedCount > 0 {
+
+		release.MovedTo, response, err = i.Get(ctx, moveUnfixedIssuesTo, nil)
+		if err != nil {
+			return nil, response, err
+		}
+
+		payload.MoveUnfixedIssuesTo = release.MovedTo.Self
+	}
+
+	release.Version, response, err = i.Update(ctx, versionId, payload)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return release, response, nil
+}
+
 func (i *internalProjectVersionImpl) Merge(ctx context.Context, versionId, versionMoveIssuesTo string) (*model.ResponseScheme, error) {
 
 	if versionId == "" {
@@ -274,9 +427,13 @@ func (i *internalProjectVersionImpl) Merge(ctx context.Context, versionId, versi
 		return nil, model.ErrNoVersionIDError
 	}
 
+	if err := checkConfirmation(ctx, i.c, confirmVersionDeletion, versionId); err != nil {
+		return nil, err
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/version/%v/mergeto/%v", i.version, versionId, versionMoveIssuesTo)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.merge", http.MethodPut, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +449,7 @@ func (i *internalProjectVersionImpl) RelatedIssueCounts(ctx context.Context, ver
 
 	endpoint := fmt.Sprintf("rest/api/%v/version/%v/relatedIssueCounts", i.version, versionId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.relatedIssueCounts", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -306,6 +463,21 @@ func (i *internalProjectVersionImpl) RelatedIssueCounts(ctx context.Context, ver
 	return issues, response, nil
 }
 
+func (i *internalProjectVersionImpl) PreviewDelete(ctx context.Context, versionId string) (*model.VersionDeletionPreviewScheme, *model.ResponseScheme, error) {
+
+	counts, response, err := i.RelatedIssueCounts(ctx, versionId)
+	if err != nil {
+		return nil, response, err
+	}
+
+	confirmation, err := issueConfirmation(i.c, confirmVersionDeletion, versionId)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return &model.VersionDeletionPreviewScheme{Counts: counts, Confirmation: confirmation}, response, nil
+}
+
 func (i *internalProjectVersionImpl) UnresolvedIssueCount(ctx context.Context, versionId string) (*model.VersionUnresolvedIssuesCountScheme, *model.ResponseScheme, error) {
 
 	if versionId == "" {
@@ -314,7 +486,7 @@ func (i *internalProjectVersionImpl) UnresolvedIssueCount(ctx context.Context, v
 
 	endpoint := fmt.Sprintf("rest/api/%v/version/%v/unresolvedIssueCount", i.version, versionId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.unresolvedIssueCount", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -327,3 +499,103 @@ func (i *internalProjectVersionImpl) UnresolvedIssueCount(ctx context.Context, v
 
 	return issues, response, nil
 }
+
+func (i *internalProjectVersionImpl) RelatedWorks(ctx context.Context, versionId string) ([]*model.VersionRelatedWorkScheme, *model.ResponseScheme, error) {
+
+	if versionId == "" {
+		return nil, nil, model.ErrNoVersionIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/version/%v/relatedwork", i.version, versionId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.relatedWorks", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var works []*model.VersionRelatedWorkScheme
+	response, err := i.c.Call(request, &works)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return works, response, nil
+}
+
+func (i *internalProjectVersionImpl) CreateRelatedWork(ctx context.Context, versionId string, payload *model.VersionRelatedWorkScheme) (*model.VersionRelatedWorkScheme, *model.ResponseScheme, error) {
+
+	if versionId == "" {
+		return nil, nil, model.ErrNoVersionIDError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/version/%v/relatedwork", i.version, versionId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.createRelatedWork", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	work := new(model.VersionRelatedWorkScheme)
+	response, err := i.c.Call(request, work)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return work, response, nil
+}
+
+func (i *internalProjectVersionImpl) UpdateRelatedWork(ctx context.Context, versionId string, payload *model.VersionRelatedWorkScheme) (*model.VersionRelatedWorkScheme, *model.ResponseScheme, error) {
+
+	if versionId == "" {
+		return nil, nil, model.ErrNoVersionIDError
+	}
+
+	if payload == nil || payload.RelatedWorkID == "" {
+		return nil, nil, model.ErrNoVersionRelatedWorkIDError
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/version/%v/relatedwork", i.version, versionId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.updateRelatedWork", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	work := new(model.VersionRelatedWorkScheme)
+	response, err := i.c.Call(request, work)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return work, response, nil
+}
+
+func (i *internalProjectVersionImpl) DeleteRelatedWork(ctx context.Context, versionId, relatedWorkId string) (*model.ResponseScheme, error) {
+
+	if versionId == "" {
+		return nil, model.ErrNoVersionIDError
+	}
+
+	if relatedWorkId == "" {
+		return nil, model.ErrNoVersionRelatedWorkIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/version/%v/relatedwork/%v", i.version, versionId, relatedWorkId)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "project.version.deleteRelatedWork", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func Test_internalProjectVersionImpl_Gets(t *testing.T) {
@@ -1301,3 +1303,991 @@ func Test_NewProjectVersionService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalProjectVersionImpl_RelatedWorks(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		versionId string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/version/10391/relatedwork",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/version/10391/relatedwork",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the project version is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/version/10391/relatedwork",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			versionService, err := NewProjectVersionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := versionService.RelatedWorks(testCase.args.ctx, testCase.args.versionId)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectVersionImpl_CreateRelatedWork(t *testing.T) {
+
+	payloadMocked := &model.VersionRelatedWorkScheme{
+		Category: "Design",
+		Title:    "Design link",
+		URL:      "https://www.atlassian.com",
+	}
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		versionId string
+		payload   *model.VersionRelatedWorkScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/version/10391/relatedwork",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.VersionRelatedWorkScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/version/10391/relatedwork",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.VersionRelatedWorkScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the project version is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionIDError,
+		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					(*model.VersionRelatedWorkScheme)(nil)).
+					Return(nil, model.ErrNilPayloadError)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayloadError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/version/10391/relatedwork",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			versionService, err := NewProjectVersionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := versionService.CreateRelatedWork(testCase.args.ctx, testCase.args.versionId,
+				testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectVersionImpl_UpdateRelatedWork(t *testing.T) {
+
+	payloadMocked := &model.VersionRelatedWorkScheme{
+		RelatedWorkID: "fabcdef6-7878-1234-beaf-43211234abcd",
+		Category:      "Design",
+		Title:         "Design link",
+		URL:           "https://www.atlassian.com",
+	}
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		versionId string
+		payload   *model.VersionRelatedWorkScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/version/10391/relatedwork",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.VersionRelatedWorkScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/version/10391/relatedwork",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.VersionRelatedWorkScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the project version is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionIDError,
+		},
+
+		{
+			name:   "when the related work id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   &model.VersionRelatedWorkScheme{Title: "Design link"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionRelatedWorkIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				payload:   payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/version/10391/relatedwork",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			versionService, err := NewProjectVersionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := versionService.UpdateRelatedWork(testCase.args.ctx, testCase.args.versionId,
+				testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectVersionImpl_DeleteRelatedWork(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                      context.Context
+		versionId, relatedWorkId string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				versionId:     "10391",
+				relatedWorkId: "fabcdef6-7878-1234-beaf-43211234abcd",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/version/10391/relatedwork/fabcdef6-7878-1234-beaf-43211234abcd",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:           context.Background(),
+				versionId:     "10391",
+				relatedWorkId: "fabcdef6-7878-1234-beaf-43211234abcd",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/2/version/10391/relatedwork/fabcdef6-7878-1234-beaf-43211234abcd",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the project version is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionIDError,
+		},
+
+		{
+			name:   "when the related work id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionRelatedWorkIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				versionId:     "10391",
+				relatedWorkId: "fabcdef6-7878-1234-beaf-43211234abcd",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/version/10391/relatedwork/fabcdef6-7878-1234-beaf-43211234abcd",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			versionService, err := NewProjectVersionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := versionService.DeleteRelatedWork(testCase.args.ctx, testCase.args.versionId,
+				testCase.args.relatedWorkId)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalProjectVersionImpl_Move(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                        context.Context
+		versionId, after, position string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the version is moved to a position",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				position:  model.VersionPositionFirst,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.VersionMovePayloadScheme{Position: "First"}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/version/10391/move",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.VersionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the version is moved after another version",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				after:     "https://ctreminiom.atlassian.net/rest/api/2/version/10390",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.VersionMovePayloadScheme{After: "https://ctreminiom.atlassian.net/rest/api/2/version/10390"}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/version/10391/move",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.VersionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the position is not valid",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				position:  "Middle",
+			},
+			wantErr: true,
+			Err:     fmt.Errorf("%w: Middle", model.ErrInvalidVersionPositionError),
+		},
+
+		{
+			name:   "when both the after version and the position are provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				after:     "https://ctreminiom.atlassian.net/rest/api/3/version/10390",
+				position:  model.VersionPositionLast,
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidVersionMoveError,
+		},
+
+		{
+			name:   "when neither the after version nor the position are provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidVersionMoveError,
+		},
+
+		{
+			name:   "when the version id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				position: model.VersionPositionLater,
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionIDError,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				versionId: "10391",
+				position:  model.VersionPositionEarlier,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.VersionMovePayloadScheme{Position: "Earlier"}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/version/10391/move",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.VersionScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			versionService, err := NewProjectVersionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := versionService.Move(testCase.args.ctx, testCase.args.versionId, testCase.args.after,
+				testCase.args.position)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalProjectVersionImpl_Release(t *testing.T) {
+
+	today := time.Now().Format("2006-01-02")
+
+	// mockUnresolvedIssueCount mocks the unresolved issues of the released version
+	mockUnresolvedIssueCount := func(client *mocks.Client, unresolved int) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/version/10391/unresolvedIssueCount",
+			nil).
+			Return(&http.Request{RequestURI: "count"}, nil).Once()
+
+		client.On("Call",
+			&http.Request{RequestURI: "count"},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.VersionUnresolvedIssuesCountScheme).IssuesUnresolvedCount = unresolved
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+	}
+
+	// mockReleaseUpdate mocks the update releasing the version
+	mockReleaseUpdate := func(client *mocks.Client, moveUnfixedIssuesTo string) {
+
+		client.On("TransformStructToReader",
+			&model.VersionPayloadScheme{Released: true, ReleaseDate: today, MoveUnfixedIssuesTo: moveUnfixedIssuesTo}).
+			Return(bytes.NewReader([]byte{}), nil).Once()
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"rest/api/3/version/10391",
+			bytes.NewReader([]byte{})).
+			Return(&http.Request{RequestURI: "update"}, nil).Once()
+
+		client.On("Call",
+			&http.Request{RequestURI: "update"},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.VersionScheme).Released = true
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+	}
+
+	t.Run("when the unresolved issues are moved to another version", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockUnresolvedIssueCount(client, 4)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/version/10392",
+			nil).
+			Return(&http.Request{RequestURI: "target"}, nil).Once()
+
+		client.On("Call",
+			&http.Request{RequestURI: "target"},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.VersionScheme).Self = "https://ctreminiom.atlassian.net/rest/api/3/version/10392"
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+
+		mockReleaseUpdate(client, "https://ctreminiom.atlassian.net/rest/api/3/version/10392")
+
+		versionService, err := NewProjectVersionService(client, "3")
+		assert.NoError(t, err)
+
+		release, _, err := versionService.Release(context.Background(), "10391", "10392")
+		assert.NoError(t, err)
+		assert.True(t, release.Version.Released)
+		assert.Equal(t, 4, release.UnresolvedIssues)
+		assert.Equal(t, "https://ctreminiom.atlassian.net/rest/api/3/version/10392", release.MovedTo.Self)
+	})
+
+	t.Run("when the unresolved issues are left on the version", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockUnresolvedIssueCount(client, 2)
+		mockReleaseUpdate(client, "")
+
+		versionService, err := NewProjectVersionService(client, "3")
+		assert.NoError(t, err)
+
+		release, _, err := versionService.Release(context.Background(), "10391", "")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, release.UnresolvedIssues)
+		assert.Nil(t, release.MovedTo)
+	})
+
+	t.Run("when the version has no unresolved issues", func(t *testing.T) {
+
+		// The target version isn't fetched when there's nothing to move
+		client := mocks.NewClient(t)
+		mockUnresolvedIssueCount(client, 0)
+		mockReleaseUpdate(client, "")
+
+		versionService, err := NewProjectVersionService(client, "3")
+		assert.NoError(t, err)
+
+		release, _, err := versionService.Release(context.Background(), "10391", "10392")
+		assert.NoError(t, err)
+		assert.Nil(t, release.MovedTo)
+	})
+
+	t.Run("when the version id is not provided", func(t *testing.T) {
+
+		versionService, err := NewProjectVersionService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = versionService.Release(context.Background(), "", "10392")
+		assert.EqualError(t, err, model.ErrNoVersionIDError.Error())
+	})
+}
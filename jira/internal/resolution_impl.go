@@ -52,7 +52,7 @@ func (i *internalResolutionImpl) Gets(ctx context.Context) ([]*model.ResolutionS
 
 	endpoint := fmt.Sprintf("rest/api/%v/resolution", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.resolution.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,7 +74,7 @@ func (i *internalResolutionImpl) Get(ctx context.Context, resolutionId string) (
 
 	endpoint := fmt.Sprintf("rest/api/%v/resolution/%v", i.version, resolutionId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.resolution.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
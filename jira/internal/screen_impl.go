@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 func NewScreenService(client service.Client, version string, scheme *ScreenSchemeService, tab *ScreenTabService) (*ScreenService, error) {
@@ -32,11 +33,13 @@ type ScreenService struct {
 
 // Fields returns a paginated list of the screens a field is used in.
 //
+// Use the "tab" expand to include the screen tabs the field is used in.
+//
 // GET /rest/api/{2-3}/field/{fieldId}/screens
 //
 // https://docs.go-atlassian.io/jira-software-cloud/screens#get-screens-for-a-field
-func (s *ScreenService) Fields(ctx context.Context, fieldId string, startAt, maxResults int) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error) {
-	return s.internalClient.Fields(ctx, fieldId, startAt, maxResults)
+func (s *ScreenService) Fields(ctx context.Context, fieldId string, startAt, maxResults int, expand []string) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Fields(ctx, fieldId, startAt, maxResults, expand)
 }
 
 // Gets returns a paginated list of all screens or those specified by one or more screen IDs.
@@ -101,7 +104,7 @@ type internalScreenImpl struct {
 	version string
 }
 
-func (i *internalScreenImpl) Fields(ctx context.Context, fieldId string, startAt, maxResults int) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error) {
+func (i *internalScreenImpl) Fields(ctx context.Context, fieldId string, startAt, maxResults int, expand []string) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error) {
 
 	if fieldId == "" {
 		return nil, nil, model.ErrNoFieldIDError
@@ -111,9 +114,13 @@ func (i *internalScreenImpl) Fields(ctx context.Context, fieldId string, startAt
 	params.Add("startAt", strconv.Itoa(startAt))
 	params.Add("maxResults", strconv.Itoa(maxResults))
 
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/screens?%v", i.version, fieldId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.fields", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -139,7 +146,7 @@ func (i *internalScreenImpl) Gets(ctx context.Context, screenIds []int, startAt,
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -174,7 +181,7 @@ func (i *internalScreenImpl) Create(ctx context.Context, name, description strin
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -196,7 +203,7 @@ func (i *internalScreenImpl) AddToDefault(ctx context.Context, fieldId string) (
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/addToDefault/%v", i.version, fieldId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.addToDefault", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -225,7 +232,7 @@ func (i *internalScreenImpl) Update(ctx context.Context, screenId int, name, des
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v", i.version, screenId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -247,7 +254,7 @@ func (i *internalScreenImpl) Delete(ctx context.Context, screenId int) (*model.R
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v", i.version, screenId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -263,7 +270,7 @@ func (i *internalScreenImpl) Available(ctx context.Context, screenId int) ([]*mo
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/availableFields", i.version, screenId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.available", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -24,6 +24,7 @@ func Test_internalScreenImpl_Fields(t *testing.T) {
 		ctx                 context.Context
 		fieldId             string
 		startAt, maxResults int
+		expand              []string
 	}
 
 	testCases := []struct {
@@ -42,6 +43,7 @@ func Test_internalScreenImpl_Fields(t *testing.T) {
 				fieldId:    "customfield_12000",
 				startAt:    100,
 				maxResults: 50,
+				expand:     []string{"tab"},
 			},
 			on: func(fields *fields) {
 
@@ -50,7 +52,7 @@ func Test_internalScreenImpl_Fields(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/3/field/customfield_12000/screens?maxResults=50&startAt=100",
+					"rest/api/3/field/customfield_12000/screens?expand=tab&maxResults=50&startAt=100",
 					nil).
 					Return(&http.Request{}, nil)
 
@@ -144,7 +146,7 @@ func Test_internalScreenImpl_Fields(t *testing.T) {
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := resolutionService.Fields(testCase.args.ctx, testCase.args.fieldId, testCase.args.startAt,
-				testCase.args.maxResults)
+				testCase.args.maxResults, testCase.args.expand)
 
 			if testCase.wantErr {
 
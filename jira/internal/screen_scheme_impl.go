@@ -99,7 +99,7 @@ func (i *internalScreenSchemeImpl) Gets(ctx context.Context, options *model.Scre
 
 	endpoint := fmt.Sprintf("rest/api/%v/screenscheme?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.scheme.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +122,7 @@ func (i *internalScreenSchemeImpl) Create(ctx context.Context, payload *model.Sc
 
 	endpoint := fmt.Sprintf("rest/api/%v/screenscheme", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.scheme.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -149,7 +149,7 @@ func (i *internalScreenSchemeImpl) Update(ctx context.Context, screenSchemeId st
 
 	endpoint := fmt.Sprintf("rest/api/%v/screenscheme/%v", i.version, screenSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.scheme.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +165,7 @@ func (i *internalScreenSchemeImpl) Delete(ctx context.Context, screenSchemeId st
 
 	endpoint := fmt.Sprintf("rest/api/%v/screenscheme/%v", i.version, screenSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.scheme.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +79,7 @@ func (i *internalScreenTabFieldImpl) Gets(ctx context.Context, screenId, tabId i
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs/%v/fields", i.version, screenId, tabId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.field.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -120,7 +120,7 @@ func (i *internalScreenTabFieldImpl) Add(ctx context.Context, screenId, tabId in
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs/%v/fields", i.version, screenId, tabId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.field.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -150,7 +150,7 @@ func (i *internalScreenTabFieldImpl) Remove(ctx context.Context, screenId, tabId
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs/%v/fields/%v", i.version, screenId, tabId, fieldId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.field.remove", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +187,7 @@ func (i *internalScreenTabFieldImpl) Move(ctx context.Context, screenId, tabId i
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs/%v/fields/%v/move", i.version, screenId, tabId, fieldId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.field.move", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +95,7 @@ func (i *internalScreenTabImpl) Gets(ctx context.Context, screenId int, projectK
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.gets", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,7 +132,7 @@ func (i *internalScreenTabImpl) Create(ctx context.Context, screenId int, tabNam
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs", i.version, screenId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -173,7 +173,7 @@ func (i *internalScreenTabImpl) Update(ctx context.Context, screenId, tabId int,
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs/%v", i.version, screenId, tabId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -199,7 +199,7 @@ func (i *internalScreenTabImpl) Delete(ctx context.Context, screenId, tabId int)
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs/%v", i.version, screenId, tabId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +219,7 @@ func (i *internalScreenTabImpl) Move(ctx context.Context, screenId, tabId, posit
 
 	endpoint := fmt.Sprintf("rest/api/%v/screens/%v/tabs/%v/move/%v", i.version, screenId, tabId, position)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "screen.tab.move", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
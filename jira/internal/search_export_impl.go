@@ -0,0 +1,436 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultSearchExportPageSize is the page size used to search the exported issues
+	defaultSearchExportPageSize = 50
+
+	defaultSearchExportSeparator = "; "
+)
+
+type searchExportFieldSchema struct {
+	Custom string `json:"custom"`
+}
+
+type searchExportPage struct {
+	StartAt int                                 `json:"startAt"`
+	Total   int                                 `json:"total"`
+	Issues  []*searchIntoIssue                  `json:"issues"`
+	Names   map[string]string                   `json:"names"`
+	Schema  map[string]*searchExportFieldSchema `json:"schema"`
+}
+
+// searchExport writes the issues of a JQL query to the writer, the issues are searched and written a page at a time.
+//
+// A failed search or a cancelled context stops the export, the issues of the previous pages are kept on the writer.
+func searchExport(ctx context.Context, client service.Client, version, jql string, fields []string, format string, w io.Writer,
+	options *model.IssueSearchExportOptionsScheme) (*model.ResponseScheme, error) {
+
+	if jql == "" {
+		return nil, model.ErrNoJQLError
+	}
+
+	if len(fields) == 0 {
+		return nil, model.ErrNoSearchExportFieldsError
+	}
+
+	if w == nil {
+		return nil, model.ErrNoSearchExportWriterError
+	}
+
+	if options == nil {
+		options = &model.IssueSearchExportOptionsScheme{}
+	}
+
+	pageSize := options.MaxResults
+	if pageSize <= 0 {
+		pageSize = defaultSearchExportPageSize
+	}
+
+	separator := options.Separator
+	if separator == "" {
+		separator = defaultSearchExportSeparator
+	}
+
+	var writer searchExportWriter
+	switch format {
+	case model.SearchExportCSV:
+
+		// The CSV columns are the requested fields, so the wildcards can't be expanded
+		for _, field := range fields {
+			if strings.HasPrefix(field, "*") {
+				return nil, fmt.Errorf("%w: the %v wildcard can't be exported to csv", model.ErrNoSearchExportFieldsError, field)
+			}
+		}
+
+		writer = &searchExportCSVWriter{csv: csv.NewWriter(w), fields: fields, separator: separator}
+
+	case model.SearchExportNDJSON:
+		writer = newSearchExportNDJSONWriter(w, fields)
+
+	default:
+		return nil, model.ErrInvalidSearchExportFormatError
+	}
+
+	var (
+		response *model.ResponseScheme
+		exported int
+	)
+
+	for startAt := 0; ; {
+
+		if err := ctx.Err(); err != nil {
+			return response, err
+		}
+
+		payload := struct {
+			Expand        []string `json:"expand,omitempty"`
+			Jql           string   `json:"jql,omitempty"`
+			MaxResults    int      `json:"maxResults,omitempty"`
+			Fields        []string `json:"fields,omitempty"`
+			StartAt       int      `json:"startAt,omitempty"`
+			ValidateQuery string   `json:"validateQuery,omitempty"`
+		}{
+			Expand:        []string{"names", "schema"},
+			Jql:           jql,
+			MaxResults:    pageSize,
+			Fields:        fields,
+			StartAt:       startAt,
+			ValidateQuery: options.ValidateQuery,
+		}
+
+		reader, err := client.TransformStructToReader(&payload)
+		if err != nil {
+			return response, err
+		}
+
+		endpoint := fmt.Sprintf("rest/api/%v/search", version)
+
+		request, err := service.NewOperationRequest(ctx, client, "issue.search.export", http.MethodPost, endpoint, reader)
+		if err != nil {
+			return response, err
+		}
+
+		page := new(searchExportPage)
+		response, err = client.Call(request, page)
+		if err != nil {
+			return response, err
+		}
+
+		if err := writer.write(page); err != nil {
+			return response, err
+		}
+
+		exported += len(page.Issues)
+		if options.Progress != nil {
+			options.Progress(exported, page.Total)
+		}
+
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return response, nil
+		}
+	}
+}
+
+type searchExportWriter interface {
+	// write writes the issues of a page, the issues are flushed to the underlying writer before it returns.
+	write(page *searchExportPage) error
+}
+
+type searchExportCSVWriter struct {
+	csv       *csv.Writer
+	fields    []string
+	separator string
+	header    bool
+}
+
+func (s *searchExportCSVWriter) write(page *searchExportPage) error {
+
+	if !s.header {
+
+		// The header is taken from the names of the first page, the fields without a name keep their id
+		header := []string{"Key"}
+		for _, field := range s.fields {
+
+			name := page.Names[field]
+			if name == "" {
+				name = field
+			}
+
+			header = append(header, name)
+		}
+
+		if err := s.csv.Write(header); err != nil {
+			return err
+		}
+
+		s.header = true
+	}
+
+	for _, issue := range page.Issues {
+
+		row := []string{issue.Key}
+		for _, field := range s.fields {
+			row = append(row, renderSearchExportField(field, issue.Fields[field], page.Schema[field], s.separator))
+		}
+
+		if err := s.csv.Write(row); err != nil {
+			return err
+		}
+	}
+
+	s.csv.Flush()
+	return s.csv.Error()
+}
+
+type searchExportNDJSONWriter struct {
+	encoder *json.Encoder
+	fields  map[string]bool
+	all     bool
+}
+
+func newSearchExportNDJSONWriter(w io.Writer, fields []string) *searchExportNDJSONWriter {
+
+	writer := &searchExportNDJSONWriter{encoder: json.NewEncoder(w), fields: make(map[string]bool, len(fields))}
+
+	for _, field := range fields {
+
+		// The wildcards keep every field returned by the search
+		if strings.HasPrefix(field, "*") {
+			writer.all = true
+		}
+
+		writer.fields[field] = true
+	}
+
+	return writer
+}
+
+func (s *searchExportNDJSONWriter) write(page *searchExportPage) error {
+
+	for _, issue := range page.Issues {
+
+		fields := make(map[string]json.RawMessage, len(s.fields))
+		for field, value := range issue.Fields {
+			if s.all || s.fields[field] {
+				fields[field] = value
+			}
+		}
+
+		line := struct {
+			ID     string                     `json:"id"`
+			Key    string                     `json:"key"`
+			Fields map[string]json.RawMessage `json:"fields"`
+		}{
+			ID:     issue.ID,
+			Key:    issue.Key,
+			Fields: fields,
+		}
+
+		// The encoder writes every issue on its own line
+		if err := s.encoder.Encode(&line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderSearchExportField renders a field on a CSV cell, the custom fields supported by the typed parse helpers are
+// rendered with their parsed values, the other fields are rendered by their JSON value.
+func renderSearchExportField(field string, raw json.RawMessage, schema *searchExportFieldSchema, separator string) string {
+
+	if len(raw) == 0 {
+		return ""
+	}
+
+	if schema != nil && schema.Custom != "" {
+		if values, ok := parseSearchExportCustomField(field, raw, schema.Custom); ok {
+			return strings.Join(values, separator)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return string(raw)
+	}
+
+	return renderSearchExportValue(value, raw, separator)
+}
+
+// parseSearchExportCustomField parses the custom field with the typed parse helper of its type, it returns false when
+// the type has no helper or the value can't be parsed.
+//
+// The helpers expect every attribute of the values, e.g. the goal of a sprint, so a panic on a partial value is
+// recovered and reported as a value that can't be parsed.
+func parseSearchExportCustomField(field string, raw json.RawMessage, customType string) (values []string, ok bool) {
+
+	defer func() {
+		if recover() != nil {
+			values, ok = nil, false
+		}
+	}()
+
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf(`{"fields":{%v:`, strconv.Quote(field)))
+	buffer.Write(raw)
+	buffer.WriteString("}}")
+
+	var err error
+
+	// The custom types are prefixed by their plugin key, e.g. "com.atlassian.jira.plugin.system.customfieldtypes:select"
+	switch customType[strings.LastIndex(customType, ":")+1:] {
+	case "multiselect", "multicheckboxes":
+
+		var options []*model.CustomFieldContextOptionScheme
+		options, err = model.ParseMultiSelectCustomField(buffer, field)
+		for _, option := range options {
+			values = append(values, option.Value)
+		}
+
+	case "select", "radiobuttons":
+
+		var option *model.CustomFieldContextOptionScheme
+		option, err = model.ParseSelectCustomField(buffer, field)
+		if option != nil {
+			values = append(values, option.Value)
+		}
+
+	case "cascadingselect":
+
+		var option *model.CascadingSelectScheme
+		option, err = model.ParseCascadingSelectCustomField(buffer, field)
+		if option != nil {
+
+			value := option.Value
+			if option.Child != nil {
+				value += " - " + option.Child.Value
+			}
+
+			values = append(values, value)
+		}
+
+	case "multiuserpicker":
+
+		var users []*model.UserDetailScheme
+		users, err = model.ParseMultiUserPickerCustomField(buffer, field)
+		for _, user := range users {
+			values = append(values, user.DisplayName)
+		}
+
+	case "userpicker":
+
+		var user *model.UserDetailScheme
+		user, err = model.ParseUserPickerCustomField(buffer, field)
+		if user != nil {
+			values = append(values, user.DisplayName)
+		}
+
+	case "multigrouppicker":
+
+		var groups []*model.GroupDetailScheme
+		groups, err = model.ParseMultiGroupPickerCustomField(buffer, field)
+		for _, group := range groups {
+			values = append(values, group.Name)
+		}
+
+	case "multiversion":
+
+		var versions []*model.VersionDetailScheme
+		versions, err = model.ParseMultiVersionCustomField(buffer, field)
+		for _, version := range versions {
+			values = append(values, version.Name)
+		}
+
+	case "float":
+
+		if string(raw) == "null" {
+			return nil, true
+		}
+
+		var number float64
+		number, err = model.ParseFloatCustomField(buffer, field)
+		values = append(values, strconv.FormatFloat(number, 'f', -1, 64))
+
+	case "labels":
+		values, err = model.ParseLabelCustomField(buffer, field)
+
+	case "gh-sprint":
+
+		var sprints []*model.SprintDetailScheme
+		sprints, err = model.ParseSprintCustomField(buffer, field)
+		for _, sprint := range sprints {
+			values = append(values, sprint.Name)
+		}
+
+	default:
+		return nil, false
+	}
+
+	if err != nil {
+		return nil, false
+	}
+
+	return values, true
+}
+
+// renderSearchExportValue renders a JSON value, the objects are rendered by their display name, name, value or key,
+// and by their raw JSON when they have none of them.
+func renderSearchExportValue(value interface{}, raw json.RawMessage, separator string) string {
+
+	switch value := value.(type) {
+	case nil:
+		return ""
+
+	case string:
+		return value
+
+	case json.Number:
+		return value.String()
+
+	case bool:
+		return strconv.FormatBool(value)
+
+	case []interface{}:
+
+		values := make([]string, 0, len(value))
+		for _, element := range value {
+
+			elementRaw, err := json.Marshal(element)
+			if err != nil {
+				return string(raw)
+			}
+
+			values = append(values, renderSearchExportValue(element, elementRaw, separator))
+		}
+
+		return strings.Join(values, separator)
+
+	case map[string]interface{}:
+
+		for _, key := range []string{"displayName", "name", "value", "key"} {
+			if name, ok := value[key].(string); ok {
+				return name
+			}
+		}
+	}
+
+	return string(raw)
+}
@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+// mockSearchExportPage mocks a search page of the export, the page is decoded from its JSON representation
+func mockSearchExportPage(client *mocks.Client, ctx context.Context, startAt int, fields []string, page string, err error) {
+
+	payload := &struct {
+		Expand        []string `json:"expand,omitempty"`
+		Jql           string   `json:"jql,omitempty"`
+		MaxResults    int      `json:"maxResults,omitempty"`
+		Fields        []string `json:"fields,omitempty"`
+		StartAt       int      `json:"startAt,omitempty"`
+		ValidateQuery string   `json:"validateQuery,omitempty"`
+	}{
+		Expand:     []string{"names", "schema"},
+		Jql:        "project = DUMMY",
+		MaxResults: 2,
+		Fields:     fields,
+		StartAt:    startAt,
+	}
+
+	request := &http.Request{Method: http.MethodPost, RequestURI: string(rune('a' + startAt))}
+
+	client.On("TransformStructToReader",
+		payload).
+		Return(bytes.NewReader([]byte{byte(startAt)}), nil).Once()
+
+	client.On("NewRequest",
+		ctx,
+		http.MethodPost,
+		"rest/api/2/search",
+		bytes.NewReader([]byte{byte(startAt)})).
+		Return(request, nil).Once()
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			if page == "" {
+				return
+			}
+
+			if err := json.Unmarshal([]byte(page), args.Get(1)); err != nil {
+				panic(err)
+			}
+		}).
+		Return(&model.ResponseScheme{}, err).Once()
+}
+
+func Test_searchExport(t *testing.T) {
+
+	fields := []string{"summary", "assignee", "labels", "customfield_10010", "customfield_10020", "customfield_10030", "customfield_10040"}
+
+	firstPage := `{"startAt":0,"total":3,
+		"names":{"summary":"Summary","assignee":"Assignee","labels":"Labels","customfield_10010":"Team",
+			"customfield_10020":"Sprint","customfield_10030":"Story Points"},
+		"schema":{
+			"customfield_10010":{"type":"array","custom":"com.atlassian.jira.plugin.system.customfieldtypes:multiselect"},
+			"customfield_10020":{"type":"array","custom":"com.pyxis.greenhopper.jira:gh-sprint"},
+			"customfield_10030":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float"},
+			"customfield_10040":{"type":"any","custom":"com.example.plugin:custom"}},
+		"issues":[
+		{"id":"10001","key":"DUMMY-1","fields":{"summary":"First, with a comma","assignee":{"accountId":"5b10a2","displayName":"Carlos"},
+			"labels":["backend","api"],"customfield_10010":[{"id":"1","value":"Platform"},{"id":"2","value":"Mobile"}],
+			"customfield_10020":[{"id":7,"name":"Sprint 7","state":"active","boardId":1,"goal":"",
+				"startDate":"2022-05-02T10:00:00.000Z","endDate":"2022-05-16T10:00:00.000Z"}],"customfield_10030":3.5,
+			"customfield_10040":{"score":1},"status":{"name":"To Do"}}},
+		{"id":"10002","key":"DUMMY-2","fields":{"summary":"Second","assignee":null,"customfield_10030":null,
+			"customfield_10020":[{"id":8,"name":"Sprint 8"}]}}]}`
+
+	secondPage := `{"startAt":2,"total":3,"issues":[
+		{"id":"10003","key":"DUMMY-3","fields":{"summary":"Third","labels":[]}}]}`
+
+	t.Run("when the issues are exported to csv", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchExportPage(client, context.Background(), 0, fields, firstPage, nil)
+		mockSearchExportPage(client, context.Background(), 2, fields, secondPage, nil)
+
+		var progress [][2]int
+
+		var buffer bytes.Buffer
+		_, err := searchExport(context.Background(), client, "2", "project = DUMMY", fields, model.SearchExportCSV, &buffer,
+			&model.IssueSearchExportOptionsScheme{
+				MaxResults: 2,
+				Separator:  "|",
+				Progress:   func(exported, total int) { progress = append(progress, [2]int{exported, total}) },
+			})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "Key,Summary,Assignee,Labels,Team,Sprint,Story Points,customfield_10040\n"+
+			"DUMMY-1,\"First, with a comma\",Carlos,backend|api,Platform|Mobile,Sprint 7,3.5,\"{\"\"score\"\":1}\"\n"+
+			"DUMMY-2,Second,,,,Sprint 8,,\n"+
+			"DUMMY-3,Third,,,,,,\n", buffer.String())
+
+		assert.Equal(t, [][2]int{{2, 3}, {3, 3}}, progress)
+	})
+
+	t.Run("when the issues are exported to ndjson", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchExportPage(client, context.Background(), 0, []string{"summary", "labels"}, firstPage, nil)
+		mockSearchExportPage(client, context.Background(), 2, []string{"summary", "labels"}, secondPage, nil)
+
+		var buffer bytes.Buffer
+		_, err := searchExport(context.Background(), client, "2", "project = DUMMY", []string{"summary", "labels"},
+			model.SearchExportNDJSON, &buffer, &model.IssueSearchExportOptionsScheme{MaxResults: 2})
+		assert.NoError(t, err)
+
+		assert.Equal(t, `{"id":"10001","key":"DUMMY-1","fields":{"labels":["backend","api"],"summary":"First, with a comma"}}`+"\n"+
+			`{"id":"10002","key":"DUMMY-2","fields":{"summary":"Second"}}`+"\n"+
+			`{"id":"10003","key":"DUMMY-3","fields":{"labels":[],"summary":"Third"}}`+"\n", buffer.String())
+	})
+
+	t.Run("when the second page cannot be searched", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchExportPage(client, context.Background(), 0, []string{"summary"}, firstPage, nil)
+		mockSearchExportPage(client, context.Background(), 2, []string{"summary"}, "", errors.New("error, request failed"))
+
+		var buffer bytes.Buffer
+		_, err := searchExport(context.Background(), client, "2", "project = DUMMY", []string{"summary"},
+			model.SearchExportCSV, &buffer, &model.IssueSearchExportOptionsScheme{MaxResults: 2})
+		assert.EqualError(t, err, "error, request failed")
+
+		// The rows of the first page are kept
+		assert.Equal(t, "Key,Summary\nDUMMY-1,\"First, with a comma\"\nDUMMY-2,Second\n", buffer.String())
+	})
+
+	t.Run("when the context is cancelled after the first page", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		client := mocks.NewClient(t)
+		mockSearchExportPage(client, ctx, 0, []string{"summary"}, firstPage, nil)
+
+		var buffer bytes.Buffer
+		_, err := searchExport(ctx, client, "2", "project = DUMMY", []string{"summary"}, model.SearchExportNDJSON, &buffer,
+			&model.IssueSearchExportOptionsScheme{MaxResults: 2, Progress: func(exported, total int) { cancel() }})
+		assert.EqualError(t, err, context.Canceled.Error())
+
+		assert.Equal(t, `{"id":"10001","key":"DUMMY-1","fields":{"summary":"First, with a comma"}}`+"\n"+
+			`{"id":"10002","key":"DUMMY-2","fields":{"summary":"Second"}}`+"\n", buffer.String())
+	})
+
+	t.Run("when the parameters are not valid", func(t *testing.T) {
+
+		var buffer bytes.Buffer
+
+		_, err := searchExport(context.Background(), nil, "2", "", fields, model.SearchExportCSV, &buffer, nil)
+		assert.EqualError(t, err, model.ErrNoJQLError.Error())
+
+		_, err = searchExport(context.Background(), nil, "2", "project = DUMMY", nil, model.SearchExportCSV, &buffer, nil)
+		assert.EqualError(t, err, model.ErrNoSearchExportFieldsError.Error())
+
+		_, err = searchExport(context.Background(), nil, "2", "project = DUMMY", fields, model.SearchExportCSV, nil, nil)
+		assert.EqualError(t, err, model.ErrNoSearchExportWriterError.Error())
+
+		_, err = searchExport(context.Background(), nil, "2", "project = DUMMY", fields, "xlsx", &buffer, nil)
+		assert.EqualError(t, err, model.ErrInvalidSearchExportFormatError.Error())
+
+		_, err = searchExport(context.Background(), nil, "2", "project = DUMMY", []string{"*all"}, model.SearchExportCSV, &buffer, nil)
+		assert.True(t, errors.Is(err, model.ErrNoSearchExportFieldsError))
+	})
+}
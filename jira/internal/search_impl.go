@@ -1,8 +1,16 @@
 package internal
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
 func NewSearchService(client service.Client, version string) (*SearchADFService, *SearchRichTextService, error) {
@@ -27,3 +35,153 @@ func NewSearchService(client service.Client, version string) (*SearchADFService,
 
 	return adfService, rtService, nil
 }
+
+// restrictedClausePattern matches the field of the clauses dropped from a search, e.g.
+// "Field 'customfield_10010' does not exist or you do not have permission to view it." or
+// "The value 'KP' does not exist for the field 'project'."
+var restrictedClausePattern = regexp.MustCompile(`(?i)field '([^']+)'`)
+
+// searchPermissionWarnings returns the parts of a search restricted to the user, the restricted clauses are read
+// from the warning messages and the restricted fields are the fields requested but missing on every issue returned.
+func searchPermissionWarnings(messages, fields []string, response *model.ResponseScheme) []*model.IssueSearchPermissionWarningScheme {
+
+	var warnings []*model.IssueSearchPermissionWarningScheme
+
+	for _, message := range messages {
+
+		lower := strings.ToLower(message)
+		if !strings.Contains(lower, "permission") && !strings.Contains(lower, "does not exist") {
+			continue
+		}
+
+		warning := &model.IssueSearchPermissionWarningScheme{Kind: model.SearchPermissionWarningClause, Message: message}
+
+		if match := restrictedClausePattern.FindStringSubmatch(message); match != nil {
+			warning.Name = match[1]
+		}
+
+		warnings = append(warnings, warning)
+	}
+
+	return append(warnings, missingSearchFields(fields, response)...)
+}
+
+// missingSearchFields returns the fields requested that aren't returned on any issue, the site returns the empty
+// fields as null but leaves out the fields the user can't see.
+//
+// It's a guess, the site also leaves out the custom fields outside the field context of the issues, so those
+// fields are reported as restricted too.
+func missingSearchFields(fields []string, response *model.ResponseScheme) []*model.IssueSearchPermissionWarningScheme {
+
+	if len(fields) == 0 || response == nil {
+		return nil
+	}
+
+	var page struct {
+		Issues []struct {
+			Fields map[string]json.RawMessage `json:"fields"`
+		} `json:"issues"`
+	}
+
+	if err := json.Unmarshal(response.Bytes.Bytes(), &page); err != nil || len(page.Issues) == 0 {
+		return nil
+	}
+
+	var warnings []*model.IssueSearchPermissionWarningScheme
+
+	for _, field := range fields {
+
+		// The navigable and excluded fields, and the issue attributes aren't returned as fields
+		if field == "" || field == "key" || field == "id" || strings.HasPrefix(field, "*") || strings.HasPrefix(field, "-") {
+			continue
+		}
+
+		var returned bool
+		for _, issue := range page.Issues {
+			if _, returned = issue.Fields[field]; returned {
+				break
+			}
+		}
+
+		if !returned {
+			warnings = append(warnings, &model.IssueSearchPermissionWarningScheme{Kind: model.SearchPermissionWarningField, Name: field})
+		}
+	}
+
+	return warnings
+}
+
+// countIssuesByJQL returns the approximate count of the issues matching the JQL query, the count may lag behind
+// the recent changes on the issues.
+func countIssuesByJQL(ctx context.Context, client service.Client, version, jql string) (int, *model.ResponseScheme, error) {
+
+	if jql == "" {
+		return 0, nil, model.ErrNoJQLError
+	}
+
+	payload := struct {
+		JQL string `json:"jql"`
+	}{
+		JQL: jql,
+	}
+
+	reader, err := client.TransformStructToReader(&payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/search/approximate-count", version)
+
+	request, err := service.NewOperationRequest(ctx, client, "issue.search.countByJQL", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	count := new(model.IssueSearchApproximateCountScheme)
+	response, err := client.Call(request, count)
+	if err != nil {
+		return 0, response, err
+	}
+
+	return count.Count, response, nil
+}
+
+// pickIssues returns the issue picker suggestions, the booleans are always sent, the site doesn't document their defaults.
+func pickIssues(ctx context.Context, client service.Client, version, query, currentJQL, currentIssueKey, currentProjectID string,
+	showSubTasks, showSubTaskParent bool) (*model.IssuePickerSuggestionsScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("showSubTasks", strconv.FormatBool(showSubTasks))
+	params.Add("showSubTaskParent", strconv.FormatBool(showSubTaskParent))
+
+	if query != "" {
+		params.Add("query", query)
+	}
+
+	if currentJQL != "" {
+		params.Add("currentJQL", currentJQL)
+	}
+
+	if currentIssueKey != "" {
+		params.Add("currentIssueKey", currentIssueKey)
+	}
+
+	if currentProjectID != "" {
+		params.Add("currentProjectId", currentProjectID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/picker?%v", version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, client, "issue.search.picker", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suggestions := new(model.IssuePickerSuggestionsScheme)
+	response, err := client.Call(request, suggestions)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return suggestions, response, nil
+}
@@ -6,6 +6,7 @@ import (
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/jira"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -27,6 +28,9 @@ func (s *SearchRichTextService) Checks(ctx context.Context, payload *model.Issue
 
 // Get search issues using JQL query under the HTTP Method GET
 //
+// The permission warnings of the result are only filled with the model.SearchValidateWarn validate mode, the
+// restricted clauses are reported instead of failing the search and the missing fields are guessed from the issues.
+//
 // GET /rest/api/2/search
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/search#search-for-issues-using-jql-get
@@ -36,6 +40,9 @@ func (s *SearchRichTextService) Get(ctx context.Context, jql string, fields, exp
 
 // Post search issues using JQL query under the HTTP Method POST
 //
+// The permission warnings of the result are only filled with the model.SearchValidateWarn validate mode, the
+// restricted clauses are reported instead of failing the search and the missing fields are guessed from the issues.
+//
 // POST /rest/api/2/search
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/search#search-for-issues-using-jql-get
@@ -43,6 +50,69 @@ func (s *SearchRichTextService) Post(ctx context.Context, jql string, fields, ex
 	return s.internalClient.Post(ctx, jql, fields, expands, startAt, maxResults, validate)
 }
 
+// Iterate returns an iterator over the issues of a JQL query, the pages of pageSize issues are searched lazily,
+// the next page is only searched when the issues of the current page are consumed.
+//
+// The iteration stops when the issues are exhausted, the context is cancelled or a page can't be searched,
+// the iterator Err and Response report the cause and the response of the failed page.
+//
+// POST /rest/api/2/search
+//
+// TODO: the documentation needs to be created
+func (s *SearchRichTextService) Iterate(ctx context.Context, jql string, fields []string, pageSize int) jira.SearchRichTextIterator {
+	return s.internalClient.Iterate(ctx, jql, fields, pageSize)
+}
+
+// SearchInto searches issues using a JQL query and decodes the fields of each issue into an element of dest.
+//
+// The dest must be a pointer to a slice of structs, the struct fields are matched to the issue fields by their jira tag,
+// e.g. `jira:"customfield_10012"`, or by their json tag. The @key, @id and @self jira tags receive the issue key, id and self.
+//
+// The tagged fields are requested when the fields are not provided.
+//
+// POST /rest/api/{2-3}/search
+//
+// TODO: the documentation needs to be created
+func (s *SearchRichTextService) SearchInto(ctx context.Context, jql string, fields []string, dest interface{}, options *model.IssueSearchIntoOptionsScheme) (*model.ResponseScheme, error) {
+	return s.internalClient.SearchInto(ctx, jql, fields, dest, options)
+}
+
+// CountByJQL returns the approximate count of the issues matching a JQL query, compare it with the total
+// of a search to detect the issues left out because of the permissions of the user.
+//
+// POST /rest/api/{2-3}/search/approximate-count
+//
+// TODO: the documentation needs to be created
+func (s *SearchRichTextService) CountByJQL(ctx context.Context, jql string) (int, *model.ResponseScheme, error) {
+	return s.internalClient.CountByJQL(ctx, jql)
+}
+
+// Picker returns the issues suggested for the characters typed on an issue picker, the suggestions are grouped
+// on the history and the current search sections.
+//
+// GET /rest/api/{2-3}/issue/picker
+//
+// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+func (s *SearchRichTextService) Picker(ctx context.Context, query, currentJQL, currentIssueKey, currentProjectID string, showSubTasks, showSubTaskParent bool) (*model.IssuePickerSuggestionsScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Picker(ctx, query, currentJQL, currentIssueKey, currentProjectID, showSubTasks, showSubTaskParent)
+}
+
+// Export searches issues using a JQL query and writes them to w, as CSV or as NDJSON.
+//
+// The CSV format writes a row per issue with the key and the fields, the header contains the field names. The custom
+// fields are rendered with the typed parse helpers, and the values of the multi-value fields are joined by the
+// options separator. The NDJSON format writes an issue JSON object per line, with only the exported fields.
+//
+// The issues are searched and written a page at a time. A failed search or a cancelled context stops the export
+// and returns the error, the issues of the previous pages are kept on w.
+//
+// POST /rest/api/{2-3}/search
+//
+// TODO: the documentation needs to be created
+func (s *SearchRichTextService) Export(ctx context.Context, jql string, fields []string, format string, w io.Writer, options *model.IssueSearchExportOptionsScheme) (*model.ResponseScheme, error) {
+	return s.internalClient.Export(ctx, jql, fields, format, w, options)
+}
+
 type internalSearchRichTextImpl struct {
 	c       service.Client
 	version string
@@ -57,7 +127,7 @@ func (i *internalSearchRichTextImpl) Checks(ctx context.Context, payload *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/jql/match", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.search.checks", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -96,7 +166,7 @@ func (i *internalSearchRichTextImpl) Get(ctx context.Context, jql string, fields
 
 	endpoint := fmt.Sprintf("rest/api/%v/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.search.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,6 +177,10 @@ func (i *internalSearchRichTextImpl) Get(ctx context.Context, jql string, fields
 		return nil, response, err
 	}
 
+	if validate == model.SearchValidateWarn {
+		issues.PermissionWarnings = searchPermissionWarnings(issues.WarningMessages, fields, response)
+	}
+
 	return issues, response, nil
 }
 
@@ -135,7 +209,7 @@ func (i *internalSearchRichTextImpl) Post(ctx context.Context, jql string, field
 
 	endpoint := fmt.Sprintf("rest/api/%v/search", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.search.post", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,5 +220,42 @@ func (i *internalSearchRichTextImpl) Post(ctx context.Context, jql string, field
 		return nil, response, err
 	}
 
+	if validate == model.SearchValidateWarn {
+		issues.PermissionWarnings = searchPermissionWarnings(issues.WarningMessages, fields, response)
+	}
+
 	return issues, response, nil
 }
+
+func (i *internalSearchRichTextImpl) SearchInto(ctx context.Context, jql string, fields []string, dest interface{}, options *model.IssueSearchIntoOptionsScheme) (*model.ResponseScheme, error) {
+	return searchInto(ctx, i.c, i.version, jql, fields, dest, options)
+}
+
+func (i *internalSearchRichTextImpl) CountByJQL(ctx context.Context, jql string) (int, *model.ResponseScheme, error) {
+	return countIssuesByJQL(ctx, i.c, i.version, jql)
+}
+
+func (i *internalSearchRichTextImpl) Picker(ctx context.Context, query, currentJQL, currentIssueKey, currentProjectID string, showSubTasks, showSubTaskParent bool) (*model.IssuePickerSuggestionsScheme, *model.ResponseScheme, error) {
+	return pickIssues(ctx, i.c, i.version, query, currentJQL, currentIssueKey, currentProjectID, showSubTasks, showSubTaskParent)
+}
+
+func (i *internalSearchRichTextImpl) Export(ctx context.Context, jql string, fields []string, format string, w io.Writer, options *model.IssueSearchExportOptionsScheme) (*model.ResponseScheme, error) {
+	return searchExport(ctx, i.c, i.version, jql, fields, format, w, options)
+}
+
+func (i *internalSearchRichTextImpl) Iterate(ctx context.Context, jql string, fields []string, pageSize int) jira.SearchRichTextIterator {
+
+	iterator := &searchRichTextIterator{}
+	iterator.pager = newSearchPager(ctx, pageSize, func(ctx context.Context, startAt, maxResults int) (int, int, *model.ResponseScheme, error) {
+
+		page, response, err := i.Post(ctx, jql, fields, nil, startAt, maxResults, "")
+		if err != nil {
+			return 0, 0, response, err
+		}
+
+		iterator.page = page
+		return len(page.Issues), page.Total, response, nil
+	})
+
+	return iterator
+}
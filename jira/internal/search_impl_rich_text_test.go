@@ -199,6 +199,48 @@ func Test_internalSearchRichTextImpl_Post(t *testing.T) {
 		wantErr bool
 		Err     error
 	}{
+		{
+			name:   "when the jql is empty and the query is validated with warnings",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				maxResults: 50,
+				validate:   "warn",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				// The empty jql is left out of the body, so the search returns every issue
+				client.On("TransformStructToReader",
+					&struct {
+						Expand        []string "json:\"expand,omitempty\""
+						Jql           string   "json:\"jql,omitempty\""
+						MaxResults    int      "json:\"maxResults,omitempty\""
+						Fields        []string "json:\"fields,omitempty\""
+						StartAt       int      "json:\"startAt,omitempty\""
+						ValidateQuery string   "json:\"validateQuery,omitempty\""
+					}{MaxResults: 50, ValidateQuery: "warn"}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchSchemeV2{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
 		{
 			name:   "when the api version is v3",
 			fields: fields{version: "3"},
@@ -1,9 +1,16 @@
 package internal
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
 	"testing"
 )
 
@@ -59,3 +66,323 @@ func Test_NewSearchService(t *testing.T) {
 		})
 	}
 }
+
+func Test_searchPermissionWarnings(t *testing.T) {
+
+	response := &model.ResponseScheme{}
+	response.Bytes.WriteString(`{"issues":[
+		{"key":"KP-1","fields":{"summary":"Login fails","customfield_10010":null}},
+		{"key":"KP-2","fields":{"summary":"Logout fails"}}
+	]}`)
+
+	messages := []string{
+		"Field 'customfield_10020' does not exist or you do not have permission to view it.",
+		"The value 'HR' does not exist for the field 'project'.",
+		"The search results were limited to the first 1000 issues.",
+	}
+
+	fields := []string{"key", "summary", "customfield_10010", "customfield_10030", "*navigable", "-description"}
+
+	assert.Equal(t, []*model.IssueSearchPermissionWarningScheme{
+		{Kind: model.SearchPermissionWarningClause, Name: "customfield_10020", Message: messages[0]},
+		{Kind: model.SearchPermissionWarningClause, Name: "project", Message: messages[1]},
+		{Kind: model.SearchPermissionWarningField, Name: "customfield_10030"},
+	}, searchPermissionWarnings(messages, fields, response))
+
+	// The missing fields can't be detected without issues
+	assert.Nil(t, searchPermissionWarnings(nil, fields, &model.ResponseScheme{}))
+}
+
+func Test_internalSearchADFImpl_Post_PermissionWarnings(t *testing.T) {
+
+	const body = `{"total":1,"issues":[{"key":"KP-1","fields":{"summary":"Login fails"}}],
+		"warningMessages":["The value 'HR' does not exist for the field 'project'."]}`
+
+	client := mocks.NewClient(t)
+
+	client.On("TransformStructToReader",
+		mock.Anything).
+		Return(bytes.NewReader([]byte{}), nil)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"rest/api/3/search",
+		bytes.NewReader([]byte{})).
+		Return(&http.Request{}, nil)
+
+	response := &model.ResponseScheme{}
+	response.Bytes.WriteString(body)
+
+	client.On("Call",
+		&http.Request{},
+		&model.IssueSearchScheme{}).
+		Run(func(args mock.Arguments) {
+			assert.NoError(t, json.Unmarshal([]byte(body), args.Get(1)))
+		}).
+		Return(response, nil)
+
+	searchService, _, err := NewSearchService(client, "3")
+	assert.NoError(t, err)
+
+	result, _, err := searchService.Post(context.Background(), "project in (KP, HR)", []string{"summary", "customfield_10010"}, nil,
+		0, 50, model.SearchValidateWarn)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*model.IssueSearchPermissionWarningScheme{
+		{Kind: model.SearchPermissionWarningClause, Name: "project", Message: "The value 'HR' does not exist for the field 'project'."},
+		{Kind: model.SearchPermissionWarningField, Name: "customfield_10010"},
+	}, result.PermissionWarnings)
+
+	// The warnings are opt-in, the other validate modes don't read them
+	result, _, err = searchService.Post(context.Background(), "project in (KP, HR)", []string{"summary", "customfield_10010"}, nil,
+		0, 50, model.SearchValidateStrict)
+	assert.NoError(t, err)
+	assert.Nil(t, result.PermissionWarnings)
+}
+
+func Test_countIssuesByJQL(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		jql     string
+		on      func(*fields)
+		want    int
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the issues are counted",
+			fields: fields{version: "3"},
+			jql:    "project = KP",
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&struct {
+						JQL string `json:"jql"`
+					}{JQL: "project = KP"}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/approximate-count",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchApproximateCountScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchApproximateCountScheme).Count = 42
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: 42,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			jql:    "project = KP",
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.Anything).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/approximate-count",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchApproximateCountScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, unable to connect with the Atlassian instance"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to connect with the Atlassian instance"),
+		},
+
+		{
+			name:    "when the jql is not provided",
+			fields:  fields{version: "3"},
+			wantErr: true,
+			Err:     model.ErrNoJQLError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			adfService, richTextService, err := NewSearchService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			var search interface {
+				CountByJQL(ctx context.Context, jql string) (int, *model.ResponseScheme, error)
+			} = adfService
+
+			if testCase.fields.version == "2" {
+				search = richTextService
+			}
+
+			gotCount, gotResponse, err := search.CountByJQL(context.Background(), testCase.jql)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotCount)
+			}
+		})
+	}
+}
+
+func Test_pickIssues(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		query, currentJQL, currentIssueKey, currentProjectID string
+		showSubTasks, showSubTaskParent                      bool
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the suggestions are returned",
+			fields: fields{version: "3"},
+			args: args{
+				query:            "KP-1",
+				currentJQL:       "project = KP",
+				currentProjectID: "10000",
+				showSubTasks:     true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/picker?currentJQL=project+%3D+KP&currentProjectId=10000&query=KP-1&showSubTaskParent=false&showSubTasks=true",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssuePickerSuggestionsScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssuePickerSuggestionsScheme).Sections = []*model.IssuePickerSectionScheme{
+							{ID: "cs", Label: "Current Search", Issues: []*model.IssuePickerIssueScheme{{Key: "KP-1", SummaryText: "Bug in business logic"}}},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/picker?showSubTaskParent=false&showSubTasks=false",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssuePickerSuggestionsScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, unable to connect with the Atlassian instance"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to connect with the Atlassian instance"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			adfService, richTextService, err := NewSearchService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			var search interface {
+				Picker(ctx context.Context, query, currentJQL, currentIssueKey, currentProjectID string, showSubTasks, showSubTaskParent bool) (
+					*model.IssuePickerSuggestionsScheme, *model.ResponseScheme, error)
+			} = adfService
+
+			if testCase.fields.version == "2" {
+				search = richTextService
+			}
+
+			gotSuggestions, gotResponse, err := search.Picker(context.Background(), testCase.args.query, testCase.args.currentJQL,
+				testCase.args.currentIssueKey, testCase.args.currentProjectID, testCase.args.showSubTasks, testCase.args.showSubTaskParent)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, "KP-1", gotSuggestions.Sections[0].Issues[0].Key)
+			}
+		})
+	}
+}
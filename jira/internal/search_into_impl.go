@@ -0,0 +1,238 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// defaultSearchIntoPageSize is the page size used to search the issues decoded into the user structs
+const defaultSearchIntoPageSize = 50
+
+// searchIntoField is a tagged field of the destination struct
+type searchIntoField struct {
+	index    int
+	fieldID  string
+	optional bool
+}
+
+type searchIntoIssue struct {
+	ID     string                     `json:"id"`
+	Key    string                     `json:"key"`
+	Self   string                     `json:"self"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+type searchIntoPage struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	Issues     []*searchIntoIssue `json:"issues"`
+}
+
+func searchInto(ctx context.Context, client service.Client, version, jql string, fields []string, dest interface{}, options *model.IssueSearchIntoOptionsScheme) (
+	*model.ResponseScheme, error) {
+
+	if jql == "" {
+		return nil, model.ErrNoJQLError
+	}
+
+	slice, structType, pointers, err := searchIntoDestination(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	if options == nil {
+		options = &model.IssueSearchIntoOptionsScheme{}
+	}
+
+	pageSize := options.MaxResults
+	if pageSize <= 0 {
+		pageSize = defaultSearchIntoPageSize
+	}
+
+	tagged := searchIntoFields(structType)
+
+	// Only the tagged fields are requested when the fields are not provided
+	if len(fields) == 0 {
+		for _, field := range tagged {
+			if !strings.HasPrefix(field.fieldID, "@") {
+				fields = append(fields, field.fieldID)
+			}
+		}
+	}
+
+	slice.Set(reflect.MakeSlice(slice.Type(), 0, 0))
+
+	var response *model.ResponseScheme
+	for startAt := options.StartAt; ; {
+
+		payload := struct {
+			Expand        []string `json:"expand,omitempty"`
+			Jql           string   `json:"jql,omitempty"`
+			MaxResults    int      `json:"maxResults,omitempty"`
+			Fields        []string `json:"fields,omitempty"`
+			StartAt       int      `json:"startAt,omitempty"`
+			ValidateQuery string   `json:"validateQuery,omitempty"`
+		}{
+			Expand:        options.Expand,
+			Jql:           jql,
+			MaxResults:    pageSize,
+			Fields:        fields,
+			StartAt:       startAt,
+			ValidateQuery: options.ValidateQuery,
+		}
+
+		reader, err := client.TransformStructToReader(&payload)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := fmt.Sprintf("rest/api/%v/search", version)
+
+		request, err := service.NewOperationRequest(ctx, client, "issue.search.searchInto", http.MethodPost, endpoint, reader)
+		if err != nil {
+			return nil, err
+		}
+
+		page := new(searchIntoPage)
+		response, err = client.Call(request, page)
+		if err != nil {
+			return response, err
+		}
+
+		for _, issue := range page.Issues {
+
+			element := reflect.New(structType)
+			if err := decodeSearchIntoIssue(issue, element.Elem(), tagged, options.Strict); err != nil {
+				return response, err
+			}
+
+			if pointers {
+				slice.Set(reflect.Append(slice, element))
+			} else {
+				slice.Set(reflect.Append(slice, element.Elem()))
+			}
+
+			if options.Limit > 0 && slice.Len() >= options.Limit {
+				return response, nil
+			}
+		}
+
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return response, nil
+		}
+	}
+}
+
+// searchIntoDestination returns the slice pointed by the destination and the struct type of its elements,
+// the elements can be structs or pointers to structs.
+func searchIntoDestination(dest interface{}) (reflect.Value, reflect.Type, bool, error) {
+
+	value := reflect.ValueOf(dest)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, nil, false, model.ErrInvalidSearchIntoDestError
+	}
+
+	slice := value.Elem()
+	elementType := slice.Type().Elem()
+
+	if elementType.Kind() == reflect.Ptr && elementType.Elem().Kind() == reflect.Struct {
+		return slice, elementType.Elem(), true, nil
+	}
+
+	if elementType.Kind() == reflect.Struct {
+		return slice, elementType, false, nil
+	}
+
+	return reflect.Value{}, nil, false, model.ErrInvalidSearchIntoDestError
+}
+
+// searchIntoFields returns the exported fields tagged with a jira tag, or with a json tag when the jira tag is not set.
+//
+// The optional fields are the fields with the optional jira option or the omitempty json option.
+func searchIntoFields(structType reflect.Type) []*searchIntoField {
+
+	var fields []*searchIntoField
+	for index := 0; index < structType.NumField(); index++ {
+
+		structField := structType.Field(index)
+		if structField.PkgPath != "" {
+			continue
+		}
+
+		tag, ok := structField.Tag.Lookup("jira")
+		optionalOption := "optional"
+		if !ok {
+			tag, ok = structField.Tag.Lookup("json")
+			optionalOption = "omitempty"
+		}
+
+		options := strings.Split(tag, ",")
+		if !ok || options[0] == "" || options[0] == "-" {
+			continue
+		}
+
+		field := &searchIntoField{index: index, fieldID: options[0]}
+		for _, option := range options[1:] {
+			if option == optionalOption {
+				field.optional = true
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+func decodeSearchIntoIssue(issue *searchIntoIssue, element reflect.Value, fields []*searchIntoField, strict bool) error {
+
+	for _, field := range fields {
+
+		var raw json.RawMessage
+
+		switch field.fieldID {
+		case model.IssueSearchIntoKeyTag:
+			raw = json.RawMessage(strconv.Quote(issue.Key))
+		case model.IssueSearchIntoIDTag:
+			raw = json.RawMessage(strconv.Quote(issue.ID))
+		case model.IssueSearchIntoSelfTag:
+			raw = json.RawMessage(strconv.Quote(issue.Self))
+		case model.IssueSearchIntoFieldsTag:
+
+			fields, err := json.Marshal(issue.Fields)
+			if err != nil {
+				return err
+			}
+
+			raw = fields
+		default:
+
+			value, ok := issue.Fields[field.fieldID]
+			if !ok {
+
+				if strict && !field.optional {
+					return fmt.Errorf("%w: %v on the issue %v", model.ErrSearchIntoMissingFieldError, field.fieldID, issue.Key)
+				}
+
+				continue
+			}
+
+			raw = value
+		}
+
+		if err := json.Unmarshal(raw, element.Field(field.index).Addr().Interface()); err != nil {
+			return fmt.Errorf("jira: the field %v of the issue %v cannot be decoded: %w", field.fieldID, issue.Key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+type searchIntoStoryPoints struct {
+	Key         string   `jira:"@key"`
+	ID          string   `jira:"@id"`
+	Summary     string   `json:"summary"`
+	StoryPoints *float64 `jira:"customfield_10012"`
+	Team        string   `jira:"customfield_10020,optional"`
+	Labels      []string `json:"labels,omitempty"`
+	ignored     string
+}
+
+// mockSearchIntoPage mocks a search page, the page is decoded from its JSON representation
+func mockSearchIntoPage(client *mocks.Client, startAt int, fields []string, page string) {
+
+	payload := &struct {
+		Expand        []string `json:"expand,omitempty"`
+		Jql           string   `json:"jql,omitempty"`
+		MaxResults    int      `json:"maxResults,omitempty"`
+		Fields        []string `json:"fields,omitempty"`
+		StartAt       int      `json:"startAt,omitempty"`
+		ValidateQuery string   `json:"validateQuery,omitempty"`
+	}{
+		Jql:        "project = DUMMY",
+		MaxResults: 2,
+		Fields:     fields,
+		StartAt:    startAt,
+	}
+
+	request := &http.Request{Method: http.MethodPost, RequestURI: string(rune('a' + startAt))}
+
+	client.On("TransformStructToReader",
+		payload).
+		Return(bytes.NewReader([]byte{byte(startAt)}), nil).Once()
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"rest/api/3/search",
+		bytes.NewReader([]byte{byte(startAt)})).
+		Return(request, nil).Once()
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			if err := json.Unmarshal([]byte(page), args.Get(1)); err != nil {
+				panic(err)
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+}
+
+func Test_searchInto(t *testing.T) {
+
+	taggedFields := []string{"summary", "customfield_10012", "customfield_10020", "labels"}
+
+	firstPage := `{"startAt":0,"maxResults":2,"total":3,"issues":[
+		{"id":"10001","key":"DUMMY-1","fields":{"summary":"First","customfield_10012":3,"customfield_10020":"Platform","labels":["backend"]}},
+		{"id":"10002","key":"DUMMY-2","fields":{"summary":"Second","customfield_10012":null}}]}`
+
+	secondPage := `{"startAt":2,"maxResults":2,"total":3,"issues":[
+		{"id":"10003","key":"DUMMY-3","fields":{"summary":"Third","customfield_10012":5}}]}`
+
+	t.Run("when the issues are decoded into structs", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchIntoPage(client, 0, taggedFields, firstPage)
+		mockSearchIntoPage(client, 2, taggedFields, secondPage)
+
+		var issues []searchIntoStoryPoints
+		_, err := searchInto(context.Background(), client, "3", "project = DUMMY", nil, &issues,
+			&model.IssueSearchIntoOptionsScheme{MaxResults: 2, Strict: true})
+		assert.NoError(t, err)
+
+		assert.Len(t, issues, 3)
+		assert.Equal(t, "DUMMY-1", issues[0].Key)
+		assert.Equal(t, "10001", issues[0].ID)
+		assert.Equal(t, "First", issues[0].Summary)
+		assert.Equal(t, 3.0, *issues[0].StoryPoints)
+		assert.Equal(t, "Platform", issues[0].Team)
+		assert.Equal(t, []string{"backend"}, issues[0].Labels)
+		assert.Nil(t, issues[1].StoryPoints)
+		assert.Equal(t, 5.0, *issues[2].StoryPoints)
+	})
+
+	t.Run("when the destination is a slice of pointers and the limit is reached", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchIntoPage(client, 0, []string{"summary"}, firstPage)
+
+		var issues []*searchIntoStoryPoints
+		_, err := searchInto(context.Background(), client, "3", "project = DUMMY", []string{"summary"}, &issues,
+			&model.IssueSearchIntoOptionsScheme{MaxResults: 2, Limit: 1})
+		assert.NoError(t, err)
+
+		assert.Len(t, issues, 1)
+		assert.Equal(t, "DUMMY-1", issues[0].Key)
+	})
+
+	t.Run("when a required field is missing on strict mode", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchIntoPage(client, 0, taggedFields, `{"total":1,"issues":[{"id":"10001","key":"DUMMY-1","fields":{"customfield_10012":1}}]}`)
+
+		var issues []searchIntoStoryPoints
+		_, err := searchInto(context.Background(), client, "3", "project = DUMMY", nil, &issues,
+			&model.IssueSearchIntoOptionsScheme{MaxResults: 2, Strict: true})
+
+		assert.True(t, errors.Is(err, model.ErrSearchIntoMissingFieldError))
+		assert.EqualError(t, err, "jira: the issue field is missing: summary on the issue DUMMY-1")
+	})
+
+	t.Run("when a field cannot be decoded", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchIntoPage(client, 0, taggedFields, `{"total":1,"issues":[{"id":"10001","key":"DUMMY-1","fields":{"customfield_10012":"three"}}]}`)
+
+		var issues []searchIntoStoryPoints
+		_, err := searchInto(context.Background(), client, "3", "project = DUMMY", nil, &issues,
+			&model.IssueSearchIntoOptionsScheme{MaxResults: 2})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "jira: the field customfield_10012 of the issue DUMMY-1 cannot be decoded")
+	})
+
+	t.Run("when the destination is not a pointer to a slice of structs", func(t *testing.T) {
+
+		var issues []string
+		_, err := searchInto(context.Background(), mocks.NewClient(t), "3", "project = DUMMY", nil, &issues, nil)
+		assert.EqualError(t, err, model.ErrInvalidSearchIntoDestError.Error())
+
+		_, err = searchInto(context.Background(), mocks.NewClient(t), "3", "project = DUMMY", nil, []searchIntoStoryPoints{}, nil)
+		assert.EqualError(t, err, model.ErrInvalidSearchIntoDestError.Error())
+	})
+
+	t.Run("when the jql is not provided", func(t *testing.T) {
+
+		var issues []searchIntoStoryPoints
+		_, err := searchInto(context.Background(), mocks.NewClient(t), "3", "", nil, &issues, nil)
+		assert.EqualError(t, err, model.ErrNoJQLError.Error())
+	})
+}
+
+func Test_SearchADFService_SearchInto(t *testing.T) {
+
+	client := mocks.NewClient(t)
+	mockSearchIntoPage(client, 0, []string{"summary"}, `{"total":1,"issues":[{"id":"10001","key":"DUMMY-1","fields":{"summary":"First"}}]}`)
+
+	adfService, _, err := NewSearchService(client, "3")
+	assert.NoError(t, err)
+
+	var issues []struct {
+		Key     string `jira:"@key"`
+		Summary string `jira:"summary"`
+	}
+
+	_, err = adfService.SearchInto(context.Background(), "project = DUMMY", nil, &issues, &model.IssueSearchIntoOptionsScheme{MaxResults: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "First", issues[0].Summary)
+}
@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// defaultSearchIteratorPageSize is the page size used when the iterator page size is not positive
+const defaultSearchIteratorPageSize = 50
+
+// searchPageFunc searches the page starting at startAt, it returns the number of issues of the page and the total of the query.
+type searchPageFunc func(ctx context.Context, startAt, maxResults int) (int, int, *model.ResponseScheme, error)
+
+// searchPager walks the positions of the issues of a search, the pages are searched when the previous page is consumed.
+type searchPager struct {
+	ctx      context.Context
+	pageSize int
+	search   searchPageFunc
+
+	startAt  int
+	index    int
+	size     int
+	last     bool
+	response *model.ResponseScheme
+	err      error
+}
+
+func newSearchPager(ctx context.Context, pageSize int, search searchPageFunc) *searchPager {
+
+	if pageSize <= 0 {
+		pageSize = defaultSearchIteratorPageSize
+	}
+
+	return &searchPager{ctx: ctx, pageSize: pageSize, search: search, index: -1}
+}
+
+func (s *searchPager) next() bool {
+
+	if s.err != nil {
+		return false
+	}
+
+	if s.index+1 < s.size {
+		s.index++
+		return true
+	}
+
+	if s.last {
+		return false
+	}
+
+	if err := s.ctx.Err(); err != nil {
+		s.err = err
+		return false
+	}
+
+	size, total, response, err := s.search(s.ctx, s.startAt, s.pageSize)
+	s.response = response
+	if err != nil {
+		s.err = err
+		return false
+	}
+
+	s.startAt += size
+	s.index, s.size = 0, size
+
+	// The search has no last page flag, so an empty page or the total of the query ends the iteration
+	s.last = size == 0 || s.startAt >= total
+
+	return size > 0
+}
+
+type searchADFIterator struct {
+	pager *searchPager
+	page  *model.IssueSearchScheme
+}
+
+func (s *searchADFIterator) Next() bool                      { return s.pager.next() }
+func (s *searchADFIterator) Response() *model.ResponseScheme { return s.pager.response }
+func (s *searchADFIterator) Err() error                      { return s.pager.err }
+
+func (s *searchADFIterator) Issue() *model.IssueScheme {
+
+	if s.page == nil || s.pager.index < 0 || s.pager.index >= len(s.page.Issues) {
+		return nil
+	}
+
+	return s.page.Issues[s.pager.index]
+}
+
+type searchRichTextIterator struct {
+	pager *searchPager
+	page  *model.IssueSearchSchemeV2
+}
+
+func (s *searchRichTextIterator) Next() bool                      { return s.pager.next() }
+func (s *searchRichTextIterator) Response() *model.ResponseScheme { return s.pager.response }
+func (s *searchRichTextIterator) Err() error                      { return s.pager.err }
+
+func (s *searchRichTextIterator) Issue() *model.IssueSchemeV2 {
+
+	if s.page == nil || s.pager.index < 0 || s.pager.index >= len(s.page.Issues) {
+		return nil
+	}
+
+	return s.page.Issues[s.pager.index]
+}
@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+// mockSearchIteratorPage mocks a page searched by the iterator, the page is decoded from its JSON representation
+func mockSearchIteratorPage(client *mocks.Client, ctx context.Context, version string, startAt int, page string, err error) {
+
+	payload := &struct {
+		Expand        []string `json:"expand,omitempty"`
+		Jql           string   `json:"jql,omitempty"`
+		MaxResults    int      `json:"maxResults,omitempty"`
+		Fields        []string `json:"fields,omitempty"`
+		StartAt       int      `json:"startAt,omitempty"`
+		ValidateQuery string   `json:"validateQuery,omitempty"`
+	}{
+		Jql:        "project = DUMMY",
+		MaxResults: 2,
+		Fields:     []string{"summary"},
+		StartAt:    startAt,
+	}
+
+	request := &http.Request{Method: http.MethodPost, RequestURI: string(rune('a' + startAt))}
+
+	client.On("TransformStructToReader",
+		payload).
+		Return(bytes.NewReader([]byte{byte(startAt)}), nil).Once()
+
+	client.On("NewRequest",
+		ctx,
+		http.MethodPost,
+		"rest/api/"+version+"/search",
+		bytes.NewReader([]byte{byte(startAt)})).
+		Return(request, nil).Once()
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			if page == "" {
+				return
+			}
+
+			if err := json.Unmarshal([]byte(page), args.Get(1)); err != nil {
+				panic(err)
+			}
+		}).
+		Return(&model.ResponseScheme{Code: http.StatusOK + startAt}, err).Once()
+}
+
+func Test_internalSearchADFImpl_Iterate(t *testing.T) {
+
+	t.Run("when the issues are iterated", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchIteratorPage(client, context.Background(), "3", 0,
+			`{"startAt":0,"total":3,"issues":[{"key":"DUMMY-1"},{"key":"DUMMY-2"}]}`, nil)
+
+		searchService, _, err := NewSearchService(client, "3")
+		assert.NoError(t, err)
+
+		iterator := searchService.Iterate(context.Background(), "project = DUMMY", []string{"summary"}, 2)
+
+		assert.True(t, iterator.Next())
+		assert.Equal(t, "DUMMY-1", iterator.Issue().Key)
+
+		// The second page is only searched once the first page is consumed
+		assert.True(t, iterator.Next())
+		assert.Equal(t, "DUMMY-2", iterator.Issue().Key)
+
+		mockSearchIteratorPage(client, context.Background(), "3", 2,
+			`{"startAt":2,"total":3,"issues":[{"key":"DUMMY-3"}]}`, nil)
+
+		assert.True(t, iterator.Next())
+		assert.Equal(t, "DUMMY-3", iterator.Issue().Key)
+
+		// The total is reached, so no other page is searched
+		assert.False(t, iterator.Next())
+		assert.NoError(t, iterator.Err())
+		assert.Equal(t, http.StatusOK+2, iterator.Response().Code)
+	})
+
+	t.Run("when a page cannot be searched", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchIteratorPage(client, context.Background(), "3", 0,
+			`{"startAt":0,"total":4,"issues":[{"key":"DUMMY-1"},{"key":"DUMMY-2"}]}`, nil)
+		mockSearchIteratorPage(client, context.Background(), "3", 2, "", errors.New("error, request failed"))
+
+		searchService, _, err := NewSearchService(client, "3")
+		assert.NoError(t, err)
+
+		var keys []string
+
+		iterator := searchService.Iterate(context.Background(), "project = DUMMY", []string{"summary"}, 2)
+		for iterator.Next() {
+			keys = append(keys, iterator.Issue().Key)
+		}
+
+		assert.Equal(t, []string{"DUMMY-1", "DUMMY-2"}, keys)
+		assert.EqualError(t, iterator.Err(), "error, request failed")
+		assert.Equal(t, http.StatusOK+2, iterator.Response().Code)
+
+		// The iterator stays stopped
+		assert.False(t, iterator.Next())
+	})
+
+	t.Run("when the context is cancelled mid-iteration", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		client := mocks.NewClient(t)
+		mockSearchIteratorPage(client, ctx, "3", 0,
+			`{"startAt":0,"total":4,"issues":[{"key":"DUMMY-1"},{"key":"DUMMY-2"}]}`, nil)
+
+		searchService, _, err := NewSearchService(client, "3")
+		assert.NoError(t, err)
+
+		iterator := searchService.Iterate(ctx, "project = DUMMY", []string{"summary"}, 2)
+
+		assert.True(t, iterator.Next())
+		cancel()
+
+		// The issues of the current page are still returned
+		assert.True(t, iterator.Next())
+		assert.False(t, iterator.Next())
+		assert.EqualError(t, iterator.Err(), context.Canceled.Error())
+	})
+
+	t.Run("when the query has no issues", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockSearchIteratorPage(client, context.Background(), "3", 0, `{"startAt":0,"total":0,"issues":[]}`, nil)
+
+		searchService, _, err := NewSearchService(client, "3")
+		assert.NoError(t, err)
+
+		iterator := searchService.Iterate(context.Background(), "project = DUMMY", []string{"summary"}, 2)
+
+		assert.False(t, iterator.Next())
+		assert.Nil(t, iterator.Issue())
+		assert.NoError(t, iterator.Err())
+	})
+}
+
+func Test_internalSearchRichTextImpl_Iterate(t *testing.T) {
+
+	client := mocks.NewClient(t)
+	mockSearchIteratorPage(client, context.Background(), "2", 0,
+		`{"startAt":0,"total":2,"issues":[{"key":"DUMMY-1"},{"key":"DUMMY-2"}]}`, nil)
+
+	_, searchService, err := NewSearchService(client, "2")
+	assert.NoError(t, err)
+
+	var keys []string
+
+	iterator := searchService.Iterate(context.Background(), "project = DUMMY", []string{"summary"}, 2)
+	for iterator.Next() {
+		keys = append(keys, iterator.Issue().Key)
+	}
+
+	assert.NoError(t, iterator.Err())
+	assert.Equal(t, []string{"DUMMY-1", "DUMMY-2"}, keys)
+}
@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func NewIssueSecurityLevelService(client service.Client, version string) (*IssueSecurityLevelService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &IssueSecurityLevelService{
+		internalClient: &internalIssueSecurityLevelImpl{c: client, version: version},
+	}, nil
+}
+
+type IssueSecurityLevelService struct {
+	internalClient jira.IssueSecurityLevelConnector
+}
+
+// Get returns details of an issue security level.
+//
+// GET /rest/api/{2-3}/securitylevel/{id}
+//
+// TODO: the documentation needs to be created
+func (i *IssueSecurityLevelService) Get(ctx context.Context, levelID string) (*model.IssueSecurityLevelScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Get(ctx, levelID)
+}
+
+// Gets returns a paginated list of issue security levels.
+//
+// Only issue security levels in the context of classic projects are returned.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/level
+//
+// TODO: the documentation needs to be created
+func (i *IssueSecurityLevelService) Gets(ctx context.Context, options *model.IssueSecurityLevelSearchOptionsScheme, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx, options, startAt, maxResults)
+}
+
+// Members returns a paginated list of issue security level members.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/level/member
+//
+// TODO: the documentation needs to be created
+func (i *IssueSecurityLevelService) Members(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptionsScheme, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Members(ctx, options, startAt, maxResults)
+}
+
+type internalIssueSecurityLevelImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalIssueSecurityLevelImpl) Get(ctx context.Context, levelID string) (*model.IssueSecurityLevelScheme, *model.ResponseScheme, error) {
+
+	if levelID == "" {
+		return nil, nil, model.ErrNoSecurityLevelIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/securitylevel/%v", i.version, levelID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.securityLevel.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := new(model.IssueSecurityLevelScheme)
+	response, err := i.c.Call(request, level)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return level, response, nil
+}
+
+func (i *internalIssueSecurityLevelImpl) Gets(ctx context.Context, options *model.IssueSecurityLevelSearchOptionsScheme, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, schemeID := range options.SchemeIDs {
+			params.Add("schemeId", schemeID)
+		}
+
+		if options.OnlyDefault {
+			params.Add("onlyDefault", "true")
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.securityLevel.gets", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecurityLevelPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecurityLevelImpl) Members(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptionsScheme, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, schemeID := range options.SchemeIDs {
+			params.Add("schemeId", schemeID)
+		}
+
+		for _, levelID := range options.LevelIDs {
+			params.Add("levelId", levelID)
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level/member?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.securityLevel.members", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecurityLevelMemberPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
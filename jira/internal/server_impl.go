@@ -42,7 +42,7 @@ func (i *internalServerServiceImpl) Info(ctx context.Context) (*model.ServerInfo
 
 	endpoint := fmt.Sprintf("rest/api/%v/serverInfo", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "server.info", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func NewSettingsService(client service.Client, version string) (*SettingsService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &SettingsService{
+		internalClient: &internalSettingsImpl{c: client, version: version},
+	}, nil
+}
+
+type SettingsService struct {
+	internalClient jira.SettingsConnector
+}
+
+// Columns returns the default issue navigator columns.
+//
+// GET /rest/api/{2-3}/settings/columns
+//
+// TODO: the documentation needs to be created
+func (s *SettingsService) Columns(ctx context.Context) ([]*model.ColumnItemScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Columns(ctx)
+}
+
+// SetColumns sets the default issue navigator columns.
+//
+// The columns are sent as form data, each column is a navigable field id, e.g. "summary".
+//
+// PUT /rest/api/{2-3}/settings/columns
+//
+// TODO: the documentation needs to be created
+func (s *SettingsService) SetColumns(ctx context.Context, columns []string) (*model.ResponseScheme, error) {
+	return s.internalClient.SetColumns(ctx, columns)
+}
+
+type internalSettingsImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalSettingsImpl) Columns(ctx context.Context) ([]*model.ColumnItemScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/settings/columns", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "settings.columns", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var columns []*model.ColumnItemScheme
+	response, err := i.c.Call(request, &columns)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return columns, response, nil
+}
+
+func (i *internalSettingsImpl) SetColumns(ctx context.Context, columns []string) (*model.ResponseScheme, error) {
+
+	if len(columns) == 0 {
+		return nil, model.ErrNoColumnsError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/settings/columns", i.version)
+
+	request, err := newColumnsFormRequest(ctx, i.c, "settings.setColumns", http.MethodPut, endpoint, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// newColumnsFormRequest creates the form-encoded request used by the column endpoints,
+// the columns are sent as repeated "columns" form values.
+func newColumnsFormRequest(ctx context.Context, client service.Client, operation, method, endpoint string, columns []string) (*http.Request, error) {
+
+	form := url.Values{}
+	for _, column := range columns {
+		form.Add("columns", column)
+	}
+
+	return service.NewOperationFormRequest(ctx, client, operation, method, endpoint, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+}
@@ -61,7 +61,7 @@ func (i *internalTaskServiceImpl) Get(ctx context.Context, taskId string) (*mode
 
 	endpoint := fmt.Sprintf("rest/api/%v/task/%v", i.version, taskId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "task.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -82,7 +82,7 @@ func (i *internalTaskServiceImpl) Cancel(ctx context.Context, taskId string) (*m
 	}
 
 	endpoint := fmt.Sprintf("rest/api/%v/task/%v/cancel", i.version, taskId)
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "task.cancel", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7,6 +7,8 @@ import (
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/jira"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 func NewTypeService(client service.Client, version string, scheme *TypeSchemeService, screenScheme *TypeScreenSchemeService) (
@@ -69,12 +71,13 @@ func (t *TypeService) Update(ctx context.Context, issueTypeId string, payload *m
 //
 // If the issue type is in use, all uses are updated with the alternative issue type (alternativeIssueTypeId).
 // A list of alternative issue types are obtained from the Get alternative issue types resource.
+// The issue types in use can't be deleted without an alternative issue type, Jira returns a 409 response.
 //
 // DELETE /rest/api/{2-3}/issuetype/{id}
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/type#delete-issue-type
-func (t *TypeService) Delete(ctx context.Context, issueTypeId string) (*model.ResponseScheme, error) {
-	return t.internalClient.Delete(ctx, issueTypeId)
+func (t *TypeService) Delete(ctx context.Context, issueTypeId, alternativeIssueTypeId string) (*model.ResponseScheme, error) {
+	return t.internalClient.Delete(ctx, issueTypeId, alternativeIssueTypeId)
 }
 
 // Alternatives returns a list of issue types that can be used to replace the issue type.
@@ -97,7 +100,7 @@ func (i *internalTypeImpl) Gets(ctx context.Context) ([]*model.IssueTypeScheme,
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetype", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -120,7 +123,7 @@ func (i *internalTypeImpl) Create(ctx context.Context, payload *model.IssueTypeP
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetype", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -142,7 +145,7 @@ func (i *internalTypeImpl) Get(ctx context.Context, issueTypeId string) (*model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetype/%v", i.version, issueTypeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,7 +172,7 @@ func (i *internalTypeImpl) Update(ctx context.Context, issueTypeId string, paylo
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetype/%v", i.version, issueTypeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -183,15 +186,22 @@ func (i *internalTypeImpl) Update(ctx context.Context, issueTypeId string, paylo
 	return issueType, response, nil
 }
 
-func (i *internalTypeImpl) Delete(ctx context.Context, issueTypeId string) (*model.ResponseScheme, error) {
+func (i *internalTypeImpl) Delete(ctx context.Context, issueTypeId, alternativeIssueTypeId string) (*model.ResponseScheme, error) {
 
 	if issueTypeId == "" {
 		return nil, model.ErrNoIssueTypeIDError
 	}
 
-	endpoint := fmt.Sprintf("rest/api/%v/issuetype/%v", i.version, issueTypeId)
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issuetype/%v", i.version, issueTypeId))
+
+	if alternativeIssueTypeId != "" {
+		params := url.Values{}
+		params.Add("alternativeIssueTypeId", alternativeIssueTypeId)
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.delete", http.MethodDelete, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -201,9 +211,13 @@ func (i *internalTypeImpl) Delete(ctx context.Context, issueTypeId string) (*mod
 
 func (i *internalTypeImpl) Alternatives(ctx context.Context, issueTypeId string) ([]*model.IssueTypeScheme, *model.ResponseScheme, error) {
 
+	if issueTypeId == "" {
+		return nil, nil, model.ErrNoIssueTypeIDError
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issuetype/%v/alternatives", i.version, issueTypeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.alternatives", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -336,6 +336,19 @@ func Test_internalTypeImpl_Alternatives(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the issue type id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueTypeIDError,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
@@ -737,8 +750,9 @@ func Test_internalTypeImpl_Delete(t *testing.T) {
 	}
 
 	type args struct {
-		ctx         context.Context
-		issueTypeId string
+		ctx                    context.Context
+		issueTypeId            string
+		alternativeIssueTypeId string
 	}
 
 	testCases := []struct {
@@ -778,6 +792,34 @@ func Test_internalTypeImpl_Delete(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the alternative issue type is provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:                    context.Background(),
+				issueTypeId:            "8",
+				alternativeIssueTypeId: "10001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issuetype/8?alternativeIssueTypeId=10001",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
@@ -852,7 +894,7 @@ func Test_internalTypeImpl_Delete(t *testing.T) {
 			newService, err := NewTypeService(testCase.fields.c, testCase.fields.version, nil, nil)
 			assert.NoError(t, err)
 
-			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.issueTypeId)
+			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.issueTypeId, testCase.args.alternativeIssueTypeId)
 
 			if testCase.wantErr {
 
@@ -138,7 +138,7 @@ func (i *internalTypeSchemeImpl) Gets(ctx context.Context, issueTypeSchemeIds []
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -161,7 +161,7 @@ func (i *internalTypeSchemeImpl) Create(ctx context.Context, payload *model.Issu
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -187,7 +187,7 @@ func (i *internalTypeSchemeImpl) Items(ctx context.Context, issueTypeSchemeIds [
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/mapping?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.items", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -213,7 +213,7 @@ func (i *internalTypeSchemeImpl) Projects(ctx context.Context, projectIds []int,
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/project?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.projects", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -252,7 +252,7 @@ func (i *internalTypeSchemeImpl) Assign(ctx context.Context, issueTypeSchemeId,
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/project", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.assign", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +273,7 @@ func (i *internalTypeSchemeImpl) Update(ctx context.Context, issueTypeSchemeId i
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/%v", i.version, issueTypeSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -289,7 +289,7 @@ func (i *internalTypeSchemeImpl) Delete(ctx context.Context, issueTypeSchemeId i
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/%v", i.version, issueTypeSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -321,7 +321,7 @@ func (i *internalTypeSchemeImpl) Append(ctx context.Context, issueTypeSchemeId i
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/%v/issuetype", i.version, issueTypeSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.append", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -341,7 +341,7 @@ func (i *internalTypeSchemeImpl) Remove(ctx context.Context, issueTypeSchemeId,
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/%v/issuetype/%v", i.version, issueTypeSchemeId, issueTypeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.scheme.remove", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +40,8 @@ func (t *TypeScreenSchemeService) Gets(ctx context.Context, options *model.Scree
 
 // Create creates an issue type screen scheme.
 //
+// The mappings must contain the default mapping, its issue type id is "default".
+//
 // POST /rest/api/{2-3}/issuetypescreenscheme
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/types/screen-scheme#create-issue-type-screen-scheme
@@ -99,6 +101,8 @@ func (t *TypeScreenSchemeService) Delete(ctx context.Context, issueTypeScreenSch
 
 // Append appends issue type to screen scheme mappings to an issue type screen scheme.
 //
+// The default mapping can't be appended, use UpdateDefault to update it.
+//
 // PUT /rest/api/{2-3}/issuetypescreenscheme/{issueTypeScreenSchemeId}/mapping
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/types/screen-scheme#append-mappings-to-issue-type-screen-scheme
@@ -166,7 +170,7 @@ func (i *internalTypeScreenSchemeImpl) Gets(ctx context.Context, options *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -182,6 +186,10 @@ func (i *internalTypeScreenSchemeImpl) Gets(ctx context.Context, options *model.
 
 func (i *internalTypeScreenSchemeImpl) Create(ctx context.Context, payload *model.IssueTypeScreenSchemePayloadScheme) (*model.IssueTypeScreenScreenCreatedScheme, *model.ResponseScheme, error) {
 
+	if err := validateScreenSchemeMappings(payload, true); err != nil {
+		return nil, nil, err
+	}
+
 	reader, err := i.c.TransformStructToReader(payload)
 	if err != nil {
 		return nil, nil, err
@@ -189,7 +197,7 @@ func (i *internalTypeScreenSchemeImpl) Create(ctx context.Context, payload *mode
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -228,7 +236,7 @@ func (i *internalTypeScreenSchemeImpl) Assign(ctx context.Context, issueTypeScre
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/project", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.assign", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +256,7 @@ func (i *internalTypeScreenSchemeImpl) Projects(ctx context.Context, projectIds
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/project?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.projects", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -274,7 +282,7 @@ func (i *internalTypeScreenSchemeImpl) Mapping(ctx context.Context, issueTypeScr
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/mapping?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.mapping", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -309,7 +317,7 @@ func (i *internalTypeScreenSchemeImpl) Update(ctx context.Context, issueTypeScre
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/%v", i.version, issueTypeScreenSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -325,7 +333,7 @@ func (i *internalTypeScreenSchemeImpl) Delete(ctx context.Context, issueTypeScre
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/%v", i.version, issueTypeScreenSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +347,10 @@ func (i *internalTypeScreenSchemeImpl) Append(ctx context.Context, issueTypeScre
 		return nil, model.ErrNoIssueTypeScreenSchemeIDError
 	}
 
+	if err := validateScreenSchemeMappings(payload, false); err != nil {
+		return nil, err
+	}
+
 	reader, err := i.c.TransformStructToReader(payload)
 	if err != nil {
 		return nil, err
@@ -346,7 +358,7 @@ func (i *internalTypeScreenSchemeImpl) Append(ctx context.Context, issueTypeScre
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/%v/mapping", i.version, issueTypeScreenSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.append", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -377,7 +389,7 @@ func (i *internalTypeScreenSchemeImpl) UpdateDefault(ctx context.Context, issueT
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/%v/mapping/default", i.version, issueTypeScreenSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.updateDefault", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -403,7 +415,7 @@ func (i *internalTypeScreenSchemeImpl) Remove(ctx context.Context, issueTypeScre
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/%v/mapping/remove", i.version, issueTypeScreenSchemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.remove", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -419,7 +431,7 @@ func (i *internalTypeScreenSchemeImpl) SchemesByProject(ctx context.Context, iss
 
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescreenscheme/%v/project?%v", i.version, issueTypeScreenSchemeId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.type.screenScheme.schemesByProject", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -432,3 +444,41 @@ func (i *internalTypeScreenSchemeImpl) SchemesByProject(ctx context.Context, iss
 
 	return page, response, nil
 }
+
+// issueTypeScreenSchemeDefaultMapping is the issue type id of the mapping used by the issue types without their own mapping
+const issueTypeScreenSchemeDefaultMapping = "default"
+
+// validateScreenSchemeMappings checks the issue type ids of the mappings, they're issue type ids or the lowercase default id.
+//
+// The default mapping is required to create a scheme, and it can't be appended to a scheme, UpdateDefault updates it.
+func validateScreenSchemeMappings(payload *model.IssueTypeScreenSchemePayloadScheme, create bool) error {
+
+	var mappings []*model.IssueTypeScreenSchemeMappingPayloadScheme
+	if payload != nil {
+		mappings = payload.IssueTypeMappings
+	}
+
+	var hasDefault bool
+	for _, mapping := range mappings {
+
+		if mapping.IssueTypeID == issueTypeScreenSchemeDefaultMapping {
+
+			if !create {
+				return model.ErrIssueTypeDefaultMappingAppendError
+			}
+
+			hasDefault = true
+			continue
+		}
+
+		if _, err := strconv.ParseUint(mapping.IssueTypeID, 10, 64); err != nil {
+			return fmt.Errorf("%w: %q", model.ErrInvalidIssueTypeMappingError, mapping.IssueTypeID)
+		}
+	}
+
+	if create && !hasDefault {
+		return model.ErrNoIssueTypeDefaultMappingError
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
@@ -1098,6 +1099,25 @@ func Test_internalTypeScreenSchemeImpl_Append(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the default mapping is appended",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:                     context.Background(),
+				issueTypeScreenSchemeId: "20001",
+				payload: &model.IssueTypeScreenSchemePayloadScheme{
+					IssueTypeMappings: []*model.IssueTypeScreenSchemeMappingPayloadScheme{
+						{IssueTypeID: "default", ScreenSchemeID: "10000"},
+					},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrIssueTypeDefaultMappingAppendError,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
@@ -1567,6 +1587,44 @@ func Test_internalTypeScreenSchemeImpl_Create(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the default mapping is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueTypeScreenSchemePayloadScheme{
+					Name: "FX 2 Issue Type Screen Scheme",
+					IssueTypeMappings: []*model.IssueTypeScreenSchemeMappingPayloadScheme{
+						{IssueTypeID: "10004", ScreenSchemeID: "10002"},
+					},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueTypeDefaultMappingError,
+		},
+
+		{
+			name:   "when the default mapping doesn't use the default id",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueTypeScreenSchemePayloadScheme{
+					Name: "FX 2 Issue Type Screen Scheme",
+					IssueTypeMappings: []*model.IssueTypeScreenSchemeMappingPayloadScheme{
+						{IssueTypeID: "Default", ScreenSchemeID: "10000"},
+					},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			wantErr: true,
+			Err:     fmt.Errorf("%w: %q", model.ErrInvalidIssueTypeMappingError, "Default"),
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
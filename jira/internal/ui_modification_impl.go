@@ -0,0 +1,199 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/jira"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func NewUIModificationService(client service.Client, version string) (*UIModificationService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &UIModificationService{
+		internalClient: &internalUIModificationImpl{c: client, version: version},
+	}, nil
+}
+
+type UIModificationService struct {
+	internalClient jira.UIModificationConnector
+}
+
+// Gets returns a paginated list of the UI modifications created by the calling Forge or Connect app.
+//
+// Use the data and contexts expand values to return the data and contexts of the UI modifications.
+//
+// GET /rest/api/{2-3}/uiModifications
+//
+// TODO: the documentation needs to be created
+func (u *UIModificationService) Gets(ctx context.Context, expand []string, startAt, maxResults int) (*model.UIModificationPageScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Gets(ctx, expand, startAt, maxResults)
+}
+
+// Create creates a UI modification, the UI modifications can only be created by Forge or Connect apps.
+//
+// An app can create up to 1000 UI modifications, a *model.UIModificationLimitError
+// matching ErrUIModificationLimitError is returned when the limit is reached.
+//
+// POST /rest/api/{2-3}/uiModifications
+//
+// TODO: the documentation needs to be created
+func (u *UIModificationService) Create(ctx context.Context, payload *model.UIModificationPayloadScheme) (*model.UIModificationIdentifiersScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Create(ctx, payload)
+}
+
+// Update updates a UI modification, the contexts sent replace the contexts of the UI modification.
+//
+// PUT /rest/api/{2-3}/uiModifications/{uiModificationId}
+//
+// TODO: the documentation needs to be created
+func (u *UIModificationService) Update(ctx context.Context, uiModificationID string, payload *model.UIModificationPayloadScheme) (*model.ResponseScheme, error) {
+	return u.internalClient.Update(ctx, uiModificationID, payload)
+}
+
+// Delete deletes a UI modification.
+//
+// DELETE /rest/api/{2-3}/uiModifications/{uiModificationId}
+//
+// TODO: the documentation needs to be created
+func (u *UIModificationService) Delete(ctx context.Context, uiModificationID string) (*model.ResponseScheme, error) {
+	return u.internalClient.Delete(ctx, uiModificationID)
+}
+
+type internalUIModificationImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalUIModificationImpl) Gets(ctx context.Context, expand []string, startAt, maxResults int) (*model.UIModificationPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/uiModifications?%v", i.version, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "uiModification.gets", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.UIModificationPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalUIModificationImpl) Create(ctx context.Context, payload *model.UIModificationPayloadScheme) (*model.UIModificationIdentifiersScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNilPayloadError
+	}
+
+	if payload.Name == "" {
+		return nil, nil, model.ErrNoUIModificationNameError
+	}
+
+	if err := payload.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/uiModifications", i.version)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "uiModification.create", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identifiers := new(model.UIModificationIdentifiersScheme)
+	response, err := i.c.Call(request, identifiers)
+	if err != nil {
+
+		if isUIModificationLimitResponse(response) {
+			return nil, response, &model.UIModificationLimitError{Err: err}
+		}
+
+		return nil, response, err
+	}
+
+	return identifiers, response, nil
+}
+
+func (i *internalUIModificationImpl) Update(ctx context.Context, uiModificationID string, payload *model.UIModificationPayloadScheme) (*model.ResponseScheme, error) {
+
+	if uiModificationID == "" {
+		return nil, model.ErrNoUIModificationIDError
+	}
+
+	if payload == nil {
+		return nil, model.ErrNilPayloadError
+	}
+
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/uiModifications/%v", i.version, uiModificationID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "uiModification.update", http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalUIModificationImpl) Delete(ctx context.Context, uiModificationID string) (*model.ResponseScheme, error) {
+
+	if uiModificationID == "" {
+		return nil, model.ErrNoUIModificationIDError
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/uiModifications/%v", i.version, uiModificationID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "uiModification.delete", http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// isUIModificationLimitResponse reports whether the site rejected the UI modification because the app reached
+// the maximum number of UI modifications, the limit is only reported on the error message.
+func isUIModificationLimitResponse(response *model.ResponseScheme) bool {
+
+	if response == nil || response.Code != http.StatusBadRequest {
+		return false
+	}
+
+	message := strings.ToLower(response.Bytes.String())
+
+	return strings.Contains(message, "maximum number of ui modifications") ||
+		(strings.Contains(message, "ui modifications") && strings.Contains(message, "limit"))
+}
@@ -115,7 +115,7 @@ func (i *internalUserImpl) Get(ctx context.Context, accountId string, expand []s
 
 	endpoint := fmt.Sprintf("rest/api/%v/user?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,7 +138,7 @@ func (i *internalUserImpl) Create(ctx context.Context, payload *model.UserPayloa
 
 	endpoint := fmt.Sprintf("rest/api/%v/user", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -162,7 +162,7 @@ func (i *internalUserImpl) Delete(ctx context.Context, accountId string) (*model
 	params.Add("accountId", accountId)
 	endpoint := fmt.Sprintf("rest/api/%v/user?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +186,7 @@ func (i *internalUserImpl) Find(ctx context.Context, accountIds []string, startA
 
 	endpoint := fmt.Sprintf("rest/api/%v/user/bulk?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.find", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -210,7 +210,7 @@ func (i *internalUserImpl) Groups(ctx context.Context, accountId string) ([]*mod
 	params.Add("accountId", accountId)
 	endpoint := fmt.Sprintf("rest/api/%v/user/groups?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.groups", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -232,7 +232,7 @@ func (i *internalUserImpl) Gets(ctx context.Context, startAt, maxResults int) ([
 
 	endpoint := fmt.Sprintf("rest/api/%v/users/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
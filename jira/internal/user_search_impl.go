@@ -104,7 +104,7 @@ func (i *internalUserSearchImpl) Check(ctx context.Context, permission string, o
 
 	endpoint := fmt.Sprintf("rest/api/%v/user/permission/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.search.check", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,7 +138,7 @@ func (i *internalUserSearchImpl) Projects(ctx context.Context, accountId string,
 
 	endpoint := fmt.Sprintf("rest/api/%v/user/assignable/multiProjectSearch?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.search.projects", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -168,7 +168,7 @@ func (i *internalUserSearchImpl) Do(ctx context.Context, accountId, query string
 
 	endpoint := fmt.Sprintf("rest/api/%v/user/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "user.search.do", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
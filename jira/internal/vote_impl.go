@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/jira"
 	"net/http"
@@ -57,6 +58,19 @@ func (v *VoteService) Delete(ctx context.Context, issueKeyOrId string) (*model.R
 	return v.internalClient.Delete(ctx, issueKeyOrId)
 }
 
+// Migrate reports the votes of the issues matching the JQL scope to move from an account to another account.
+//
+// The votes can only be added and removed by the voting user, the report lists the actions left by issue,
+// e.g. the target account must vote and the source account must remove its vote.
+//
+// The issues are checked concurrently, a positive concurrency pins the requests in flight,
+// otherwise it starts at 5 and adapts to the rate limit of the site.
+//
+// TODO: the documentation needs to be created
+func (v *VoteService) Migrate(ctx context.Context, fromAccountID, toAccountID, jqlScope string, concurrency int) (*model.IssueUserMigrationReportScheme, *model.ResponseScheme, error) {
+	return v.internalClient.Migrate(ctx, fromAccountID, toAccountID, jqlScope, concurrency)
+}
+
 type internalVoteImpl struct {
 	c       service.Client
 	version string
@@ -70,7 +84,7 @@ func (i *internalVoteImpl) Gets(ctx context.Context, issueKeyOrId string) (*mode
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/votes", i.version, issueKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.vote.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,7 +106,7 @@ func (i *internalVoteImpl) Add(ctx context.Context, issueKeyOrId string) (*model
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/votes", i.version, issueKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.vote.add", http.MethodPost, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -108,10 +122,47 @@ func (i *internalVoteImpl) Delete(ctx context.Context, issueKeyOrId string) (*mo
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/votes", i.version, issueKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.vote.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalVoteImpl) Migrate(ctx context.Context, fromAccountID, toAccountID, jqlScope string, concurrency int) (*model.IssueUserMigrationReportScheme, *model.ResponseScheme, error) {
+
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+
+	return migrateIssueUser(ctx, search, "voter", fromAccountID, toAccountID, jqlScope, concurrency,
+		func(ctx context.Context, controller *throttle.Controller, issueKey string) *model.IssueUserMigrationResultScheme {
+
+			result := &model.IssueUserMigrationResultScheme{IssueKey: issueKey}
+
+			var votes *model.IssueVoteScheme
+			_, result.Err = callWithController(ctx, controller, defaultBulkMaxRetries, func() (response *model.ResponseScheme, err error) {
+				votes, response, err = i.Gets(ctx, issueKey)
+				return response, err
+			})
+
+			if result.Err != nil {
+				return result
+			}
+
+			voted := false
+			for _, voter := range votes.Voters {
+
+				if voter.AccountID == toAccountID {
+					voted = true
+					break
+				}
+			}
+
+			if !voted {
+				result.Actions = append(result.Actions, model.IssueUserMigrationActionVote)
+			}
+
+			result.Actions = append(result.Actions, model.IssueUserMigrationActionUnvote)
+			return result
+		})
+}
@@ -1,12 +1,14 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -444,6 +446,173 @@ func Test_internalVoteImpl_Delete(t *testing.T) {
 	}
 }
 
+func Test_internalVoteImpl_Migrate(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                                  context.Context
+		fromAccountID, toAccountID, jqlScope string
+		concurrency                          int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueUserMigrationReportScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				fromAccountID: "old-account-id",
+				toAccountID:   "new-account-id",
+				jqlScope:      "project = KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockMigrationSearch(client,
+					`voter = "old-account-id" AND (project = KP)`,
+					"KP-1", "KP-2", "KP-3")
+
+				voters := map[string][]*model.UserScheme{
+					"KP-1": {{AccountID: "old-account-id"}, {AccountID: "new-account-id"}},
+					"KP-2": {{AccountID: "old-account-id"}},
+				}
+
+				for _, issueKey := range []string{"KP-1", "KP-2", "KP-3"} {
+
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodGet,
+						"rest/api/3/issue/"+issueKey+"/votes",
+						nil).
+						Return(&http.Request{RequestURI: issueKey}, nil)
+				}
+
+				for _, issueKey := range []string{"KP-1", "KP-2"} {
+
+					issueVoters := voters[issueKey]
+					client.On("Call",
+						&http.Request{RequestURI: issueKey},
+						&model.IssueVoteScheme{}).
+						Run(func(args mock.Arguments) {
+							args.Get(1).(*model.IssueVoteScheme).Voters = issueVoters
+						}).
+						Return(&model.ResponseScheme{}, nil)
+				}
+
+				client.On("Call",
+					&http.Request{RequestURI: "KP-3"},
+					&model.IssueVoteScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusNotFound}, model.ErrInvalidStatusCodeError)
+
+				fields.c = client
+			},
+			want: &model.IssueUserMigrationReportScheme{
+				Issues: []*model.IssueUserMigrationResultScheme{
+					{IssueKey: "KP-1", Actions: []string{model.IssueUserMigrationActionUnvote}},
+					{IssueKey: "KP-2", Actions: []string{model.IssueUserMigrationActionVote, model.IssueUserMigrationActionUnvote}},
+					{IssueKey: "KP-3", Err: model.ErrInvalidStatusCodeError},
+				},
+				Processed: []string{"KP-1", "KP-2"},
+			},
+		},
+
+		{
+			name:   "when the account ids are the same",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:           context.Background(),
+				fromAccountID: "old-account-id",
+				toAccountID:   "old-account-id",
+			},
+			wantErr: true,
+			Err:     model.ErrSameAccountIDError,
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				toAccountID: "new-account-id",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountIDError,
+		},
+
+		{
+			name:   "when the search fails",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:           context.Background(),
+				fromAccountID: "old-account-id",
+				toAccountID:   "new-account-id",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.Anything).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			voteService, err := NewVoteService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := voteService.Migrate(testCase.args.ctx, testCase.args.fromAccountID,
+				testCase.args.toAccountID, testCase.args.jqlScope, testCase.args.concurrency)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
 func Test_NewVoteService(t *testing.T) {
 
 	type args struct {
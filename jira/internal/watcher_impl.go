@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/jira"
+	"io"
 	"net/http"
 	"net/url"
 )
@@ -41,8 +43,8 @@ func (w *WatcherService) Gets(ctx context.Context, issueKeyOrId string) (*model.
 // POST /rest/api/{2-3}/issue/{issueIdOrKey}/watchers
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#add-watcher
-func (w *WatcherService) Add(ctx context.Context, issueKeyOrId string) (*model.ResponseScheme, error) {
-	return w.internalClient.Add(ctx, issueKeyOrId)
+func (w *WatcherService) Add(ctx context.Context, issueKeyOrId, accountId string) (*model.ResponseScheme, error) {
+	return w.internalClient.Add(ctx, issueKeyOrId, accountId)
 }
 
 // Delete deletes a user as a watcher of an issue.
@@ -54,6 +56,29 @@ func (w *WatcherService) Delete(ctx context.Context, issueKeyOrId, accountId str
 	return w.internalClient.Delete(ctx, issueKeyOrId, accountId)
 }
 
+// AddAccount adds a user, identified by account id, as a watcher of an issue.
+//
+// POST /rest/api/{2-3}/issue/{issueIdOrKey}/watchers
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#add-watcher
+//
+// Deprecated: use Add, it sends the account id too.
+func (w *WatcherService) AddAccount(ctx context.Context, issueKeyOrId, accountId string) (*model.ResponseScheme, error) {
+	return w.internalClient.AddAccount(ctx, issueKeyOrId, accountId)
+}
+
+// Migrate moves the watches of the issues matching the JQL scope from an account to another account.
+//
+// The target account is added as a watcher before the source account is removed, the issues are migrated concurrently.
+// A positive concurrency pins the requests in flight, otherwise it starts at 5 and adapts to the rate limit of the site.
+//
+// The failures are reported by issue, the report processed keys can be excluded from the scope to resume the migration.
+//
+// TODO: the documentation needs to be created
+func (w *WatcherService) Migrate(ctx context.Context, fromAccountID, toAccountID, jqlScope string, concurrency int) (*model.IssueUserMigrationReportScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Migrate(ctx, fromAccountID, toAccountID, jqlScope, concurrency)
+}
+
 type internalWatcherImpl struct {
 	c       service.Client
 	version string
@@ -67,7 +92,7 @@ func (i *internalWatcherImpl) Gets(ctx context.Context, issueKeyOrId string) (*m
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/watchers", i.version, issueKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.watcher.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,15 +106,27 @@ func (i *internalWatcherImpl) Gets(ctx context.Context, issueKeyOrId string) (*m
 	return watchers, response, nil
 }
 
-func (i *internalWatcherImpl) Add(ctx context.Context, issueKeyOrId string) (*model.ResponseScheme, error) {
+func (i *internalWatcherImpl) Add(ctx context.Context, issueKeyOrId, accountId string) (*model.ResponseScheme, error) {
 
 	if issueKeyOrId == "" {
 		return nil, model.ErrNoIssueKeyOrIDError
 	}
 
+	// The request without a body adds the calling user
+	var reader io.Reader
+	if accountId != "" {
+
+		// The account id is sent as a JSON string
+		var err error
+		reader, err = i.c.TransformStructToReader(&accountId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/watchers", i.version, issueKeyOrId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.watcher.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -112,10 +149,49 @@ func (i *internalWatcherImpl) Delete(ctx context.Context, issueKeyOrId, accountI
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/watchers?%v", i.version, issueKeyOrId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.watcher.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalWatcherImpl) AddAccount(ctx context.Context, issueKeyOrId, accountId string) (*model.ResponseScheme, error) {
+
+	if issueKeyOrId == "" {
+		return nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if accountId == "" {
+		return nil, model.ErrNoAccountIDError
+	}
+
+	return i.Add(ctx, issueKeyOrId, accountId)
+}
+
+func (i *internalWatcherImpl) Migrate(ctx context.Context, fromAccountID, toAccountID, jqlScope string, concurrency int) (*model.IssueUserMigrationReportScheme, *model.ResponseScheme, error) {
+
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+
+	return migrateIssueUser(ctx, search, "watcher", fromAccountID, toAccountID, jqlScope, concurrency,
+		func(ctx context.Context, controller *throttle.Controller, issueKey string) *model.IssueUserMigrationResultScheme {
+
+			result := &model.IssueUserMigrationResultScheme{IssueKey: issueKey}
+
+			_, result.Err = callWithController(ctx, controller, defaultBulkMaxRetries, func() (*model.ResponseScheme, error) {
+				return i.Add(ctx, issueKey, toAccountID)
+			})
+
+			if result.Err != nil {
+				return result
+			}
+
+			_, result.Err = callWithController(ctx, controller, defaultBulkMaxRetries, func() (*model.ResponseScheme, error) {
+				return i.Delete(ctx, issueKey, fromAccountID)
+			})
+
+			result.Migrated = result.Err == nil
+			return result
+		})
+}
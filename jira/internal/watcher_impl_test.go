@@ -1,13 +1,16 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
@@ -166,6 +169,7 @@ func Test_internalWatcherImpl_Add(t *testing.T) {
 	type args struct {
 		ctx          context.Context
 		issueKeyOrId string
+		accountId    string
 	}
 
 	testCases := []struct {
@@ -176,6 +180,139 @@ func Test_internalWatcherImpl_Add(t *testing.T) {
 		wantErr bool
 		Err     error
 	}{
+		{
+			name:   "when the account id is provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				accountId:    "5b10ac8d82e05b22cc7d4ef5",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				accountId := "5b10ac8d82e05b22cc7d4ef5"
+
+				client.On("TransformStructToReader",
+					&accountId).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/watchers",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the account id is not provided, the calling user is added",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-5/watchers",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the user is not authenticated",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				accountId:    "5b10ac8d82e05b22cc7d4ef5",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.Anything).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/watchers",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusUnauthorized}, model.ErrInvalidStatusCodeError)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidStatusCodeError,
+		},
+
+		{
+			name:   "when the issue or the user does not exist",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				accountId:    "5b10ac8d82e05b22cc7d4ef5",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.Anything).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/watchers",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusNotFound}, model.ErrInvalidStatusCodeError)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidStatusCodeError,
+		},
+
 		{
 			name:   "when the api version is v3",
 			fields: fields{version: "3"},
@@ -280,7 +417,7 @@ func Test_internalWatcherImpl_Add(t *testing.T) {
 			newService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
 			assert.NoError(t, err)
 
-			gotResponse, err := newService.Add(testCase.args.ctx, testCase.args.issueKeyOrId)
+			gotResponse, err := newService.Add(testCase.args.ctx, testCase.args.issueKeyOrId, testCase.args.accountId)
 
 			if testCase.wantErr {
 
@@ -321,6 +458,47 @@ func Test_internalWatcherImpl_Delete(t *testing.T) {
 		wantErr bool
 		Err     error
 	}{
+		{
+			name:   "when the account id is escaped on the query string",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				accountId:    "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issue/DUMMY-5/watchers?accountId=557058%3Af58131cb-b67d-43c7-b30d-6b58d40bd077",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the account id of the watcher removed is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountIDError,
+		},
+
 		{
 			name:   "when the api version is v3",
 			fields: fields{version: "3"},
@@ -460,6 +638,391 @@ func Test_internalWatcherImpl_Delete(t *testing.T) {
 	}
 }
 
+func Test_internalWatcherImpl_AddAccount(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                     context.Context
+		issueKeyOrId, accountId string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				accountId:    "account-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				accountId := "account-id-sample"
+				client.On("TransformStructToReader",
+					&accountId).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/watchers",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountId: "account-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrIDError,
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				accountId:    "account-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				accountId := "account-id-sample"
+				client.On("TransformStructToReader",
+					&accountId).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-5/watchers",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			watcherService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := watcherService.AddAccount(testCase.args.ctx, testCase.args.issueKeyOrId, testCase.args.accountId)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+// mockMigrationSearch mocks the search of the issues to migrate.
+func mockMigrationSearch(client *mocks.Client, jql string, issueKeys ...string) {
+
+	client.On("TransformStructToReader",
+		mock.MatchedBy(func(payload interface{}) bool {
+			value := reflect.ValueOf(payload)
+			return value.Kind() == reflect.Ptr && value.Elem().Kind() == reflect.Struct && value.Elem().FieldByName("Jql").IsValid() &&
+				value.Elem().FieldByName("Jql").String() == jql
+		})).
+		Return(bytes.NewReader([]byte(jql)), nil)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPost,
+		"rest/api/3/search",
+		bytes.NewReader([]byte(jql))).
+		Return(&http.Request{RequestURI: "search"}, nil)
+
+	client.On("Call",
+		&http.Request{RequestURI: "search"},
+		&model.IssueSearchScheme{}).
+		Run(func(args mock.Arguments) {
+			page := args.Get(1).(*model.IssueSearchScheme)
+			for _, issueKey := range issueKeys {
+				page.Issues = append(page.Issues, &model.IssueScheme{Key: issueKey})
+			}
+			page.Total = len(issueKeys)
+		}).
+		Return(&model.ResponseScheme{}, nil)
+}
+
+func Test_internalWatcherImpl_Migrate(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx                                  context.Context
+		fromAccountID, toAccountID, jqlScope string
+		concurrency                          int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueUserMigrationReportScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				fromAccountID: "old-account-id",
+				toAccountID:   "new-account-id",
+				jqlScope:      "project = KP",
+				concurrency:   2,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockMigrationSearch(client,
+					`watcher = "old-account-id" AND (project = KP)`,
+					"KP-1", "KP-2")
+
+				accountId := "new-account-id"
+				client.On("TransformStructToReader",
+					&accountId).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				for _, issueKey := range []string{"KP-1", "KP-2"} {
+
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodPost,
+						"rest/api/3/issue/"+issueKey+"/watchers",
+						bytes.NewReader([]byte{})).
+						Return(&http.Request{RequestURI: "add-" + issueKey}, nil)
+
+					client.On("Call",
+						&http.Request{RequestURI: "add-" + issueKey},
+						nil).
+						Return(&model.ResponseScheme{}, nil)
+
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodDelete,
+						"rest/api/3/issue/"+issueKey+"/watchers?accountId=old-account-id",
+						nil).
+						Return(&http.Request{RequestURI: "delete-" + issueKey}, nil)
+				}
+
+				client.On("Call",
+					&http.Request{RequestURI: "delete-KP-1"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "delete-KP-2"},
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusForbidden}, model.ErrInvalidStatusCodeError)
+
+				fields.c = client
+			},
+			want: &model.IssueUserMigrationReportScheme{
+				Issues: []*model.IssueUserMigrationResultScheme{
+					{IssueKey: "KP-1", Migrated: true},
+					{IssueKey: "KP-2", Err: model.ErrInvalidStatusCodeError},
+				},
+				Processed: []string{"KP-1"},
+			},
+		},
+
+		{
+			name:   "when the context is cancelled",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:           cancelledContext(),
+				fromAccountID: "old-account-id",
+				toAccountID:   "new-account-id",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.Anything).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					mock.Anything,
+					http.MethodPost,
+					"rest/api/2/search",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueSearchScheme)
+						page.Issues, page.Total = []*model.IssueScheme{{Key: "KP-1"}}, 1
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name:   "when the account ids are the same",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				fromAccountID: "old-account-id",
+				toAccountID:   "old-account-id",
+			},
+			wantErr: true,
+			Err:     model.ErrSameAccountIDError,
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				fromAccountID: "old-account-id",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountIDError,
+		},
+
+		{
+			name:   "when the search fails",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				fromAccountID: "old-account-id",
+				toAccountID:   "new-account-id",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					mock.Anything).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			watcherService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := watcherService.Migrate(testCase.args.ctx, testCase.args.fromAccountID,
+				testCase.args.toAccountID, testCase.args.jqlScope, testCase.args.concurrency)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
+// cancelledContext returns a context cancelled before the call.
+func cancelledContext() context.Context {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	return ctx
+}
+
 func Test_NewWatcherService(t *testing.T) {
 
 	type args struct {
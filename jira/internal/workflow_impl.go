@@ -73,6 +73,24 @@ func (w *WorkflowService) Delete(ctx context.Context, workflowId string) (*model
 	return w.internalClient.Delete(ctx, workflowId)
 }
 
+// ProjectUsages returns the projects using a given workflow.
+//
+// GET /rest/api/{2-3}/workflow/{workflowId}/projectUsages
+//
+// TODO: the documentation needs to be created
+func (w *WorkflowService) ProjectUsages(ctx context.Context, workflowID, nextPageToken string, maxResults int) (*model.WorkflowProjectUsageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.ProjectUsages(ctx, workflowID, nextPageToken, maxResults)
+}
+
+// IssueTypeUsages returns the issue types in a project that are using a given workflow.
+//
+// GET /rest/api/{2-3}/workflow/{workflowId}/project/{projectId}/issueTypeUsages
+//
+// TODO: the documentation needs to be created
+func (w *WorkflowService) IssueTypeUsages(ctx context.Context, workflowID, projectID, nextPageToken string, maxResults int) (*model.WorkflowIssueTypeUsageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.IssueTypeUsages(ctx, workflowID, projectID, nextPageToken, maxResults)
+}
+
 type internalWorkflowImpl struct {
 	c       service.Client
 	version string
@@ -87,7 +105,7 @@ func (i *internalWorkflowImpl) Create(ctx context.Context, payload *model.Workfl
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflow", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,7 +147,7 @@ func (i *internalWorkflowImpl) Gets(ctx context.Context, options *model.Workflow
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflow/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -151,10 +169,72 @@ func (i *internalWorkflowImpl) Delete(ctx context.Context, workflowId string) (*
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflow/%v", i.version, workflowId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalWorkflowImpl) ProjectUsages(ctx context.Context, workflowID, nextPageToken string, maxResults int) (*model.WorkflowProjectUsageScheme, *model.ResponseScheme, error) {
+
+	if workflowID == "" {
+		return nil, nil, model.ErrNoWorkflowIDError
+	}
+
+	params := url.Values{}
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if nextPageToken != "" {
+		params.Add("nextPageToken", nextPageToken)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflow/%v/projectUsages?%v", i.version, workflowID, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.projectUsages", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usages := new(model.WorkflowProjectUsageScheme)
+	response, err := i.c.Call(request, usages)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return usages, response, nil
+}
+
+func (i *internalWorkflowImpl) IssueTypeUsages(ctx context.Context, workflowID, projectID, nextPageToken string, maxResults int) (*model.WorkflowIssueTypeUsageScheme, *model.ResponseScheme, error) {
+
+	if workflowID == "" {
+		return nil, nil, model.ErrNoWorkflowIDError
+	}
+
+	if projectID == "" {
+		return nil, nil, model.ErrNoProjectIDError
+	}
+
+	params := url.Values{}
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if nextPageToken != "" {
+		params.Add("nextPageToken", nextPageToken)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflow/%v/project/%v/issueTypeUsages?%v", i.version, workflowID, projectID, params.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.issueTypeUsages", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usages := new(model.WorkflowIssueTypeUsageScheme)
+	response, err := i.c.Call(request, usages)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return usages, response, nil
+}
@@ -483,6 +483,286 @@ func Test_internalWorkflowImpl_Create(t *testing.T) {
 	}
 }
 
+func Test_internalWorkflowImpl_ProjectUsages(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx           context.Context
+		workflowID    string
+		nextPageToken string
+		maxResults    int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				workflowID:    "a498d711-685d-428d-8c3e-bc03bb450ea7",
+				nextPageToken: "eyJvIjoyfQ==",
+				maxResults:    50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/workflow/a498d711-685d-428d-8c3e-bc03bb450ea7/projectUsages?maxResults=50&nextPageToken=eyJvIjoyfQ%3D%3D",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.WorkflowProjectUsageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				workflowID: "a498d711-685d-428d-8c3e-bc03bb450ea7",
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/workflow/a498d711-685d-428d-8c3e-bc03bb450ea7/projectUsages?maxResults=50",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.WorkflowProjectUsageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the workflow id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkflowIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				workflowID: "a498d711-685d-428d-8c3e-bc03bb450ea7",
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/workflow/a498d711-685d-428d-8c3e-bc03bb450ea7/projectUsages?maxResults=50",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWorkflowService(testCase.fields.c, testCase.fields.version, nil, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.ProjectUsages(testCase.args.ctx, testCase.args.workflowID,
+				testCase.args.nextPageToken, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalWorkflowImpl_IssueTypeUsages(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx           context.Context
+		workflowID    string
+		projectID     string
+		nextPageToken string
+		maxResults    int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				workflowID: "a498d711-685d-428d-8c3e-bc03bb450ea7",
+				projectID:  "10000",
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/workflow/a498d711-685d-428d-8c3e-bc03bb450ea7/project/10000/issueTypeUsages?maxResults=50",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.WorkflowIssueTypeUsageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the workflow id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: "10000",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkflowIDError,
+		},
+
+		{
+			name:   "when the project id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				workflowID: "a498d711-685d-428d-8c3e-bc03bb450ea7",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				workflowID: "a498d711-685d-428d-8c3e-bc03bb450ea7",
+				projectID:  "10000",
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/workflow/a498d711-685d-428d-8c3e-bc03bb450ea7/project/10000/issueTypeUsages?maxResults=50",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWorkflowService(testCase.fields.c, testCase.fields.version, nil, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.IssueTypeUsages(testCase.args.ctx, testCase.args.workflowID,
+				testCase.args.projectID, testCase.args.nextPageToken, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
 func Test_NewWorkflowService(t *testing.T) {
 
 	type args struct {
@@ -121,7 +121,7 @@ func (i *internalWorkflowSchemeImpl) Gets(ctx context.Context, startAt, maxResul
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.scheme.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,7 +144,7 @@ func (i *internalWorkflowSchemeImpl) Create(ctx context.Context, payload *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.scheme.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -175,7 +175,7 @@ func (i *internalWorkflowSchemeImpl) Get(ctx context.Context, schemeId int, retu
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.scheme.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -202,7 +202,7 @@ func (i *internalWorkflowSchemeImpl) Update(ctx context.Context, schemeId int, p
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v", i.version, schemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.scheme.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -224,7 +224,7 @@ func (i *internalWorkflowSchemeImpl) Delete(ctx context.Context, schemeId int) (
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v", i.version, schemeId)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.scheme.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +245,7 @@ func (i *internalWorkflowSchemeImpl) Associations(ctx context.Context, projectId
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/project?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.scheme.associations", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -284,7 +284,7 @@ func (i *internalWorkflowSchemeImpl) Assign(ctx context.Context, schemeId, proje
 
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/project", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.scheme.assign", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +65,8 @@ func (w *WorkflowStatusService) Delete(ctx context.Context, ids []string) (*mode
 
 // Search returns a paginated list of statuses that match a search on name or project.
 //
+// The options AcceptLanguage pins the locale of the status names returned.
+//
 // GET /rest/api/{2-3}/statuses/search
 //
 // https://docs.go-atlassian.io/jira-software-cloud/workflow/status#search-workflow-statuses
@@ -98,6 +100,29 @@ func (w *WorkflowStatusService) Get(ctx context.Context, idOrName string) (*mode
 	return w.internalClient.Get(ctx, idOrName)
 }
 
+// Usages returns the statuses specified by one or more status IDs, including the projects and issue types using them.
+//
+// The usages are always expanded, use the expand to add the workflow usages.
+//
+// GET /rest/api/{2-3}/statuses
+//
+// TODO: the documentation needs to be created
+func (w *WorkflowStatusService) Usages(ctx context.Context, ids, expand []string) ([]*model.WorkflowStatusDetailScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Usages(ctx, ids, expand)
+}
+
+// ResolveByName returns the status matching the name, whatever the locale of the user.
+//
+// The statuses associated with active workflows are matched, in order, on the exact untranslated name,
+// on the exact localized name, then on both names ignoring the case.
+//
+// GET /rest/api/{2-3}/status
+//
+// TODO: the documentation needs to be created
+func (w *WorkflowStatusService) ResolveByName(ctx context.Context, name string) (*model.StatusDetailScheme, *model.ResponseScheme, error) {
+	return w.internalClient.ResolveByName(ctx, name)
+}
+
 type internalWorkflowStatusImpl struct {
 	c       service.Client
 	version string
@@ -111,7 +136,7 @@ func (i *internalWorkflowStatusImpl) Get(ctx context.Context, idOrName string) (
 
 	endpoint := fmt.Sprintf("/rest/api/%v/status/%v", i.version, idOrName)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.status.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,7 +154,7 @@ func (i *internalWorkflowStatusImpl) Bulk(ctx context.Context) ([]*model.StatusD
 
 	endpoint := fmt.Sprintf("/rest/api/%v/status", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.status.bulk", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -161,7 +186,7 @@ func (i *internalWorkflowStatusImpl) Gets(ctx context.Context, ids, expand []str
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.status.gets", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -184,7 +209,7 @@ func (i *internalWorkflowStatusImpl) Update(ctx context.Context, payload *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/statuses", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.status.update", http.MethodPut, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +234,7 @@ func (i *internalWorkflowStatusImpl) Create(ctx context.Context, payload *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/statuses", i.version)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.status.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -236,7 +261,7 @@ func (i *internalWorkflowStatusImpl) Delete(ctx context.Context, ids []string) (
 
 	endpoint := fmt.Sprintf("rest/api/%v/statuses?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.status.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -271,11 +296,15 @@ func (i *internalWorkflowStatusImpl) Search(ctx context.Context, options *model.
 
 	endpoint := fmt.Sprintf("rest/api/%v/statuses/search?%v", i.version, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "workflow.status.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if options != nil && options.AcceptLanguage != "" {
+		request.Header.Set("Accept-Language", options.AcceptLanguage)
+	}
+
 	page := new(model.WorkflowStatusDetailPageScheme)
 	response, err := i.c.Call(request, page)
 	if err != nil {
@@ -284,3 +313,52 @@ func (i *internalWorkflowStatusImpl) Search(ctx context.Context, options *model.
 
 	return page, response, nil
 }
+
+func (i *internalWorkflowStatusImpl) Usages(ctx context.Context, ids, expand []string) ([]*model.WorkflowStatusDetailScheme, *model.ResponseScheme, error) {
+
+	if len(ids) == 0 {
+		return nil, nil, model.ErrNoWorkflowStatusesError
+	}
+
+	expandWithUsages := []string{"usages"}
+	for _, value := range expand {
+		if value != "usages" {
+			expandWithUsages = append(expandWithUsages, value)
+		}
+	}
+
+	return i.Gets(ctx, ids, expandWithUsages)
+}
+
+func (i *internalWorkflowStatusImpl) ResolveByName(ctx context.Context, name string) (*model.StatusDetailScheme, *model.ResponseScheme, error) {
+
+	if name == "" {
+		return nil, nil, model.ErrNoWorkflowStatusNameOrIdError
+	}
+
+	statuses, response, err := i.Bulk(ctx)
+	if err != nil {
+		return nil, response, err
+	}
+
+	entities := make([]namedEntity, len(statuses))
+	for position, status := range statuses {
+		entities[position] = namedEntity{id: status.ID, name: status.Name, untranslatedName: status.UntranslatedName}
+	}
+
+	positions := matchName(name, entities)
+
+	switch len(positions) {
+	case 0:
+		return nil, response, &model.NameResolutionError{Err: model.ErrStatusNameNotFoundError, Name: name}
+	case 1:
+		return statuses[positions[0]], response, nil
+	}
+
+	var candidates []string
+	for _, position := range positions {
+		candidates = append(candidates, statuses[position].ID)
+	}
+
+	return nil, response, &model.NameResolutionError{Err: model.ErrAmbiguousStatusNameError, Name: name, Candidates: candidates}
+}
@@ -703,6 +703,42 @@ func Test_internalWorkflowStatusImpl_Search(t *testing.T) {
 		wantErr bool
 		Err     error
 	}{
+		{
+			name:   "when the accept language is set",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.WorkflowStatusSearchParams{
+					SearchString:   "UAT",
+					AcceptLanguage: "de-DE",
+				},
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/statuses/search?maxResults=50&searchString=UAT&startAt=0",
+					nil).
+					Return(&http.Request{Header: http.Header{}}, nil)
+
+				client.On("Call",
+					mock.MatchedBy(func(request *http.Request) bool {
+						return request.Header.Get("Accept-Language") == "de-DE"
+					}),
+					&model.WorkflowStatusDetailPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
 		{
 			name:   "when the api version is v3",
 			fields: fields{version: "3"},
@@ -1103,6 +1139,148 @@ func Test_internalWorkflowStatusImpl_Get(t *testing.T) {
 	}
 }
 
+func Test_internalWorkflowStatusImpl_Usages(t *testing.T) {
+
+	type fields struct {
+		c       service.Client
+		version string
+	}
+
+	type args struct {
+		ctx    context.Context
+		ids    []string
+		expand []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				ids:    []string{"10000", "10001"},
+				expand: []string{"usages", "workflowUsages"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/statuses?expand=usages%2CworkflowUsages&id=10000&id=10001",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				ids: []string{"10000"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/statuses?expand=usages&id=10000",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the status ids are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkflowStatusesError,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				ids: []string{"10000"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/statuses?expand=usages&id=10000",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWorkflowStatusService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Usages(testCase.args.ctx, testCase.args.ids, testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
 func Test_NewWorkflowStatusService(t *testing.T) {
 
 	type args struct {
@@ -1155,3 +1333,72 @@ func Test_NewWorkflowStatusService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalWorkflowStatusImpl_ResolveByName(t *testing.T) {
+
+	statuses := []*model.StatusDetailScheme{
+		{ID: "1", Name: "Offen", UntranslatedName: "Open"},
+		{ID: "2", Name: "Open", UntranslatedName: "Backlog"},
+		{ID: "3", Name: "Erledigt", UntranslatedName: "Done"},
+		{ID: "4", Name: "In Arbeit", UntranslatedName: "In Progress"},
+		{ID: "5", Name: "In arbeit", UntranslatedName: "Working"},
+	}
+
+	testCases := []struct {
+		name   string
+		status string
+		wantID string
+		Err    error
+	}{
+		{name: "when the untranslated name matches before the localized name", status: "Open", wantID: "1"},
+		{name: "when the localized name matches", status: "Erledigt", wantID: "3"},
+		{name: "when the name matches ignoring the case", status: "done", wantID: "3"},
+		{name: "when the name matches several statuses ignoring the case", status: "IN ARBEIT", Err: model.ErrAmbiguousStatusNameError},
+		{name: "when the name matches no status", status: "Closed", Err: model.ErrStatusNameNotFoundError},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewClient(t)
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodGet,
+				"/rest/api/3/status",
+				nil).
+				Return(&http.Request{}, nil)
+
+			client.On("Call",
+				&http.Request{},
+				mock.Anything).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*[]*model.StatusDetailScheme) = statuses
+				}).
+				Return(&model.ResponseScheme{}, nil)
+
+			newService, err := NewWorkflowStatusService(client, "3")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.ResolveByName(context.Background(), testCase.status)
+			assert.NotNil(t, gotResponse)
+
+			if testCase.Err != nil {
+				assert.ErrorIs(t, err, testCase.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantID, gotResult.ID)
+		})
+	}
+
+	t.Run("when the name is not provided", func(t *testing.T) {
+
+		newService, err := NewWorkflowStatusService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.ResolveByName(context.Background(), "")
+		assert.ErrorIs(t, err, model.ErrNoWorkflowStatusNameOrIdError)
+	})
+}
@@ -557,6 +557,76 @@ func Test_internalWorklogAdfImpl_Delete(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the estimate is set to a new value",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				worklogId:    "h837372",
+				options: &model.WorklogOptionsScheme{
+					Notify:         true,
+					AdjustEstimate: "new",
+					NewEstimate:    "2d",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issue/DUMMY-5/worklog/h837372?adjustEstimate=new&newEstimate=2d&notifyUsers=true&overrideEditableFlag=false",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the estimate is increased manually",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrId: "DUMMY-5",
+				worklogId:    "h837372",
+				options: &model.WorklogOptionsScheme{
+					Notify:         true,
+					AdjustEstimate: "manual",
+					IncreaseBy:     "3h",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issue/DUMMY-5/worklog/h837372?adjustEstimate=manual&increaseBy=3h&notifyUsers=true&overrideEditableFlag=false",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
@@ -712,7 +782,7 @@ func Test_internalWorklogAdfImpl_Deleted(t *testing.T) {
 
 	type args struct {
 		ctx   context.Context
-		since int
+		since int64
 	}
 
 	testCases := []struct {
@@ -752,6 +822,35 @@ func Test_internalWorklogAdfImpl_Deleted(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the since is a milliseconds timestamp",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:   context.Background(),
+				since: 1640995200000,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/worklog/deleted?since=1640995200000",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ChangedWorklogPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
@@ -846,7 +945,7 @@ func Test_internalWorklogAdfImpl_Updated(t *testing.T) {
 
 	type args struct {
 		ctx    context.Context
-		since  int
+		since  int64
 		expand []string
 	}
 
@@ -888,6 +987,36 @@ func Test_internalWorklogAdfImpl_Updated(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the since is a milliseconds timestamp",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:    context.Background(),
+				since:  1640995200000,
+				expand: []string{"properties"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/worklog/updated?expand=properties&since=1640995200000",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ChangedWorklogPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
@@ -1065,6 +1194,84 @@ func Test_internalWorklogAdfImpl_Add(t *testing.T) {
 			Err:     nil,
 		},
 
+		{
+			name:   "when the estimate is set to a new value",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				payload:      payloadMocked,
+				options: &model.WorklogOptionsScheme{
+					Notify:         true,
+					AdjustEstimate: "new",
+					NewEstimate:    "2d",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/worklog?adjustEstimate=new&newEstimate=2d&notifyUsers=true&overrideEditableFlag=false",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueWorklogScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the estimate is reduced manually",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				payload:      payloadMocked,
+				options: &model.WorklogOptionsScheme{
+					Notify:         true,
+					AdjustEstimate: "manual",
+					ReduceBy:       "3h",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/worklog?adjustEstimate=manual&notifyUsers=true&overrideEditableFlag=false&reduceBy=3h",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueWorklogScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
 		{
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
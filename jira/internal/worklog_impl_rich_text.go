@@ -80,10 +80,12 @@ func (w *WorklogRichTextService) Delete(ctx context.Context, issueKeyOrId, workl
 //
 // This resource does not return worklogs deleted during the minute preceding the request.
 //
+// The since date is a UNIX timestamp in milliseconds, the next page is requested with the until of the previous page.
+//
 // GET /rest/api/{2-3}/worklog/deleted
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs#get-ids-of-deleted-worklogs
-func (w *WorklogRichTextService) Deleted(ctx context.Context, since int) (result *model.ChangedWorklogPageScheme, response *model.ResponseScheme, err error) {
+func (w *WorklogRichTextService) Deleted(ctx context.Context, since int64) (result *model.ChangedWorklogPageScheme, response *model.ResponseScheme, err error) {
 	return w.internalClient.Deleted(ctx, since)
 }
 
@@ -96,10 +98,12 @@ func (w *WorklogRichTextService) Deleted(ctx context.Context, since int) (result
 //
 // This resource does not return worklogs updated during the minute preceding the request.
 //
+// The since date is a UNIX timestamp in milliseconds, the next page is requested with the until of the previous page.
+//
 // GET /rest/api/{2-3}/worklog/updated
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs#get-ids-of-updated-worklogs
-func (w *WorklogRichTextService) Updated(ctx context.Context, since int, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
+func (w *WorklogRichTextService) Updated(ctx context.Context, since int64, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
 	return w.internalClient.Updated(ctx, since, expand)
 }
 
@@ -159,7 +163,7 @@ func (i *internalWorklogRichTextImpl) Gets(ctx context.Context, worklogIds []int
 		return nil, nil, err
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.gets", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -195,7 +199,7 @@ func (i *internalWorklogRichTextImpl) Get(ctx context.Context, issueKeyOrId, wor
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -229,7 +233,7 @@ func (i *internalWorklogRichTextImpl) Issue(ctx context.Context, issueKeyOrId st
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/worklog?%v", i.version, issueKeyOrId, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.issue", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -256,10 +260,15 @@ func (i *internalWorklogRichTextImpl) Delete(ctx context.Context, issueKeyOrId,
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v", i.version, issueKeyOrId, worklogId))
 
+	// The notifications suppressed on the context are sent with the default options
+	if options == nil && model.NotificationsSuppressed(ctx) {
+		options = &model.WorklogOptionsScheme{}
+	}
+
 	if options != nil {
 
 		params := url.Values{}
-		params.Add("notifyUsers", fmt.Sprintf("%v", options.Notify))
+		params.Add("notifyUsers", fmt.Sprintf("%v", notifyUsers(ctx, options.Notify)))
 		params.Add("overrideEditableFlag", fmt.Sprintf("%v", options.OverrideEditableFlag))
 
 		if options.AdjustEstimate != "" {
@@ -274,6 +283,10 @@ func (i *internalWorklogRichTextImpl) Delete(ctx context.Context, issueKeyOrId,
 			params.Add("reduceBy", options.ReduceBy)
 		}
 
+		if options.IncreaseBy != "" {
+			params.Add("increaseBy", options.IncreaseBy)
+		}
+
 		if len(options.Expand) != 0 {
 			params.Add("expand", strings.Join(options.Expand, ","))
 		}
@@ -281,7 +294,7 @@ func (i *internalWorklogRichTextImpl) Delete(ctx context.Context, issueKeyOrId,
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.delete", http.MethodDelete, endpoint.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -289,11 +302,11 @@ func (i *internalWorklogRichTextImpl) Delete(ctx context.Context, issueKeyOrId,
 	return i.c.Call(request, nil)
 }
 
-func (i *internalWorklogRichTextImpl) Deleted(ctx context.Context, since int) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
+func (i *internalWorklogRichTextImpl) Deleted(ctx context.Context, since int64) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
 
 	params := url.Values{}
 	if since != 0 {
-		params.Add("since", strconv.Itoa(since))
+		params.Add("since", strconv.FormatInt(since, 10))
 	}
 
 	var endpoint strings.Builder
@@ -303,7 +316,7 @@ func (i *internalWorklogRichTextImpl) Deleted(ctx context.Context, since int) (*
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.deleted", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -317,11 +330,11 @@ func (i *internalWorklogRichTextImpl) Deleted(ctx context.Context, since int) (*
 	return worklogs, response, nil
 }
 
-func (i *internalWorklogRichTextImpl) Updated(ctx context.Context, since int, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
+func (i *internalWorklogRichTextImpl) Updated(ctx context.Context, since int64, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
 
 	params := url.Values{}
 	if since != 0 {
-		params.Add("since", strconv.Itoa(since))
+		params.Add("since", strconv.FormatInt(since, 10))
 	}
 
 	if len(expand) != 0 {
@@ -335,7 +348,7 @@ func (i *internalWorklogRichTextImpl) Updated(ctx context.Context, since int, ex
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.updated", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -358,11 +371,16 @@ func (i *internalWorklogRichTextImpl) Add(ctx context.Context, issueKeyOrID stri
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issue/%v/worklog", i.version, issueKeyOrID))
 
+	// The notifications suppressed on the context are sent with the default options
+	if options == nil && model.NotificationsSuppressed(ctx) {
+		options = &model.WorklogOptionsScheme{}
+	}
+
 	if options != nil {
 
 		params := url.Values{}
 
-		params.Add("notifyUsers", fmt.Sprintf("%v", options.Notify))
+		params.Add("notifyUsers", fmt.Sprintf("%v", notifyUsers(ctx, options.Notify)))
 		params.Add("overrideEditableFlag", fmt.Sprintf("%v", options.OverrideEditableFlag))
 
 		if options.AdjustEstimate != "" {
@@ -389,7 +407,7 @@ func (i *internalWorklogRichTextImpl) Add(ctx context.Context, issueKeyOrID stri
 		return nil, nil, err
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.add", http.MethodPost, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -416,11 +434,16 @@ func (i *internalWorklogRichTextImpl) Update(ctx context.Context, issueKeyOrId,
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v", i.version, issueKeyOrId, worklogId))
 
+	// The notifications suppressed on the context are sent with the default options
+	if options == nil && model.NotificationsSuppressed(ctx) {
+		options = &model.WorklogOptionsScheme{}
+	}
+
 	if options != nil {
 
 		params := url.Values{}
 
-		params.Add("notifyUsers", fmt.Sprintf("%v", options.Notify))
+		params.Add("notifyUsers", fmt.Sprintf("%v", notifyUsers(ctx, options.Notify)))
 		params.Add("overrideEditableFlag", fmt.Sprintf("%v", options.OverrideEditableFlag))
 
 		if options.AdjustEstimate != "" {
@@ -447,7 +470,7 @@ func (i *internalWorklogRichTextImpl) Update(ctx context.Context, issueKeyOrId,
 		return nil, nil, err
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint.String(), reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "issue.worklog.update", http.MethodPut, endpoint.String(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,331 @@
+// Package jql builds Jira Query Language (JQL) queries, escaping the values and the text searches.
+//
+// The queries are immutable, every method returns a new query:
+//
+//	query := jql.Project("ENG").And(jql.In("status", "Open", "In Progress"), jql.TextContains("summary", input)).OrderBy("rank")
+//
+// The query string is returned by the String method and can be used by the GET and POST search methods.
+package jql
+
+import (
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/query"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Direction is the sort direction of an ORDER BY clause
+type Direction string
+
+const (
+	Asc  Direction = "ASC"
+	Desc Direction = "DESC"
+)
+
+// Keyword is a JQL keyword used as a value, keywords are not quoted
+type Keyword string
+
+const (
+	Empty Keyword = "EMPTY"
+	Null  Keyword = "NULL"
+)
+
+// DateLayout is the layout of the dates of the JQL queries, the dates are interpreted by Jira
+// on the time zone of the user running the query.
+const DateLayout = "2006/01/02 15:04"
+
+// MaxLength is the maximum length of a JQL query accepted by Jira
+const MaxLength = 65000
+
+// textSearchReserved are the characters with a meaning on the text searches, they're escaped by TextContains
+const textSearchReserved = `\+-&|!(){}[]^~*?:"/`
+
+// plainField matches the field names used without quotes, e.g. summary or cf[10012]
+var plainField = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_.]*|cf\[\d+\])$`)
+
+// Function is a JQL function used as a value, e.g. currentUser() or startOfDay("-1d")
+type Function struct {
+	name      string
+	arguments []string
+}
+
+// Func returns a JQL function, the arguments are quoted.
+func Func(name string, arguments ...string) Function {
+	return Function{name: name, arguments: arguments}
+}
+
+// CurrentUser returns the currentUser() function.
+func CurrentUser() Function {
+	return Func("currentUser")
+}
+
+func (f Function) String() string {
+
+	quoted := make([]string, len(f.arguments))
+	for index, argument := range f.arguments {
+		quoted[index] = Quote(argument)
+	}
+
+	return f.name + "(" + strings.Join(quoted, ", ") + ")"
+}
+
+// Query is a JQL query or a clause of a query.
+type Query struct {
+	expression query.Expression
+}
+
+func newQuery(clause string) *Query {
+	return &Query{expression: query.Expression{Clause: clause}}
+}
+
+// String returns the JQL query.
+func (q *Query) String() string {
+	return q.expression.String()
+}
+
+// And joins the query and the clauses with the AND operator, the OR groups are wrapped on parentheses.
+func (q *Query) And(clauses ...*Query) *Query {
+	return q.join("AND", clauses)
+}
+
+// Or joins the query and the clauses with the OR operator, the AND groups are wrapped on parentheses.
+func (q *Query) Or(clauses ...*Query) *Query {
+	return q.join("OR", clauses)
+}
+
+// OrderBy sorts the results by the field, the direction is optional and the calls are cumulative.
+func (q *Query) OrderBy(field string, direction ...Direction) *Query {
+
+	orderBy := fieldName(field)
+	if len(direction) != 0 && direction[0] != "" {
+		orderBy += " " + string(direction[0])
+	}
+
+	return &Query{expression: q.expression.SortBy(orderBy)}
+}
+
+func (q *Query) join(operator string, clauses []*Query) *Query {
+
+	expressions := make([]query.Expression, 0, len(clauses))
+	for _, clause := range clauses {
+		if clause != nil {
+			expressions = append(expressions, clause.expression)
+		}
+	}
+
+	return &Query{expression: q.expression.Join(operator, expressions)}
+}
+
+// And joins the clauses with the AND operator.
+func And(clauses ...*Query) *Query {
+	return new(Query).And(clauses...)
+}
+
+// Or joins the clauses with the OR operator, the group is wrapped on parentheses when it's joined with other clauses.
+func Or(clauses ...*Query) *Query {
+	return new(Query).Or(clauses...)
+}
+
+// Not negates the clause.
+func Not(clause *Query) *Query {
+	return &Query{expression: query.Not(clause.expression)}
+}
+
+// Field compares a field to a value using an operator, e.g. Field("assignee", "=", CurrentUser())
+//
+// The values can be strings, numbers, dates, keywords or functions, the strings are quoted.
+func Field(field, operator string, value interface{}) *Query {
+	return newQuery(fieldName(field) + " " + operator + " " + Value(value))
+}
+
+// Equals matches the fields equal to the value.
+func Equals(field string, value interface{}) *Query {
+	return Field(field, "=", value)
+}
+
+// In matches the fields equal to one of the values.
+func In(field string, values ...interface{}) *Query {
+	return newQuery(fieldName(field) + " in " + list(values))
+}
+
+// NotIn matches the fields not equal to any of the values.
+func NotIn(field string, values ...interface{}) *Query {
+	return newQuery(fieldName(field) + " not in " + list(values))
+}
+
+// Project matches the issues of the projects.
+func Project(projectKeys ...string) *Query {
+
+	if len(projectKeys) == 1 {
+		return Equals("project", projectKeys[0])
+	}
+
+	return In("project", strings2Values(projectKeys)...)
+}
+
+// IssueKeys matches the issues with the keys.
+func IssueKeys(issueKeys ...string) *Query {
+	return In("key", strings2Values(issueKeys)...)
+}
+
+// TextContains matches the text fields containing the text, the characters with a meaning on the text searches
+// are escaped, so the text is searched literally.
+func TextContains(field, text string) *Query {
+
+	var escaped strings.Builder
+	for _, character := range text {
+
+		if strings.ContainsRune(textSearchReserved, character) {
+			escaped.WriteRune('\\')
+		}
+
+		escaped.WriteRune(character)
+	}
+
+	return Field(field, "~", escaped.String())
+}
+
+// Is matches the fields that are empty or null, e.g. Is("assignee", Empty)
+func Is(field string, keyword Keyword) *Query {
+	return Field(field, "IS", keyword)
+}
+
+// IsNot matches the fields that are not empty or not null, e.g. IsNot("assignee", Empty)
+func IsNot(field string, keyword Keyword) *Query {
+	return Field(field, "IS NOT", keyword)
+}
+
+// After matches the date fields after the date.
+//
+// The date is formatted on its own location, use t.In to format it on the time zone of the user running the query.
+func After(field string, t time.Time) *Query {
+	return Field(field, ">", t)
+}
+
+// Before matches the date fields before the date.
+func Before(field string, t time.Time) *Query {
+	return Field(field, "<", t)
+}
+
+// Value returns the JQL representation of a value, the strings are quoted and the dates formatted.
+func Value(value interface{}) string {
+
+	switch value := value.(type) {
+	case string:
+		return Quote(value)
+	case Keyword:
+		return string(value)
+	case Function:
+		return value.String()
+	case time.Time:
+		return Quote(value.Format(DateLayout))
+	case int:
+		return strconv.Itoa(value)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case fmt.Stringer:
+		return Quote(value.String())
+	default:
+		return Quote(fmt.Sprint(value))
+	}
+}
+
+// Quote returns the value wrapped on double quotes, escaping the backslashes and the double quotes of the value.
+func Quote(value string) string {
+	return query.Quote(value)
+}
+
+// ChunkIn splits a long list of values into queries below the maximum length, each query joining the base query,
+// which can be nil, with an "in" clause of the field. The MaxLength is used when the maximum length is 0.
+//
+// An error is returned when a single value doesn't fit on a query.
+func ChunkIn(base *Query, field string, values []string, maxLength int) ([]*Query, error) {
+
+	if maxLength <= 0 {
+		maxLength = MaxLength
+	}
+
+	if base == nil {
+		base = new(Query)
+	}
+
+	build := func(chunk []string) *Query {
+		return base.And(In(field, strings2Values(chunk)...))
+	}
+
+	// The length of a query is the length of the query without the values plus the length of the quoted values
+	// joined by ", ", so the length of the chunk is tracked instead of building the query on every value
+	overhead := len(build([]string{""}).String()) - len(Quote(""))
+
+	var (
+		queries []*Query
+		chunk   []string
+		length  int
+	)
+
+	for _, value := range values {
+
+		quoted := len(Quote(value))
+		if overhead+quoted > maxLength {
+			return nil, fmt.Errorf("%w: %q", model.ErrJQLValueTooLongError, value)
+		}
+
+		if len(chunk) != 0 && overhead+length+len(", ")+quoted > maxLength {
+			queries = append(queries, build(chunk))
+			chunk, length = nil, 0
+		}
+
+		if len(chunk) != 0 {
+			length += len(", ")
+		}
+
+		chunk = append(chunk, value)
+		length += quoted
+	}
+
+	if len(chunk) != 0 {
+		queries = append(queries, build(chunk))
+	}
+
+	return queries, nil
+}
+
+// ChunkIssueKeys splits a long list of issue keys into queries below the maximum length.
+func ChunkIssueKeys(base *Query, issueKeys []string, maxLength int) ([]*Query, error) {
+	return ChunkIn(base, "key", issueKeys, maxLength)
+}
+
+func list(values []interface{}) string {
+
+	formatted := make([]string, len(values))
+	for index, value := range values {
+		formatted[index] = Value(value)
+	}
+
+	return query.List(formatted)
+}
+
+func strings2Values(values []string) []interface{} {
+
+	converted := make([]interface{}, len(values))
+	for index, value := range values {
+		converted[index] = value
+	}
+
+	return converted
+}
+
+// fieldName returns the field name, the names with spaces or other characters are quoted, e.g. "Story Points"
+func fieldName(field string) string {
+
+	if plainField.MatchString(field) {
+		return field
+	}
+
+	return Quote(field)
+}
@@ -0,0 +1,161 @@
+package jql
+
+import (
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuery_String(t *testing.T) {
+
+	updated := time.Date(2023, 3, 14, 9, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name: "when the clauses are joined and sorted",
+			query: Project("ENG").
+				And(In("status", "Open", "In Progress"), TextContains("summary", "login"), After("updated", updated)).
+				OrderBy("rank"),
+			want: `project = "ENG" AND status in ("Open", "In Progress") AND summary ~ "login" AND updated > "2023/03/14 09:30" ORDER BY rank`,
+		},
+
+		{
+			name:  "when the values are functions",
+			query: Equals("assignee", CurrentUser()).And(Field("created", ">=", Func("startOfDay", "-1d"))),
+			want:  `assignee = currentUser() AND created >= startOfDay("-1d")`,
+		},
+
+		{
+			name:  "when the fields are compared to empty or null",
+			query: Is("assignee", Empty).Or(IsNot("duedate", Null)),
+			want:  `assignee IS EMPTY OR duedate IS NOT NULL`,
+		},
+
+		{
+			name:  "when an OR group is joined with AND",
+			query: Project("ENG", "OPS").And(Or(Equals("priority", "High"), In("labels", "urgent", Empty)), Not(Equals("type", "Epic"))),
+			want:  `project in ("ENG", "OPS") AND (priority = "High" OR labels in ("urgent", EMPTY)) AND NOT type = "Epic"`,
+		},
+
+		{
+			name:  "when a group is negated",
+			query: Not(Or(Equals("status", "Done"), Equals("status", "Closed"))),
+			want:  `NOT (status = "Done" OR status = "Closed")`,
+		},
+
+		{
+			name:  "when the values have quotes and backslashes",
+			query: Equals("summary", `say "hi" \ bye`),
+			want:  `summary = "say \"hi\" \\ bye"`,
+		},
+
+		{
+			name:  "when the text search has reserved characters",
+			query: TextContains("summary", `[fix] a-b "c"`),
+			want:  `summary ~ "\\[fix\\] a\\-b \\\"c\\\""`,
+		},
+
+		{
+			name:  "when the values are numbers",
+			query: Equals("cf[10012]", 5).And(NotIn("Story Points", 1.5, int64(8))),
+			want:  `cf[10012] = 5 AND "Story Points" not in (1.5, 8)`,
+		},
+
+		{
+			name:  "when the query is sorted by several fields",
+			query: IssueKeys("ENG-1", "ENG-2").OrderBy("priority", Desc).OrderBy("created", Asc),
+			want:  `key in ("ENG-1", "ENG-2") ORDER BY priority DESC, created ASC`,
+		},
+
+		{
+			name:  "when the query only has an ORDER BY clause",
+			query: And().OrderBy("updated", Desc),
+			want:  `ORDER BY updated DESC`,
+		},
+
+		{
+			name:  "when the clauses are nil",
+			query: And(nil, Before("created", updated), nil),
+			want:  `created < "2023/03/14 09:30"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.query.String())
+		})
+	}
+}
+
+func TestQuery_Immutable(t *testing.T) {
+
+	base := Project("ENG")
+
+	_ = base.And(Equals("status", "Open")).OrderBy("rank")
+
+	assert.Equal(t, `project = "ENG"`, base.String())
+}
+
+func TestChunkIn(t *testing.T) {
+
+	issueKeys := make([]string, 500)
+	for index := range issueKeys {
+		issueKeys[index] = "ENG-" + strings.Repeat("1", 1+index%4)
+	}
+
+	t.Run("when the keys are split on several queries", func(t *testing.T) {
+
+		queries, err := ChunkIssueKeys(Project("ENG"), issueKeys, 1000)
+		assert.NoError(t, err)
+		assert.True(t, len(queries) > 1)
+
+		var count int
+		for _, query := range queries {
+
+			assert.True(t, len(query.String()) <= 1000)
+			assert.True(t, strings.HasPrefix(query.String(), `project = "ENG" AND key in (`))
+
+			count += strings.Count(query.String(), `"ENG-`)
+		}
+
+		assert.Equal(t, len(issueKeys), count)
+	})
+
+	t.Run("when the chunks are filled up to the maximum length", func(t *testing.T) {
+
+		queries, err := ChunkIssueKeys(Project("ENG"), issueKeys, 1000)
+		assert.NoError(t, err)
+
+		// Each query is the longest one below the maximum length, the next key doesn't fit
+		var start int
+		for _, query := range queries[:len(queries)-1] {
+
+			end := start + strings.Count(query.String(), `"ENG-`)
+
+			longer := Project("ENG").And(IssueKeys(issueKeys[start : end+1]...))
+			assert.True(t, len(longer.String()) > 1000)
+
+			start = end
+		}
+	})
+
+	t.Run("when the keys fit on the default length", func(t *testing.T) {
+
+		queries, err := ChunkIn(nil, "key", issueKeys, 0)
+		assert.NoError(t, err)
+		assert.Len(t, queries, 1)
+	})
+
+	t.Run("when a key doesn't fit on a query", func(t *testing.T) {
+
+		_, err := ChunkIn(nil, "key", []string{"ENG-1", strings.Repeat("A", 100)}, 50)
+		assert.True(t, errors.Is(err, model.ErrJQLValueTooLongError))
+	})
+}
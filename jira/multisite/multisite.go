@@ -0,0 +1,266 @@
+// Package multisite runs the Jira clients of an OAuth app on every site where the app is installed.
+package multisite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	v3 "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/throttle"
+	"github.com/ctreminiom/go-atlassian/service/common"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+const (
+	// DefaultSite is the base url of the Jira API of the OAuth apps, the cloud id of the site is appended to it
+	DefaultSite = "https://api.atlassian.com/ex/jira/"
+
+	// AccessibleResourcesURL returns the sites the OAuth token can access
+	AccessibleResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+
+	defaultConcurrency        = 5
+	defaultSiteConcurrency    = 5
+	defaultSiteMaxConcurrency = 20
+)
+
+// TokenSource returns the OAuth access token of the requests, it's called on every request,
+// so it should cache the token until it expires.
+type TokenSource func(ctx context.Context) (string, error)
+
+// Options configures the sites runner.
+type Options struct {
+	HTTPClient      common.HttpClient // The client sending the requests of every site, http.DefaultClient when it's nil
+	Concurrency     int               // The sites run at the same time, 5 by default
+	SiteConcurrency int               // The requests in flight per site, it adapts to the rate limit of each site when it's not set
+	FailFast        bool              // Whether the first failed site skips the sites not started yet
+	Site            string            // The base url of the sites, DefaultSite by default
+}
+
+// New returns a runner of the sites accessible with the token source.
+func New(tokens TokenSource, options *Options) (*Runner, error) {
+
+	if tokens == nil {
+		return nil, models.ErrNoTokenSourceError
+	}
+
+	if options == nil {
+		options = &Options{}
+	}
+
+	runner := &Runner{
+		tokens:          tokens,
+		http:            options.HTTPClient,
+		concurrency:     options.Concurrency,
+		siteConcurrency: options.SiteConcurrency,
+		failFast:        options.FailFast,
+		site:            options.Site,
+		clients:         make(map[string]*v3.Client),
+	}
+
+	if runner.http == nil {
+		runner.http = http.DefaultClient
+	}
+
+	if runner.concurrency <= 0 {
+		runner.concurrency = defaultConcurrency
+	}
+
+	if runner.site == "" {
+		runner.site = DefaultSite
+	}
+
+	return runner, nil
+}
+
+// Runner builds a Jira client per site and runs funcs on several sites at once.
+//
+// The clients share the HTTP client, so the transport and its connections are reused, but every site throttles
+// its own requests, a site answering with 429 doesn't slow the other sites down.
+type Runner struct {
+	tokens          TokenSource
+	http            common.HttpClient
+	concurrency     int
+	siteConcurrency int
+	failFast        bool
+	site            string
+
+	mu      sync.Mutex
+	clients map[string]*v3.Client
+}
+
+// Client returns the Jira client of the site with the cloud id, the client is built once and reused by the runs.
+func (r *Runner) Client(cloudID string) (*v3.Client, error) {
+
+	if cloudID == "" {
+		return nil, models.ErrNoCloudIDError
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, ok := r.clients[cloudID]; ok {
+		return client, nil
+	}
+
+	controller := throttle.NewAdaptive(defaultSiteConcurrency, defaultSiteMaxConcurrency)
+	if r.siteConcurrency > 0 {
+		controller = throttle.NewFixed(r.siteConcurrency)
+	}
+
+	client, err := v3.New(&siteHTTPClient{http: r.http, tokens: r.tokens, controller: controller}, r.site+url.PathEscape(cloudID))
+	if err != nil {
+		return nil, err
+	}
+
+	r.clients[cloudID] = client
+	return client, nil
+}
+
+// Run runs the func on the sites with the cloud ids, at most the runner concurrency sites at once.
+//
+// The report contains a result per cloud id. On fail-fast mode, the first failed site cancels the context of the
+// other funcs and skips the sites not started yet.
+func (r *Runner) Run(ctx context.Context, cloudIDs []string, run func(ctx context.Context, client *v3.Client) error) (*models.MultiSiteReportScheme, error) {
+
+	if len(cloudIDs) == 0 {
+		return nil, models.ErrNoCloudIDSliceError
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		report = &models.MultiSiteReportScheme{Sites: make(map[string]*models.MultiSiteResultScheme, len(cloudIDs))}
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		slots  = make(chan struct{}, r.concurrency)
+	)
+
+	for _, cloudID := range cloudIDs {
+		report.Sites[cloudID] = &models.MultiSiteResultScheme{CloudID: cloudID, Skipped: true}
+	}
+
+	for _, cloudID := range cloudIDs {
+
+		// The sites are started in order, the cancelled context leaves the remaining sites skipped
+		select {
+		case <-ctx.Done():
+		case slots <- struct{}{}:
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(cloudID string) {
+
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			client, err := r.Client(cloudID)
+			if err == nil {
+				err = run(ctx, client)
+			}
+
+			mu.Lock()
+			report.Sites[cloudID].Skipped = false
+			report.Sites[cloudID].Err = err
+			mu.Unlock()
+
+			if err != nil && r.failFast {
+				cancel()
+			}
+		}(cloudID)
+	}
+
+	wg.Wait()
+
+	return report, nil
+}
+
+// AccessibleResources returns the sites the token of the runner can access, their ids are the cloud ids of Run.
+//
+// GET https://api.atlassian.com/oauth/token/accessible-resources
+func (r *Runner) AccessibleResources(ctx context.Context) ([]*models.AccessibleResourceScheme, error) {
+
+	endpoint := AccessibleResourcesURL
+	if r.site != DefaultSite {
+
+		// The custom sites, e.g. a proxy, serve the accessible resources from their host
+		site, err := url.Parse(r.site)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint = site.ResolveReference(&url.URL{Path: "/oauth/token/accessible-resources"}).String()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	token, err := r.tokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", token))
+
+	response, err := r.http.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidStatusCodeError, response.Status)
+	}
+
+	var resources []*models.AccessibleResourceScheme
+	if err := json.NewDecoder(response.Body).Decode(&resources); err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// siteHTTPClient sends the requests of a site with the OAuth token, every request takes a slot of the site controller.
+type siteHTTPClient struct {
+	http       common.HttpClient
+	tokens     TokenSource
+	controller *throttle.Controller
+}
+
+func (s *siteHTTPClient) Do(request *http.Request) (*http.Response, error) {
+
+	token, err := s.tokens(request.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", token))
+
+	release, err := s.controller.Acquire(request.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.http.Do(request)
+	if err != nil {
+		release(0, nil)
+		return nil, err
+	}
+
+	release(response.StatusCode, response.Header)
+	return response, nil
+}
@@ -0,0 +1,164 @@
+package multisite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v3 "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// serverInfo requests the server info of the site of the client
+func serverInfo(ctx context.Context, client *v3.Client) error {
+
+	request, err := client.NewRequest(ctx, http.MethodGet, "rest/api/3/serverInfo", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Call(request, nil)
+	return err
+}
+
+func staticToken(ctx context.Context) (string, error) {
+	return "token", nil
+}
+
+func TestRunner_Run(t *testing.T) {
+
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		mu.Lock()
+		requests = append(requests, r.URL.Path)
+		mu.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if strings.HasPrefix(r.URL.Path, "/ex/jira/broken/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `{"baseUrl":"https://example.atlassian.net"}`)
+	}))
+	defer server.Close()
+
+	t.Run("when the func runs on every site", func(t *testing.T) {
+
+		requests = nil
+
+		runner, err := New(staticToken, &Options{HTTPClient: server.Client(), Concurrency: 2, Site: server.URL + "/ex/jira/"})
+		assert.NoError(t, err)
+
+		report, err := runner.Run(context.Background(), []string{"site-a", "broken", "site-b"}, serverInfo)
+		assert.NoError(t, err)
+
+		assert.Len(t, report.Sites, 3)
+		assert.NoError(t, report.Sites["site-a"].Err)
+		assert.NoError(t, report.Sites["site-b"].Err)
+		assert.True(t, errors.Is(report.Sites["broken"].Err, models.ErrInvalidStatusCodeError))
+		assert.Equal(t, []string{"broken"}, report.Failed())
+
+		assert.ElementsMatch(t, []string{"/ex/jira/site-a/rest/api/3/serverInfo", "/ex/jira/broken/rest/api/3/serverInfo",
+			"/ex/jira/site-b/rest/api/3/serverInfo"}, requests)
+
+		// The clients are reused by the next runs
+		client, err := runner.Client("site-a")
+		assert.NoError(t, err)
+
+		again, err := runner.Client("site-a")
+		assert.NoError(t, err)
+		assert.True(t, client == again)
+	})
+
+	t.Run("when the first failed site skips the others", func(t *testing.T) {
+
+		runner, err := New(staticToken, &Options{HTTPClient: server.Client(), Concurrency: 1, FailFast: true, Site: server.URL + "/ex/jira/"})
+		assert.NoError(t, err)
+
+		report, err := runner.Run(context.Background(), []string{"broken", "site-a", "site-b"}, serverInfo)
+		assert.NoError(t, err)
+
+		assert.Error(t, report.Sites["broken"].Err)
+		assert.False(t, report.Sites["broken"].Skipped)
+
+		assert.True(t, report.Sites["site-a"].Skipped)
+		assert.True(t, report.Sites["site-b"].Skipped)
+		assert.NoError(t, report.Sites["site-b"].Err)
+	})
+
+	t.Run("when the token cannot be retrieved", func(t *testing.T) {
+
+		tokens := func(ctx context.Context) (string, error) { return "", errors.New("error, token expired") }
+
+		runner, err := New(tokens, &Options{HTTPClient: server.Client(), Site: server.URL + "/ex/jira/"})
+		assert.NoError(t, err)
+
+		report, err := runner.Run(context.Background(), []string{"site-a"}, serverInfo)
+		assert.NoError(t, err)
+		assert.EqualError(t, report.Sites["site-a"].Err, "error, token expired")
+	})
+
+	t.Run("when the parameters are not valid", func(t *testing.T) {
+
+		_, err := New(nil, nil)
+		assert.EqualError(t, err, models.ErrNoTokenSourceError.Error())
+
+		runner, err := New(staticToken, nil)
+		assert.NoError(t, err)
+
+		_, err = runner.Run(context.Background(), nil, serverInfo)
+		assert.EqualError(t, err, models.ErrNoCloudIDSliceError.Error())
+
+		_, err = runner.Client("")
+		assert.EqualError(t, err, models.ErrNoCloudIDError.Error())
+	})
+}
+
+func TestRunner_AccessibleResources(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Path != "/oauth/token/accessible-resources" || r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `[{"id":"1324a887-45db-1bf4-1e99-ef0ff456d421","url":"https://your-domain.atlassian.net",
+			"name":"your-domain","scopes":["read:jira-work"]}]`)
+	}))
+	defer server.Close()
+
+	runner, err := New(staticToken, &Options{HTTPClient: server.Client(), Site: server.URL + "/ex/jira/"})
+	assert.NoError(t, err)
+
+	resources, err := runner.AccessibleResources(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.AccessibleResourceScheme{{
+		ID:     "1324a887-45db-1bf4-1e99-ef0ff456d421",
+		URL:    "https://your-domain.atlassian.net",
+		Name:   "your-domain",
+		Scopes: []string{"read:jira-work"},
+	}}, resources)
+
+	denied, err := New(func(ctx context.Context) (string, error) { return "other", nil },
+		&Options{HTTPClient: server.Client(), Site: server.URL + "/ex/jira/"})
+	assert.NoError(t, err)
+
+	_, err = denied.AccessibleResources(context.Background())
+	assert.True(t, errors.Is(err, models.ErrInvalidStatusCodeError))
+}
@@ -61,6 +61,12 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 	}
 	client.Organization = organizationService
 
+	portalService, err := internal.NewPortalService(client, "latest")
+	if err != nil {
+		return nil, err
+	}
+	client.Portal = portalService
+
 	commentService, err := internal.NewCommentService(client, "latest")
 	if err != nil {
 		return nil, err
@@ -111,6 +117,7 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 	client.Request = requestService
+	client.SLAMonitor = internal.NewSLAMonitorService(requestService)
 
 	queueService, err := internal.NewQueueService(client, "latest")
 	if err != nil {
@@ -122,6 +129,13 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 	client.ServiceDesk = serviceDeskService
+
+	formService, err := internal.NewFormService(client, "1")
+	if err != nil {
+		return nil, err
+	}
+	client.Form = formService
+
 	return client, nil
 }
 
@@ -130,11 +144,26 @@ type Client struct {
 	Auth          common.Authentication
 	Site          *url.URL
 	Customer      *internal.CustomerService
+	Form          *internal.FormService
 	Info          *internal.InfoService
 	Knowledgebase *internal.KnowledgebaseService
 	Organization  *internal.OrganizationService
+	Portal        *internal.PortalService
 	Request       *internal.RequestService
 	ServiceDesk   *internal.ServiceDeskService
+	SLAMonitor    *internal.SLAMonitorService
+}
+
+// NewOperationRequest creates the request of a service method, the request context is named with the operation,
+// prefixed with sm., see models.WithOperation.
+func (c *Client) NewOperationRequest(ctx context.Context, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+	return c.NewRequest(models.WithOperation(ctx, "sm."+operation), method, apiEndpoint, payload)
+}
+
+// NewOperationFormRequest creates the form request of a service method, the request context is named with the operation,
+// prefixed with sm., see models.WithOperation.
+func (c *Client) NewOperationFormRequest(ctx context.Context, operation, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
+	return c.NewFormRequest(models.WithOperation(ctx, "sm."+operation), method, apiEndpoint, contentType, payload)
 }
 
 func (c *Client) NewFormRequest(ctx context.Context, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
@@ -214,10 +243,11 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 func (c *Client) TransformTheHTTPResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
 
 	responseTransformed := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Operation: models.OperationFromContext(response.Request.Context()),
 	}
 
 	responseAsBytes, err := ioutil.ReadAll(response.Body)
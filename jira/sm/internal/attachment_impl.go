@@ -63,7 +63,7 @@ func (i *internalServiceRequestAttachmentImpl) Gets(ctx context.Context, issueKe
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/attachment?%v", issueKeyOrID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.attachment.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -102,7 +102,7 @@ func (i *internalServiceRequestAttachmentImpl) Create(ctx context.Context, issue
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/attachment", issueKeyOrID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.attachment.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,7 +90,7 @@ func (i *internalCustomerImpl) Create(ctx context.Context, email, displayName st
 
 	endpoint := "rest/servicedeskapi/customer"
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "customer.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,7 +116,7 @@ func (i *internalCustomerImpl) Gets(ctx context.Context, serviceDeskID int, quer
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer?%v", serviceDeskID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "customer.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -153,7 +153,7 @@ func (i *internalCustomerImpl) Add(ctx context.Context, serviceDeskID int, accou
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "customer.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +184,7 @@ func (i *internalCustomerImpl) Remove(ctx context.Context, serviceDeskID int, ac
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "customer.remove", http.MethodDelete, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
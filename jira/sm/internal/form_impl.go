@@ -0,0 +1,258 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/sm"
+	"net/http"
+	"sync"
+)
+
+// formsAPIBaseURL is the Atlassian API gateway serving the forms API, the site URL isn't used
+const formsAPIBaseURL = "https://api.atlassian.com"
+
+func NewFormService(client service.Client, version string) (*FormService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &FormService{
+		internalClient: &internalFormImpl{c: client, version: version},
+	}, nil
+}
+
+// FormService manages the forms attached to the customer requests.
+//
+// The forms API is served by the Atlassian API gateway, https://api.atlassian.com, the cloud id of the site is
+// resolved on the first call and reused by the next calls.
+type FormService struct {
+	internalClient sm.FormConnector
+}
+
+// GetsOnRequest returns the forms attached to a customer request.
+//
+// GET /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form
+//
+// TODO: the documentation needs to be created
+func (f *FormService) GetsOnRequest(ctx context.Context, issueKeyOrID string) ([]*model.FormIndexScheme, *model.ResponseScheme, error) {
+	return f.internalClient.GetsOnRequest(ctx, issueKeyOrID)
+}
+
+// Get returns a form attached to a customer request, use the FormScheme.Answers method to read the answers.
+//
+// GET /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form/{formId}
+//
+// TODO: the documentation needs to be created
+func (f *FormService) Get(ctx context.Context, issueKeyOrID, formID string) (*model.FormScheme, *model.ResponseScheme, error) {
+	return f.internalClient.Get(ctx, issueKeyOrID, formID)
+}
+
+// Attach adds a form to a customer request, using a form template of the project.
+//
+// POST /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form
+//
+// TODO: the documentation needs to be created
+func (f *FormService) Attach(ctx context.Context, issueKeyOrID, formTemplateID string) (*model.FormScheme, *model.ResponseScheme, error) {
+	return f.internalClient.Attach(ctx, issueKeyOrID, formTemplateID)
+}
+
+// Submit submits a form attached to a customer request.
+//
+// PUT /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form/{formId}/action/submit
+//
+// TODO: the documentation needs to be created
+func (f *FormService) Submit(ctx context.Context, issueKeyOrID, formID string) (*model.FormStatusScheme, *model.ResponseScheme, error) {
+	return f.internalClient.Submit(ctx, issueKeyOrID, formID)
+}
+
+// Reopen reopens a submitted form, so the answers can be changed.
+//
+// PUT /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form/{formId}/action/reopen
+//
+// TODO: the documentation needs to be created
+func (f *FormService) Reopen(ctx context.Context, issueKeyOrID, formID string) (*model.FormStatusScheme, *model.ResponseScheme, error) {
+	return f.internalClient.Reopen(ctx, issueKeyOrID, formID)
+}
+
+type internalFormImpl struct {
+	c       service.Client
+	version string
+
+	mu      sync.Mutex
+	cloudID string
+}
+
+func (i *internalFormImpl) GetsOnRequest(ctx context.Context, issueKeyOrID string) ([]*model.FormIndexScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	endpoint, response, err := i.formsEndpoint(ctx, issueKeyOrID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "form.getsOnRequest", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var forms []*model.FormIndexScheme
+	response, err = i.c.Call(request, &forms)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return forms, response, nil
+}
+
+func (i *internalFormImpl) Get(ctx context.Context, issueKeyOrID, formID string) (*model.FormScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if formID == "" {
+		return nil, nil, model.ErrNoFormIDError
+	}
+
+	endpoint, response, err := i.formsEndpoint(ctx, issueKeyOrID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "form.get", http.MethodGet, fmt.Sprintf("%v/%v", endpoint, formID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	form := new(model.FormScheme)
+	response, err = i.c.Call(request, form)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return form, response, nil
+}
+
+func (i *internalFormImpl) Attach(ctx context.Context, issueKeyOrID, formTemplateID string) (*model.FormScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if formTemplateID == "" {
+		return nil, nil, model.ErrNoFormTemplateIDError
+	}
+
+	endpoint, response, err := i.formsEndpoint(ctx, issueKeyOrID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	payload := struct {
+		FormTemplate *model.FormTemplateReferenceScheme `json:"formTemplate"`
+	}{
+		FormTemplate: &model.FormTemplateReferenceScheme{ID: formTemplateID},
+	}
+
+	reader, err := i.c.TransformStructToReader(&payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "form.attach", http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	form := new(model.FormScheme)
+	response, err = i.c.Call(request, form)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return form, response, nil
+}
+
+func (i *internalFormImpl) Submit(ctx context.Context, issueKeyOrID, formID string) (*model.FormStatusScheme, *model.ResponseScheme, error) {
+	return i.action(ctx, issueKeyOrID, formID, "submit")
+}
+
+func (i *internalFormImpl) Reopen(ctx context.Context, issueKeyOrID, formID string) (*model.FormStatusScheme, *model.ResponseScheme, error) {
+	return i.action(ctx, issueKeyOrID, formID, "reopen")
+}
+
+func (i *internalFormImpl) action(ctx context.Context, issueKeyOrID, formID, action string) (*model.FormStatusScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrIDError
+	}
+
+	if formID == "" {
+		return nil, nil, model.ErrNoFormIDError
+	}
+
+	endpoint, response, err := i.formsEndpoint(ctx, issueKeyOrID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, "form."+action, http.MethodPut, fmt.Sprintf("%v/%v/action/%v", endpoint, formID, action), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.FormStatusScheme)
+	response, err = i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
+
+// formsEndpoint returns the endpoint of the forms of an issue, resolving the cloud id of the site when it's not cached.
+//
+// The cloud id is fetched without holding the lock, so a slow tenant info request doesn't block the other calls,
+// the concurrent calls may fetch it more than once but the first cloud id stored is kept.
+func (i *internalFormImpl) formsEndpoint(ctx context.Context, issueKeyOrID string) (string, *model.ResponseScheme, error) {
+
+	i.mu.Lock()
+	cloudID := i.cloudID
+	i.mu.Unlock()
+
+	if cloudID == "" {
+
+		request, err := service.NewOperationRequest(ctx, i.c, "form.tenantInfo", http.MethodGet, "_edge/tenant_info", nil)
+		if err != nil {
+			return "", nil, err
+		}
+
+		tenant := struct {
+			CloudID string `json:"cloudId"`
+		}{}
+
+		response, err := i.c.Call(request, &tenant)
+		if err != nil {
+			return "", response, err
+		}
+
+		if tenant.CloudID == "" {
+			return "", response, model.ErrNoCloudIDError
+		}
+
+		i.mu.Lock()
+		if i.cloudID == "" {
+			i.cloudID = tenant.CloudID
+		}
+		cloudID = i.cloudID
+		i.mu.Unlock()
+	}
+
+	return fmt.Sprintf("%v/jira/forms/cloud/%v/issue/%v/form", formsAPIBaseURL, cloudID, issueKeyOrID), nil, nil
+}
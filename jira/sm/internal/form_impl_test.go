@@ -0,0 +1,393 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+// mockTenantInfo mocks the resolution of the cloud id of the site.
+func mockTenantInfo(client *mocks.Client, body string) {
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"_edge/tenant_info",
+		nil).
+		Return(&http.Request{RequestURI: "tenant"}, nil).
+		Once()
+
+	client.On("Call",
+		&http.Request{RequestURI: "tenant"},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = json.Unmarshal([]byte(body), args.Get(1))
+		}).
+		Return(&model.ResponseScheme{}, nil).
+		Once()
+}
+
+func Test_internalFormImpl_GetsOnRequest(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-3",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockTenantInfo(client, `{"cloudId":"a436116f-02ce-4520-8fbb-7301462a1674"}`)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"https://api.atlassian.com/jira/forms/cloud/a436116f-02ce-4520-8fbb-7301462a1674/issue/DUMMY-3/form",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.AnythingOfType("*[]*models.FormIndexScheme")).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the cloud id is not returned",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-3",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockTenantInfo(client, `{}`)
+
+				fields.c = client
+			},
+			Err:     model.ErrNoCloudIDError,
+			wantErr: true,
+		},
+
+		{
+			name: "when the cloud id cannot be resolved",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-3",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"_edge/tenant_info",
+					nil).
+					Return(&http.Request{}, errors.New("client: no http request created"))
+
+				fields.c = client
+			},
+			Err:     errors.New("client: no http request created"),
+			wantErr: true,
+		},
+
+		{
+			name: "when the issue key or id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			Err:     model.ErrNoIssueKeyOrIDError,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			smService, err := NewFormService(testCase.fields.c, "1")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := smService.GetsOnRequest(testCase.args.ctx, testCase.args.issueKeyOrID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalFormImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx                  context.Context
+		issueKeyOrID, formID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-3",
+				formID:       "1d1b0b9a-3de8-4e18-a9fd-ec0fe4e34e41",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockTenantInfo(client, `{"cloudId":"a436116f-02ce-4520-8fbb-7301462a1674"}`)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"https://api.atlassian.com/jira/forms/cloud/a436116f-02ce-4520-8fbb-7301462a1674/issue/DUMMY-3/form/1d1b0b9a-3de8-4e18-a9fd-ec0fe4e34e41",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FormScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the form id is not provided",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-3",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			Err:     model.ErrNoFormIDError,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			smService, err := NewFormService(testCase.fields.c, "1")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := smService.Get(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.formID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalFormImpl_Attach(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx                          context.Context
+		issueKeyOrID, formTemplateID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:            context.Background(),
+				issueKeyOrID:   "DUMMY-3",
+				formTemplateID: "b9e4f5d8-d4f2-4bb5-8d3a-0a5ddd8e2f3c",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				mockTenantInfo(client, `{"cloudId":"a436116f-02ce-4520-8fbb-7301462a1674"}`)
+
+				client.On("TransformStructToReader",
+					mock.MatchedBy(func(payload interface{}) bool {
+						encoded, _ := json.Marshal(payload)
+						return string(encoded) == `{"formTemplate":{"id":"b9e4f5d8-d4f2-4bb5-8d3a-0a5ddd8e2f3c"}}`
+					})).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"https://api.atlassian.com/jira/forms/cloud/a436116f-02ce-4520-8fbb-7301462a1674/issue/DUMMY-3/form",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FormScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the form template id is not provided",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-3",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewClient(t)
+			},
+			Err:     model.ErrNoFormTemplateIDError,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			smService, err := NewFormService(testCase.fields.c, "1")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := smService.Attach(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.formTemplateID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalFormImpl_SubmitAndReopen(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	// The cloud id is resolved once for both calls
+	mockTenantInfo(client, `{"cloudId":"a436116f-02ce-4520-8fbb-7301462a1674"}`)
+
+	for _, action := range []string{"submit", "reopen"} {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"https://api.atlassian.com/jira/forms/cloud/a436116f-02ce-4520-8fbb-7301462a1674/issue/DUMMY-3/form/1d1b0b9a/action/"+action,
+			nil).
+			Return(&http.Request{RequestURI: action}, nil)
+
+		client.On("Call",
+			&http.Request{RequestURI: action},
+			&model.FormStatusScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	smService, err := NewFormService(client, "1")
+	assert.NoError(t, err)
+
+	_, _, err = smService.Submit(context.Background(), "DUMMY-3", "1d1b0b9a")
+	assert.NoError(t, err)
+
+	_, _, err = smService.Reopen(context.Background(), "DUMMY-3", "1d1b0b9a")
+	assert.NoError(t, err)
+
+	_, _, err = smService.Reopen(context.Background(), "DUMMY-3", "")
+	assert.EqualError(t, err, model.ErrNoFormIDError.Error())
+}
@@ -41,7 +41,7 @@ func (i *internalInfoImpl) Get(ctx context.Context) (*model.InfoScheme, *model.R
 
 	endpoint := "rest/servicedeskapi/info"
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "info.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
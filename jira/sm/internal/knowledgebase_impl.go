@@ -63,7 +63,7 @@ func (i *internalKnowledgebaseImpl) Search(ctx context.Context, query string, hi
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/knowledgebase/article?%v", params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "knowledgebase.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -95,7 +95,7 @@ func (i *internalKnowledgebaseImpl) Gets(ctx context.Context, serviceDeskID int,
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/knowledgebase/article?%v", serviceDeskID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "knowledgebase.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
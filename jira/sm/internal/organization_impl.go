@@ -155,7 +155,7 @@ func (i *internalOrganizationImpl) Gets(ctx context.Context, accountID string, s
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/organization?%v", params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -177,7 +177,7 @@ func (i *internalOrganizationImpl) Get(ctx context.Context, organizationID int)
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/organization/%v", organizationID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -199,7 +199,7 @@ func (i *internalOrganizationImpl) Delete(ctx context.Context, organizationID in
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/organization/%v", organizationID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +226,7 @@ func (i *internalOrganizationImpl) Create(ctx context.Context, name string) (*mo
 
 	endpoint := "rest/servicedeskapi/organization"
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -252,7 +252,7 @@ func (i *internalOrganizationImpl) Users(ctx context.Context, organizationID, st
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/organization/%v/user?%v", organizationID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.users", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -289,7 +289,7 @@ func (i *internalOrganizationImpl) Add(ctx context.Context, organizationID int,
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/organization/%v/user", organizationID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -320,7 +320,7 @@ func (i *internalOrganizationImpl) Remove(ctx context.Context, organizationID in
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/organization/%v/user", organizationID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.remove", http.MethodDelete, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -344,7 +344,7 @@ func (i *internalOrganizationImpl) Project(ctx context.Context, accountID string
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization?%v", serviceDeskID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.project", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -381,7 +381,7 @@ func (i *internalOrganizationImpl) Associate(ctx context.Context, serviceDeskID,
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization", serviceDeskID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.associate", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -412,7 +412,7 @@ func (i *internalOrganizationImpl) Detach(ctx context.Context, serviceDeskID, or
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization", serviceDeskID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "organization.detach", http.MethodDelete, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
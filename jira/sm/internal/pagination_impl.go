@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// defaultPageLimit is the page size used by the methods following every page
+const defaultPageLimit = 50
+
+// servicePage is implemented by the service management pages embedding the models.ServiceManagementPageScheme struct.
+type servicePage interface {
+	HasNext() bool
+	PageLinks() *model.ServiceManagementPageLinksScheme
+}
+
+// followPages requests the endpoint and follows the next links of the pages until the last page, every page is decoded
+// into a new page and passed to the visit func. The visit error stops the pagination and it's returned as is.
+//
+// The next links are rebased on the site of the client, only their path and query are used, so a crafted response
+// can't send the credentials of the client elsewhere and the sites reached through a proxy or the API gateway keep working.
+func followPages(ctx context.Context, client service.Client, operation, endpoint string, newPage func() servicePage, visit func(page servicePage) error) (*model.ResponseScheme, error) {
+
+	var response *model.ResponseScheme
+
+	for {
+
+		if err := ctx.Err(); err != nil {
+			return response, err
+		}
+
+		request, err := service.NewOperationRequest(ctx, client, operation, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return response, err
+		}
+
+		page := newPage()
+		response, err = client.Call(request, page)
+		if err != nil {
+			return response, err
+		}
+
+		if err := visit(page); err != nil {
+			return response, err
+		}
+
+		if !page.HasNext() {
+			return response, nil
+		}
+
+		endpoint, err = nextPageLink(endpoint, page.PageLinks())
+		if err != nil {
+			return response, err
+		}
+	}
+}
+
+// nextPageLink returns the endpoint of the next link relative to the site of the client, the site context path of the
+// links is removed. The endpoint must move away from the current endpoint, a link to the page itself would loop forever.
+func nextPageLink(current string, links *model.ServiceManagementPageLinksScheme) (string, error) {
+
+	link, err := url.Parse(links.Next)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", model.ErrInvalidPageLinkError, err)
+	}
+
+	path := strings.TrimPrefix(strings.TrimPrefix(link.Path, strings.TrimSuffix(links.Context, "/")), "/")
+	if path == "" {
+		return "", fmt.Errorf("%w: %v", model.ErrInvalidPageLinkError, links.Next)
+	}
+
+	endpoint := (&url.URL{Path: path, RawQuery: link.RawQuery}).String()
+	if endpoint == strings.TrimPrefix(current, "/") {
+		return "", fmt.Errorf("%w: the link points to the same page", model.ErrInvalidPageLinkError)
+	}
+
+	return endpoint, nil
+}
@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// mockServicePage mocks a page requested by the endpoint, the page is decoded from its JSON representation
+func mockServicePage(client *mocks.Client, endpoint, page string) {
+
+	requestURL, err := url.Parse("https://ctreminiom.atlassian.net/")
+	if err != nil {
+		panic(err)
+	}
+
+	relative, err := url.Parse(endpoint)
+	if err != nil {
+		panic(err)
+	}
+
+	request := &http.Request{Method: http.MethodGet, URL: requestURL.ResolveReference(relative)}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		endpoint,
+		nil).
+		Return(request, nil).Once()
+
+	client.On("Call",
+		request,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			if err := json.Unmarshal([]byte(page), args.Get(1)); err != nil {
+				panic(err)
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+}
+
+func Test_internalServiceRequestImpl_GetsAll(t *testing.T) {
+
+	firstEndpoint := "rest/servicedeskapi/request?limit=50&requestStatus=OPEN_REQUESTS&start=0"
+
+	t.Run("when the next links are followed", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockServicePage(client, firstEndpoint, `{"size":1,"start":0,"limit":1,"isLastPage":false,
+			"values":[{"issueKey":"DESK-1"}],
+			"_links":{"next":"https://ctreminiom.atlassian.net/rest/servicedeskapi/request?limit=1&start=1"}}`)
+		mockServicePage(client, "rest/servicedeskapi/request?limit=1&start=1",
+			`{"size":1,"start":1,"limit":1,"isLastPage":true,"values":[{"issueKey":"DESK-2"}],
+			"_links":{"prev":"https://ctreminiom.atlassian.net/rest/servicedeskapi/request?limit=1&start=0"}}`)
+
+		requestService, err := NewRequestService(client, "latest", &ServiceRequestSubServices{})
+		assert.NoError(t, err)
+
+		var keys []string
+		_, err = requestService.GetsAll(context.Background(), &model.ServiceRequestOptionScheme{RequestStatus: "OPEN_REQUESTS"},
+			func(page *model.CustomerRequestPageScheme) error {
+				for _, customerRequest := range page.Values {
+					keys = append(keys, customerRequest.IssueKey)
+				}
+				return nil
+			})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"DESK-1", "DESK-2"}, keys)
+	})
+
+	t.Run("when the next link points to another host", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockServicePage(client, firstEndpoint, `{"isLastPage":false,"values":[{"issueKey":"DESK-1"}],
+			"_links":{"next":"https://attacker.example.com/rest/servicedeskapi/request?start=1"}}`)
+		mockServicePage(client, "rest/servicedeskapi/request?start=1", `{"isLastPage":true,"values":[{"issueKey":"DESK-2"}]}`)
+
+		requestService, err := NewRequestService(client, "latest", &ServiceRequestSubServices{})
+		assert.NoError(t, err)
+
+		var pages int
+		_, err = requestService.GetsAll(context.Background(), &model.ServiceRequestOptionScheme{RequestStatus: "OPEN_REQUESTS"},
+			func(page *model.CustomerRequestPageScheme) error {
+				pages++
+				return nil
+			})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, pages)
+	})
+
+	t.Run("when the visit func fails", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockServicePage(client, firstEndpoint, `{"isLastPage":false,"values":[{"issueKey":"DESK-1"}],
+			"_links":{"next":"https://ctreminiom.atlassian.net/rest/servicedeskapi/request?start=1"}}`)
+
+		requestService, err := NewRequestService(client, "latest", &ServiceRequestSubServices{})
+		assert.NoError(t, err)
+
+		_, err = requestService.GetsAll(context.Background(), &model.ServiceRequestOptionScheme{RequestStatus: "OPEN_REQUESTS"},
+			func(page *model.CustomerRequestPageScheme) error { return errors.New("error, stop") })
+
+		assert.EqualError(t, err, "error, stop")
+	})
+
+	t.Run("when the visit func is not provided", func(t *testing.T) {
+
+		requestService, err := NewRequestService(nil, "latest", &ServiceRequestSubServices{})
+		assert.NoError(t, err)
+
+		_, err = requestService.GetsAll(context.Background(), nil, nil)
+		assert.EqualError(t, err, model.ErrNoPageVisitorError.Error())
+	})
+}
+
+func Test_nextPageLink(t *testing.T) {
+
+	current := "rest/servicedeskapi/request?start=0"
+
+	testCases := []struct {
+		name    string
+		links   *model.ServiceManagementPageLinksScheme
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "when the link is absolute",
+			links: &model.ServiceManagementPageLinksScheme{Next: "https://ctreminiom.atlassian.net/rest/servicedeskapi/request?start=50"},
+			want:  "rest/servicedeskapi/request?start=50",
+		},
+		{
+			name:  "when the link is relative",
+			links: &model.ServiceManagementPageLinksScheme{Next: "/rest/servicedeskapi/request?start=50"},
+			want:  "rest/servicedeskapi/request?start=50",
+		},
+		{
+			name:  "when the link points to another host",
+			links: &model.ServiceManagementPageLinksScheme{Next: "http://ctreminiom.example.com:8443/rest/servicedeskapi/request?start=50"},
+			want:  "rest/servicedeskapi/request?start=50",
+		},
+		{
+			name: "when the site has a context path",
+			links: &model.ServiceManagementPageLinksScheme{
+				Context: "/jira",
+				Next:    "https://jira.example.com/jira/rest/servicedeskapi/request?start=50",
+			},
+			want: "rest/servicedeskapi/request?start=50",
+		},
+		{
+			name:    "when the link points to the same page",
+			links:   &model.ServiceManagementPageLinksScheme{Next: "https://ctreminiom.atlassian.net/rest/servicedeskapi/request?start=0"},
+			wantErr: true,
+		},
+		{
+			name:    "when the link has no path",
+			links:   &model.ServiceManagementPageLinksScheme{Next: "https://ctreminiom.atlassian.net"},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := nextPageLink(current, testCase.links)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, model.ErrInvalidPageLinkError))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
@@ -70,7 +70,7 @@ func (i *internalServiceRequestParticipantImpl) Gets(ctx context.Context, issueK
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/participant?%v", issueKeyOrID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.participant.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,7 +107,7 @@ func (i *internalServiceRequestParticipantImpl) Add(ctx context.Context, issueKe
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/participant", issueKeyOrID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.participant.add", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -143,7 +143,7 @@ func (i *internalServiceRequestParticipantImpl) Remove(ctx context.Context, issu
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/participant", issueKeyOrID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.participant.remove", http.MethodDelete, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
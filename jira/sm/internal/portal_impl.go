@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/sm"
+	"io"
+	"net/http"
+)
+
+func NewPortalService(client service.Client, version string) (*PortalService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &PortalService{
+		internalClient: &internalPortalImpl{c: client, version: version},
+	}, nil
+}
+
+type PortalService struct {
+	internalClient sm.PortalConnector
+}
+
+// HelpCenterAnnouncement returns the announcement of the help center.
+//
+// The announcement endpoints are experimental, the experimental header is always sent.
+//
+// GET /rest/servicedeskapi/announcement
+//
+// TODO: the documentation needs to be created
+func (p *PortalService) HelpCenterAnnouncement(ctx context.Context) (*model.PortalAnnouncementScheme, *model.ResponseScheme, error) {
+	return p.internalClient.HelpCenterAnnouncement(ctx)
+}
+
+// UpdateHelpCenterAnnouncement sets the announcement of the help center, an empty header and message remove it.
+//
+// ErrPortalAnnouncementForbiddenError is returned when the user isn't a Jira administrator.
+//
+// PUT /rest/servicedeskapi/announcement
+//
+// TODO: the documentation needs to be created
+func (p *PortalService) UpdateHelpCenterAnnouncement(ctx context.Context, payload *model.PortalAnnouncementScheme) (*model.ResponseScheme, error) {
+	return p.internalClient.UpdateHelpCenterAnnouncement(ctx, payload)
+}
+
+// Announcement returns the announcement of the customer portal of a service desk.
+//
+// The announcement endpoints are experimental, the experimental header is always sent.
+//
+// GET /rest/servicedeskapi/servicedesk/{serviceDeskId}/announcement
+//
+// TODO: the documentation needs to be created
+func (p *PortalService) Announcement(ctx context.Context, serviceDeskID int) (*model.PortalAnnouncementScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Announcement(ctx, serviceDeskID)
+}
+
+// UpdateAnnouncement sets the announcement of the customer portal of a service desk, an empty header and message remove it.
+//
+// ErrPortalAnnouncementForbiddenError is returned when the user isn't an administrator of the service desk.
+//
+// PUT /rest/servicedeskapi/servicedesk/{serviceDeskId}/announcement
+//
+// TODO: the documentation needs to be created
+func (p *PortalService) UpdateAnnouncement(ctx context.Context, serviceDeskID int, payload *model.PortalAnnouncementScheme) (*model.ResponseScheme, error) {
+	return p.internalClient.UpdateAnnouncement(ctx, serviceDeskID, payload)
+}
+
+type internalPortalImpl struct {
+	c       service.Client
+	version string
+}
+
+func (i *internalPortalImpl) HelpCenterAnnouncement(ctx context.Context) (*model.PortalAnnouncementScheme, *model.ResponseScheme, error) {
+	return i.announcement(ctx, "portal.helpCenterAnnouncement", "rest/servicedeskapi/announcement")
+}
+
+func (i *internalPortalImpl) UpdateHelpCenterAnnouncement(ctx context.Context, payload *model.PortalAnnouncementScheme) (*model.ResponseScheme, error) {
+	return i.updateAnnouncement(ctx, "portal.updateHelpCenterAnnouncement", "rest/servicedeskapi/announcement", payload)
+}
+
+func (i *internalPortalImpl) Announcement(ctx context.Context, serviceDeskID int) (*model.PortalAnnouncementScheme, *model.ResponseScheme, error) {
+
+	if serviceDeskID == 0 {
+		return nil, nil, model.ErrNoServiceDeskIDError
+	}
+
+	return i.announcement(ctx, "portal.announcement", fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/announcement", serviceDeskID))
+}
+
+func (i *internalPortalImpl) UpdateAnnouncement(ctx context.Context, serviceDeskID int, payload *model.PortalAnnouncementScheme) (*model.ResponseScheme, error) {
+
+	if serviceDeskID == 0 {
+		return nil, model.ErrNoServiceDeskIDError
+	}
+
+	return i.updateAnnouncement(ctx, "portal.updateAnnouncement", fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/announcement", serviceDeskID), payload)
+}
+
+func (i *internalPortalImpl) announcement(ctx context.Context, operation, endpoint string) (*model.PortalAnnouncementScheme, *model.ResponseScheme, error) {
+
+	request, err := i.newExperimentalRequest(ctx, operation, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	announcement := new(model.PortalAnnouncementScheme)
+	response, err := i.c.Call(request, announcement)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return announcement, response, nil
+}
+
+func (i *internalPortalImpl) updateAnnouncement(ctx context.Context, operation, endpoint string, payload *model.PortalAnnouncementScheme) (*model.ResponseScheme, error) {
+
+	if payload == nil {
+		payload = &model.PortalAnnouncementScheme{}
+	}
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := i.newExperimentalRequest(ctx, operation, http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+
+		if response != nil && response.Code == http.StatusForbidden {
+			return response, model.ErrPortalAnnouncementForbiddenError
+		}
+
+		return response, err
+	}
+
+	return response, nil
+}
+
+// newExperimentalRequest creates a request opted in to the experimental endpoints, even when the client experimental flag isn't set.
+func (i *internalPortalImpl) newExperimentalRequest(ctx context.Context, operation, method, endpoint string, payload io.Reader) (*http.Request, error) {
+
+	request, err := service.NewOperationRequest(ctx, i.c, operation, method, endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Header == nil {
+		request.Header = make(http.Header)
+	}
+
+	request.Header.Set("X-ExperimentalApi", "opt-in")
+
+	return request, nil
+}
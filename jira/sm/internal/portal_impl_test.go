@@ -0,0 +1,391 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+func Test_internalPortalImpl_Announcement(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx           context.Context
+		serviceDeskID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.PortalAnnouncementScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				request := &http.Request{Header: http.Header{}}
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/servicedeskapi/servicedesk/10001/announcement",
+					nil).
+					Return(request, nil)
+
+				client.On("Call",
+					mock.MatchedBy(func(r *http.Request) bool {
+						return r.Header.Get("X-ExperimentalApi") == "opt-in"
+					}),
+					&model.PortalAnnouncementScheme{}).
+					Run(func(args mock.Arguments) {
+						announcement := args.Get(1).(*model.PortalAnnouncementScheme)
+						announcement.Header = "Maintenance"
+						announcement.Message = "The portal will be down on Sunday"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.PortalAnnouncementScheme{Header: "Maintenance", Message: "The portal will be down on Sunday"},
+		},
+
+		{
+			name: "when the request has no headers",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/servicedeskapi/servicedesk/10001/announcement",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					mock.MatchedBy(func(r *http.Request) bool {
+						return r.Header.Get("X-ExperimentalApi") == "opt-in"
+					}),
+					&model.PortalAnnouncementScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.PortalAnnouncementScheme{},
+		},
+
+		{
+			name: "when the service desk id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoServiceDeskIDError,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/servicedeskapi/servicedesk/10001/announcement",
+					nil).
+					Return(nil, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			portalService, err := NewPortalService(testCase.fields.c, "latest")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := portalService.Announcement(testCase.args.ctx, testCase.args.serviceDeskID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+
+		})
+	}
+}
+
+func Test_internalPortalImpl_HelpCenterAnnouncement(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/servicedeskapi/announcement",
+		nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		mock.MatchedBy(func(r *http.Request) bool {
+			return r.Header.Get("X-ExperimentalApi") == "opt-in"
+		}),
+		&model.PortalAnnouncementScheme{}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.PortalAnnouncementScheme).Header = "Welcome"
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	portalService, err := NewPortalService(client, "latest")
+	assert.NoError(t, err)
+
+	announcement, response, err := portalService.HelpCenterAnnouncement(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, "Welcome", announcement.Header)
+}
+
+func Test_internalPortalImpl_UpdateAnnouncement(t *testing.T) {
+
+	payload := &model.PortalAnnouncementScheme{Header: "Maintenance", Message: "The portal will be down on Sunday"}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx           context.Context
+		serviceDeskID int
+		payload       *model.PortalAnnouncementScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+				payload:       payload,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payload).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/servicedeskapi/servicedesk/10001/announcement",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					mock.MatchedBy(func(r *http.Request) bool {
+						return r.Header.Get("X-ExperimentalApi") == "opt-in"
+					}),
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.PortalAnnouncementScheme{}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/servicedeskapi/servicedesk/10001/announcement",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					mock.Anything,
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the user is not a service desk administrator",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+				payload:       payload,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payload).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/servicedeskapi/servicedesk/10001/announcement",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					mock.Anything,
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusForbidden}, errors.New("client: no authorization"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrPortalAnnouncementForbiddenError,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+				payload:       payload,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payload).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/servicedeskapi/servicedesk/10001/announcement",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					mock.Anything,
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusBadRequest}, errors.New("error, request failed. Please try again"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed. Please try again"),
+		},
+
+		{
+			name: "when the service desk id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: payload,
+			},
+			wantErr: true,
+			Err:     model.ErrNoServiceDeskIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			portalService, err := NewPortalService(testCase.fields.c, "latest")
+			assert.NoError(t, err)
+
+			gotResponse, err := portalService.UpdateAnnouncement(testCase.args.ctx, testCase.args.serviceDeskID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_NewPortalService(t *testing.T) {
+
+	_, err := NewPortalService(nil, "")
+	assert.ErrorIs(t, err, model.ErrNoVersionProvided)
+}
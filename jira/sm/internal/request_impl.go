@@ -28,8 +28,10 @@ func NewRequestService(client service.Client, version string, subServices *Servi
 		return nil, model.ErrNoVersionProvided
 	}
 
+	internalClient := &internalServiceRequestImpl{c: client, version: version}
+
 	requestService := &RequestService{
-		internalClient: &internalServiceRequestImpl{c: client, version: version},
+		internalClient: internalClient,
 	}
 
 	if subServices != nil {
@@ -43,6 +45,19 @@ func NewRequestService(client service.Client, version string, subServices *Servi
 
 	}
 
+	// The request types are resolved by the type service, so the resolutions are cached once per client
+	if requestService.Type != nil {
+		internalClient.requestType = requestService.Type.internalClient
+	} else {
+
+		typeService, err := NewTypeService(client, version)
+		if err != nil {
+			return nil, err
+		}
+
+		internalClient.requestType = typeService.internalClient
+	}
+
 	return requestService, nil
 }
 
@@ -68,6 +83,18 @@ func (s *RequestService) Create(ctx context.Context, payload *model.CreateCustom
 	return s.internalClient.Create(ctx, payload, fields)
 }
 
+// CreateByName creates a customer request, resolving the service desk and the request type by their names.
+//
+// The service desk and request type ids of the payload are replaced by the resolved ids.
+//
+// POST /rest/servicedeskapi/request
+//
+// TODO: the documentation needs to be created
+func (s *RequestService) CreateByName(ctx context.Context, serviceDeskNameOrProjectKey, requestTypeName string, payload *model.CreateCustomerRequestPayloadScheme,
+	fields *model.CustomerRequestFields) (*model.CustomerRequestScheme, *model.ResponseScheme, error) {
+	return s.internalClient.CreateByName(ctx, serviceDeskNameOrProjectKey, requestTypeName, payload, fields)
+}
+
 // Gets returns all customer requests for the user executing the query.
 //
 // The returned customer requests are ordered chronologically by the latest activity on each request. For example, the latest status transition or comment.
@@ -79,6 +106,18 @@ func (s *RequestService) Gets(ctx context.Context, options *model.ServiceRequest
 	return s.internalClient.Gets(ctx, options, start, limit)
 }
 
+// GetsAll returns all customer requests for the user executing the query, a page at a time.
+//
+// The pages are followed by their next link until the last page, every page is passed to the visit func.
+// The visit error stops the pagination and it's returned as is.
+//
+// GET /rest/servicedeskapi/request
+//
+// TODO: the documentation needs to be created
+func (s *RequestService) GetsAll(ctx context.Context, options *model.ServiceRequestOptionScheme, visit func(page *model.CustomerRequestPageScheme) error) (*model.ResponseScheme, error) {
+	return s.internalClient.GetsAll(ctx, options, visit)
+}
+
 // Get returns a customer request.
 //
 // GET /rest/servicedeskapi/request/{issueIdOrKey}
@@ -127,8 +166,9 @@ func (s *RequestService) Transition(ctx context.Context, issueKeyOrID, transitio
 }
 
 type internalServiceRequestImpl struct {
-	c       service.Client
-	version string
+	c           service.Client
+	version     string
+	requestType sm.TypeConnector
 }
 
 func (i *internalServiceRequestImpl) Create(ctx context.Context, payload *model.CreateCustomerRequestPayloadScheme, fields *model.CustomerRequestFields) (*model.CustomerRequestScheme, *model.ResponseScheme, error) {
@@ -149,7 +189,7 @@ func (i *internalServiceRequestImpl) Create(ctx context.Context, payload *model.
 
 	endpoint := "rest/servicedeskapi/request"
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -163,8 +203,58 @@ func (i *internalServiceRequestImpl) Create(ctx context.Context, payload *model.
 	return serviceRequest, response, nil
 }
 
+func (i *internalServiceRequestImpl) CreateByName(ctx context.Context, serviceDeskNameOrProjectKey, requestTypeName string, payload *model.CreateCustomerRequestPayloadScheme,
+	fields *model.CustomerRequestFields) (*model.CustomerRequestScheme, *model.ResponseScheme, error) {
+
+	resolution, response, err := i.requestType.Resolve(ctx, serviceDeskNameOrProjectKey, requestTypeName)
+	if err != nil {
+		return nil, response, err
+	}
+
+	// Copy the payload, the caller payload is not modified
+	payloadWithIDs := new(model.CreateCustomerRequestPayloadScheme)
+	if payload != nil {
+		*payloadWithIDs = *payload
+	}
+
+	payloadWithIDs.ServiceDeskID = resolution.ServiceDesk.ID
+	payloadWithIDs.RequestTypeID = resolution.RequestType.ID
+
+	return i.Create(ctx, payloadWithIDs, fields)
+}
+
 func (i *internalServiceRequestImpl) Gets(ctx context.Context, options *model.ServiceRequestOptionScheme, start, limit int) (*model.CustomerRequestPageScheme, *model.ResponseScheme, error) {
 
+	endpoint := serviceRequestsEndpoint(options, start, limit)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "request.gets", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CustomerRequestPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalServiceRequestImpl) GetsAll(ctx context.Context, options *model.ServiceRequestOptionScheme, visit func(page *model.CustomerRequestPageScheme) error) (*model.ResponseScheme, error) {
+
+	if visit == nil {
+		return nil, model.ErrNoPageVisitorError
+	}
+
+	return followPages(ctx, i.c, "request.getsAll", serviceRequestsEndpoint(options, 0, defaultPageLimit),
+		func() servicePage { return new(model.CustomerRequestPageScheme) },
+		func(page servicePage) error { return visit(page.(*model.CustomerRequestPageScheme)) })
+}
+
+// serviceRequestsEndpoint returns the endpoint of a page of the customer requests matching the options.
+func serviceRequestsEndpoint(options *model.ServiceRequestOptionScheme, start, limit int) string {
+
 	params := url.Values{}
 	params.Add("start", strconv.Itoa(start))
 	params.Add("limit", strconv.Itoa(limit))
@@ -204,20 +294,7 @@ func (i *internalServiceRequestImpl) Gets(ctx context.Context, options *model.Se
 		}
 	}
 
-	endpoint := fmt.Sprintf("rest/servicedeskapi/request?%v", params.Encode())
-
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	page := new(model.CustomerRequestPageScheme)
-	response, err := i.c.Call(request, page)
-	if err != nil {
-		return nil, response, err
-	}
-
-	return page, response, nil
+	return fmt.Sprintf("rest/servicedeskapi/request?%v", params.Encode())
 }
 
 func (i *internalServiceRequestImpl) Get(ctx context.Context, issueKeyOrID string, expand []string) (*model.CustomerRequestScheme, *model.ResponseScheme, error) {
@@ -236,7 +313,7 @@ func (i *internalServiceRequestImpl) Get(ctx context.Context, issueKeyOrID strin
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.get", http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -258,7 +335,7 @@ func (i *internalServiceRequestImpl) Subscribe(ctx context.Context, issueKeyOrID
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/notification", issueKeyOrID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.subscribe", http.MethodPut, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -274,7 +351,7 @@ func (i *internalServiceRequestImpl) Unsubscribe(ctx context.Context, issueKeyOr
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/notification", issueKeyOrID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.unsubscribe", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +371,7 @@ func (i *internalServiceRequestImpl) Transitions(ctx context.Context, issueKeyOr
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/transition?%v", issueKeyOrID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.transitions", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -339,7 +416,7 @@ func (i internalServiceRequestImpl) Transition(ctx context.Context, issueKeyOrID
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/transition", issueKeyOrID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.transition", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -1083,3 +1083,156 @@ func Test_internalServiceRequestImpl_Create(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalServiceRequestImpl_CreateByName(t *testing.T) {
+
+	payloadMocked := &map[string]interface{}{
+		"requestFieldValues": map[string]interface{}{
+			"summary": "Request JSD help via REST"},
+		"requestParticipants": []interface{}{
+			"uuid-sample-1"},
+		"requestTypeId": "10",
+		"serviceDeskId": "1"}
+
+	fieldsMocked := &model.CustomerRequestFields{}
+
+	if err := fieldsMocked.Text("summary", "Request JSD help via REST"); err != nil {
+		t.Fatal(err)
+	}
+
+	serviceDesks := &model.ServiceDeskPageScheme{
+		IsLastPage: true,
+		Values:     []*model.ServiceDeskScheme{{ID: "1", ProjectName: "IT Support", ProjectKey: "ITS"}},
+	}
+
+	requestTypes := &model.ProjectRequestTypePageScheme{
+		IsLastPage: true,
+		Values:     []*model.RequestTypeScheme{{ID: "10", Name: "Get IT help"}},
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx                                          context.Context
+		serviceDeskNameOrProjectKey, requestTypeName string
+		payload                                      *model.CreateCustomerRequestPayloadScheme
+		fields                                       *model.CustomerRequestFields
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "ITS",
+				requestTypeName:             "get it help",
+				payload: &model.CreateCustomerRequestPayloadScheme{
+					RequestParticipants: []string{"uuid-sample-1"},
+					ServiceDeskID:       "29990",
+				},
+				fields: fieldsMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+				mockRequestTypePage(client, requestTypes)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/servicedeskapi/request",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CustomerRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the request type is not found",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "ITS",
+				requestTypeName:             "Request new hardware",
+				fields:                      fieldsMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+				mockRequestTypePage(client, requestTypes)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err: &model.RequestTypeResolutionError{
+				Err:        model.ErrRequestTypeNotFoundError,
+				Name:       "Request new hardware",
+				Candidates: []string{"Get IT help"},
+			},
+		},
+
+		{
+			name: "when the service desk name is not provided",
+			args: args{
+				ctx:             context.Background(),
+				requestTypeName: "Get IT help",
+				fields:          fieldsMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoServiceDeskNameError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			smService, err := NewRequestService(testCase.fields.c, "latest", nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := smService.CreateByName(testCase.args.ctx, testCase.args.serviceDeskNameOrProjectKey,
+				testCase.args.requestTypeName, testCase.args.payload, testCase.args.fields)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				// The caller payload is not modified
+				assert.Equal(t, "29990", testCase.args.payload.ServiceDeskID)
+			}
+		})
+	}
+}
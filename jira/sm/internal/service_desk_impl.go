@@ -75,7 +75,7 @@ func (i *internalServiceDeskImpl) Gets(ctx context.Context, start, limit int) (*
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk?%v", params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "serviceDesk.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,7 +97,7 @@ func (i *internalServiceDeskImpl) Get(ctx context.Context, serviceDeskID int) (*
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v", serviceDeskID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "serviceDesk.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -142,7 +142,7 @@ func (i *internalServiceDeskImpl) Attach(ctx context.Context, serviceDeskID int,
 
 	writer.Close()
 
-	request, err := i.c.NewFormRequest(ctx, http.MethodPost, endpoint, writer.FormDataContentType(), reader)
+	request, err := service.NewOperationFormRequest(ctx, i.c, "serviceDesk.attach", http.MethodPost, endpoint, writer.FormDataContentType(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
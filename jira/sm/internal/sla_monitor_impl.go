@@ -0,0 +1,268 @@
+package internal
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/sm"
+	"sort"
+	"sync"
+	"time"
+)
+
+const defaultSLAMonitorConcurrency = 5
+
+// NewSLAMonitorService returns a service checking the SLAs of the open requests, the requests are paged with the
+// request service of an authenticated client, e.g. client.Request.
+func NewSLAMonitorService(request sm.RequestConnector) *SLAMonitorService {
+	return &SLAMonitorService{request: request}
+}
+
+type SLAMonitorService struct {
+	request sm.RequestConnector
+}
+
+// Check returns the open requests of the service desks with an ongoing SLA cycle breached or with less remaining
+// time than the threshold.
+//
+// The service desks are paged concurrently, 5 service desks at a time by default, and their pages are followed with
+// the request GetsAll method. A service desk that can't be paged doesn't stop the check, it's added to the result errors.
+//
+// The remaining time of the running cycles is measured to their breach time with the options clock, the remaining time
+// returned by Jira is used for the paused cycles. The requests are sorted by the remaining time of their most urgent SLA,
+// so the most overdue requests come first.
+//
+// The requests of the options watermark aren't reported again on the same state, an at-risk request is reported again
+// when it's breached. The result watermark is passed to the next check.
+func (s *SLAMonitorService) Check(ctx context.Context, options *model.SLAMonitorOptionsScheme) (*model.SLAMonitorResultScheme, error) {
+
+	if options == nil || len(options.ServiceDeskIDs) == 0 {
+		return nil, model.ErrNoServiceDeskSliceError
+	}
+
+	monitor := &slaMonitor{
+		request:     s.request,
+		threshold:   options.Threshold,
+		concurrency: defaultSLAMonitorConcurrency,
+		now:         time.Now,
+		watermark:   make(map[string]string, len(options.Watermark)),
+	}
+
+	if options.Concurrency > 0 {
+		monitor.concurrency = options.Concurrency
+	}
+
+	if options.Now != nil {
+		monitor.now = options.Now
+	}
+
+	for _, watermark := range options.Watermark {
+		if watermark != nil {
+			monitor.watermark[watermark.IssueKey] = watermark.State
+		}
+	}
+
+	return monitor.check(ctx, options.ServiceDeskIDs)
+}
+
+type slaMonitor struct {
+	request     sm.RequestConnector
+	threshold   time.Duration
+	concurrency int
+	now         func() time.Time
+	watermark   map[string]string // The state of the watermark requests by issue key
+
+	mu       sync.Mutex
+	open     map[string]bool
+	requests []*model.SLAMonitorRequestScheme
+	errors   []*model.SLAMonitorErrorScheme
+}
+
+func (m *slaMonitor) check(ctx context.Context, serviceDeskIDs []int) (*model.SLAMonitorResultScheme, error) {
+
+	m.open = make(map[string]bool)
+
+	var workers sync.WaitGroup
+
+	queue := make(chan int)
+	for worker := 0; worker < m.concurrency; worker++ {
+
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+
+			for serviceDeskID := range queue {
+				m.page(ctx, serviceDeskID)
+			}
+		}()
+	}
+
+	for _, serviceDeskID := range serviceDeskIDs {
+		queue <- serviceDeskID
+	}
+
+	close(queue)
+	workers.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(m.requests, func(i, j int) bool {
+
+		if m.requests[i].Remaining != m.requests[j].Remaining {
+			return m.requests[i].Remaining < m.requests[j].Remaining
+		}
+
+		return m.requests[i].Request.IssueKey < m.requests[j].Request.IssueKey
+	})
+
+	sort.Slice(m.errors, func(i, j int) bool {
+		return m.errors[i].ServiceDeskID < m.errors[j].ServiceDeskID
+	})
+
+	result := &model.SLAMonitorResultScheme{Requests: m.requests, Errors: m.errors}
+
+	// The reported requests stay on the watermark while they're open, a request closed and reopened is reported again.
+	// The requests reported on this check replace their previous state.
+	watermark := make(map[string]string, len(m.open))
+	for key := range m.open {
+		if state, ok := m.watermark[key]; ok {
+			watermark[key] = state
+		}
+	}
+
+	for _, request := range m.requests {
+		watermark[request.Request.IssueKey] = slaMonitorState(request.Breached)
+	}
+
+	for key, state := range watermark {
+		result.Watermark = append(result.Watermark, &model.SLAMonitorWatermarkScheme{IssueKey: key, State: state})
+	}
+
+	sort.Slice(result.Watermark, func(i, j int) bool {
+		return result.Watermark[i].IssueKey < result.Watermark[j].IssueKey
+	})
+
+	return result, nil
+}
+
+// page evaluates the open requests of a service desk, the pages are followed in order.
+func (m *slaMonitor) page(ctx context.Context, serviceDeskID int) {
+
+	options := &model.ServiceRequestOptionScheme{
+		ServiceDeskID:     serviceDeskID,
+		RequestStatus:     "OPEN_REQUESTS",
+		RequestOwnerships: []string{"ALL_REQUESTS"},
+		Expand:            []string{"sla"},
+	}
+
+	_, err := m.request.GetsAll(ctx, options, func(page *model.CustomerRequestPageScheme) error {
+
+		for _, request := range page.Values {
+			m.evaluate(request)
+		}
+
+		return nil
+	})
+
+	// The canceled checks return the context error
+	if err != nil && ctx.Err() == nil {
+		m.fail(serviceDeskID, err)
+	}
+}
+
+// evaluate adds the request to the result when an ongoing cycle is breached or at risk.
+func (m *slaMonitor) evaluate(request *model.CustomerRequestScheme) {
+
+	if request == nil {
+		return
+	}
+
+	m.mu.Lock()
+	m.open[request.IssueKey] = true
+	m.mu.Unlock()
+
+	if request.SLA == nil {
+		return
+	}
+
+	now := m.now()
+
+	var cycles []*model.SLAMonitorCycleScheme
+	for _, sla := range request.SLA.Values {
+
+		if sla == nil || sla.OngoingCycle == nil {
+			continue
+		}
+
+		remaining, ok := slaRemainingTime(sla.OngoingCycle, now)
+		if !ok {
+			continue
+		}
+
+		breached := sla.OngoingCycle.Breached || remaining <= 0
+		if !breached && remaining >= m.threshold {
+			continue
+		}
+
+		cycles = append(cycles, &model.SLAMonitorCycleScheme{SLA: sla, Remaining: remaining, Breached: breached})
+	}
+
+	if len(cycles) == 0 {
+		return
+	}
+
+	sort.SliceStable(cycles, func(i, j int) bool {
+		return cycles[i].Remaining < cycles[j].Remaining
+	})
+
+	if state, ok := m.watermark[request.IssueKey]; ok && state == slaMonitorState(cycles[0].Breached) {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests = append(m.requests, &model.SLAMonitorRequestScheme{
+		Request:   request,
+		SLAs:      cycles,
+		Remaining: cycles[0].Remaining,
+		Breached:  cycles[0].Breached,
+	})
+}
+
+func (m *slaMonitor) fail(serviceDeskID int, err error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.errors = append(m.errors, &model.SLAMonitorErrorScheme{ServiceDeskID: serviceDeskID, Err: err})
+}
+
+// slaMonitorState returns the watermark state of a reported request.
+func slaMonitorState(breached bool) string {
+
+	if breached {
+		return model.SLAMonitorStateBreached
+	}
+
+	return model.SLAMonitorStateAtRisk
+}
+
+// slaRemainingTime returns the remaining time of an ongoing cycle, false when the cycle has no goal.
+//
+// The breach time is used for the running cycles, so the remaining time doesn't depend on when the page was fetched.
+// The paused cycles keep the remaining time returned by Jira, their breach time moves while they're paused.
+func slaRemainingTime(cycle *model.RequestSLAOngoingCycleScheme, now time.Time) (time.Duration, bool) {
+
+	if !cycle.Paused && cycle.BreachTime != nil && cycle.BreachTime.EpochMillis != 0 {
+		breachTime := time.Unix(0, int64(cycle.BreachTime.EpochMillis)*int64(time.Millisecond))
+		return breachTime.Sub(now), true
+	}
+
+	if cycle.RemainingTime != nil {
+		return time.Duration(cycle.RemainingTime.Millis) * time.Millisecond, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,186 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/sm"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeSLARequests struct {
+	sm.RequestConnector
+
+	mu      sync.Mutex
+	pages   map[int][]*model.CustomerRequestPageScheme
+	errs    map[int]error
+	options []*model.ServiceRequestOptionScheme
+}
+
+func (f *fakeSLARequests) GetsAll(ctx context.Context, options *model.ServiceRequestOptionScheme, visit func(page *model.CustomerRequestPageScheme) error) (*model.ResponseScheme, error) {
+
+	f.mu.Lock()
+	f.options = append(f.options, options)
+	f.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := f.errs[options.ServiceDeskID]; err != nil {
+		return nil, err
+	}
+
+	for _, page := range f.pages[options.ServiceDeskID] {
+		if err := visit(page); err != nil {
+			return &model.ResponseScheme{}, err
+		}
+	}
+
+	return &model.ResponseScheme{}, nil
+}
+
+// slaRequest returns a request with an SLA per cycle.
+func slaRequest(key string, cycles ...*model.RequestSLAOngoingCycleScheme) *model.CustomerRequestScheme {
+
+	request := &model.CustomerRequestScheme{IssueKey: key, SLA: &model.RequestSLAPageScheme{}}
+	for _, cycle := range cycles {
+		request.SLA.Values = append(request.SLA.Values, &model.RequestSLAScheme{Name: "Time to resolution", OngoingCycle: cycle})
+	}
+
+	return request
+}
+
+func Test_SLAMonitorService_Check(t *testing.T) {
+
+	now := time.Date(2022, 5, 13, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	breachAt := func(after time.Duration) *model.CustomerRequestDateScheme {
+		return &model.CustomerRequestDateScheme{EpochMillis: int(now.Add(after).UnixNano() / int64(time.Millisecond))}
+	}
+
+	requests := &fakeSLARequests{
+		pages: map[int][]*model.CustomerRequestPageScheme{
+			1: {
+				{Values: []*model.CustomerRequestScheme{
+					// The remaining time is measured to the breach time, the stale remaining time is ignored
+					slaRequest("DESK-1", &model.RequestSLAOngoingCycleScheme{
+						BreachTime:    breachAt(30 * time.Minute),
+						RemainingTime: &model.RequestSLADurationScheme{Millis: 7200000, Friendly: "2h"},
+					}),
+					slaRequest("DESK-2", &model.RequestSLAOngoingCycleScheme{BreachTime: breachAt(3 * time.Hour)}),
+				}},
+				{ServiceManagementPageScheme: model.ServiceManagementPageScheme{IsLastPage: true}, Values: []*model.CustomerRequestScheme{
+					slaRequest("DESK-3", &model.RequestSLAOngoingCycleScheme{
+						Breached:      true,
+						BreachTime:    breachAt(-2 * time.Hour),
+						RemainingTime: &model.RequestSLADurationScheme{Millis: -7200000, Friendly: "-2h"},
+					}),
+					// The breach time of a paused cycle moves, so the remaining time is used
+					slaRequest("DESK-4", &model.RequestSLAOngoingCycleScheme{
+						Paused:        true,
+						BreachTime:    breachAt(-time.Hour),
+						RemainingTime: &model.RequestSLADurationScheme{Millis: 600000, Friendly: "10m"},
+					}),
+					// The watermark requests aren't reported again on the same state
+					slaRequest("DESK-5", &model.RequestSLAOngoingCycleScheme{BreachTime: breachAt(time.Minute)}),
+					// The at-risk watermark requests are reported again when they're breached
+					slaRequest("DESK-6", &model.RequestSLAOngoingCycleScheme{BreachTime: breachAt(-30 * time.Minute)}),
+				}},
+			},
+			2: {
+				{ServiceManagementPageScheme: model.ServiceManagementPageScheme{IsLastPage: true}, Values: []*model.CustomerRequestScheme{
+					slaRequest("HELP-1",
+						&model.RequestSLAOngoingCycleScheme{BreachTime: breachAt(5 * time.Hour)},
+						&model.RequestSLAOngoingCycleScheme{BreachTime: breachAt(-time.Minute)},
+						&model.RequestSLAOngoingCycleScheme{},
+					),
+					{IssueKey: "HELP-2"},
+				}},
+			},
+		},
+		errs: map[int]error{3: errors.New("error, the service desk does not exist")},
+	}
+
+	options := &model.SLAMonitorOptionsScheme{
+		ServiceDeskIDs: []int{1, 2, 3},
+		Threshold:      time.Hour,
+		Watermark: []*model.SLAMonitorWatermarkScheme{
+			{IssueKey: "DESK-5", State: model.SLAMonitorStateAtRisk},
+			{IssueKey: "DESK-6", State: model.SLAMonitorStateAtRisk},
+			{IssueKey: "DESK-9", State: model.SLAMonitorStateBreached},
+		},
+		Concurrency: 2,
+		Now:         clock,
+	}
+
+	result, err := NewSLAMonitorService(requests).Check(context.Background(), options)
+	assert.NoError(t, err)
+
+	var keys []string
+	for _, request := range result.Requests {
+		keys = append(keys, request.Request.IssueKey)
+	}
+
+	assert.Equal(t, []string{"DESK-3", "DESK-6", "HELP-1", "DESK-4", "DESK-1"}, keys)
+
+	assert.Equal(t, -2*time.Hour, result.Requests[0].Remaining)
+	assert.True(t, result.Requests[0].Breached)
+
+	assert.True(t, result.Requests[1].Breached)
+
+	assert.Len(t, result.Requests[2].SLAs, 1)
+	assert.Equal(t, -time.Minute, result.Requests[2].Remaining)
+	assert.True(t, result.Requests[2].Breached)
+
+	assert.Equal(t, 10*time.Minute, result.Requests[3].Remaining)
+	assert.False(t, result.Requests[3].Breached)
+
+	assert.Equal(t, 30*time.Minute, result.Requests[4].Remaining)
+	assert.False(t, result.Requests[4].Breached)
+
+	// DESK-9 isn't open anymore, so it's dropped from the watermark
+	assert.Equal(t, []*model.SLAMonitorWatermarkScheme{
+		{IssueKey: "DESK-1", State: model.SLAMonitorStateAtRisk},
+		{IssueKey: "DESK-3", State: model.SLAMonitorStateBreached},
+		{IssueKey: "DESK-4", State: model.SLAMonitorStateAtRisk},
+		{IssueKey: "DESK-5", State: model.SLAMonitorStateAtRisk},
+		{IssueKey: "DESK-6", State: model.SLAMonitorStateBreached},
+		{IssueKey: "HELP-1", State: model.SLAMonitorStateBreached},
+	}, result.Watermark)
+
+	assert.Equal(t, []*model.SLAMonitorErrorScheme{
+		{ServiceDeskID: 3, Err: errors.New("error, the service desk does not exist")},
+	}, result.Errors)
+
+	for _, requestOptions := range requests.options {
+		assert.Equal(t, "OPEN_REQUESTS", requestOptions.RequestStatus)
+		assert.Equal(t, []string{"sla"}, requestOptions.Expand)
+	}
+
+	// The next check with the result watermark doesn't report the same requests
+	options.Watermark = result.Watermark
+
+	result, err = NewSLAMonitorService(requests).Check(context.Background(), options)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Requests)
+	assert.Equal(t, options.Watermark, result.Watermark)
+}
+
+func Test_SLAMonitorService_Check_Errors(t *testing.T) {
+
+	monitor := NewSLAMonitorService(&fakeSLARequests{})
+
+	_, err := monitor.Check(context.Background(), nil)
+	assert.EqualError(t, err, model.ErrNoServiceDeskSliceError.Error())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = monitor.Check(ctx, &model.SLAMonitorOptionsScheme{ServiceDeskIDs: []int{1}})
+	assert.EqualError(t, err, context.Canceled.Error())
+}
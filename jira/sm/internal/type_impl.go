@@ -3,12 +3,14 @@ package internal
 import (
 	"context"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/cache"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service"
 	"github.com/ctreminiom/go-atlassian/service/sm"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 func NewTypeService(client service.Client, version string) (*TypeService, error) {
@@ -17,13 +19,39 @@ func NewTypeService(client service.Client, version string) (*TypeService, error)
 		return nil, model.ErrNoVersionProvided
 	}
 
+	resolutions := cache.NewTTL(defaultRequestTypeResolutionTTL)
+
 	return &TypeService{
-		internalClient: &internalTypeImpl{c: client, version: version},
+		internalClient: &internalTypeImpl{
+			c:           client,
+			version:     version,
+			serviceDesk: &internalServiceDeskImpl{c: client, version: version},
+			cache:       resolutions,
+		},
+		cache: resolutions,
 	}, nil
 }
 
 type TypeService struct {
 	internalClient sm.TypeConnector
+	cache          *cache.TTL
+}
+
+// Resolve returns the service desk and the request type matching their names, the names are case-insensitive.
+//
+// The service desk is matched by project key first, then by project name. The resolutions are cached
+// for 5 minutes by default, use SetCacheTTL to change it.
+//
+// A *model.RequestTypeResolutionError listing the candidates is returned when a name is missing or ambiguous.
+//
+// TODO: the documentation needs to be created
+func (t *TypeService) Resolve(ctx context.Context, serviceDeskNameOrProjectKey, requestTypeName string) (*model.RequestTypeResolutionScheme, *model.ResponseScheme, error) {
+	return t.internalClient.Resolve(ctx, serviceDeskNameOrProjectKey, requestTypeName)
+}
+
+// SetCacheTTL sets how long the resolutions are cached, the cache is disabled when the ttl is zero.
+func (t *TypeService) SetCacheTTL(ttl time.Duration) {
+	t.cache.SetTTL(ttl)
 }
 
 // Search returns all customer request types used in the Jira Service Management instance,
@@ -82,8 +110,10 @@ func (t *TypeService) Fields(ctx context.Context, serviceDeskID, requestTypeID i
 }
 
 type internalTypeImpl struct {
-	c       service.Client
-	version string
+	c           service.Client
+	version     string
+	serviceDesk sm.ServiceDeskConnector
+	cache       *cache.TTL
 }
 
 func (i *internalTypeImpl) Search(ctx context.Context, query string, start, limit int) (*model.RequestTypePageScheme, *model.ResponseScheme, error) {
@@ -98,7 +128,7 @@ func (i *internalTypeImpl) Search(ctx context.Context, query string, start, limi
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/requesttype?%v", params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.type.search", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,7 +158,7 @@ func (i *internalTypeImpl) Gets(ctx context.Context, serviceDeskID, groupID, sta
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype?%v", serviceDeskID, params.Encode())
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.type.gets", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -167,7 +197,7 @@ func (i *internalTypeImpl) Create(ctx context.Context, serviceDeskID int, issueT
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype", serviceDeskID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, reader)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.type.create", http.MethodPost, endpoint, reader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,7 +223,7 @@ func (i *internalTypeImpl) Get(ctx context.Context, serviceDeskID, requestTypeID
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v", serviceDeskID, requestTypeID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.type.get", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -219,7 +249,7 @@ func (i *internalTypeImpl) Delete(ctx context.Context, serviceDeskID, requestTyp
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v", serviceDeskID, requestTypeID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.type.delete", http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +269,7 @@ func (i *internalTypeImpl) Fields(ctx context.Context, serviceDeskID, requestTyp
 
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v/field", serviceDeskID, requestTypeID)
 
-	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := service.NewOperationRequest(ctx, i.c, "request.type.fields", http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRequestTypeResolutionTTL = 5 * time.Minute
+	requestTypeResolutionPageSize   = 50
+)
+
+func (i *internalTypeImpl) Resolve(ctx context.Context, serviceDeskNameOrProjectKey, requestTypeName string) (*model.RequestTypeResolutionScheme, *model.ResponseScheme, error) {
+
+	if serviceDeskNameOrProjectKey == "" {
+		return nil, nil, model.ErrNoServiceDeskNameError
+	}
+
+	if requestTypeName == "" {
+		return nil, nil, model.ErrNoRequestTypeNameError
+	}
+
+	key := requestTypeResolutionKey(serviceDeskNameOrProjectKey, requestTypeName)
+	if resolution, ok := i.cache.Get(key); ok {
+		return resolution.(*model.RequestTypeResolutionScheme), nil, nil
+	}
+
+	serviceDesk, response, err := i.resolveServiceDesk(ctx, serviceDeskNameOrProjectKey)
+	if err != nil {
+		return nil, response, err
+	}
+
+	requestType, response, err := i.resolveRequestType(ctx, serviceDesk, requestTypeName)
+	if err != nil {
+		return nil, response, err
+	}
+
+	resolution := &model.RequestTypeResolutionScheme{ServiceDesk: serviceDesk, RequestType: requestType}
+	i.cache.Set(key, resolution)
+
+	return resolution, response, nil
+}
+
+// resolveServiceDesk returns the service desk matching the project key or, when no project key matches, the project name.
+func (i *internalTypeImpl) resolveServiceDesk(ctx context.Context, serviceDeskNameOrProjectKey string) (*model.ServiceDeskScheme, *model.ResponseScheme, error) {
+
+	var (
+		byKey, byName []*model.ServiceDeskScheme
+		candidates    []string
+		response      *model.ResponseScheme
+	)
+
+	for start := 0; ; start += requestTypeResolutionPageSize {
+
+		page, pageResponse, err := i.serviceDesk.Gets(ctx, start, requestTypeResolutionPageSize)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+
+		for _, serviceDesk := range page.Values {
+
+			if strings.EqualFold(serviceDesk.ProjectKey, serviceDeskNameOrProjectKey) {
+				byKey = append(byKey, serviceDesk)
+			} else if strings.EqualFold(strings.TrimSpace(serviceDesk.ProjectName), strings.TrimSpace(serviceDeskNameOrProjectKey)) {
+				byName = append(byName, serviceDesk)
+			}
+
+			candidates = append(candidates, fmt.Sprintf("%v (%v)", serviceDesk.ProjectName, serviceDesk.ProjectKey))
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	matches := byKey
+	if len(matches) == 0 {
+		matches = byName
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], response, nil
+	case 0:
+		return nil, response, &model.RequestTypeResolutionError{Err: model.ErrServiceDeskNotFoundError, Name: serviceDeskNameOrProjectKey, Candidates: candidates}
+	}
+
+	var ambiguous []string
+	for _, serviceDesk := range matches {
+		ambiguous = append(ambiguous, fmt.Sprintf("%v (%v)", serviceDesk.ProjectName, serviceDesk.ProjectKey))
+	}
+
+	return nil, response, &model.RequestTypeResolutionError{Err: model.ErrAmbiguousServiceDeskError, Name: serviceDeskNameOrProjectKey, Candidates: ambiguous}
+}
+
+// resolveRequestType returns the request type of the service desk matching the name.
+func (i *internalTypeImpl) resolveRequestType(ctx context.Context, serviceDesk *model.ServiceDeskScheme, requestTypeName string) (*model.RequestTypeScheme, *model.ResponseScheme, error) {
+
+	serviceDeskID, err := strconv.Atoi(serviceDesk.ID)
+	if err != nil {
+		return nil, nil, model.ErrNoServiceDeskIDError
+	}
+
+	var (
+		matches    []*model.RequestTypeScheme
+		candidates []string
+		response   *model.ResponseScheme
+	)
+
+	for start := 0; ; start += requestTypeResolutionPageSize {
+
+		page, pageResponse, err := i.Gets(ctx, serviceDeskID, 0, start, requestTypeResolutionPageSize)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+
+		for _, requestType := range page.Values {
+
+			if strings.EqualFold(strings.TrimSpace(requestType.Name), strings.TrimSpace(requestTypeName)) {
+				matches = append(matches, requestType)
+			}
+
+			candidates = append(candidates, requestType.Name)
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], response, nil
+	case 0:
+		return nil, response, &model.RequestTypeResolutionError{Err: model.ErrRequestTypeNotFoundError, Name: requestTypeName, Candidates: candidates}
+	}
+
+	var ambiguous []string
+	for _, requestType := range matches {
+		ambiguous = append(ambiguous, fmt.Sprintf("%v (%v)", requestType.Name, requestType.ID))
+	}
+
+	return nil, response, &model.RequestTypeResolutionError{Err: model.ErrAmbiguousRequestTypeError, Name: requestTypeName, Candidates: ambiguous}
+}
+
+// requestTypeResolutionKey returns the cache key of the names, the names are case-insensitive.
+func requestTypeResolutionKey(serviceDeskNameOrProjectKey, requestTypeName string) string {
+	return strings.ToLower(strings.TrimSpace(serviceDeskNameOrProjectKey)) + "\x00" + strings.ToLower(strings.TrimSpace(requestTypeName))
+}
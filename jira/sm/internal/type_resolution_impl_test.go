@@ -0,0 +1,367 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func mockServiceDeskPage(client *mocks.Client, page *model.ServiceDeskPageScheme) {
+
+	request := &http.Request{RequestURI: "servicedesk"}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/servicedeskapi/servicedesk?limit=50&start=0",
+		nil).
+		Return(request, nil).Once()
+
+	client.On("Call",
+		request,
+		&model.ServiceDeskPageScheme{}).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.ServiceDeskPageScheme) = *page
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+}
+
+func mockRequestTypePage(client *mocks.Client, page *model.ProjectRequestTypePageScheme) {
+
+	request := &http.Request{RequestURI: "requesttype"}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/servicedeskapi/servicedesk/1/requesttype?limit=50&start=0",
+		nil).
+		Return(request, nil).Once()
+
+	client.On("Call",
+		request,
+		&model.ProjectRequestTypePageScheme{}).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.ProjectRequestTypePageScheme) = *page
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+}
+
+func Test_internalTypeImpl_Resolve(t *testing.T) {
+
+	serviceDesks := &model.ServiceDeskPageScheme{
+		IsLastPage: true,
+		Values: []*model.ServiceDeskScheme{
+			{ID: "1", ProjectName: "IT Support", ProjectKey: "ITS"},
+			{ID: "2", ProjectName: "HR Desk", ProjectKey: "HR"},
+			{ID: "3", ProjectName: "HR Desk", ProjectKey: "HRD"},
+		},
+	}
+
+	requestTypes := &model.ProjectRequestTypePageScheme{
+		IsLastPage: true,
+		Values: []*model.RequestTypeScheme{
+			{ID: "10", Name: "Get IT help"},
+			{ID: "11", Name: "Fix an account problem"},
+			{ID: "12", Name: "Fix an account problem"},
+		},
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx                                          context.Context
+		serviceDeskNameOrProjectKey, requestTypeName string
+	}
+
+	testCases := []struct {
+		name           string
+		fields         fields
+		args           args
+		on             func(*fields)
+		wantErr        bool
+		Err            error
+		wantCandidates []string
+	}{
+		{
+			name: "when the service desk is resolved by project key",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "its",
+				requestTypeName:             " get it HELP ",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+				mockRequestTypePage(client, requestTypes)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the service desk is resolved by project name",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "it support",
+				requestTypeName:             "Get IT help",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+				mockRequestTypePage(client, requestTypes)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the service desk name is ambiguous",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "HR Desk",
+				requestTypeName:             "Get IT help",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+
+				fields.c = client
+			},
+			wantErr:        true,
+			Err:            model.ErrAmbiguousServiceDeskError,
+			wantCandidates: []string{"HR Desk (HR)", "HR Desk (HRD)"},
+		},
+
+		{
+			name: "when the service desk is not found",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "Facilities",
+				requestTypeName:             "Get IT help",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+
+				fields.c = client
+			},
+			wantErr:        true,
+			Err:            model.ErrServiceDeskNotFoundError,
+			wantCandidates: []string{"IT Support (ITS)", "HR Desk (HR)", "HR Desk (HRD)"},
+		},
+
+		{
+			name: "when the request type name is ambiguous",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "ITS",
+				requestTypeName:             "fix an account problem",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+				mockRequestTypePage(client, requestTypes)
+
+				fields.c = client
+			},
+			wantErr:        true,
+			Err:            model.ErrAmbiguousRequestTypeError,
+			wantCandidates: []string{"Fix an account problem (11)", "Fix an account problem (12)"},
+		},
+
+		{
+			name: "when the request type is not found",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "ITS",
+				requestTypeName:             "Request new hardware",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+				mockServiceDeskPage(client, serviceDesks)
+				mockRequestTypePage(client, requestTypes)
+
+				fields.c = client
+			},
+			wantErr:        true,
+			Err:            model.ErrRequestTypeNotFoundError,
+			wantCandidates: []string{"Get IT help", "Fix an account problem", "Fix an account problem"},
+		},
+
+		{
+			name: "when the service desks cannot be fetched",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "ITS",
+				requestTypeName:             "Get IT help",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/servicedeskapi/servicedesk?limit=50&start=0",
+					nil).
+					Return(&http.Request{}, errors.New("client: no http request created"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("client: no http request created"),
+		},
+
+		{
+			name: "when the service desk name is not provided",
+			args: args{
+				ctx:             context.Background(),
+				requestTypeName: "Get IT help",
+			},
+			wantErr: true,
+			Err:     model.ErrNoServiceDeskNameError,
+		},
+
+		{
+			name: "when the request type name is not provided",
+			args: args{
+				ctx:                         context.Background(),
+				serviceDeskNameOrProjectKey: "ITS",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRequestTypeNameError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			smService, err := NewTypeService(testCase.fields.c, "latest")
+			assert.NoError(t, err)
+
+			gotResult, _, err := smService.Resolve(testCase.args.ctx, testCase.args.serviceDeskNameOrProjectKey,
+				testCase.args.requestTypeName)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				if errors.Is(err, testCase.Err) {
+					assert.ErrorIs(t, err, testCase.Err)
+				} else {
+					assert.EqualError(t, err, testCase.Err.Error())
+				}
+
+				if testCase.wantCandidates != nil {
+
+					var resolutionErr *model.RequestTypeResolutionError
+					assert.True(t, errors.As(err, &resolutionErr))
+					assert.Equal(t, testCase.wantCandidates, resolutionErr.Candidates)
+				}
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, "1", gotResult.ServiceDesk.ID)
+				assert.Equal(t, "10", gotResult.RequestType.ID)
+			}
+		})
+	}
+}
+
+func Test_internalTypeImpl_Resolve_Cache(t *testing.T) {
+
+	serviceDesks := &model.ServiceDeskPageScheme{
+		IsLastPage: true,
+		Values:     []*model.ServiceDeskScheme{{ID: "1", ProjectName: "IT Support", ProjectKey: "ITS"}},
+	}
+
+	requestTypes := &model.ProjectRequestTypePageScheme{
+		IsLastPage: true,
+		Values:     []*model.RequestTypeScheme{{ID: "10", Name: "Get IT help"}},
+	}
+
+	t.Run("when the resolution is cached", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockServiceDeskPage(client, serviceDesks)
+		mockRequestTypePage(client, requestTypes)
+
+		smService, err := NewTypeService(client, "latest")
+		assert.NoError(t, err)
+
+		first, _, err := smService.Resolve(context.Background(), "ITS", "Get IT help")
+		assert.NoError(t, err)
+
+		// The names are case-insensitive, the cached resolution is returned without any request
+		second, response, err := smService.Resolve(context.Background(), "its", "GET IT HELP")
+		assert.NoError(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("when the cache is disabled", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockServiceDeskPage(client, serviceDesks)
+		mockRequestTypePage(client, requestTypes)
+		mockServiceDeskPage(client, serviceDesks)
+		mockRequestTypePage(client, requestTypes)
+
+		smService, err := NewTypeService(client, "latest")
+		assert.NoError(t, err)
+
+		smService.SetCacheTTL(0)
+
+		_, _, err = smService.Resolve(context.Background(), "ITS", "Get IT help")
+		assert.NoError(t, err)
+
+		_, response, err := smService.Resolve(context.Background(), "ITS", "Get IT help")
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("when the cached resolution expires", func(t *testing.T) {
+
+		client := mocks.NewClient(t)
+		mockServiceDeskPage(client, serviceDesks)
+		mockRequestTypePage(client, requestTypes)
+		mockServiceDeskPage(client, serviceDesks)
+		mockRequestTypePage(client, requestTypes)
+
+		smService, err := NewTypeService(client, "latest")
+		assert.NoError(t, err)
+
+		smService.SetCacheTTL(time.Millisecond)
+
+		_, _, err = smService.Resolve(context.Background(), "ITS", "Get IT help")
+		assert.NoError(t, err)
+
+		time.Sleep(5 * time.Millisecond)
+
+		_, response, err := smService.Resolve(context.Background(), "ITS", "Get IT help")
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+}
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -14,6 +15,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
@@ -157,6 +159,30 @@ func TestClient_Call(t *testing.T) {
 	}
 }
 
+func TestClient_Stream(t *testing.T) {
+
+	expectedResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader("Hello, world!")),
+	}
+
+	client := mocks.NewHttpClient(t)
+
+	client.On("Do", (*http.Request)(nil)).
+		Return(expectedResponse, nil)
+
+	c := &Client{HTTP: client}
+
+	got, err := c.Stream(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponse, got)
+
+	// The body is returned unread
+	body, err := ioutil.ReadAll(got.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello, world!", string(body))
+}
+
 func TestNewV2(t *testing.T) {
 
 	mockClient, err := New(http.DefaultClient, "https://ctreminiom.atlassian.net")
@@ -648,3 +674,168 @@ func TestClient_NewRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_EnableStrictDecoding(t *testing.T) {
+
+	newResponse := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request: &http.Request{
+				Method: http.MethodGet,
+				URL:    &url.URL{Path: "rest/agile/1.0/board/4"},
+			},
+		}
+	}
+
+	t.Run("when the response has unknown fields", func(t *testing.T) {
+
+		var drifted []*models.ResponseScheme
+
+		c := &Client{}
+		c.EnableStrictDecoding(func(response *models.ResponseScheme) {
+			drifted = append(drifted, response)
+		})
+
+		board := &models.BoardScheme{}
+		got, err := c.TransformTheHTTPResponse(newResponse(`{"id":4,"name":"KP - Scrum","favourite":true,
+			"location":{"projectId":10000,"projectColor":"blue"}}`), board)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "KP - Scrum", board.Name)
+		assert.Equal(t, []string{"favourite", "location.projectColor"}, got.UnknownFields)
+		assert.Equal(t, []*models.ResponseScheme{got}, drifted)
+	})
+
+	t.Run("when the response matches the scheme", func(t *testing.T) {
+
+		c := &Client{}
+		c.EnableStrictDecoding(func(response *models.ResponseScheme) {
+			t.Fatalf("unexpected drift on %v", response.Endpoint)
+		})
+
+		got, err := c.TransformTheHTTPResponse(newResponse(`{"id":4,"name":"KP - Scrum"}`), &models.BoardScheme{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, got.UnknownFields)
+	})
+
+	t.Run("when the strict decoding is disabled", func(t *testing.T) {
+
+		got, err := (&Client{}).TransformTheHTTPResponse(newResponse(`{"id":4,"favourite":true}`), &models.BoardScheme{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, got.UnknownFields)
+	})
+}
+
+func TestClient_SetRequestCompression(t *testing.T) {
+
+	type received struct {
+		encoding string
+		body     string
+	}
+
+	var requests []received
+
+	// The fixture decompresses the gzipped bodies, like the endpoints accepting them
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+
+			reader, err := gzip.NewReader(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			body = reader
+		}
+
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, received{encoding: r.Header.Get("Content-Encoding"), body: string(data)})
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	client.SetRequestCompression(64, "rest/api/*/issue/*/properties/*")
+
+	largePayload := `{"fields":{"summary":"` + strings.Repeat("DUMMY ", 50) + `"}}`
+
+	send := func(ctx context.Context, endpoint, payload string) {
+
+		request, err := client.NewRequest(ctx, http.MethodPost, endpoint, strings.NewReader(payload))
+		assert.NoError(t, err)
+
+		_, err = client.Call(request, nil)
+		assert.NoError(t, err)
+	}
+
+	send(context.Background(), "rest/api/3/issue/bulk", largePayload)
+	send(context.Background(), "rest/api/3/issue/bulk", `{"fields":{}}`)
+	send(context.Background(), "rest/api/3/issue/DUMMY-1/properties/sprint?expand=all", largePayload)
+	send(context.Background(), "rest/api/3/issue/DUMMY-1/attachments", largePayload)
+	send(models.WithoutRequestCompression(context.Background()), "rest/api/3/issue/bulk", largePayload)
+
+	assert.Equal(t, []received{
+		{encoding: "gzip", body: largePayload},
+		{body: `{"fields":{}}`},
+		{body: largePayload},
+		{body: largePayload},
+		{body: largePayload},
+	}, requests)
+
+	// The form requests are never compressed
+	request, err := client.NewFormRequest(context.Background(), http.MethodPost, "rest/api/3/issue/DUMMY-1/attachments",
+		"multipart/form-data", strings.NewReader(largePayload))
+	assert.NoError(t, err)
+	assert.Empty(t, request.Header.Get("Content-Encoding"))
+}
+
+// operationRecorder is an HTTP client wrapping the requests, reading their operation like a metrics recorder.
+type operationRecorder struct {
+	client     common.HttpClient
+	operations []string
+}
+
+func (o *operationRecorder) Do(request *http.Request) (*http.Response, error) {
+	o.operations = append(o.operations, models.OperationFromContext(request.Context()))
+	return o.client.Do(request)
+}
+
+func TestClient_NewOperationRequest(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"10000","name":"v1.0"}]`))
+	}))
+	defer server.Close()
+
+	recorder := &operationRecorder{client: server.Client()}
+
+	client, err := New(recorder, server.URL)
+	assert.NoError(t, err)
+
+	versions, response, err := client.Project.Version.Gets(context.Background(), "KP")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 1)
+	assert.Equal(t, "jira.v2.project.version.gets", response.Operation)
+
+	// The requests created without a service method aren't named
+	request, err := client.NewRequest(context.Background(), http.MethodGet, "rest/api/3/myself", nil)
+	assert.NoError(t, err)
+
+	response, err = client.Call(request, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, response.Operation)
+
+	assert.Equal(t, []string{"jira.v2.project.version.gets", ""}, recorder.operations)
+}
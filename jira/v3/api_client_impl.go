@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/ctreminiom/go-atlassian/jira/internal"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/compression"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/drift"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service/common"
 	"io"
@@ -13,6 +15,7 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"time"
 )
 
 func New(httpClient common.HttpClient, site string) (*Client, error) {
@@ -50,17 +53,17 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
-	filterShareService, err := internal.NewFilterShareService(client, "3")
+	groupService, err := internal.NewGroupService(client, "3")
 	if err != nil {
 		return nil, err
 	}
 
-	filterService, err := internal.NewFilterService(client, "3", filterShareService)
+	filterShareService, err := internal.NewFilterShareService(client, "3", groupService)
 	if err != nil {
 		return nil, err
 	}
 
-	groupService, err := internal.NewGroupService(client, "3")
+	filterService, err := internal.NewFilterService(client, "3", filterShareService)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +108,13 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
-	issueFieldService, err := internal.NewIssueFieldService(client, "3", fieldConfigService, fieldContextService, fieldTrashService)
+	fieldOptionService, err := internal.NewIssueFieldOptionService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
+	issueFieldService, err := internal.NewIssueFieldService(client, "3", fieldConfigService, fieldContextService, fieldTrashService,
+		fieldOptionService)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +129,12 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
-	link, _, err := internal.NewLinkService(client, "3", linkType)
+	remoteLink, err := internal.NewRemoteLinkService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
+	link, _, err := internal.NewLinkService(client, "3", linkType, remoteLink)
 	if err != nil {
 		return nil, err
 	}
@@ -135,11 +149,26 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	issueProperty, err := internal.NewIssuePropertyService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
 	resolution, err := internal.NewResolutionService(client, "3")
 	if err != nil {
 		return nil, err
 	}
 
+	development, err := internal.NewDevelopmentService(client, "1.0")
+	if err != nil {
+		return nil, err
+	}
+
+	securityLevel, err := internal.NewIssueSecurityLevelService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
 	search, _, err := internal.NewSearchService(client, "3")
 	if err != nil {
 		return nil, err
@@ -176,19 +205,22 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 	}
 
 	issueServices := &internal.IssueServices{
-		Attachment: issueAttachmentService,
-		CommentADF: commentService,
-		Field:      issueFieldService,
-		Label:      label,
-		LinkADF:    link,
-		Metadata:   metadata,
-		Priority:   priority,
-		Resolution: resolution,
-		SearchADF:  search,
-		Type:       type_,
-		Vote:       vote,
-		Watcher:    watcher,
-		WorklogAdf: worklog,
+		Attachment:    issueAttachmentService,
+		CommentADF:    commentService,
+		Development:   development,
+		Field:         issueFieldService,
+		Label:         label,
+		LinkADF:       link,
+		Metadata:      metadata,
+		Priority:      priority,
+		Property:      issueProperty,
+		Resolution:    resolution,
+		SecurityLevel: securityLevel,
+		SearchADF:     search,
+		Type:          type_,
+		Vote:          vote,
+		Watcher:       watcher,
+		WorklogAdf:    worklog,
 	}
 
 	mySelf, err := internal.NewMySelfService(client, "3")
@@ -266,10 +298,22 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	projectAnalytics, err := internal.NewProjectAnalyticsService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
+	projectFieldMap, err := internal.NewProjectFieldMapService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
 	projectSubService := &internal.ProjectChildServices{
+		Analytics:  projectAnalytics,
 		Category:   projectCategory,
 		Component:  projectComponent,
 		Feature:    projectFeature,
+		FieldMap:   projectFieldMap,
 		Permission: projectPermission,
 		Property:   projectProperties,
 		Role:       projectRole,
@@ -313,6 +357,21 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	license, err := internal.NewLicenseService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
+	uiModification, err := internal.NewUIModificationService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := internal.NewSettingsService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
 	userSearch, err := internal.NewUserSearchService(client, "3")
 	if err != nil {
 		return nil, err
@@ -343,6 +402,11 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	adfConversion, err := internal.NewADFConversionService(client, "3")
+	if err != nil {
+		return nil, err
+	}
+
 	client.Audit = auditRecord
 	client.Permission = permission
 	client.MySelf = mySelf
@@ -356,32 +420,107 @@ func New(httpClient common.HttpClient, site string) (*Client, error) {
 	client.Screen = screen
 	client.Task = task
 	client.Server = server
+	client.License = license
+	client.UIModification = uiModification
+	client.Settings = settings
 	client.User = user
 	client.Workflow = workflow
 	client.JQL = jql
+	client.ADFConversion = adfConversion
 
 	return client, nil
 }
 
 type Client struct {
-	HTTP       common.HttpClient
-	Auth       common.Authentication
-	Site       *url.URL
-	Audit      *internal.AuditRecordService
-	Role       *internal.ApplicationRoleService
-	Dashboard  *internal.DashboardService
-	Filter     *internal.FilterService
-	Group      *internal.GroupService
-	Issue      *internal.IssueADFService
-	MySelf     *internal.MySelfService
-	Permission *internal.PermissionService
-	Project    *internal.ProjectService
-	Screen     *internal.ScreenService
-	Task       *internal.TaskService
-	Server     *internal.ServerService
-	User       *internal.UserService
-	Workflow   *internal.WorkflowService
-	JQL        *internal.JQLService
+	HTTP           common.HttpClient
+	Auth           common.Authentication
+	Site           *url.URL
+	Audit          *internal.AuditRecordService
+	Role           *internal.ApplicationRoleService
+	Dashboard      *internal.DashboardService
+	Filter         *internal.FilterService
+	Group          *internal.GroupService
+	Issue          *internal.IssueADFService
+	MySelf         *internal.MySelfService
+	Permission     *internal.PermissionService
+	Project        *internal.ProjectService
+	Screen         *internal.ScreenService
+	Task           *internal.TaskService
+	Server         *internal.ServerService
+	License        *internal.LicenseService
+	UIModification *internal.UIModificationService
+	Settings       *internal.SettingsService
+	User           *internal.UserService
+	Workflow       *internal.WorkflowService
+	JQL            *internal.JQLService
+	ADFConversion  *internal.ADFConversionService
+
+	internalAPIs  bool
+	confirmations *internal.ConfirmationRegistry
+
+	strictDecoding bool
+	onDrift        func(response *models.ResponseScheme)
+
+	compression *compression.Policy
+}
+
+// EnableInternalAPIs opts in to the undocumented Jira APIs, e.g. the development information of the issues.
+//
+// These APIs are not part of the public REST API, they can change without notice.
+func (c *Client) EnableInternalAPIs() {
+	c.internalAPIs = true
+}
+
+// HasInternalAPIs reports whether the client opted in to the undocumented Jira APIs.
+func (c *Client) HasInternalAPIs() bool {
+	return c.internalAPIs
+}
+
+// EnableProtectedMode opts in to the confirmation of the destructive methods, e.g. the project deletion.
+//
+// On protected mode, the destructive methods require the token returned by their PreviewDelete method on the context,
+// see models.WithConfirmation. The tokens are used once and expire after the validity, 5 minutes when it's not positive.
+func (c *Client) EnableProtectedMode(validity time.Duration) {
+	c.confirmations = internal.NewConfirmationRegistry(validity)
+}
+
+// Confirmations returns the confirmation tokens issued by the client, nil when the protected mode is disabled.
+func (c *Client) Confirmations() *internal.ConfirmationRegistry {
+	return c.confirmations
+}
+
+// EnableStrictDecoding opts in to the detection of the response fields the schemes don't know, e.g. the fields
+// added or renamed by Atlassian. The calls don't fail, the unknown fields are recorded on the UnknownFields
+// of the responses.
+//
+// The onDrift func, when it's not nil, is called with the responses containing unknown fields, e.g. to log them.
+// The responses are decoded twice on strict mode, the clients without it decode them once.
+func (c *Client) EnableStrictDecoding(onDrift func(response *models.ResponseScheme)) {
+	c.strictDecoding = true
+	c.onDrift = onDrift
+}
+
+// SetRequestCompression gzips the JSON request bodies larger than the min size, in bytes, and sets their
+// Content-Encoding header, e.g. to send the large bulk payloads.
+//
+// The endpoints of compression.DefaultDenylist and of the denylist are never compressed, the patterns are matched
+// against the endpoint path, e.g. rest/api/*/issue/*/properties/*. Use models.WithoutRequestCompression to send
+// a single request as is. The form requests, e.g. the multipart attachment uploads, are never compressed.
+func (c *Client) SetRequestCompression(minSize int, denylist ...string) {
+	c.compression = compression.NewPolicy(minSize)
+	c.compression.Deny(denylist...)
+}
+
+// NewOperationRequest creates the request of a service method, the request context is named with the operation,
+// prefixed with jira.v3., see models.WithOperation.
+func (c *Client) NewOperationRequest(ctx context.Context, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+	return c.NewRequest(models.WithOperation(ctx, "jira.v3."+operation), method, apiEndpoint, payload)
+}
+
+// NewOperationFormRequest creates the form request of a service method, the request context is named with the operation,
+// prefixed with jira.v3., see models.WithOperation.
+func (c *Client) NewOperationFormRequest(ctx context.Context, operation, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
+	return c.NewFormRequest(models.WithOperation(ctx, "jira.v3."+operation), method, apiEndpoint, contentType, payload)
 }
 
 func (c *Client) NewFormRequest(ctx context.Context, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
@@ -422,6 +561,15 @@ func (c *Client) NewRequest(ctx context.Context, method, apiEndpoint string, pay
 
 	var endpoint = c.Site.ResolveReference(relativePath).String()
 
+	var compressed bool
+	if c.compression != nil && !models.RequestCompressionSkipped(ctx) {
+
+		payload, compressed, err = c.compression.Compress(relativePath.Path, payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	request, err := http.NewRequestWithContext(ctx, method, endpoint, payload)
 	if err != nil {
 		return nil, err
@@ -433,6 +581,10 @@ func (c *Client) NewRequest(ctx context.Context, method, apiEndpoint string, pay
 		request.Header.Set("Content-Type", "application/json")
 	}
 
+	if compressed {
+		request.Header.Set("Content-Encoding", "gzip")
+	}
+
 	if c.Auth.HasBasicAuth() {
 		request.SetBasicAuth(c.Auth.GetBasicAuth())
 	}
@@ -454,13 +606,19 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 	return c.TransformTheHTTPResponse(response, structure)
 }
 
+// Stream sends the request and returns the HTTP response without reading its body, the caller closes the body.
+func (c *Client) Stream(request *http.Request) (*http.Response, error) {
+	return c.HTTP.Do(request)
+}
+
 func (c *Client) TransformTheHTTPResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
 
 	responseTransformed := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Operation: models.OperationFromContext(response.Request.Context()),
 	}
 
 	responseAsBytes, err := ioutil.ReadAll(response.Body)
@@ -479,11 +637,34 @@ func (c *Client) TransformTheHTTPResponse(response *http.Response, structure int
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
 			return responseTransformed, err
 		}
+
+		if c.strictDecoding {
+			if err = c.detectDrift(responseTransformed, responseAsBytes, structure); err != nil {
+				return responseTransformed, err
+			}
+		}
 	}
 
 	return responseTransformed, nil
 }
 
+// detectDrift records the fields of the response the structure can't decode and reports them to the drift func.
+func (c *Client) detectDrift(response *models.ResponseScheme, responseAsBytes []byte, structure interface{}) error {
+
+	unknownFields, err := drift.UnknownFields(responseAsBytes, structure)
+	if err != nil || len(unknownFields) == 0 {
+		return err
+	}
+
+	response.UnknownFields = unknownFields
+
+	if c.onDrift != nil {
+		c.onDrift(response)
+	}
+
+	return nil
+}
+
 func (c *Client) TransformStructToReader(structure interface{}) (io.Reader, error) {
 
 	if structure == nil {
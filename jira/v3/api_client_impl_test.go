@@ -2,9 +2,11 @@ package v3
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/ctreminiom/go-atlassian/jira/internal"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/service/common"
@@ -13,10 +15,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"io"
 	"io/ioutil"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"path"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestClient_Call(t *testing.T) {
@@ -157,6 +165,30 @@ func TestClient_Call(t *testing.T) {
 	}
 }
 
+func TestClient_Stream(t *testing.T) {
+
+	expectedResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader("Hello, world!")),
+	}
+
+	client := mocks.NewHttpClient(t)
+
+	client.On("Do", (*http.Request)(nil)).
+		Return(expectedResponse, nil)
+
+	c := &Client{HTTP: client}
+
+	got, err := c.Stream(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResponse, got)
+
+	// The body is returned unread
+	body, err := ioutil.ReadAll(got.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello, world!", string(body))
+}
+
 func TestNew(t *testing.T) {
 
 	mockClient, err := New(http.DefaultClient, "https://ctreminiom.atlassian.net")
@@ -648,3 +680,431 @@ func TestClient_NewRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_EnableStrictDecoding(t *testing.T) {
+
+	newResponse := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request: &http.Request{
+				Method: http.MethodGet,
+				URL:    &url.URL{Path: "rest/agile/1.0/board/4"},
+			},
+		}
+	}
+
+	t.Run("when the response has unknown fields", func(t *testing.T) {
+
+		var drifted []*models.ResponseScheme
+
+		c := &Client{}
+		c.EnableStrictDecoding(func(response *models.ResponseScheme) {
+			drifted = append(drifted, response)
+		})
+
+		board := &models.BoardScheme{}
+		got, err := c.TransformTheHTTPResponse(newResponse(`{"id":4,"name":"KP - Scrum","favourite":true,
+			"location":{"projectId":10000,"projectColor":"blue"}}`), board)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "KP - Scrum", board.Name)
+		assert.Equal(t, []string{"favourite", "location.projectColor"}, got.UnknownFields)
+		assert.Equal(t, []*models.ResponseScheme{got}, drifted)
+	})
+
+	t.Run("when the response matches the scheme", func(t *testing.T) {
+
+		c := &Client{}
+		c.EnableStrictDecoding(func(response *models.ResponseScheme) {
+			t.Fatalf("unexpected drift on %v", response.Endpoint)
+		})
+
+		got, err := c.TransformTheHTTPResponse(newResponse(`{"id":4,"name":"KP - Scrum"}`), &models.BoardScheme{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, got.UnknownFields)
+	})
+
+	t.Run("when the strict decoding is disabled", func(t *testing.T) {
+
+		got, err := (&Client{}).TransformTheHTTPResponse(newResponse(`{"id":4,"favourite":true}`), &models.BoardScheme{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, got.UnknownFields)
+	})
+}
+
+func TestClient_SetRequestCompression(t *testing.T) {
+
+	type received struct {
+		encoding string
+		body     string
+	}
+
+	var requests []received
+
+	// The fixture decompresses the gzipped bodies, like the endpoints accepting them
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+
+			reader, err := gzip.NewReader(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			body = reader
+		}
+
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, received{encoding: r.Header.Get("Content-Encoding"), body: string(data)})
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	client.SetRequestCompression(64, "rest/api/*/issue/*/properties/*")
+
+	largePayload := `{"fields":{"summary":"` + strings.Repeat("DUMMY ", 50) + `"}}`
+
+	send := func(ctx context.Context, endpoint, payload string) {
+
+		request, err := client.NewRequest(ctx, http.MethodPost, endpoint, strings.NewReader(payload))
+		assert.NoError(t, err)
+
+		_, err = client.Call(request, nil)
+		assert.NoError(t, err)
+	}
+
+	send(context.Background(), "rest/api/3/issue/bulk", largePayload)
+	send(context.Background(), "rest/api/3/issue/bulk", `{"fields":{}}`)
+	send(context.Background(), "rest/api/3/issue/DUMMY-1/properties/sprint?expand=all", largePayload)
+	send(context.Background(), "rest/api/3/issue/DUMMY-1/attachments", largePayload)
+	send(models.WithoutRequestCompression(context.Background()), "rest/api/3/issue/bulk", largePayload)
+
+	assert.Equal(t, []received{
+		{encoding: "gzip", body: largePayload},
+		{body: `{"fields":{}}`},
+		{body: largePayload},
+		{body: largePayload},
+		{body: largePayload},
+	}, requests)
+
+	// The form requests are never compressed
+	request, err := client.NewFormRequest(context.Background(), http.MethodPost, "rest/api/3/issue/DUMMY-1/attachments",
+		"multipart/form-data", strings.NewReader(largePayload))
+	assert.NoError(t, err)
+	assert.Empty(t, request.Header.Get("Content-Encoding"))
+}
+
+// operationRecorder is an HTTP client wrapping the requests, reading their operation like a metrics recorder.
+type operationRecorder struct {
+	client     common.HttpClient
+	operations []string
+}
+
+func (o *operationRecorder) Do(request *http.Request) (*http.Response, error) {
+	o.operations = append(o.operations, models.OperationFromContext(request.Context()))
+	return o.client.Do(request)
+}
+
+func TestClient_NewOperationRequest(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"10000","name":"v1.0"}]`))
+	}))
+	defer server.Close()
+
+	recorder := &operationRecorder{client: server.Client()}
+
+	client, err := New(recorder, server.URL)
+	assert.NoError(t, err)
+
+	versions, response, err := client.Project.Version.Gets(context.Background(), "KP")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 1)
+	assert.Equal(t, "jira.v3.project.version.gets", response.Operation)
+
+	// The requests created without a service method aren't named
+	request, err := client.NewRequest(context.Background(), http.MethodGet, "rest/api/3/myself", nil)
+	assert.NoError(t, err)
+
+	response, err = client.Call(request, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, response.Operation)
+
+	assert.Equal(t, []string{"jira.v3.project.version.gets", ""}, recorder.operations)
+}
+
+// gatedReader returns the chunks of a file, the last chunk waits for the gate, so the file can't be read
+// in full before the request is sent.
+type gatedReader struct {
+	chunks int
+	gate   chan struct{}
+}
+
+func (g *gatedReader) Read(p []byte) (int, error) {
+
+	if g.chunks == 0 {
+		return 0, io.EOF
+	}
+
+	if g.chunks == 1 {
+		select {
+		case <-g.gate:
+		case <-time.After(5 * time.Second):
+			return 0, errors.New("error, the file was read before the request was sent")
+		}
+	}
+
+	g.chunks--
+
+	chunk := bytes.Repeat([]byte("A"), 32*1024)
+	return copy(p, chunk), nil
+}
+
+func TestClient_AddAttachmentStreaming(t *testing.T) {
+
+	file := &gatedReader{chunks: 64, gate: make(chan struct{})}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		assert.NoError(t, err)
+		assert.Equal(t, "multipart/form-data", mediaType)
+		assert.NotEmpty(t, params["boundary"])
+
+		part, err := multipart.NewReader(r.Body, params["boundary"]).NextPart()
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		assert.Equal(t, "file", part.FormName())
+		assert.Equal(t, "LARGE.bin", part.FileName())
+
+		// The first bytes of the file are received while the last chunk is still waiting to be read
+		first := make([]byte, 1024)
+		_, err = io.ReadFull(part, first)
+		assert.NoError(t, err)
+		close(file.gate)
+
+		rest, err := ioutil.ReadAll(part)
+		assert.NoError(t, err)
+
+		_, _ = fmt.Fprintf(w, `[{"id":"10001","filename":"LARGE.bin","size":%d}]`, len(first)+len(rest))
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	attachments, response, err := client.Issue.Attachment.Add(context.Background(), "DUMMY-1", "LARGE.bin", file)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, []*models.AttachmentScheme{{ID: "10001", Filename: "LARGE.bin", Size: 64 * 32 * 1024}}, attachments)
+}
+
+// endlessReader returns slow chunks until the file is closed, the reads ending after the close are counted.
+type endlessReader struct {
+	mu        sync.Mutex
+	closed    bool
+	lateReads int
+}
+
+func (e *endlessReader) Read(p []byte) (int, error) {
+
+	time.Sleep(10 * time.Millisecond)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		e.lateReads++
+	}
+
+	return copy(p, bytes.Repeat([]byte("A"), 1024)), nil
+}
+
+func (e *endlessReader) Close() error {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.closed = true
+	return nil
+}
+
+func TestClient_AddAttachmentRejected(t *testing.T) {
+
+	file := &endlessReader{}
+
+	// The upload is rejected before the file is read in full
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Connection", "close")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	_, response, err := client.Issue.Attachment.Add(context.Background(), "DUMMY-1", "LARGE.bin", file)
+	assert.Error(t, err)
+
+	if assert.NotNil(t, response) {
+		assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+		assert.Equal(t, "jira.v3.issue.attachment.add", response.Operation)
+	}
+
+	// The file isn't read once Add returns, so the caller can close it
+	assert.NoError(t, file.Close())
+	time.Sleep(50 * time.Millisecond)
+
+	file.mu.Lock()
+	defer file.mu.Unlock()
+	assert.Zero(t, file.lateReads)
+}
+
+func TestClient_DownloadAttachment(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch r.URL.Path {
+		case "/rest/api/3/attachment/content/10000", "/rest/api/3/attachment/thumbnail/10000":
+			http.Redirect(w, r, "/media/"+path.Base(path.Dir(r.URL.Path)), http.StatusSeeOther)
+
+		case "/media/content":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("DUMMY CONTENT"))
+
+		case "/media/thumbnail":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("DUMMY THUMBNAIL"))
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	var content bytes.Buffer
+	response, err := client.Issue.Attachment.Download(context.Background(), "10000", true, &content)
+	assert.NoError(t, err)
+	assert.Equal(t, "DUMMY CONTENT", content.String())
+	assert.Equal(t, "text/plain", response.Header.Get("Content-Type"))
+	assert.Equal(t, int64(13), response.ContentLength)
+
+	// The content is streamed into the writer, it's not kept on the response
+	assert.Zero(t, response.Bytes.Len())
+
+	var thumbnail bytes.Buffer
+	response, err = client.Issue.Attachment.DownloadThumbnail(context.Background(), "10000", nil, &thumbnail)
+	assert.NoError(t, err)
+	assert.Equal(t, "DUMMY THUMBNAIL", thumbnail.String())
+	assert.Equal(t, "image/png", response.Header.Get("Content-Type"))
+
+	_, err = client.Issue.Attachment.Download(context.Background(), "10001", true, &content)
+	assert.True(t, errors.Is(err, models.ErrInvalidStatusCodeError))
+}
+
+func TestClient_UpdateLinkType(t *testing.T) {
+
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		body, _ = ioutil.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	linkType, _, err := client.Issue.Link.Type.Update(context.Background(), "10000", &models.LinkTypeScheme{Name: "Blocks"})
+	assert.NoError(t, err)
+	assert.Equal(t, "blocks", linkType.Outward)
+
+	// The empty fields are not sent, so the inward and outward descriptions are not blanked
+	assert.JSONEq(t, `{"name":"Blocks"}`, string(body))
+}
+
+func TestClient_UpdateNullFields(t *testing.T) {
+
+	bodies := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies[r.URL.Path] = string(body)
+
+		if r.URL.Path == "/rest/api/3/issue/KP-1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"10000","name":"v1.0.0"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	issue := &models.IssueScheme{Fields: &models.IssueFieldsScheme{Summary: "New summary"}}
+	issue.ClearFields("duedate", "assignee", "fixVersions")
+
+	customFields := &models.CustomFields{}
+	assert.NoError(t, customFields.Clear("customfield_10020"))
+
+	_, err = client.Issue.Update(context.Background(), "KP-1", false, issue, customFields, nil)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{"fields":{"summary":"New summary","duedate":null,"assignee":null,"fixVersions":null,"customfield_10020":null}}`,
+		bodies["/rest/api/3/issue/KP-1"])
+
+	_, _, err = client.Project.Version.Update(context.Background(), "10000", &models.VersionPayloadScheme{
+		Name:       "v1.0.0",
+		NullFields: []string{"releaseDate", "driver"},
+	})
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{"name":"v1.0.0","releaseDate":null,"driver":null}`, bodies["/rest/api/3/version/10000"])
+}
+
+func TestClient_DeleteIssueTypeInUse(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"errorMessages":["The issue type is in use, select an alternative issue type."],"errors":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	response, err := client.Issue.Type.Delete(context.Background(), "10000", "")
+	assert.True(t, errors.Is(err, models.ErrInvalidStatusCodeError))
+
+	// The conflict body is kept on the response, so the Jira error messages can be surfaced
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Contains(t, response.Bytes.String(), "select an alternative issue type")
+}
@@ -0,0 +1,121 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+const (
+	IssueCreatedEvent   = "jira:issue_created"
+	IssueUpdatedEvent   = "jira:issue_updated"
+	IssueDeletedEvent   = "jira:issue_deleted"
+	CommentCreatedEvent = "comment_created"
+	CommentUpdatedEvent = "comment_updated"
+	CommentDeletedEvent = "comment_deleted"
+	SprintCreatedEvent  = "sprint_created"
+	SprintUpdatedEvent  = "sprint_updated"
+	SprintStartedEvent  = "sprint_started"
+	SprintClosedEvent   = "sprint_closed"
+	SprintDeletedEvent  = "sprint_deleted"
+	WorklogCreatedEvent = "worklog_created"
+	WorklogUpdatedEvent = "worklog_updated"
+	WorklogDeletedEvent = "worklog_deleted"
+)
+
+// Event is a parsed webhook payload, use a type switch to get the event payload scheme.
+type Event interface {
+
+	// Name returns the webhook event of the payload, e.g. jira:issue_updated
+	Name() string
+}
+
+// WebhookEventHeaderScheme holds the properties shared by every webhook payload.
+type WebhookEventHeaderScheme struct {
+	Timestamp    int64  `json:"timestamp,omitempty"`
+	WebhookEvent string `json:"webhookEvent,omitempty"`
+}
+
+// Name returns the webhook event of the payload, e.g. jira:issue_updated
+func (w *WebhookEventHeaderScheme) Name() string {
+	return w.WebhookEvent
+}
+
+// WebhookEventIssue is the payload of the issue created, updated and deleted events.
+type WebhookEventIssue struct {
+	WebhookEventHeaderScheme
+	IssueEventTypeName string                             `json:"issue_event_type_name,omitempty"`
+	User               *model.UserScheme                  `json:"user,omitempty"`
+	Issue              *model.IssueSchemeV2               `json:"issue,omitempty"`
+	Changelog          *model.IssueChangelogHistoryScheme `json:"changelog,omitempty"`
+	Comment            *model.IssueCommentSchemeV2        `json:"comment,omitempty"`
+}
+
+// Changed returns the changelog item of a field, the field is matched by its id or by its name.
+//
+// A nil item is returned when the field was not changed.
+func (w *WebhookEventIssue) Changed(field string) *model.IssueChangelogHistoryItemScheme {
+
+	if w.Changelog == nil {
+		return nil
+	}
+
+	for _, item := range w.Changelog.Items {
+		if item.FieldID == field || item.Field == field {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// WebhookEventComment is the payload of the comment created, updated and deleted events.
+type WebhookEventComment struct {
+	WebhookEventHeaderScheme
+	Comment *model.IssueCommentSchemeV2 `json:"comment,omitempty"`
+	Issue   *model.IssueSchemeV2        `json:"issue,omitempty"`
+}
+
+// WebhookEventSprint is the payload of the sprint events, the old value is only set on the sprint updated events.
+type WebhookEventSprint struct {
+	WebhookEventHeaderScheme
+	Sprint   *model.SprintScheme `json:"sprint,omitempty"`
+	OldValue *model.SprintScheme `json:"oldValue,omitempty"`
+}
+
+// WebhookEventWorklog is the payload of the worklog created, updated and deleted events.
+type WebhookEventWorklog struct {
+	WebhookEventHeaderScheme
+	Worklog *model.IssueWorklogScheme `json:"worklog,omitempty"`
+}
+
+// ParseEvent decodes a webhook payload into the event payload scheme matching its webhookEvent property.
+func ParseEvent(body []byte) (Event, error) {
+
+	header := new(WebhookEventHeaderScheme)
+	if err := json.Unmarshal(body, header); err != nil {
+		return nil, err
+	}
+
+	var event Event
+	switch header.WebhookEvent {
+	case "":
+		return nil, model.ErrNoWebhookEventError
+	case IssueCreatedEvent, IssueUpdatedEvent, IssueDeletedEvent:
+		event = new(WebhookEventIssue)
+	case CommentCreatedEvent, CommentUpdatedEvent, CommentDeletedEvent:
+		event = new(WebhookEventComment)
+	case SprintCreatedEvent, SprintUpdatedEvent, SprintStartedEvent, SprintClosedEvent, SprintDeletedEvent:
+		event = new(WebhookEventSprint)
+	case WorklogCreatedEvent, WorklogUpdatedEvent, WorklogDeletedEvent:
+		event = new(WebhookEventWorklog)
+	default:
+		return nil, fmt.Errorf("%w: %v", model.ErrUnsupportedWebhookEventError, header.WebhookEvent)
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
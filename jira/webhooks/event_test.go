@@ -0,0 +1,133 @@
+package webhooks
+
+import (
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseEvent(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		body    string
+		check   func(t *testing.T, event Event)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the payload is an issue updated event",
+			body: `{
+				"timestamp": 1672531200000,
+				"webhookEvent": "jira:issue_updated",
+				"issue_event_type_name": "issue_generic",
+				"user": {"accountId": "account-id-sample", "displayName": "Jane"},
+				"issue": {"id": "10002", "key": "DUMMY-2", "fields": {"summary": "Login fails"}},
+				"changelog": {
+					"id": "10100",
+					"items": [
+						{"field": "status", "fieldtype": "jira", "fieldId": "status", "from": "1", "fromString": "To Do", "to": "3", "toString": "In Progress"},
+						{"field": "Story Points", "fieldtype": "custom", "fieldId": "customfield_10012", "fromString": "3", "toString": "5"}
+					]
+				}
+			}`,
+			check: func(t *testing.T, event Event) {
+
+				issueEvent, ok := event.(*WebhookEventIssue)
+				assert.True(t, ok)
+
+				assert.Equal(t, IssueUpdatedEvent, issueEvent.Name())
+				assert.Equal(t, int64(1672531200000), issueEvent.Timestamp)
+				assert.Equal(t, "account-id-sample", issueEvent.User.AccountID)
+				assert.Equal(t, "DUMMY-2", issueEvent.Issue.Key)
+				assert.Equal(t, "Login fails", issueEvent.Issue.Fields.Summary)
+
+				assert.Equal(t, "In Progress", issueEvent.Changed("status").ToString)
+				assert.Equal(t, "5", issueEvent.Changed("Story Points").ToString)
+				assert.Nil(t, issueEvent.Changed("assignee"))
+			},
+		},
+
+		{
+			name: "when the payload is a comment event",
+			body: `{
+				"webhookEvent": "comment_created",
+				"comment": {"id": "10500", "body": "Looks good"},
+				"issue": {"id": "10002", "key": "DUMMY-2"}
+			}`,
+			check: func(t *testing.T, event Event) {
+
+				commentEvent, ok := event.(*WebhookEventComment)
+				assert.True(t, ok)
+				assert.Equal(t, "Looks good", commentEvent.Comment.Body)
+				assert.Equal(t, "DUMMY-2", commentEvent.Issue.Key)
+			},
+		},
+
+		{
+			name: "when the payload is a sprint event",
+			body: `{
+				"webhookEvent": "sprint_updated",
+				"sprint": {"id": 4, "state": "active", "name": "Sprint 4"},
+				"oldValue": {"id": 4, "state": "future", "name": "Sprint 4"}
+			}`,
+			check: func(t *testing.T, event Event) {
+
+				sprintEvent, ok := event.(*WebhookEventSprint)
+				assert.True(t, ok)
+				assert.Equal(t, "active", sprintEvent.Sprint.State)
+				assert.Equal(t, "future", sprintEvent.OldValue.State)
+			},
+		},
+
+		{
+			name: "when the payload is a worklog event",
+			body: `{
+				"webhookEvent": "worklog_created",
+				"worklog": {"id": "10800", "issueId": "10002", "timeSpentSeconds": 3600}
+			}`,
+			check: func(t *testing.T, event Event) {
+
+				worklogEvent, ok := event.(*WebhookEventWorklog)
+				assert.True(t, ok)
+				assert.Equal(t, 3600, worklogEvent.Worklog.TimeSpentSeconds)
+			},
+		},
+
+		{
+			name:    "when the webhook event is not supported",
+			body:    `{"webhookEvent": "board_created"}`,
+			wantErr: true,
+			Err:     model.ErrUnsupportedWebhookEventError,
+		},
+
+		{
+			name:    "when the webhook event is not set",
+			body:    `{"timestamp": 1672531200000}`,
+			wantErr: true,
+			Err:     model.ErrNoWebhookEventError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			event, err := ParseEvent([]byte(testCase.body))
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err))
+
+			} else {
+
+				assert.NoError(t, err)
+				testCase.check(t, event)
+			}
+		})
+	}
+}
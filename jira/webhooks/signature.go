@@ -0,0 +1,87 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// SignatureHeader is the header carrying the signature of the payloads sent by the dynamically registered webhooks
+const SignatureHeader = "X-Hub-Signature"
+
+// signatureMethods are the HMAC methods accepted on the signature header, e.g. sha256=5d41...
+var signatureMethods = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// Verifier verifies the signature of the webhook payloads using the secret set when the webhook was registered.
+type Verifier struct {
+	secret []byte
+}
+
+func NewVerifier(secret string) (*Verifier, error) {
+
+	if secret == "" {
+		return nil, model.ErrNoWebhookSecretError
+	}
+
+	return &Verifier{secret: []byte(secret)}, nil
+}
+
+// Verify checks the signature of the payload, the signature is the value of the X-Hub-Signature header.
+//
+// The signatures are compared in constant time.
+func (v *Verifier) Verify(body []byte, signature string) error {
+
+	method, digest := signature, ""
+	if separator := strings.Index(signature, "="); separator != -1 {
+		method, digest = signature[:separator], signature[separator+1:]
+	}
+
+	newHash, ok := signatureMethods[strings.ToLower(method)]
+	if !ok {
+		return model.ErrInvalidWebhookSignatureError
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return model.ErrInvalidWebhookSignatureError
+	}
+
+	mac := hmac.New(newHash, v.secret)
+	mac.Write(body)
+
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return model.ErrInvalidWebhookSignatureError
+	}
+
+	return nil
+}
+
+// VerifyRequest reads the body of a webhook request and checks its signature, the body is returned
+// and restored on the request so it can be read again.
+func (v *Verifier) VerifyRequest(request *http.Request) ([]byte, error) {
+
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if err := v.Verify(body, request.Header.Get(SignatureHeader)); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
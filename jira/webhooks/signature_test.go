@@ -0,0 +1,73 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+
+	body := []byte(`{"webhookEvent":"jira:issue_created"}`)
+
+	mac := hmac.New(sha256.New, []byte("webhook-secret"))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	verifier, err := NewVerifier("webhook-secret")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		body      []byte
+		signature string
+		wantErr   bool
+	}{
+		{name: "when the signature is valid", body: body, signature: signature},
+		{name: "when the body was modified", body: []byte(`{"webhookEvent":"jira:issue_deleted"}`), signature: signature, wantErr: true},
+		{name: "when the method is not supported", body: body, signature: "md5=" + signature[len("sha256="):], wantErr: true},
+		{name: "when the signature is not hexadecimal", body: body, signature: "sha256=not-hex", wantErr: true},
+		{name: "when the signature is not provided", body: body, wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := verifier.Verify(testCase.body, testCase.signature)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, model.ErrInvalidWebhookSignatureError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("when the request is verified", func(t *testing.T) {
+
+		request, err := http.NewRequest(http.MethodPost, "https://example.com/webhook", bytes.NewReader(body))
+		assert.NoError(t, err)
+		request.Header.Set(SignatureHeader, signature)
+
+		gotBody, err := verifier.VerifyRequest(request)
+		assert.NoError(t, err)
+		assert.Equal(t, body, gotBody)
+
+		// The body is restored on the request
+		restored, err := ioutil.ReadAll(request.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, body, restored)
+	})
+
+	t.Run("when the secret is not provided", func(t *testing.T) {
+
+		_, err := NewVerifier("")
+		assert.EqualError(t, err, model.ErrNoWebhookSecretError.Error())
+	})
+}
@@ -0,0 +1,189 @@
+// Package ops is the client of the Jira Service Management operations API, formerly Opsgenie.
+package ops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/ops/internal"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/common"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// DefaultSite is the base url of the operations API, the cloud id of the site is appended to it
+const DefaultSite = "https://api.atlassian.com/jsm/ops/api/"
+
+// New returns the client of the operations API of the site with the cloud id,
+// set the credentials using the SetBasicAuth or the SetBearerToken methods of the Auth service.
+func New(httpClient common.HttpClient, cloudID string) (*Client, error) {
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	if cloudID == "" {
+		return nil, models.ErrNoCloudIDError
+	}
+
+	siteAsURL, err := url.Parse(DefaultSite + url.PathEscape(cloudID) + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{
+		HTTP: httpClient,
+		Site: siteAsURL,
+	}
+
+	client.Auth = internal.NewAuthenticationService(client)
+	client.Alert = internal.NewAlertService(client)
+
+	return client, nil
+}
+
+type Client struct {
+	HTTP  common.HttpClient
+	Site  *url.URL
+	Auth  *internal.AuthenticationService
+	Alert *internal.AlertService
+}
+
+// NewOperationRequest creates the request of a service method, the request context is named with the operation,
+// prefixed with ops., see models.WithOperation.
+func (c *Client) NewOperationRequest(ctx context.Context, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+	return c.NewRequest(models.WithOperation(ctx, "ops."+operation), method, apiEndpoint, payload)
+}
+
+// NewOperationFormRequest creates the form request of a service method, the request context is named with the operation,
+// prefixed with ops., see models.WithOperation.
+func (c *Client) NewOperationFormRequest(ctx context.Context, operation, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
+	return c.NewFormRequest(models.WithOperation(ctx, "ops."+operation), method, apiEndpoint, contentType, payload)
+}
+
+func (c *Client) NewFormRequest(ctx context.Context, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
+
+	relativePath, err := url.Parse(apiEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = c.Site.ResolveReference(relativePath).String()
+
+	request, err := http.NewRequestWithContext(ctx, method, endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Content-Type", contentType)
+	request.Header.Add("Accept", "application/json")
+
+	if c.Auth.HasBearerToken() {
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", c.Auth.GetBearerToken()))
+	} else if c.Auth.HasBasicAuth() {
+		request.SetBasicAuth(c.Auth.GetBasicAuth())
+	}
+
+	if c.Auth.HasUserAgent() {
+		request.Header.Set("User-Agent", c.Auth.GetUserAgent())
+	}
+
+	return request, nil
+}
+
+func (c *Client) NewRequest(ctx context.Context, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+
+	relativePath, err := url.Parse(apiEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = c.Site.ResolveReference(relativePath).String()
+
+	request, err := http.NewRequestWithContext(ctx, method, endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.Auth.HasBearerToken() {
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", c.Auth.GetBearerToken()))
+	} else if c.Auth.HasBasicAuth() {
+		request.SetBasicAuth(c.Auth.GetBasicAuth())
+	}
+
+	if c.Auth.HasUserAgent() {
+		request.Header.Set("User-Agent", c.Auth.GetUserAgent())
+	}
+
+	return request, nil
+}
+
+func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
+
+	response, err := c.HTTP.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.TransformTheHTTPResponse(response, structure)
+}
+
+func (c *Client) TransformTheHTTPResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
+
+	responseTransformed := &models.ResponseScheme{
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Operation: models.OperationFromContext(response.Request.Context()),
+	}
+
+	responseAsBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return responseTransformed, err
+	}
+
+	responseTransformed.Bytes.Write(responseAsBytes)
+
+	var wasSuccess = response.StatusCode >= 200 && response.StatusCode < 300
+	if !wasSuccess {
+		return responseTransformed, models.ErrInvalidStatusCodeError
+	}
+
+	if structure != nil {
+		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
+			return responseTransformed, err
+		}
+	}
+
+	return responseTransformed, nil
+}
+
+func (c *Client) TransformStructToReader(structure interface{}) (io.Reader, error) {
+
+	if structure == nil {
+		return nil, models.ErrNilPayloadError
+	}
+
+	if reflect.ValueOf(structure).Type().Kind() == reflect.Struct {
+		return nil, models.ErrNonPayloadPointerError
+	}
+
+	structureAsBodyBytes, err := json.Marshal(structure)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(structureAsBodyBytes), nil
+}
@@ -0,0 +1,54 @@
+package ops
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+
+	client, err := New(nil, "cloud-id-sample")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.atlassian.com/jsm/ops/api/cloud-id-sample/", client.Site.String())
+	assert.NotNil(t, client.Alert)
+
+	_, err = New(nil, "")
+	assert.Equal(t, models.ErrNoCloudIDError, err)
+}
+
+func TestClient_NewRequest(t *testing.T) {
+
+	t.Run("when the bearer token is set", func(t *testing.T) {
+
+		client, err := New(nil, "cloud-id-sample")
+		assert.NoError(t, err)
+
+		client.Auth.SetBasicAuth("mail", "token")
+		client.Auth.SetBearerToken("access-token-sample")
+
+		request, err := client.NewRequest(context.Background(), http.MethodGet, "v1/alerts/alert-id-sample", nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "https://api.atlassian.com/jsm/ops/api/cloud-id-sample/v1/alerts/alert-id-sample", request.URL.String())
+		assert.Equal(t, "Bearer access-token-sample", request.Header.Get("Authorization"))
+	})
+
+	t.Run("when the basic authentication is set", func(t *testing.T) {
+
+		client, err := New(nil, "cloud-id-sample")
+		assert.NoError(t, err)
+
+		client.Auth.SetBasicAuth("mail", "token")
+
+		request, err := client.NewRequest(context.Background(), http.MethodGet, "v1/alerts", nil)
+		assert.NoError(t, err)
+
+		mail, token, ok := request.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "mail", mail)
+		assert.Equal(t, "token", token)
+	})
+}
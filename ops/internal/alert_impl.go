@@ -0,0 +1,237 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/ops"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func NewAlertService(client service.Client) *AlertService {
+
+	return &AlertService{
+		internalClient: &internalAlertImpl{c: client},
+	}
+}
+
+type AlertService struct {
+	internalClient ops.AlertConnector
+}
+
+// Create creates an alert.
+//
+// The alerts are created asynchronously, use the request id of the returned request to poll its status.
+//
+// POST /v1/alerts
+func (a *AlertService) Create(ctx context.Context, payload *model.AlertPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Create(ctx, payload)
+}
+
+// Get returns an alert.
+//
+// GET /v1/alerts/{id}
+func (a *AlertService) Get(ctx context.Context, alertID string) (*model.AlertScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Get(ctx, alertID)
+}
+
+// List returns the alerts matching the search query, the alerts are paginated using the offset and the size.
+//
+// GET /v1/alerts
+func (a *AlertService) List(ctx context.Context, options *model.AlertSearchOptionsScheme, offset, size int) (*model.AlertPageScheme, *model.ResponseScheme, error) {
+	return a.internalClient.List(ctx, options, offset, size)
+}
+
+// Close closes an alert, the alert is closed asynchronously.
+//
+// POST /v1/alerts/{id}/close
+func (a *AlertService) Close(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Close(ctx, alertID, payload)
+}
+
+// Acknowledge acknowledges an alert, the alert is acknowledged asynchronously.
+//
+// POST /v1/alerts/{id}/acknowledge
+func (a *AlertService) Acknowledge(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Acknowledge(ctx, alertID, payload)
+}
+
+// AddNote adds a note to an alert, the note is added asynchronously.
+//
+// POST /v1/alerts/{id}/notes
+func (a *AlertService) AddNote(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+	return a.internalClient.AddNote(ctx, alertID, payload)
+}
+
+// RequestStatus returns the status of an alert request, the alert id is set once the request is processed.
+//
+// GET /v1/alerts/requests/{requestId}
+func (a *AlertService) RequestStatus(ctx context.Context, requestID string) (*model.AlertRequestStatusScheme, *model.ResponseScheme, error) {
+	return a.internalClient.RequestStatus(ctx, requestID)
+}
+
+type internalAlertImpl struct {
+	c service.Client
+}
+
+func (i *internalAlertImpl) Create(ctx context.Context, payload *model.AlertPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.Message == "" {
+		return nil, nil, model.ErrNoAlertMessageError
+	}
+
+	switch payload.Priority {
+	case "", model.AlertPriorityP1, model.AlertPriorityP2, model.AlertPriorityP3, model.AlertPriorityP4, model.AlertPriorityP5:
+	default:
+		return nil, nil, model.ErrInvalidAlertPriorityError
+	}
+
+	return i.request(ctx, "alert.create", "v1/alerts", payload)
+}
+
+func (i *internalAlertImpl) Get(ctx context.Context, alertID string) (*model.AlertScheme, *model.ResponseScheme, error) {
+
+	if alertID == "" {
+		return nil, nil, model.ErrNoAlertIDError
+	}
+
+	endpoint := fmt.Sprintf("v1/alerts/%v", alertID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "alert.get", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(model.AlertScheme)
+	response, err := i.c.Call(request, alert)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return alert, response, nil
+}
+
+func (i *internalAlertImpl) List(ctx context.Context, options *model.AlertSearchOptionsScheme, offset, size int) (*model.AlertPageScheme, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("offset", strconv.Itoa(offset))
+	query.Add("size", strconv.Itoa(size))
+
+	if options != nil {
+
+		if options.Query != "" {
+			query.Add("query", options.Query)
+		}
+
+		if options.Sort != "" {
+			query.Add("sort", options.Sort)
+		}
+
+		if options.Order != "" {
+			query.Add("order", options.Order)
+		}
+	}
+
+	endpoint := fmt.Sprintf("v1/alerts?%v", query.Encode())
+
+	request, err := service.NewOperationRequest(ctx, i.c, "alert.list", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.AlertPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalAlertImpl) Close(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+
+	if alertID == "" {
+		return nil, nil, model.ErrNoAlertIDError
+	}
+
+	return i.request(ctx, "alert.close", fmt.Sprintf("v1/alerts/%v/close", alertID), alertActionPayload(payload))
+}
+
+func (i *internalAlertImpl) Acknowledge(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+
+	if alertID == "" {
+		return nil, nil, model.ErrNoAlertIDError
+	}
+
+	return i.request(ctx, "alert.acknowledge", fmt.Sprintf("v1/alerts/%v/acknowledge", alertID), alertActionPayload(payload))
+}
+
+func (i *internalAlertImpl) AddNote(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+
+	if alertID == "" {
+		return nil, nil, model.ErrNoAlertIDError
+	}
+
+	if payload == nil || payload.Note == "" {
+		return nil, nil, model.ErrNoAlertNoteError
+	}
+
+	return i.request(ctx, "alert.addNote", fmt.Sprintf("v1/alerts/%v/notes", alertID), payload)
+}
+
+func (i *internalAlertImpl) RequestStatus(ctx context.Context, requestID string) (*model.AlertRequestStatusScheme, *model.ResponseScheme, error) {
+
+	if requestID == "" {
+		return nil, nil, model.ErrNoAlertRequestIDError
+	}
+
+	endpoint := fmt.Sprintf("v1/alerts/requests/%v", requestID)
+
+	request, err := service.NewOperationRequest(ctx, i.c, "alert.requestStatus", http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.AlertRequestStatusScheme)
+	response, err := i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
+
+// request posts the payload to the endpoint, returning the accepted request.
+func (i *internalAlertImpl) request(ctx context.Context, operation, endpoint string, payload interface{}) (*model.AlertRequestScheme, *model.ResponseScheme, error) {
+
+	reader, err := i.c.TransformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.NewOperationRequest(ctx, i.c, operation, http.MethodPost, endpoint, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accepted := new(model.AlertRequestScheme)
+	response, err := i.c.Call(request, accepted)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return accepted, response, nil
+}
+
+// alertActionPayload returns an empty payload when the payload is not set, the action endpoints require a body.
+func alertActionPayload(payload *model.AlertActionPayloadScheme) *model.AlertActionPayloadScheme {
+
+	if payload == nil {
+		return &model.AlertActionPayloadScheme{}
+	}
+
+	return payload
+}
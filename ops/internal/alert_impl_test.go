@@ -0,0 +1,291 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
+	"github.com/ctreminiom/go-atlassian/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func Test_internalAlertImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.AlertPayloadScheme{
+		Message:    "Checkout latency above the threshold",
+		Priority:   model.AlertPriorityP1,
+		Responders: []*model.AlertResponderScheme{{ID: "team-id-sample", Type: "team"}},
+		Tags:       []string{"checkout", "latency"},
+	}
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.AlertPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"v1/alerts",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AlertRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					payloadMocked).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"v1/alerts",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the priority is not valid",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.AlertPayloadScheme{Message: "Checkout latency above the threshold", Priority: "P6"},
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidAlertPriorityError,
+		},
+
+		{
+			name: "when the message is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.AlertPayloadScheme{Priority: model.AlertPriorityP3},
+			},
+			wantErr: true,
+			Err:     model.ErrNoAlertMessageError,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAlertMessageError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewAlertService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalAlertImpl_Close(t *testing.T) {
+
+	type fields struct {
+		c service.Client
+	}
+
+	type args struct {
+		ctx     context.Context
+		alertID string
+		payload *model.AlertActionPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:     context.Background(),
+				alertID: "alert-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewClient(t)
+
+				client.On("TransformStructToReader",
+					&model.AlertActionPayloadScheme{}).
+					Return(bytes.NewReader([]byte{}), nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"v1/alerts/alert-id-sample/close",
+					bytes.NewReader([]byte{})).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AlertRequestScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the alert id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAlertIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewAlertService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Close(testCase.args.ctx, testCase.args.alertID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalAlertImpl_List(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"v1/alerts?offset=20&order=desc&query=status%3A+open+AND+priority%3A+P1&size=10&sort=createdAt",
+		nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		&model.AlertPageScheme{}).
+		Return(&model.ResponseScheme{}, nil)
+
+	options := &model.AlertSearchOptionsScheme{Query: "status: open AND priority: P1", Sort: "createdAt", Order: "desc"}
+
+	page, _, err := NewAlertService(client).List(context.Background(), options, 20, 10)
+	assert.NoError(t, err)
+	assert.NotNil(t, page)
+}
+
+func Test_internalAlertImpl_RequestStatus(t *testing.T) {
+
+	client := mocks.NewClient(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"v1/alerts/requests/request-id-sample",
+		nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		&model.AlertRequestStatusScheme{}).
+		Return(&model.ResponseScheme{}, nil)
+
+	status, _, err := NewAlertService(client).RequestStatus(context.Background(), "request-id-sample")
+	assert.NoError(t, err)
+	assert.NotNil(t, status)
+
+	_, _, err = NewAlertService(client).RequestStatus(context.Background(), "")
+	assert.Equal(t, model.ErrNoAlertRequestIDError, err)
+}
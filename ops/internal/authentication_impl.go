@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"github.com/ctreminiom/go-atlassian/service"
+)
+
+func NewAuthenticationService(client service.Client) *AuthenticationService {
+	return &AuthenticationService{c: client}
+}
+
+// AuthenticationService stores the credentials of the operations API, the basic authentication uses an email
+// and an API token, the bearer token is an OAuth 2.0 access token.
+type AuthenticationService struct {
+	c service.Client
+
+	basicAuthProvided bool
+	mail, token       string
+
+	bearerTokenProvided bool
+	bearerToken         string
+
+	userAgentProvided bool
+	agent             string
+}
+
+func (a *AuthenticationService) SetExperimentalFlag() {}
+
+func (a *AuthenticationService) HasSetExperimentalFlag() bool {
+	return false
+}
+
+func (a *AuthenticationService) SetBasicAuth(mail, token string) {
+	a.mail = mail
+	a.token = token
+
+	a.basicAuthProvided = true
+}
+
+func (a *AuthenticationService) GetBasicAuth() (string, string) {
+	return a.mail, a.token
+}
+
+func (a *AuthenticationService) HasBasicAuth() bool {
+	return a.basicAuthProvided
+}
+
+func (a *AuthenticationService) SetBearerToken(token string) {
+	a.bearerToken = token
+	a.bearerTokenProvided = true
+}
+
+func (a *AuthenticationService) GetBearerToken() string {
+	return a.bearerToken
+}
+
+func (a *AuthenticationService) HasBearerToken() bool {
+	return a.bearerTokenProvided
+}
+
+func (a *AuthenticationService) SetUserAgent(agent string) {
+	a.agent = agent
+	a.userAgentProvided = true
+}
+
+func (a *AuthenticationService) GetUserAgent() string {
+	return a.agent
+}
+
+func (a *AuthenticationService) HasUserAgent() bool {
+	return a.userAgentProvided
+}
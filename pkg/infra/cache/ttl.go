@@ -0,0 +1,86 @@
+// Package cache holds the expiring caches used by the services resolving names and metadata.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// TTL is a cache safe for concurrent use, the values expire after the ttl.
+//
+// A zero or negative ttl disables the cache, the values set aren't stored.
+type TTL struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*ttlEntry
+}
+
+type ttlEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// NewTTL returns an empty cache expiring the values after the ttl.
+func NewTTL(ttl time.Duration) *TTL {
+	return &TTL{ttl: ttl, entries: make(map[string]*ttlEntry)}
+}
+
+// Get returns the value of the key, false is returned when the key is missing or expired.
+func (c *TTL) Get(key string) (interface{}, bool) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// Set stores the value of the key until the ttl expires.
+func (c *TTL) Set(key string, value interface{}) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.entries[key] = &ttlEntry{value: value, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// Delete removes the value of the key.
+func (c *TTL) Delete(key string) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Clear removes all the values.
+func (c *TTL) Clear() {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*ttlEntry)
+}
+
+// SetTTL changes the ttl of the cache, the values already stored are removed.
+func (c *TTL) SetTTL(ttl time.Duration) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ttl = ttl
+	c.entries = make(map[string]*ttlEntry)
+}
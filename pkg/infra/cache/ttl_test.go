@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTTL(t *testing.T) {
+
+	cache := NewTTL(time.Minute)
+
+	_, ok := cache.Get("KP")
+	assert.False(t, ok)
+
+	cache.Set("KP", "10000")
+	cache.Set("DESK", "10001")
+
+	value, ok := cache.Get("KP")
+	assert.True(t, ok)
+	assert.Equal(t, "10000", value)
+
+	cache.Delete("KP")
+	_, ok = cache.Get("KP")
+	assert.False(t, ok)
+
+	cache.Clear()
+	_, ok = cache.Get("DESK")
+	assert.False(t, ok)
+
+	// The values expire after the ttl
+	cache.SetTTL(time.Millisecond)
+	cache.Set("KP", "10000")
+	time.Sleep(5 * time.Millisecond)
+
+	_, ok = cache.Get("KP")
+	assert.False(t, ok)
+
+	// The cache is disabled when the ttl is zero
+	cache.SetTTL(0)
+	cache.Set("KP", "10000")
+
+	_, ok = cache.Get("KP")
+	assert.False(t, ok)
+}
+
+func TestTTL_SetTTL(t *testing.T) {
+
+	cache := NewTTL(time.Minute)
+	cache.Set("KP", "10000")
+
+	// The values stored with the previous ttl are removed
+	cache.SetTTL(time.Hour)
+
+	_, ok := cache.Get("KP")
+	assert.False(t, ok)
+}
@@ -0,0 +1,102 @@
+// Package compression gzips the large request bodies sent by the clients.
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+)
+
+// DefaultDenylist are the endpoints known to reject the compressed bodies, the attachment uploads.
+//
+// The multipart uploads are sent by the form requests, which are never compressed, the denylist also covers
+// the JSON requests sent to these endpoints.
+var DefaultDenylist = []string{
+	"rest/api/*/issue/*/attachments",
+	"rest/api/*/attachment/*",
+}
+
+// Policy decides which request bodies are compressed.
+type Policy struct {
+	minSize int
+
+	mu       sync.RWMutex
+	denylist []string
+}
+
+// NewPolicy returns a policy compressing the bodies larger than the min size, on the endpoints not matching
+// DefaultDenylist.
+func NewPolicy(minSize int) *Policy {
+
+	if minSize < 0 {
+		minSize = 0
+	}
+
+	return &Policy{minSize: minSize, denylist: append([]string{}, DefaultDenylist...)}
+}
+
+// Deny adds endpoints to the denylist, the patterns are matched with path.Match against the endpoint path,
+// without the leading slash and the query, e.g. rest/api/*/issue/*/properties/*.
+func (p *Policy) Deny(patterns ...string) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, pattern := range patterns {
+		p.denylist = append(p.denylist, strings.TrimPrefix(pattern, "/"))
+	}
+}
+
+// Denied reports whether the endpoint path matches a pattern of the denylist.
+func (p *Policy) Denied(endpointPath string) bool {
+
+	endpointPath = strings.TrimPrefix(endpointPath, "/")
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, pattern := range p.denylist {
+		if matched, _ := path.Match(pattern, endpointPath); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Compress returns the body to send to the endpoint path and whether it's gzipped.
+//
+// The payload is read to measure it, so the body returned is always a new reader, the payloads up to the min size
+// or sent to a denied endpoint are returned as they were read.
+func (p *Policy) Compress(endpointPath string, payload io.Reader) (io.Reader, bool, error) {
+
+	if payload == nil || p.Denied(endpointPath) {
+		return payload, false, nil
+	}
+
+	data, err := ioutil.ReadAll(payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(data) <= p.minSize {
+		return bytes.NewReader(data), false, nil
+	}
+
+	var compressed bytes.Buffer
+
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(data); err != nil {
+		return nil, false, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, false, err
+	}
+
+	return bytes.NewReader(compressed.Bytes()), true, nil
+}
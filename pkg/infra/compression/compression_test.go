@@ -0,0 +1,105 @@
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+type failingReader struct{}
+
+func (f failingReader) Read(p []byte) (int, error) { return 0, errors.New("error, unable to read the payload") }
+
+func TestPolicy_Compress(t *testing.T) {
+
+	// A bulk payload repeats the same fields on every issue, so it compresses well
+	largePayload := `{"issueUpdates":[` + strings.Repeat(`{"fields":{"summary":"DUMMY","project":{"key":"KP"}}},`, 200) + `{}]}`
+
+	testCases := []struct {
+		name           string
+		endpoint       string
+		payload        io.Reader
+		denylist       []string
+		wantCompressed bool
+		wantErr        bool
+	}{
+		{
+			name:           "when the payload is larger than the min size",
+			endpoint:       "rest/api/3/issue/bulk",
+			payload:        strings.NewReader(largePayload),
+			wantCompressed: true,
+		},
+		{
+			name:     "when the payload is not larger than the min size",
+			endpoint: "rest/api/3/issue/bulk",
+			payload:  strings.NewReader(largePayload[:1024]),
+		},
+		{
+			name:     "when the endpoint is on the default denylist",
+			endpoint: "/rest/api/3/issue/DUMMY-1/attachments",
+			payload:  strings.NewReader(largePayload),
+		},
+		{
+			name:     "when the endpoint is on the custom denylist",
+			endpoint: "rest/api/3/issue/DUMMY-1/properties/sprint",
+			payload:  strings.NewReader(largePayload),
+			denylist: []string{"/rest/api/*/issue/*/properties/*"},
+		},
+		{
+			name:     "when the payload is nil",
+			endpoint: "rest/api/3/issue/bulk",
+		},
+		{
+			name:     "when the payload cannot be read",
+			endpoint: "rest/api/3/issue/bulk",
+			payload:  failingReader{},
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			policy := NewPolicy(1024)
+			policy.Deny(testCase.denylist...)
+
+			body, compressed, err := policy.Compress(testCase.endpoint, testCase.payload)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantCompressed, compressed)
+
+			if testCase.payload == nil {
+				assert.Nil(t, body)
+				return
+			}
+
+			data, err := ioutil.ReadAll(body)
+			assert.NoError(t, err)
+
+			if !compressed {
+				assert.True(t, strings.HasPrefix(largePayload, string(data)))
+				return
+			}
+
+			// The compressed bulk payload is a fraction of the original payload
+			assert.Less(t, len(data), len(largePayload)/10)
+
+			reader, err := gzip.NewReader(bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			decompressed, err := ioutil.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, largePayload, string(decompressed))
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Package drift detects the response fields the schemes don't know, e.g. the fields added or renamed by Atlassian.
+package drift
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// UnknownFields returns the paths of the JSON fields the structure can't decode, sorted and without duplicates.
+//
+// The nested fields are joined with dots and the array elements are marked with [], e.g. issues[].fields.priority.
+// The fields are matched like encoding/json does, so the names are case-insensitive. The values decoded into an
+// interface, a map of interfaces or a type with its own UnmarshalJSON method accept any field.
+func UnknownFields(data []byte, structure interface{}) ([]string, error) {
+
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]struct{})
+	walk(document, reflect.TypeOf(structure), "", fields)
+
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func walk(value interface{}, kind reflect.Type, path string, fields map[string]struct{}) {
+
+	for kind != nil && kind.Kind() == reflect.Ptr {
+		kind = kind.Elem()
+	}
+
+	if kind == nil || decodesItself(kind) {
+		return
+	}
+
+	switch kind.Kind() {
+	case reflect.Struct:
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		known := structFields(kind)
+		for name, fieldValue := range object {
+
+			field, ok := lookup(known, name)
+			if !ok {
+				fields[join(path, name)] = struct{}{}
+				continue
+			}
+
+			walk(fieldValue, field, join(path, name), fields)
+		}
+
+	case reflect.Map:
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for name, fieldValue := range object {
+			walk(fieldValue, kind.Elem(), join(path, name), fields)
+		}
+
+	case reflect.Slice, reflect.Array:
+
+		elements, ok := value.([]interface{})
+		if !ok {
+			return
+		}
+
+		for _, element := range elements {
+			walk(element, kind.Elem(), path+"[]", fields)
+		}
+	}
+}
+
+// structFields returns the types of the JSON fields of the struct, the fields of the embedded structs are promoted.
+func structFields(kind reflect.Type) map[string]reflect.Type {
+
+	fields := make(map[string]reflect.Type)
+
+	for index := 0; index < kind.NumField(); index++ {
+
+		field := kind.Field(index)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+
+		embedded := field.Type
+		if embedded.Kind() == reflect.Ptr {
+			embedded = embedded.Elem()
+		}
+
+		if field.Anonymous && name == "" && embedded.Kind() == reflect.Struct {
+			for promotedName, promoted := range structFields(embedded) {
+				if _, ok := fields[promotedName]; !ok {
+					fields[promotedName] = promoted
+				}
+			}
+
+			continue
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[name] = field.Type
+	}
+
+	return fields
+}
+
+func lookup(fields map[string]reflect.Type, name string) (reflect.Type, bool) {
+
+	if field, ok := fields[name]; ok {
+		return field, true
+	}
+
+	for fieldName, field := range fields {
+		if strings.EqualFold(fieldName, name) {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+// decodesItself reports whether the type accepts any JSON value, the interfaces and the custom unmarshalers.
+func decodesItself(kind reflect.Type) bool {
+
+	if kind.Kind() == reflect.Interface {
+		return true
+	}
+
+	pointer := reflect.PtrTo(kind)
+	return pointer.Implements(unmarshalerType) || pointer.Implements(textUnmarshalerType)
+}
+
+func join(path, name string) string {
+
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
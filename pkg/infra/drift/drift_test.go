@@ -0,0 +1,97 @@
+package drift
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type priorityScheme struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type pageScheme struct {
+	Total int `json:"total,omitempty"`
+}
+
+type rawScheme struct{}
+
+func (r *rawScheme) UnmarshalJSON(data []byte) error { return nil }
+
+type issueScheme struct {
+	pageScheme
+
+	Key      string                 `json:"key,omitempty"`
+	Priority *priorityScheme        `json:"priority,omitempty"`
+	Labels   []string               `json:"labels,omitempty"`
+	Links    []*priorityScheme      `json:"links,omitempty"`
+	Names    map[string]string      `json:"names,omitempty"`
+	Schema   map[string]interface{} `json:"schema,omitempty"`
+	Custom   interface{}            `json:"custom,omitempty"`
+	Raw      *rawScheme             `json:"raw,omitempty"`
+	Created  time.Time              `json:"created,omitempty"`
+	Ignored  string                 `json:"-"`
+	Summary  string
+	internal string
+}
+
+func TestUnknownFields(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		data      string
+		structure interface{}
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name: "when the response matches the scheme",
+			data: `{"total":1,"key":"DUMMY-1","priority":{"id":"1","name":"High"},"labels":["a"],
+				"links":[{"id":"2"}],"names":{"summary":"Summary"},"schema":{"summary":{"type":"string"}},
+				"custom":{"any":"value"},"raw":{"any":"value"},"created":"2022-01-01T00:00:00Z","SUMMARY":"DUMMY"}`,
+			structure: &issueScheme{},
+			want:      []string{},
+		},
+		{
+			name: "when the response has unknown fields",
+			data: `{"key":"DUMMY-1","status":"Done","priority":{"id":"1","color":"red"},
+				"links":[{"id":"2","rank":1},{"id":"3","rank":2,"type":"blocks"}],"Ignored":"value","internal":"value"}`,
+			structure: &issueScheme{},
+			want:      []string{"Ignored", "internal", "links[].rank", "links[].type", "priority.color", "status"},
+		},
+		{
+			name:      "when the response is an array",
+			data:      `[{"id":"1","color":"red"}]`,
+			structure: &[]*priorityScheme{},
+			want:      []string{"[].color"},
+		},
+		{
+			name:      "when the structure is a pointer to a pointer",
+			data:      `{"id":"1","color":"red"}`,
+			structure: new(*priorityScheme),
+			want:      []string{"color"},
+		},
+		{
+			name:      "when the response is not a JSON document",
+			data:      `{`,
+			structure: &issueScheme{},
+			wantErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := UnknownFields([]byte(testCase.data), testCase.structure)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
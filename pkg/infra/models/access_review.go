@@ -0,0 +1,52 @@
+package models
+
+const (
+	AccessReviewSourceJira                 = "jira"
+	AccessReviewSourceConfluence           = "confluence"
+	AccessReviewSourceJiraApplicationRoles = "jira-application-roles"
+)
+
+type AccessReviewOptionsScheme struct {
+
+	// Concurrency is the number of users reviewed at the same time, 5 users by default
+	Concurrency int
+}
+
+type AccessReviewReportScheme struct {
+
+	// Users are the reviewed users, on the order of the requested account ids
+	Users []*AccessReviewUserScheme
+
+	// Errors are the requests that failed, the report contains the data of the other sources
+	Errors []*AccessReviewErrorScheme
+}
+
+type AccessReviewUserScheme struct {
+	AccountID string
+
+	// Groups are the groups of the user on every source, sorted by name
+	Groups []*AccessReviewGroupScheme
+
+	// ApplicationRoles are the Jira application roles granted by the groups of the user, sorted by key
+	ApplicationRoles []*AccessReviewApplicationRoleScheme
+}
+
+type AccessReviewGroupScheme struct {
+	Name    string
+	ID      string
+	Sources []string
+}
+
+type AccessReviewApplicationRoleScheme struct {
+	Key  string
+	Name string
+
+	// Groups are the groups of the user granting the application role
+	Groups []string
+}
+
+type AccessReviewErrorScheme struct {
+	Source    string
+	AccountID string
+	Err       error
+}
@@ -73,3 +73,33 @@ type AdminUserPermissionProfileScheme struct {
 	ExtendedProfileLocation     *AdminUserPermissionGrantScheme `json:"extended_profile.location,omitempty"`
 	ExtendedProfileTeamType     *AdminUserPermissionGrantScheme `json:"extended_profile.team_type,omitempty"`
 }
+
+const (
+	AdminProductAccessDirect = "direct" // The product role was assigned to the user
+	AdminProductAccessGroup  = "group"  // The user was added or removed from the product default group
+)
+
+type AdminUserProductAccessScheme struct {
+	Data  *AdminUserProductAccessDataScheme `json:"data,omitempty"`
+	Links *LinkPageModelScheme              `json:"links,omitempty"`
+}
+
+type AdminUserProductAccessDataScheme struct {
+	ProductAccess []*AdminUserProductScheme `json:"product_access,omitempty"`
+	AddedToOrg    string                    `json:"added_to_org,omitempty"`
+}
+
+type AdminUserProductScheme struct {
+	ID                  string `json:"id,omitempty"`
+	Key                 string `json:"key,omitempty"`
+	LastActiveTimestamp string `json:"last_active_timestamp,omitempty"`
+}
+
+// AdminUserProductAccessResultScheme reports how the product access of a user was changed,
+// GroupID is only set when the default group of the product was used.
+type AdminUserProductAccessResultScheme struct {
+	AccountID string `json:"account_id,omitempty"`
+	ProductID string `json:"product_id,omitempty"`
+	Mechanism string `json:"mechanism,omitempty"`
+	GroupID   string `json:"group_id,omitempty"`
+}
@@ -0,0 +1,161 @@
+package models
+
+const (
+	BuildStatePending    = "pending"
+	BuildStateInProgress = "in_progress"
+	BuildStateSuccessful = "successful"
+	BuildStateFailed     = "failed"
+	BuildStateCancelled  = "cancelled"
+	BuildStateUnknown    = "unknown"
+)
+
+const (
+	DeploymentStatePending    = "pending"
+	DeploymentStateInProgress = "in_progress"
+	DeploymentStateSuccessful = "successful"
+	DeploymentStateFailed     = "failed"
+	DeploymentStateCancelled  = "cancelled"
+	DeploymentStateRolledBack = "rolled_back"
+	DeploymentStateUnknown    = "unknown"
+)
+
+const (
+	DeploymentEnvironmentUnmapped    = "unmapped"
+	DeploymentEnvironmentDevelopment = "development"
+	DeploymentEnvironmentTesting     = "testing"
+	DeploymentEnvironmentStaging     = "staging"
+	DeploymentEnvironmentProduction  = "production"
+)
+
+const (
+	DeploymentAssociationIssueKeys      = "issueKeys"
+	DeploymentAssociationIssueIDOrKeys  = "issueIdOrKeys"
+	DeploymentAssociationServiceIDOrKey = "serviceIdOrKeys"
+)
+
+// DevOpsProviderMetadataScheme represents the tool sending the data, e.g. the CI/CD server and its version.
+type DevOpsProviderMetadataScheme struct {
+	Product string `json:"product,omitempty"`
+}
+
+type DevOpsErrorScheme struct {
+	Message      string `json:"message,omitempty"`
+	ErrorTraceID string `json:"errorTraceId,omitempty"`
+}
+
+type BuildBulkPayloadScheme struct {
+	Properties       map[string]string             `json:"properties,omitempty"`
+	Builds           []*BuildInfoScheme            `json:"builds"`
+	ProviderMetadata *DevOpsProviderMetadataScheme `json:"providerMetadata,omitempty"`
+}
+
+type BuildInfoScheme struct {
+	SchemaVersion        string                  `json:"schemaVersion,omitempty"`
+	PipelineID           string                  `json:"pipelineId,omitempty"`
+	BuildNumber          int                     `json:"buildNumber,omitempty"`
+	UpdateSequenceNumber int64                   `json:"updateSequenceNumber,omitempty"`
+	DisplayName          string                  `json:"displayName,omitempty"`
+	Description          string                  `json:"description,omitempty"`
+	Label                string                  `json:"label,omitempty"`
+	URL                  string                  `json:"url,omitempty"`
+	State                string                  `json:"state,omitempty"`
+	LastUpdated          string                  `json:"lastUpdated,omitempty"`
+	IssueKeys            []string                `json:"issueKeys,omitempty"`
+	TestInfo             *BuildTestInfoScheme    `json:"testInfo,omitempty"`
+	References           []*BuildReferenceScheme `json:"references,omitempty"`
+}
+
+type BuildTestInfoScheme struct {
+	TotalNumber   int `json:"totalNumber"`
+	NumberPassed  int `json:"numberPassed"`
+	NumberFailed  int `json:"numberFailed"`
+	NumberSkipped int `json:"numberSkipped,omitempty"`
+}
+
+type BuildReferenceScheme struct {
+	Commit *BuildCommitReferenceScheme `json:"commit,omitempty"`
+	Ref    *BuildRefReferenceScheme    `json:"ref,omitempty"`
+}
+
+type BuildCommitReferenceScheme struct {
+	ID            string `json:"id,omitempty"`
+	RepositoryURI string `json:"repositoryUri,omitempty"`
+}
+
+type BuildRefReferenceScheme struct {
+	Name string `json:"name,omitempty"`
+	URI  string `json:"uri,omitempty"`
+}
+
+type BuildKeyScheme struct {
+	PipelineID  string `json:"pipelineId,omitempty"`
+	BuildNumber int    `json:"buildNumber,omitempty"`
+}
+
+type BuildBulkResponseScheme struct {
+	AcceptedBuilds   []*BuildKeyScheme      `json:"acceptedBuilds,omitempty"`
+	RejectedBuilds   []*RejectedBuildScheme `json:"rejectedBuilds,omitempty"`
+	UnknownIssueKeys []string               `json:"unknownIssueKeys,omitempty"`
+}
+
+type RejectedBuildScheme struct {
+	Key    *BuildKeyScheme      `json:"key,omitempty"`
+	Errors []*DevOpsErrorScheme `json:"errors,omitempty"`
+}
+
+type DeploymentBulkPayloadScheme struct {
+	Properties       map[string]string             `json:"properties,omitempty"`
+	Deployments      []*DeploymentInfoScheme       `json:"deployments"`
+	ProviderMetadata *DevOpsProviderMetadataScheme `json:"providerMetadata,omitempty"`
+}
+
+type DeploymentInfoScheme struct {
+	SchemaVersion            string                         `json:"schemaVersion,omitempty"`
+	DeploymentSequenceNumber int64                          `json:"deploymentSequenceNumber,omitempty"`
+	UpdateSequenceNumber     int64                          `json:"updateSequenceNumber,omitempty"`
+	Associations             []*DeploymentAssociationScheme `json:"associations,omitempty"`
+	IssueKeys                []string                       `json:"issueKeys,omitempty"` // Deprecated, use the issueKeys associations
+	DisplayName              string                         `json:"displayName,omitempty"`
+	URL                      string                         `json:"url,omitempty"`
+	Description              string                         `json:"description,omitempty"`
+	LastUpdated              string                         `json:"lastUpdated,omitempty"`
+	Label                    string                         `json:"label,omitempty"`
+	State                    string                         `json:"state,omitempty"`
+	Pipeline                 *DeploymentPipelineScheme      `json:"pipeline,omitempty"`
+	Environment              *DeploymentEnvironmentScheme   `json:"environment,omitempty"`
+}
+
+type DeploymentAssociationScheme struct {
+	AssociationType string   `json:"associationType,omitempty"`
+	Values          []string `json:"values,omitempty"`
+}
+
+type DeploymentPipelineScheme struct {
+	ID          string `json:"id,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+type DeploymentEnvironmentScheme struct {
+	ID          string `json:"id,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type DeploymentKeyScheme struct {
+	PipelineID               string `json:"pipelineId,omitempty"`
+	EnvironmentID            string `json:"environmentId,omitempty"`
+	DeploymentSequenceNumber int64  `json:"deploymentSequenceNumber,omitempty"`
+}
+
+type DeploymentBulkResponseScheme struct {
+	AcceptedDeployments []*DeploymentKeyScheme         `json:"acceptedDeployments,omitempty"`
+	RejectedDeployments []*RejectedDeploymentScheme    `json:"rejectedDeployments,omitempty"`
+	UnknownIssueKeys    []string                       `json:"unknownIssueKeys,omitempty"`
+	UnknownAssociations []*DeploymentAssociationScheme `json:"unknownAssociations,omitempty"`
+}
+
+type RejectedDeploymentScheme struct {
+	Key    *DeploymentKeyScheme `json:"key,omitempty"`
+	Errors []*DevOpsErrorScheme `json:"errors,omitempty"`
+}
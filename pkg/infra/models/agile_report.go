@@ -0,0 +1,83 @@
+package models
+
+// SprintReportScheme represents the sprint report of the GreenHopper charts, the endpoint is undocumented.
+type SprintReportScheme struct {
+	Contents *SprintReportContentsScheme `json:"contents,omitempty"`
+	Sprint   *SprintReportSprintScheme   `json:"sprint,omitempty"`
+}
+
+type SprintReportContentsScheme struct {
+	CompletedIssues                                  []*SprintReportIssueScheme `json:"completedIssues,omitempty"`
+	IssuesNotCompletedInCurrentSprint                []*SprintReportIssueScheme `json:"issuesNotCompletedInCurrentSprint,omitempty"`
+	PuntedIssues                                     []*SprintReportIssueScheme `json:"puntedIssues,omitempty"`
+	IssuesCompletedInAnotherSprint                   []*SprintReportIssueScheme `json:"issuesCompletedInAnotherSprint,omitempty"`
+	CompletedIssuesInitialEstimateSum                *SprintReportSumScheme     `json:"completedIssuesInitialEstimateSum,omitempty"`
+	CompletedIssuesEstimateSum                       *SprintReportSumScheme     `json:"completedIssuesEstimateSum,omitempty"`
+	IssuesNotCompletedInitialEstimateSum             *SprintReportSumScheme     `json:"issuesNotCompletedInitialEstimateSum,omitempty"`
+	IssuesNotCompletedEstimateSum                    *SprintReportSumScheme     `json:"issuesNotCompletedEstimateSum,omitempty"`
+	AllIssuesEstimateSum                             *SprintReportSumScheme     `json:"allIssuesEstimateSum,omitempty"`
+	PuntedIssuesInitialEstimateSum                   *SprintReportSumScheme     `json:"puntedIssuesInitialEstimateSum,omitempty"`
+	PuntedIssuesEstimateSum                          *SprintReportSumScheme     `json:"puntedIssuesEstimateSum,omitempty"`
+	IssuesCompletedInAnotherSprintInitialEstimateSum *SprintReportSumScheme     `json:"issuesCompletedInAnotherSprintInitialEstimateSum,omitempty"`
+	IssuesCompletedInAnotherSprintEstimateSum        *SprintReportSumScheme     `json:"issuesCompletedInAnotherSprintEstimateSum,omitempty"`
+	IssueKeysAddedDuringSprint                       map[string]bool            `json:"issueKeysAddedDuringSprint,omitempty"`
+}
+
+type SprintReportIssueScheme struct {
+	ID                       int                          `json:"id,omitempty"`
+	Key                      string                       `json:"key,omitempty"`
+	Summary                  string                       `json:"summary,omitempty"`
+	TypeID                   string                       `json:"typeId,omitempty"`
+	TypeName                 string                       `json:"typeName,omitempty"`
+	PriorityName             string                       `json:"priorityName,omitempty"`
+	StatusID                 string                       `json:"statusId,omitempty"`
+	StatusName               string                       `json:"statusName,omitempty"`
+	Assignee                 string                       `json:"assignee,omitempty"`
+	AssigneeName             string                       `json:"assigneeName,omitempty"`
+	Epic                     string                       `json:"epic,omitempty"`
+	ProjectID                int                          `json:"projectId,omitempty"`
+	Done                     bool                         `json:"done,omitempty"`
+	Flagged                  bool                         `json:"flagged,omitempty"`
+	Hidden                   bool                         `json:"hidden,omitempty"`
+	EstimateStatistic        *SprintReportStatisticScheme `json:"estimateStatistic,omitempty"`
+	CurrentEstimateStatistic *SprintReportStatisticScheme `json:"currentEstimateStatistic,omitempty"`
+}
+
+// SprintReportStatisticScheme represents the estimate of an issue, EstimateStatistic is the estimate when the sprint started.
+type SprintReportStatisticScheme struct {
+	StatFieldID    string                 `json:"statFieldId,omitempty"`
+	StatFieldValue *SprintReportSumScheme `json:"statFieldValue,omitempty"`
+}
+
+type SprintReportSumScheme struct {
+	Value float64 `json:"value,omitempty"`
+	Text  string  `json:"text,omitempty"`
+}
+
+type SprintReportSprintScheme struct {
+	ID            int    `json:"id,omitempty"`
+	Sequence      int    `json:"sequence,omitempty"`
+	Name          string `json:"name,omitempty"`
+	State         string `json:"state,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+	IsoStartDate  string `json:"isoStartDate,omitempty"`
+	IsoEndDate    string `json:"isoEndDate,omitempty"`
+	IsoCompleted  string `json:"isoCompletedDate,omitempty"`
+	DaysRemaining int    `json:"daysRemaining,omitempty"`
+}
+
+// BoardVelocityScheme represents the velocity chart of a board, the sprints are sorted as returned by the chart.
+type BoardVelocityScheme struct {
+	Sprints []*SprintVelocityScheme
+}
+
+// SprintVelocityScheme represents the commitment, the estimate when the sprint started, and the completed estimate of a sprint.
+type SprintVelocityScheme struct {
+	ID         int
+	Sequence   int
+	Name       string
+	State      string
+	Goal       string
+	Commitment float64
+	Completed  float64
+}
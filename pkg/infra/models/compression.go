@@ -0,0 +1,22 @@
+package models
+
+import "context"
+
+type skipRequestCompressionKey struct{}
+
+// WithoutRequestCompression returns a copy of the context that sends the request bodies as is, even when the client
+// compresses the large request bodies, e.g. for an endpoint rejecting the compressed bodies not denied by the client.
+func WithoutRequestCompression(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipRequestCompressionKey{}, true)
+}
+
+// RequestCompressionSkipped reports whether the context was created by WithoutRequestCompression.
+func RequestCompressionSkipped(ctx context.Context) bool {
+
+	if ctx == nil {
+		return false
+	}
+
+	skipped, _ := ctx.Value(skipRequestCompressionKey{}).(bool)
+	return skipped
+}
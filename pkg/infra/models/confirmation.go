@@ -0,0 +1,50 @@
+package models
+
+import (
+	"context"
+	"time"
+)
+
+type confirmationTokenKey struct{}
+
+// WithConfirmation returns a copy of the context carrying the confirmation token of a preview, the destructive methods
+// of the clients on protected mode require it, e.g. the version merge after its PreviewDelete.
+func WithConfirmation(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, confirmationTokenKey{}, token)
+}
+
+// ConfirmationToken returns the confirmation token of the context, empty when the context wasn't created by WithConfirmation.
+func ConfirmationToken(ctx context.Context) string {
+
+	if ctx == nil {
+		return ""
+	}
+
+	token, _ := ctx.Value(confirmationTokenKey{}).(string)
+	return token
+}
+
+// ConfirmationScheme represents the token confirming a destructive operation, it's used once before it expires.
+type ConfirmationScheme struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// VersionDeletionPreviewScheme represents the issues affected by the deletion of a version.
+type VersionDeletionPreviewScheme struct {
+	Counts       *VersionIssueCountsScheme
+	Confirmation *ConfirmationScheme // The confirmation of the deletion, nil when the protected mode is disabled
+}
+
+// ProjectDeletionPreviewScheme represents the project deleted and its approximate number of issues.
+type ProjectDeletionPreviewScheme struct {
+	Project      *ProjectScheme
+	Issues       int
+	Confirmation *ConfirmationScheme // The confirmation of the deletion, nil when the protected mode is disabled
+}
+
+// FilterShareDeletionPreviewScheme represents the share permission removed from a filter.
+type FilterShareDeletionPreviewScheme struct {
+	Permission   *SharePermissionScheme
+	Confirmation *ConfirmationScheme // The confirmation of the deletion, nil when the protected mode is disabled
+}
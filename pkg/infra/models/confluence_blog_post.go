@@ -0,0 +1,113 @@
+package models
+
+import (
+	"net/url"
+	"time"
+)
+
+const (
+	BlogPostSortByCreatedDate        = "created-date"
+	BlogPostSortByCreatedDateDesc    = "-created-date"
+	BlogPostSortByModifiedDate       = "modified-date"
+	BlogPostSortByModifiedDateDesc   = "-modified-date"
+	BlogPostSortByID                 = "id"
+	BlogPostSortByIDDesc             = "-id"
+	BlogPostBodyFormatStorage        = "storage"
+	BlogPostBodyFormatAtlasDocFormat = "atlas_doc_format"
+	BlogPostStatusCurrent            = "current"
+	BlogPostStatusDraft              = "draft"
+	BlogPostStatusTrashed            = "trashed"
+	BlogPostStatusDeleted            = "deleted"
+)
+
+type BlogPostScheme struct {
+	ID        string                 `json:"id,omitempty"`
+	Status    string                 `json:"status,omitempty"`
+	Title     string                 `json:"title,omitempty"`
+	SpaceID   string                 `json:"spaceId,omitempty"`
+	AuthorID  string                 `json:"authorId,omitempty"`
+	CreatedAt string                 `json:"createdAt,omitempty"`
+	Version   *BlogPostVersionScheme `json:"version,omitempty"`
+	Body      *BlogPostBodyScheme    `json:"body,omitempty"`
+	Links     *BlogPostLinksScheme   `json:"_links,omitempty"`
+}
+
+// Created returns the creation date of the blog post, the zero time is returned when the date is not set or invalid.
+func (b *BlogPostScheme) Created() time.Time {
+
+	created, err := time.Parse(time.RFC3339, b.CreatedAt)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return created
+}
+
+type BlogPostVersionScheme struct {
+	CreatedAt string `json:"createdAt,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Number    int    `json:"number,omitempty"`
+	MinorEdit bool   `json:"minorEdit,omitempty"`
+	AuthorID  string `json:"authorId,omitempty"`
+}
+
+type BlogPostBodyScheme struct {
+	Storage        *BodyTypeScheme `json:"storage,omitempty"`
+	AtlasDocFormat *BodyTypeScheme `json:"atlas_doc_format,omitempty"`
+}
+
+type BodyTypeScheme struct {
+	Representation string `json:"representation,omitempty"`
+	Value          string `json:"value,omitempty"`
+}
+
+type BlogPostLinksScheme struct {
+	WebUI  string `json:"webui,omitempty"`
+	EditUI string `json:"editui,omitempty"`
+	TinyUI string `json:"tinyui,omitempty"`
+}
+
+type BlogPostChunkScheme struct {
+	Results []*BlogPostScheme         `json:"results,omitempty"`
+	Links   *BlogPostChunkLinksScheme `json:"_links,omitempty"`
+}
+
+// Cursor returns the cursor of the next chunk, an empty cursor is returned on the last chunk.
+func (b *BlogPostChunkScheme) Cursor() string {
+
+	if b.Links == nil || b.Links.Next == "" {
+		return ""
+	}
+
+	next, err := url.Parse(b.Links.Next)
+	if err != nil {
+		return ""
+	}
+
+	return next.Query().Get("cursor")
+}
+
+type BlogPostChunkLinksScheme struct {
+	Next string `json:"next,omitempty"`
+}
+
+type BlogPostOptionsScheme struct {
+	BlogPostIDs []int
+	SpaceIDs    []int
+	Sort        string
+	Status      []string
+	Title       string
+	BodyFormat  string
+}
+
+type BlogPostPayloadScheme struct {
+	ID      string                 `json:"id,omitempty"`
+	SpaceID string                 `json:"spaceId,omitempty"`
+	Status  string                 `json:"status,omitempty"`
+	Title   string                 `json:"title,omitempty"`
+	Body    *BodyTypeScheme        `json:"body,omitempty"`
+	Version *BlogPostVersionScheme `json:"version,omitempty"`
+
+	// CreatedAt backdates the blog post, Confluence only accepts it when the site allows backdating
+	CreatedAt string `json:"createdAt,omitempty"`
+}
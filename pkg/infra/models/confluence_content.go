@@ -50,11 +50,14 @@ type ContentScheme struct {
 }
 
 type ContentExtensionScheme struct {
-	MediaType            string `json:"mediaType,omitempty"`
-	FileSize             int    `json:"fileSize,omitempty"`
-	Comment              string `json:"comment,omitempty"`
-	MediaTypeDescription string `json:"mediaTypeDescription,omitempty"`
-	FileID               string `json:"fileId,omitempty"`
+	MediaType            string                         `json:"mediaType,omitempty"`
+	FileSize             int                            `json:"fileSize,omitempty"`
+	Comment              string                         `json:"comment,omitempty"`
+	MediaTypeDescription string                         `json:"mediaTypeDescription,omitempty"`
+	FileID               string                         `json:"fileId,omitempty"`
+	Location             string                         `json:"location,omitempty"`         // The comment location, footer or inline
+	InlineProperties     *CommentInlinePropertiesScheme `json:"inlineProperties,omitempty"` // The anchor of an inline comment
+	Resolution           *CommentResolutionScheme       `json:"resolution,omitempty"`       // The resolution of an inline comment
 }
 
 type BodyScheme struct {
@@ -0,0 +1,49 @@
+package models
+
+const (
+	CommentLocationFooter   = "footer"
+	CommentLocationInline   = "inline"
+	CommentLocationResolved = "resolved"
+)
+
+// ContentCommentPayloadScheme represents a new comment on a page or a blog post.
+//
+// The inline comments set the extensions location to inline with the inline properties anchoring the comment
+// to the text selected, the replies set the parent comment as their ancestor.
+type ContentCommentPayloadScheme struct {
+	Container  *ContentCommentContainerScheme `json:"container,omitempty"`
+	Ancestors  []*ContentScheme               `json:"ancestors,omitempty"`
+	Body       *BodyScheme                    `json:"body,omitempty"`
+	Extensions *ContentExtensionScheme        `json:"extensions,omitempty"`
+}
+
+type ContentCommentContainerScheme struct {
+	ID     string `json:"id,omitempty"`
+	Type   string `json:"type,omitempty"` // page or blogpost
+	Status string `json:"status,omitempty"`
+}
+
+// CommentInlinePropertiesScheme anchors an inline comment, the marker ref is the id of the marker wrapping
+// the original selection on the page storage body.
+type CommentInlinePropertiesScheme struct {
+	OriginalSelection string `json:"originalSelection,omitempty"`
+	MarkerRef         string `json:"markerRef,omitempty"`
+}
+
+type CommentResolutionScheme struct {
+	Status           string             `json:"status,omitempty"` // open, reopened, resolved or dangling
+	LastModifier     *ContentUserScheme `json:"lastModifier,omitempty"`
+	LastModifiedDate string             `json:"lastModifiedDate,omitempty"`
+}
+
+// InlineCommentScheme represents an inline comment returned by the v2 API.
+type InlineCommentScheme struct {
+	ID               string                 `json:"id,omitempty"`
+	Status           string                 `json:"status,omitempty"`
+	Title            string                 `json:"title,omitempty"`
+	PageID           string                 `json:"pageId,omitempty"`
+	BlogPostID       string                 `json:"blogPostId,omitempty"`
+	ResolutionStatus string                 `json:"resolutionStatus,omitempty"`
+	Version          *BlogPostVersionScheme `json:"version,omitempty"`
+	Body             *BlogPostBodyScheme    `json:"body,omitempty"`
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestContentScheme_InlineCommentRoundTrip(t *testing.T) {
+
+	const response = `{
+		"id": "2326536199",
+		"type": "comment",
+		"status": "current",
+		"title": "Re: Release notes",
+		"body": {"storage": {"value": "<p>Is this date final?</p>", "representation": "storage"}},
+		"ancestors": [{"id": "2326536100", "type": "comment"}],
+		"extensions": {
+			"location": "inline",
+			"inlineProperties": {
+				"originalSelection": "ships on March 3rd",
+				"markerRef": "7d8c0b4e-3f21-4a6b-9d0e-1c2f3a4b5c6d"
+			},
+			"resolution": {
+				"status": "resolved",
+				"lastModifier": {"type": "known", "accountId": "5b10ac8d82e05b22cc7d4ef5"},
+				"lastModifiedDate": "2023-03-01T10:15:30.000Z"
+			}
+		}
+	}`
+
+	comment := new(ContentScheme)
+	assert.NoError(t, json.Unmarshal([]byte(response), comment))
+
+	assert.Equal(t, CommentLocationInline, comment.Extensions.Location)
+	assert.Equal(t, "ships on March 3rd", comment.Extensions.InlineProperties.OriginalSelection)
+	assert.Equal(t, "7d8c0b4e-3f21-4a6b-9d0e-1c2f3a4b5c6d", comment.Extensions.InlineProperties.MarkerRef)
+	assert.Equal(t, "resolved", comment.Extensions.Resolution.Status)
+	assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", comment.Extensions.Resolution.LastModifier.AccountID)
+	assert.Equal(t, "2326536100", comment.Ancestors[0].ID)
+
+	encoded, err := json.Marshal(comment)
+	assert.NoError(t, err)
+	assert.JSONEq(t, response, string(encoded))
+}
+
+func TestContentCommentPayloadScheme_Inline(t *testing.T) {
+
+	payload := &ContentCommentPayloadScheme{
+		Container: &ContentCommentContainerScheme{ID: "2326536000", Type: "page", Status: "current"},
+		Body:      &BodyScheme{Storage: &BodyNodeScheme{Value: "<p>Is this date final?</p>", Representation: "storage"}},
+		Extensions: &ContentExtensionScheme{
+			Location: CommentLocationInline,
+			InlineProperties: &CommentInlinePropertiesScheme{
+				OriginalSelection: "ships on March 3rd",
+				MarkerRef:         "7d8c0b4e-3f21-4a6b-9d0e-1c2f3a4b5c6d",
+			},
+		},
+	}
+
+	encoded, err := json.Marshal(payload)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"container": {"id": "2326536000", "type": "page", "status": "current"},
+		"body": {"storage": {"value": "<p>Is this date final?</p>", "representation": "storage"}},
+		"extensions": {
+			"location": "inline",
+			"inlineProperties": {
+				"originalSelection": "ships on March 3rd",
+				"markerRef": "7d8c0b4e-3f21-4a6b-9d0e-1c2f3a4b5c6d"
+			}
+		}
+	}`, string(encoded))
+
+	decoded := new(ContentCommentPayloadScheme)
+	assert.NoError(t, json.Unmarshal(encoded, decoded))
+	assert.Equal(t, payload, decoded)
+}
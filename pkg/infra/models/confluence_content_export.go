@@ -0,0 +1,25 @@
+package models
+
+// ContentExportOptionsScheme represents the options used to export a page tree
+type ContentExportOptionsScheme struct {
+
+	// Concurrency is the number of pages fetched at the same time, 5 pages by default
+	Concurrency int
+
+	// PageSize is the page size used to list the child pages and the attachments, 50 by default
+	PageSize int
+
+	// MaxRetries is the number of retries of the rate limited requests, 3 retries by default
+	MaxRetries int
+
+	// Checkpoint is the id of the last visited page, the export resumes after it
+	Checkpoint string
+
+	// Expand are the properties expanded on the pages, the storage body and the version are always expanded
+	Expand []string
+}
+
+// ContentExportVisitorFunc is called for each page of the exported tree, in depth-first order.
+//
+// The attachments are the attachment contents of the page, the export stops when the visitor returns an error.
+type ContentExportVisitorFunc func(page *ContentScheme, body string, attachments []*ContentScheme) error
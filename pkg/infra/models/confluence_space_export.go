@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+const (
+	SpaceExportHTML = "HTML"
+	SpaceExportXML  = "XML"
+)
+
+type SpaceExportOptionsScheme struct {
+	PollInterval time.Duration // The interval between the long task polls, 2 seconds by default
+}
+
+// SpaceExportScheme represents a finished space export, Bytes is the size of the archive written.
+type SpaceExportScheme struct {
+	SpaceKey    string
+	Format      string
+	TaskID      string
+	DownloadURL string
+	Bytes       int64
+}
@@ -0,0 +1,9 @@
+package models
+
+type UserMembershipPageScheme struct {
+	Results []*SpaceGroupScheme `json:"results,omitempty"`
+	Start   int                 `json:"start,omitempty"`
+	Limit   int                 `json:"limit,omitempty"`
+	Size    int                 `json:"size,omitempty"`
+	Links   *LinkScheme         `json:"_links,omitempty"`
+}
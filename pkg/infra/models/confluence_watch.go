@@ -0,0 +1,48 @@
+package models
+
+// WatchStatusScheme represents the watch status of a content or a space
+type WatchStatusScheme struct {
+	Watching bool `json:"watching"`
+}
+
+// SpaceSubscriptionOptionsScheme represents the options used to subscribe and unsubscribe a user from the spaces
+type SpaceSubscriptionOptionsScheme struct {
+
+	// PageSize is the page size used to list the pages of the space, 100 pages by default
+	PageSize int
+
+	// MaxRetries is the number of retries of the rate limited requests, 3 retries by default
+	MaxRetries int
+
+	// ResumeToken is the token returned on the report of an interrupted subscription, the pages are walked from it
+	ResumeToken string
+
+	// Progress is called after each page is processed, with the number of processed and failed pages
+	Progress func(processed, failed int)
+}
+
+// SpaceSubscriptionReportScheme represents the result of a subscription or an unsubscription
+type SpaceSubscriptionReportScheme struct {
+
+	// AccountID is the account id of the user
+	AccountID string
+
+	// Spaces are the keys of the spaces watched or unwatched
+	Spaces []string
+
+	// Contents are the ids of the pages watched or unwatched
+	Contents []string
+
+	// Failed are the spaces and the pages that couldn't be watched or unwatched
+	Failed []*SpaceSubscriptionFailureScheme
+
+	// ResumeToken is set when the subscription is interrupted, use it on the options to resume the subscription
+	ResumeToken string
+}
+
+// SpaceSubscriptionFailureScheme represents a space or a page that couldn't be watched or unwatched
+type SpaceSubscriptionFailureScheme struct {
+	SpaceKey  string
+	ContentID string
+	Err       error
+}
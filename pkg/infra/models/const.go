@@ -2,4 +2,7 @@ package models
 
 const (
 	DateFormatJira = "2006-01-02T15:04:05.999-0700"
+
+	// DateFormatWorklogStarted is the format of the worklog started dates, Jira rejects the dates without the milliseconds
+	DateFormatWorklogStarted = "2006-01-02T15:04:05.000-0700"
 )
@@ -32,6 +32,15 @@ var (
 	ErrNoFileReaderError                   = errors.New("sm: no io.Reader set")
 	ErrNoCustomRequestFieldsError          = errors.New("sm: no customer request fields set")
 	ErrNoSLAMetricIDError                  = errors.New("sm: no sla metric id set")
+	ErrNoFormIDError                       = errors.New("sm: no form id set")
+	ErrNoFormTemplateIDError               = errors.New("sm: no form template id set")
+	ErrNoServiceDeskNameError              = errors.New("sm: no service desk name or project key set")
+	ErrNoRequestTypeNameError              = errors.New("sm: no request type name set")
+	ErrServiceDeskNotFoundError            = errors.New("sm: service desk not found")
+	ErrAmbiguousServiceDeskError           = errors.New("sm: the service desk name matches several service desks")
+	ErrRequestTypeNotFoundError            = errors.New("sm: request type not found")
+	ErrAmbiguousRequestTypeError           = errors.New("sm: the request type name matches several request types")
+	ErrNoCloudIDError                      = errors.New("sm: the cloud id of the site cannot be resolved")
 	ErrNoContentAttachmentIDError          = errors.New("confluence: no attachment id set")
 	ErrNoContentAttachmentNameError        = errors.New("confluence: no attachment filename set")
 	ErrNoContentReaderError                = errors.New("confluence: no reader set")
@@ -47,14 +56,99 @@ var (
 	ErrNoContentRestrictionKeyError        = errors.New("confluence: no content restriction operation key set")
 	ErrNoConfluenceGroupError              = errors.New("confluence: no group id or name set")
 	ErrNoLabelNameError                    = errors.New("confluence: no label name set")
+	ErrNoContentExportVisitorError         = errors.New("confluence: no export visitor set")
+	ErrContentCheckpointNotFoundError      = errors.New("confluence: the export checkpoint is not part of the page tree")
+	ErrNoBlogPostIDError                   = errors.New("confluence: no blog post id set")
+	ErrNoSpaceIDError                      = errors.New("confluence: no space id set")
+	ErrInvalidDateRangeError               = errors.New("confluence: the date range end is before its start")
+	ErrNoAccessReviewSourceError           = errors.New("helpers: no jira or confluence service set")
+	ErrInvalidSearchIntoDestError          = errors.New("jira: the destination must be a pointer to a slice of structs")
+	ErrSearchIntoMissingFieldError         = errors.New("jira: the issue field is missing")
+	ErrNoWebhookEventError                 = errors.New("jira: no webhook event set on the payload")
+	ErrUnsupportedWebhookEventError        = errors.New("jira: unsupported webhook event")
+	ErrNoWebhookSecretError                = errors.New("jira: no webhook secret set")
+	ErrInvalidWebhookSignatureError        = errors.New("jira: invalid webhook signature")
+	ErrJQLValueTooLongError                = errors.New("jira: the jql value exceeds the maximum query length")
+	ErrInvalidResumeTokenError             = errors.New("confluence: invalid resume token")
+	ErrNoCommentVisitorError               = errors.New("jira: no comment visitor set")
+	ErrInvalidCommentOrderError            = errors.New("jira: the comments can only be ordered by created, +created or -created")
+	ErrInvalidVersionFieldError            = errors.New("jira: the version field must be fixVersion or affectedVersion")
+	ErrNoAlertIDError                      = errors.New("ops: no alert id set")
+	ErrNoAlertMessageError                 = errors.New("ops: no alert message set")
+	ErrInvalidAlertPriorityError           = errors.New("ops: the alert priority must be P1, P2, P3, P4 or P5")
+	ErrNoAlertRequestIDError               = errors.New("ops: no alert request id set")
+	ErrNoAlertNoteError                    = errors.New("ops: no alert note set")
+	ErrNoSourceClientError                 = errors.New("jira: no source client set")
+	ErrAttachmentTooLargeError             = errors.New("jira: the attachment exceeds the upload limit of the target site")
+	ErrAttachmentsDisabledError            = errors.New("jira: the attachments are disabled on the target site")
+	ErrInvalidInactiveDateError            = errors.New("jira: the inactivity date must be set and be in the past")
+	ErrFieldNameNotFoundError              = errors.New("jira: no field matches the display name on the project")
+	ErrAmbiguousFieldNameError             = errors.New("jira: the display name matches several fields on the project")
+	ErrInvalidSpaceExportFormatError       = errors.New("confluence: the space export format must be HTML or XML")
+	ErrSpaceExportNotPermittedError        = errors.New("confluence: the space export is not permitted on this site or plan")
+	ErrSpaceExportUnsupportedError         = errors.New("confluence: the space export endpoint is not available on this site")
+	ErrSpaceExportFailedError              = errors.New("confluence: the space export failed")
+	ErrNoSpaceExportDownloadError          = errors.New("confluence: the space export finished without a download link")
+	ErrPortalAnnouncementForbiddenError    = errors.New("sm: the announcements can only be managed by the service desk administrators")
+	ErrNoAdminProductIDError               = errors.New("admin: no product id set")
+	ErrNoAdminProductGroupError            = errors.New("admin: the product access cannot be changed directly and the product has no default group set")
+	ErrStatusNameNotFoundError             = errors.New("jira: no status matches the name")
+	ErrAmbiguousStatusNameError            = errors.New("jira: the name matches several statuses")
+	ErrIssueFieldNameNotFoundError         = errors.New("jira: no field matches the name")
+	ErrAmbiguousIssueFieldNameError        = errors.New("jira: the name matches several fields")
+	ErrNoFieldNameError                    = errors.New("jira: no field name set")
+	ErrNoContentCommentIDError             = errors.New("confluence: no comment id set")
+	ErrNoContentCommentContainerError      = errors.New("confluence: no comment container id and type set")
+	ErrNoContentCommentBodyError           = errors.New("confluence: no comment storage body set")
+	ErrNoInlineCommentAnchorError          = errors.New("confluence: the inline comment needs an original selection and a marker ref")
+	ErrGroupNameNotFoundError              = errors.New("jira: no group found with the name")
+	ErrGroupNameAndIDError                 = errors.New("jira: the group name and the group id are mutually exclusive")
+	ErrNoServiceDeskSliceError             = errors.New("sm: no service desk id's set")
+	ErrNoSearchExportFieldsError           = errors.New("jira: no export fields set")
+	ErrNoSearchExportWriterError           = errors.New("jira: no export writer set")
+	ErrInvalidSearchExportFormatError      = errors.New("jira: the search export format must be csv or ndjson")
+	ErrNoADFConversionItemsError           = errors.New("jira: no bodies to convert set")
+	ErrADFConversionError                  = errors.New("jira: the body cannot be converted")
+	ErrADFConversionResultsError           = errors.New("jira: the conversion results don't match the bodies sent")
+	ErrConfirmationRequiredError           = errors.New("jira: the protected mode requires a confirmation token")
+	ErrInvalidConfirmationError            = errors.New("jira: the confirmation token is invalid, expired or issued for another target")
+	ErrInvalidVersionMoveError             = errors.New("jira: the version move needs either the after version or the position")
+	ErrInvalidVersionPositionError         = errors.New("jira: the version position must be First, Last, Earlier or Later")
+	ErrNoIssuesSliceError                  = errors.New("jira: no issues set")
+	ErrNoCloudIDSliceError                 = errors.New("jira: no cloud id's set")
+	ErrNoTokenSourceError                  = errors.New("jira: no oauth token source set")
+	ErrInvalidPageLinkError                = errors.New("sm: the next page link doesn't belong to the site")
+	ErrNoPageVisitorError                  = errors.New("sm: no page visit func set")
+	ErrNoFieldAuditSourceError             = errors.New("helpers: no field configuration or screen service set")
+	ErrNoFieldAuditSpecError               = errors.New("helpers: no field configuration or screen spec set")
+	ErrNoReplayClientError                 = errors.New("replay: no http client set to record the requests")
+	ErrNoReplayFixtureError                = errors.New("replay: no fixture matches the request")
+	ErrNoAttachmentWriterError             = errors.New("jira: no attachment writer set")
+	ErrNoCommentIDsError                   = errors.New("jira: no comment ids set")
+	ErrNoRemoteLinkIDError                 = errors.New("jira: no remote link id set")
+	ErrNoRemoteLinkGlobalIDError           = errors.New("jira: no remote link global id set")
+	ErrIssueArchivalLimitError             = errors.New("jira: the issues archived or unarchived exceed the limit of 1000 issues")
+	ErrNoIssueNotifyRecipientsError        = errors.New("jira: no notification recipients set")
+	ErrIssueUpdateConflictError            = errors.New("jira: the fields are set on the fields and the update operations")
+	ErrNoIssueTypeDefaultMappingError      = errors.New("jira: no default issue type mapping set")
+	ErrInvalidIssueTypeMappingError        = errors.New("jira: the issue type id of the mapping is not an issue type id or default")
+	ErrIssueTypeDefaultMappingAppendError  = errors.New("jira: the default issue type mapping can't be appended, use UpdateDefault instead")
 	ErrNoBoardIDError                      = errors.New("agile: no board id set")
 	ErrNoFilterIDError                     = errors.New("agile: no filter id set")
+	ErrNoColumnsError                      = errors.New("jira: no columns set")
+	ErrNoFilterShareTypeError              = errors.New("jira: no filter share type set")
+	ErrInvalidFilterShareTypeError         = errors.New("jira: invalid filter share type")
+	ErrFilterShareFieldsMismatchError      = errors.New("jira: the filter share fields don't match the share type")
 	ErrNoEpicIDError                       = errors.New("agile: no epic id set")
 	ErrNoSprintIDError                     = errors.New("agile: no sprint id set")
+	ErrNoBuildsError                       = errors.New("agile: no builds set")
+	ErrNoDeploymentsError                  = errors.New("agile: no deployments set")
+	ErrNoEntityPropertiesError             = errors.New("agile: no entity properties set")
 	ErrNoApplicationRoleError              = errors.New("jira: no application role key set")
+	ErrNoApplicationKeyError               = errors.New("jira: no application key set")
 	ErrNoDashboardIDError                  = errors.New("jira: no dashboard id set")
 	ErrNoGroupNameError                    = errors.New("jira: no group name set")
-	ErrNoGroupIDError                      = errors.New("jira: no group name set")
+	ErrNoGroupIDError                      = errors.New("jira: no group id set")
 	ErrNoGroupsNameError                   = errors.New("jira: no groups names set")
 	ErrNoIssueKeyOrIDError                 = errors.New("jira: no issue key/id set")
 	ErrNoIssueSchemeError                  = errors.New("jira: no jira.IssueScheme set")
@@ -75,6 +169,8 @@ var (
 	ErrNoEditValueError                    = errors.New("jira: no update operation value set")
 	ErrNoCustomFieldError                  = errors.New("jira: no custom-fields set")
 	ErrNoCustomFieldIDError                = errors.New("jira: no custom-field id set")
+	ErrNoFieldKeyError                     = errors.New("jira: no field key set")
+	ErrNoFieldOptionIDError                = errors.New("jira: no field option id set")
 	ErrNoWorkflowStatusesError             = errors.New("jira: no workflow statuses set")
 	ErrNoWorkflowScopeError                = errors.New("jira: no workflow scope set")
 	ErrNoWorkflowStatusNameOrIdError       = errors.New("jira: no workflow status name or id set")
@@ -84,22 +180,40 @@ var (
 	ErrNoContextOptionIDError              = errors.New("jira: no field context option id set")
 	ErrNoTypeIDError                       = errors.New("jira: no link id set")
 	ErrNoLinkTypeIDError                   = errors.New("jira: no link type id set")
+	ErrNoLinkTypeNameError                 = errors.New("jira: no link type name set")
 	ErrNoPriorityIDError                   = errors.New("jira: no priority id set")
 	ErrNoResolutionIDError                 = errors.New("jira: no resolution id set")
+	ErrNoSecurityLevelIDError              = errors.New("jira: no security level id set")
+	ErrNoIssueIDError                      = errors.New("jira: no issue id set")
+	ErrNoApplicationTypeError              = errors.New("jira: no application type set")
+	ErrNoDevelopmentDataTypeError          = errors.New("jira: no development data type set")
+	ErrInternalAPIsNotEnabledError         = errors.New("jira: the internal apis are not enabled")
 	ErrNoJQLError                          = errors.New("jira: no sql set")
 	ErrNoIssueTypeIDError                  = errors.New("jira: no issue type id set")
 	ErrNoIssueTypeScreenSchemeIDError      = errors.New("jira: no issue type screen scheme id set")
 	ErrNoScreenSchemeIDError               = errors.New("jira: no screen scheme id set")
 	ErrNoAccountIDError                    = errors.New("jira: no account id set")
+	ErrSameAccountIDError                  = errors.New("jira: the source and target account id are the same")
 	ErrNoWorklogIDError                    = errors.New("jira: no worklog id set")
 	ErrNpWorklogsError                     = errors.New("jira: no worklog's id set")
 	ErrNoPermissionSchemeIDError           = errors.New("jira: no permission scheme id set")
 	ErrNoPermissionGrantIDError            = errors.New("jira: no permission grant id set")
+	ErrNoPermissionHolderError             = errors.New("jira: no permission holder set")
+	ErrInvalidPermissionHolderTypeError    = errors.New("jira: invalid permission holder type")
+	ErrNoPermissionHolderParameterError    = errors.New("jira: no permission holder parameter or value set")
 	ErrNoComponentIDError                  = errors.New("jira: no component id set")
 	ErrProjectTypeKeyError                 = errors.New("jira: no project type key set")
+	ErrInvalidProjectTypeKeyError          = errors.New("jira: invalid project type key, use software, service_desk or business")
+	ErrProjectTemplateTypeMismatchError    = errors.New("jira: the project template key doesn't belong to the project type key")
 	ErrNoProjectNameError                  = errors.New("jira: no project name set")
 	ErrNoVersionIDError                    = errors.New("jira: no version id set")
+	ErrNoVersionRelatedWorkIDError         = errors.New("jira: no version related work id set")
 	ErrNoScreenNameError                   = errors.New("jira: no screen name set")
+	ErrNoUIModificationIDError             = errors.New("jira: no ui modification id set")
+	ErrNoUIModificationNameError           = errors.New("jira: no ui modification name set")
+	ErrUIModificationDataTooLargeError     = errors.New("jira: the ui modification data exceeds the 50000 characters limit")
+	ErrUIModificationLimitError            = errors.New("jira: the app reached the limit of ui modifications")
+	ErrNoUIModificationContextError        = errors.New("jira: the ui modification context requires the project, issue type and view type")
 	ErrNoScreenTabNameError                = errors.New("jira: no screen tab name set")
 	ErrNoAccountSliceError                 = errors.New("jira: no account id's set")
 	ErrNoProjectKeySliceError              = errors.New("jira: no project key's set")
@@ -132,6 +246,7 @@ var (
 	ErrNoCheckBoxTypeError                 = errors.New("custom-field: no check-box type set")
 	ErrNoCascadingParentError              = errors.New("custom-field: no cascading parent value set")
 	ErrNoCascadingChildError               = errors.New("custom-field: no cascading child value set")
+	ErrInvalidCustomFieldIDError           = errors.New("custom-field: the field id doesn't have the customfield_ prefix")
 	ErrNoAttachmentIdsError                = errors.New("sm: no attachment id's set")
 	ErrNoLabelsError                       = errors.New("sm: no label names set")
 	ErrNoComponentsError                   = errors.New("sm: no components set")
@@ -0,0 +1,104 @@
+package models
+
+const (
+	FieldAuditDriftMissingField  = "missing-field"
+	FieldAuditDriftRequired      = "wrong-required"
+	FieldAuditDriftHidden        = "wrong-hidden"
+	FieldAuditDriftRenderer      = "wrong-renderer"
+	FieldAuditDriftMissingTab    = "missing-tab"
+	FieldAuditDriftMissingOnTab  = "missing-screen-field"
+	FieldAuditDriftWrongTab      = "wrong-tab"
+	FieldAuditDriftExtraField    = "extra-screen-field"
+	FieldAuditDriftWrongPosition = "wrong-position"
+)
+
+const (
+	FieldAuditStepUpdateItems    = "FieldConfigurationItem.Update"
+	FieldAuditStepCreateTab      = "ScreenTab.Create"
+	FieldAuditStepAddTabField    = "ScreenTabField.Add"
+	FieldAuditStepRemoveTabField = "ScreenTabField.Remove"
+	FieldAuditStepMoveTabField   = "ScreenTabField.Move"
+)
+
+// FieldAuditSpecScheme is the desired state of the field configurations and the screens.
+type FieldAuditSpecScheme struct {
+	FieldConfigurations []*FieldConfigurationSpecScheme
+	Screens             []*ScreenSpecScheme
+}
+
+type FieldConfigurationSpecScheme struct {
+	ID int
+
+	// Fields are the desired items of the fields, the renderer is only compared when it's set.
+	// The fields not listed aren't audited.
+	Fields []*FieldConfigurationItemScheme
+}
+
+type ScreenSpecScheme struct {
+	ID int
+
+	// Tabs are the audited tabs, matched by name. The fields of the tabs not listed aren't reported as extra fields.
+	Tabs []*ScreenTabSpecScheme
+}
+
+type ScreenTabSpecScheme struct {
+	Name string
+
+	// Fields are the ids of the fields of the tab, on the desired order
+	Fields []string
+}
+
+type FieldAuditOptionsScheme struct {
+
+	// Plan is whether the report contains the API calls reconciling the drifts
+	Plan bool
+}
+
+type FieldAuditReportScheme struct {
+
+	// Drifts are the differences between the spec and the live state, on the order of the spec
+	Drifts []*FieldAuditDriftScheme
+
+	// Plan are the API calls reconciling the drifts, on the order they must be sent. The plan isn't applied.
+	Plan []*FieldAuditStepScheme
+}
+
+// HasDrifts reports whether the live state differs from the spec.
+func (f *FieldAuditReportScheme) HasDrifts() bool {
+	return len(f.Drifts) != 0
+}
+
+type FieldAuditDriftScheme struct {
+	Kind string
+
+	// FieldConfigurationID is set on the drifts of the field configurations
+	FieldConfigurationID int
+
+	// ScreenID and Tab are set on the drifts of the screens, the tab is the desired tab of the field
+	ScreenID int
+	Tab      string
+
+	FieldID string
+
+	// Want and Got are the desired and the live values, e.g. the requiredness, the tab or the order of the fields
+	Want string
+	Got  string
+}
+
+// FieldAuditStepScheme is an API call of the reconciliation plan, the operation is the service method to call.
+type FieldAuditStepScheme struct {
+	Operation string
+
+	FieldConfigurationID int
+	Items                *UpdateFieldConfigurationItemPayloadScheme
+
+	// TabID is 0 when the tab is created by a previous step, the tab is then found by its name
+	ScreenID int
+	TabID    int
+	TabName  string
+	FieldID  string
+
+	// After and Position are the arguments of the field moves
+	After    string
+	Position string
+}
@@ -0,0 +1,14 @@
+package models
+
+const (
+	ADFConversionWiki = "wiki" // The wiki markup used by the bodies of the rich text API (v2)
+	ADFConversionADF  = "adf"  // The Atlassian Document Format used by the bodies of the ADF API (v3)
+)
+
+// ADFConversionResultScheme represents a converted body, the results are returned on the order of the bodies.
+type ADFConversionResultScheme struct {
+	Index int                // The index of the body converted
+	ADF   *CommentNodeScheme // The ADF document, set by the conversions to ADF
+	Wiki  string             // The wiki markup, set by the conversions to wiki markup
+	Err   error              // The reason the body couldn't be converted, the other bodies are still converted
+}
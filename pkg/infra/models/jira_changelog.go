@@ -34,3 +34,8 @@ type IssueChangelogHistoryItemScheme struct {
 	To         string `json:"to,omitempty"`
 	ToString   string `json:"toString,omitempty"`
 }
+
+type IssueChangelogPageScheme struct {
+	PageMeta
+	Values []*IssueChangelogHistoryScheme `json:"values,omitempty"`
+}
@@ -0,0 +1,578 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CommentV2ToV3 converts a comment of the rich text API (v2) to the ADF API (v3), the body is built with ADFFromWikiMarkup.
+func CommentV2ToV3(comment *IssueCommentSchemeV2) *IssueCommentScheme {
+
+	if comment == nil {
+		return nil
+	}
+
+	return &IssueCommentScheme{
+		Self:         comment.Self,
+		ID:           comment.ID,
+		Author:       comment.Author,
+		RenderedBody: comment.RenderedBody,
+		Body:         ADFFromWikiMarkup(comment.Body),
+		JSDPublic:    comment.JSDPublic,
+		UpdateAuthor: comment.UpdateAuthor,
+		Created:      comment.Created,
+		Updated:      comment.Updated,
+		Visibility:   comment.Visibility,
+	}
+}
+
+// CommentV3ToV2 converts a comment of the ADF API (v3) to the rich text API (v2), the body is rendered with ADFToWikiMarkup.
+func CommentV3ToV2(comment *IssueCommentScheme) *IssueCommentSchemeV2 {
+
+	if comment == nil {
+		return nil
+	}
+
+	return &IssueCommentSchemeV2{
+		Self:         comment.Self,
+		ID:           comment.ID,
+		Body:         ADFToWikiMarkup(comment.Body),
+		RenderedBody: comment.RenderedBody,
+		Author:       comment.Author,
+		JSDPublic:    comment.JSDPublic,
+		UpdateAuthor: comment.UpdateAuthor,
+		Created:      comment.Created,
+		Updated:      comment.Updated,
+		Visibility:   comment.Visibility,
+	}
+}
+
+// CommentPayloadV2ToV3 converts a comment payload of the rich text API (v2) to the ADF API (v3).
+func CommentPayloadV2ToV3(payload *CommentPayloadSchemeV2) *CommentPayloadScheme {
+
+	if payload == nil {
+		return nil
+	}
+
+	return &CommentPayloadScheme{Visibility: payload.Visibility, Body: ADFFromWikiMarkup(payload.Body)}
+}
+
+// CommentPayloadV3ToV2 converts a comment payload of the ADF API (v3) to the rich text API (v2).
+func CommentPayloadV3ToV2(payload *CommentPayloadScheme) *CommentPayloadSchemeV2 {
+
+	if payload == nil {
+		return nil
+	}
+
+	return &CommentPayloadSchemeV2{Visibility: payload.Visibility, Body: ADFToWikiMarkup(payload.Body)}
+}
+
+// ADFToWikiMarkup renders an ADF document as wiki markup, the markup used by the bodies of the rich text API (v2).
+//
+// The paragraphs, headings, lists, code blocks, quotes, rules, hard breaks, mentions, emojis and the strong, em, code,
+// strike, underline and link marks are rendered, the content of the other nodes is rendered as plain text.
+// The *, _, {, [ and | characters of the text are escaped with a backslash, except on the code blocks.
+func ADFToWikiMarkup(node *CommentNodeScheme) string {
+
+	if node == nil {
+		return ""
+	}
+
+	if node.Type == "doc" {
+		return renderWikiBlocks(node.Content, "\n\n")
+	}
+
+	return renderWikiBlock(node)
+}
+
+func renderWikiBlocks(nodes []*CommentNodeScheme, separator string) string {
+
+	blocks := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		blocks = append(blocks, renderWikiBlock(node))
+	}
+
+	return strings.Join(blocks, separator)
+}
+
+func renderWikiBlock(node *CommentNodeScheme) string {
+
+	switch node.Type {
+	case "paragraph":
+		return renderWikiInline(node.Content)
+
+	case "heading":
+		return fmt.Sprintf("h%v. %v", intAttribute(node.Attrs, "level", 1), renderWikiInline(node.Content))
+
+	case "bulletList", "orderedList":
+		return renderWikiList(node, "")
+
+	case "codeBlock":
+
+		tag := "{code}"
+		if language, ok := node.Attrs["language"].(string); ok && language != "" {
+			tag = fmt.Sprintf("{code:%v}", language)
+		}
+
+		var code strings.Builder
+		for _, child := range node.Content {
+			code.WriteString(child.Text)
+		}
+
+		return fmt.Sprintf("%v\n%v\n{code}", tag, code.String())
+
+	case "blockquote":
+		return fmt.Sprintf("{quote}\n%v\n{quote}", renderWikiBlocks(node.Content, "\n\n"))
+
+	case "rule":
+		return "----"
+
+	case "text", "hardBreak", "mention", "emoji", "inlineCard":
+		return renderWikiInline([]*CommentNodeScheme{node})
+	}
+
+	return renderWikiBlocks(node.Content, "\n\n")
+}
+
+// renderWikiList renders the list items prefixed by the markers of the parent lists, e.g. "*#" for a numbered list
+// nested in a bulleted list.
+func renderWikiList(list *CommentNodeScheme, markers string) string {
+
+	marker := "*"
+	if list.Type == "orderedList" {
+		marker = "#"
+	}
+
+	markers += marker
+
+	var lines []string
+	for _, item := range list.Content {
+
+		var text []string
+		var nested []string
+
+		for _, child := range item.Content {
+
+			if child.Type == "bulletList" || child.Type == "orderedList" {
+				nested = append(nested, renderWikiList(child, markers))
+				continue
+			}
+
+			text = append(text, renderWikiBlock(child))
+		}
+
+		lines = append(lines, markers+" "+strings.Join(text, " "))
+		lines = append(lines, nested...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func renderWikiInline(nodes []*CommentNodeScheme) string {
+
+	var builder strings.Builder
+
+	for _, node := range nodes {
+
+		switch node.Type {
+		case "text":
+			builder.WriteString(renderWikiMarks(node.Text, node.Marks))
+
+		case "hardBreak":
+			builder.WriteString("\n")
+
+		case "mention":
+			builder.WriteString(fmt.Sprintf("[~accountid:%v]", node.Attrs["id"]))
+
+		case "emoji":
+			builder.WriteString(fmt.Sprint(node.Attrs["shortName"]))
+
+		case "inlineCard":
+			builder.WriteString(fmt.Sprintf("[%v]", node.Attrs["url"]))
+
+		default:
+			builder.WriteString(renderWikiInline(node.Content))
+		}
+	}
+
+	return builder.String()
+}
+
+// wikiEscaped are the wiki markup characters escaped on the text nodes
+const wikiEscaped = "*_{[|"
+
+func escapeWikiText(text string) string {
+
+	var builder strings.Builder
+	for _, character := range text {
+
+		if strings.ContainsRune(wikiEscaped, character) {
+			builder.WriteByte('\\')
+		}
+
+		builder.WriteRune(character)
+	}
+
+	return builder.String()
+}
+
+// unescapeWikiText removes the backslashes escaping the wiki markup characters.
+func unescapeWikiText(text string) string {
+
+	var builder strings.Builder
+	for index := 0; index < len(text); index++ {
+
+		if isWikiEscape(text, index) {
+			index++
+		}
+
+		builder.WriteByte(text[index])
+	}
+
+	return builder.String()
+}
+
+// isWikiEscape reports whether the character at the index is a backslash escaping a wiki markup character.
+func isWikiEscape(text string, index int) bool {
+	return text[index] == '\\' && index+1 < len(text) && strings.IndexByte(wikiEscaped, text[index+1]) != -1
+}
+
+func renderWikiMarks(text string, marks []*MarkScheme) string {
+
+	text = escapeWikiText(text)
+
+	for _, mark := range marks {
+
+		switch mark.Type {
+		case "strong":
+			text = "*" + text + "*"
+		case "em":
+			text = "_" + text + "_"
+		case "code":
+			text = "{{" + text + "}}"
+		case "strike":
+			text = "-" + text + "-"
+		case "underline":
+			text = "+" + text + "+"
+		case "link":
+			text = fmt.Sprintf("[%v|%v]", text, mark.Attrs["href"])
+		}
+	}
+
+	return text
+}
+
+// ADFFromWikiMarkup builds an ADF document from wiki markup, the markup used by the bodies of the rich text API (v2).
+//
+// The markup rendered by ADFToWikiMarkup is parsed back into the same nodes, the other markup is kept as plain text.
+func ADFFromWikiMarkup(markup string) *CommentNodeScheme {
+
+	document := &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{}}
+
+	lines := strings.Split(strings.ReplaceAll(markup, "\r\n", "\n"), "\n")
+
+	for index := 0; index < len(lines); {
+
+		line := lines[index]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			index++
+
+		case strings.HasPrefix(line, "{code"):
+
+			end := index + 1
+			for end < len(lines) && lines[end] != "{code}" {
+				end++
+			}
+
+			block := &CommentNodeScheme{Type: "codeBlock"}
+			if language := strings.TrimSuffix(strings.TrimPrefix(line, "{code:"), "}"); strings.HasPrefix(line, "{code:") && language != "" {
+				block.Attrs = map[string]interface{}{"language": language}
+			}
+
+			if code := strings.Join(lines[index+1:minInt(end, len(lines))], "\n"); code != "" {
+				block.Content = []*CommentNodeScheme{{Type: "text", Text: code}}
+			}
+
+			document.AppendNode(block)
+			index = end + 1
+
+		case line == "{quote}":
+
+			end := index + 1
+			for end < len(lines) && lines[end] != "{quote}" {
+				end++
+			}
+
+			quote := ADFFromWikiMarkup(strings.Join(lines[index+1:minInt(end, len(lines))], "\n"))
+			document.AppendNode(&CommentNodeScheme{Type: "blockquote", Content: quote.Content})
+			index = end + 1
+
+		case line == "----":
+			document.AppendNode(&CommentNodeScheme{Type: "rule"})
+			index++
+
+		case isWikiHeading(line):
+			document.AppendNode(&CommentNodeScheme{
+				Type:    "heading",
+				Attrs:   map[string]interface{}{"level": int(line[1] - '0')},
+				Content: parseWikiInline(line[4:]),
+			})
+			index++
+
+		case wikiListMarkers(line) != "":
+
+			end := index
+			for end < len(lines) && wikiListMarkers(lines[end]) != "" {
+				end++
+			}
+
+			// A list with another type at the first depth starts a new list
+			for index < end {
+				list, parsed := parseWikiList(lines[index:end], 0)
+				document.AppendNode(list)
+				index += parsed
+			}
+
+		default:
+
+			end := index
+			for end < len(lines) && isWikiParagraphLine(lines[end]) {
+				end++
+			}
+
+			var content []*CommentNodeScheme
+			for position, paragraphLine := range lines[index:end] {
+
+				if position != 0 {
+					content = append(content, &CommentNodeScheme{Type: "hardBreak"})
+				}
+
+				content = append(content, parseWikiInline(paragraphLine)...)
+			}
+
+			document.AppendNode(&CommentNodeScheme{Type: "paragraph", Content: content})
+			index = end
+		}
+	}
+
+	return document
+}
+
+func isWikiHeading(line string) bool {
+	return len(line) > 4 && line[0] == 'h' && line[1] >= '1' && line[1] <= '6' && line[2:4] == ". "
+}
+
+// wikiListMarkers returns the list markers of a line, e.g. "*#" for "*# item", empty when the line isn't a list item.
+func wikiListMarkers(line string) string {
+
+	end := strings.IndexFunc(line, func(r rune) bool { return r != '*' && r != '#' })
+	if end <= 0 || line[end] != ' ' {
+		return ""
+	}
+
+	return line[:end]
+}
+
+func isWikiParagraphLine(line string) bool {
+	return strings.TrimSpace(line) != "" && !strings.HasPrefix(line, "{code") && line != "{quote}" && line != "----" &&
+		!isWikiHeading(line) && wikiListMarkers(line) == ""
+}
+
+// parseWikiList parses the list items at the depth, the items with deeper markers are nested on the previous item.
+// The list ends on the first item with another marker at the depth, e.g. a "#" item after "*" items.
+// It returns the list and the number of lines parsed.
+func parseWikiList(lines []string, depth int) (*CommentNodeScheme, int) {
+
+	marker := wikiListMarkers(lines[0])[depth]
+
+	list := &CommentNodeScheme{Type: "bulletList"}
+	if marker == '#' {
+		list.Type = "orderedList"
+	}
+
+	index := 0
+	for index < len(lines) {
+
+		markers := wikiListMarkers(lines[index])
+
+		if len(markers) <= depth || markers[depth] != marker {
+			break
+		}
+
+		if len(markers) > depth+1 && len(list.Content) != 0 {
+
+			nested, parsed := parseWikiList(lines[index:], depth+1)
+
+			item := list.Content[len(list.Content)-1]
+			item.AppendNode(nested)
+
+			index += parsed
+			continue
+		}
+
+		list.AppendNode(&CommentNodeScheme{
+			Type:    "listItem",
+			Content: []*CommentNodeScheme{{Type: "paragraph", Content: parseWikiInline(lines[index][len(markers)+1:])}},
+		})
+
+		index++
+	}
+
+	return list, index
+}
+
+// wikiMarks maps the wiki markup delimiters to the ADF marks.
+var wikiMarks = []struct {
+	open, close, mark string
+}{
+	{"{{", "}}", "code"},
+	{"*", "*", "strong"},
+	{"_", "_", "em"},
+	{"-", "-", "strike"},
+	{"+", "+", "underline"},
+}
+
+// parseWikiInline parses the text, links, mentions and marks of a line.
+func parseWikiInline(line string) []*CommentNodeScheme {
+
+	var nodes []*CommentNodeScheme
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() != 0 {
+			nodes = append(nodes, &CommentNodeScheme{Type: "text", Text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for index := 0; index < len(line); {
+
+		if isWikiEscape(line, index) {
+			text.WriteByte(line[index+1])
+			index += 2
+			continue
+		}
+
+		if line[index] == '[' {
+
+			if end := strings.IndexByte(line[index:], ']'); end != -1 {
+
+				inner := line[index+1 : index+end]
+
+				if strings.HasPrefix(inner, "~accountid:") {
+
+					flush()
+					nodes = append(nodes, &CommentNodeScheme{Type: "mention", Attrs: map[string]interface{}{"id": strings.TrimPrefix(inner, "~accountid:")}})
+					index += end + 1
+					continue
+				}
+
+				if separator := strings.LastIndexByte(inner, '|'); separator != -1 {
+
+					flush()
+
+					mark := &MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": inner[separator+1:]}}
+					for _, node := range parseWikiInline(inner[:separator]) {
+						node.Marks = append(node.Marks, mark)
+						nodes = append(nodes, node)
+					}
+
+					index += end + 1
+					continue
+				}
+			}
+		}
+
+		if marked, length := parseWikiMark(line, index); marked != nil {
+
+			flush()
+			nodes = append(nodes, marked...)
+			index += length
+			continue
+		}
+
+		text.WriteByte(line[index])
+		index++
+	}
+
+	flush()
+
+	return nodes
+}
+
+// parseWikiMark parses the marked text starting at the index, the delimiters must open at a word boundary and
+// close before a word boundary, e.g. "*bold*" but not "2*3*4". It returns the nodes and the length parsed.
+func parseWikiMark(line string, index int) ([]*CommentNodeScheme, int) {
+
+	if index > 0 && isWikiWordByte(line[index-1]) {
+		return nil, 0
+	}
+
+	for _, delimiter := range wikiMarks {
+
+		if !strings.HasPrefix(line[index:], delimiter.open) {
+			continue
+		}
+
+		start := index + len(delimiter.open)
+		if start >= len(line) || line[start] == ' ' {
+			continue
+		}
+
+		for end := start + 1; end+len(delimiter.close) <= len(line); end++ {
+
+			if !strings.HasPrefix(line[end:], delimiter.close) || line[end-1] == ' ' || isWikiEscape(line, end-1) {
+				continue
+			}
+
+			after := end + len(delimiter.close)
+			if after < len(line) && isWikiWordByte(line[after]) {
+				continue
+			}
+
+			var inner []*CommentNodeScheme
+			if delimiter.mark == "code" {
+				inner = []*CommentNodeScheme{{Type: "text", Text: unescapeWikiText(line[start:end])}}
+			} else {
+				inner = parseWikiInline(line[start:end])
+			}
+
+			for _, node := range inner {
+				if node.Type == "text" {
+					node.Marks = append([]*MarkScheme{{Type: delimiter.mark}}, node.Marks...)
+				}
+			}
+
+			return inner, after - index
+		}
+	}
+
+	return nil, 0
+}
+
+func isWikiWordByte(character byte) bool {
+	return character == '_' || character >= '0' && character <= '9' || character >= 'a' && character <= 'z' || character >= 'A' && character <= 'Z'
+}
+
+func intAttribute(attrs map[string]interface{}, key string, fallback int) int {
+
+	switch value := attrs[key].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+
+	return fallback
+}
+
+func minInt(a, b int) int {
+
+	if a < b {
+		return a
+	}
+
+	return b
+}
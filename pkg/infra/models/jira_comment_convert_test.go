@@ -0,0 +1,214 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func text(value string, marks ...*MarkScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: "text", Text: value, Marks: marks}
+}
+
+func paragraph(content ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: "paragraph", Content: content}
+}
+
+func listItem(content ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: "listItem", Content: content}
+}
+
+func TestADFToWikiMarkup(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		node *CommentNodeScheme
+		want string
+	}{
+		{
+			name: "when the document contains marks",
+			node: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				paragraph(
+					text("Login "),
+					text("fails", &MarkScheme{Type: "strong"}),
+					text(" on "),
+					text("Safari", &MarkScheme{Type: "em"}, &MarkScheme{Type: "strong"}),
+					text(" with "),
+					text("HTTP 500", &MarkScheme{Type: "code"}),
+					&CommentNodeScheme{Type: "hardBreak"},
+					text("see "),
+					text("the logs", &MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": "https://example.com"}}),
+					text(" "),
+					&CommentNodeScheme{Type: "mention", Attrs: map[string]interface{}{"id": "5b86be50b8e3cb5895860d6d"}},
+				),
+			}},
+			want: "Login *fails* on *_Safari_* with {{HTTP 500}}\nsee [the logs|https://example.com] [~accountid:5b86be50b8e3cb5895860d6d]",
+		},
+		{
+			name: "when the document contains blocks",
+			node: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				{Type: "heading", Attrs: map[string]interface{}{"level": float64(2)}, Content: []*CommentNodeScheme{text("Steps")}},
+				{Type: "orderedList", Content: []*CommentNodeScheme{
+					listItem(paragraph(text("open the app")), &CommentNodeScheme{Type: "bulletList", Content: []*CommentNodeScheme{
+						listItem(paragraph(text("on Safari"))),
+					}}),
+					listItem(paragraph(text("log in"))),
+				}},
+				{Type: "codeBlock", Attrs: map[string]interface{}{"language": "go"}, Content: []*CommentNodeScheme{text("panic(err)")}},
+				{Type: "rule"},
+			}},
+			want: "h2. Steps\n\n# open the app\n#* on Safari\n# log in\n\n{code:go}\npanic(err)\n{code}\n\n----",
+		},
+		{
+			name: "when the text contains wiki markup characters",
+			node: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				paragraph(
+					text("rate *2* on snake_case {id} [draft] a|b", &MarkScheme{Type: "strong"}),
+					text(" map[string]int", &MarkScheme{Type: "code"}),
+				),
+				{Type: "codeBlock", Content: []*CommentNodeScheme{text("m := map[string]int{\"*\": 1}")}},
+			}},
+			want: "*rate \\*2\\* on snake\\_case \\{id} \\[draft] a\\|b*{{ map\\[string]int}}\n\n{code}\nm := map[string]int{\"*\": 1}\n{code}",
+		},
+		{
+			name: "when the nested lists have different types",
+			node: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				{Type: "bulletList", Content: []*CommentNodeScheme{
+					listItem(paragraph(text("first")), &CommentNodeScheme{Type: "orderedList", Content: []*CommentNodeScheme{
+						listItem(paragraph(text("step")), &CommentNodeScheme{Type: "bulletList", Content: []*CommentNodeScheme{
+							listItem(paragraph(text("detail"))),
+						}}),
+					}}),
+				}},
+			}},
+			want: "* first\n*# step\n*#* detail",
+		},
+		{
+			name: "when the document is nil",
+			want: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, ADFToWikiMarkup(testCase.node))
+		})
+	}
+}
+
+func TestADFFromWikiMarkup(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		markup string
+		want   *CommentNodeScheme
+	}{
+		{
+			name:   "when the markup contains plain text",
+			markup: "first line\nsecond line\n\nsecond paragraph",
+			want: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				paragraph(text("first line"), &CommentNodeScheme{Type: "hardBreak"}, text("second line")),
+				paragraph(text("second paragraph")),
+			}},
+		},
+		{
+			name:   "when the markup characters aren't at a word boundary",
+			markup: "2*3*4 re-run snake_case_name a - b - c",
+			want: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				paragraph(text("2*3*4 re-run snake_case_name a - b - c")),
+			}},
+		},
+		{
+			name:   "when the list type changes at a depth",
+			markup: "* first\n*# step\n** detail\n# numbered",
+			want: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				{Type: "bulletList", Content: []*CommentNodeScheme{
+					listItem(paragraph(text("first")),
+						&CommentNodeScheme{Type: "orderedList", Content: []*CommentNodeScheme{listItem(paragraph(text("step")))}},
+						&CommentNodeScheme{Type: "bulletList", Content: []*CommentNodeScheme{listItem(paragraph(text("detail")))}},
+					),
+				}},
+				{Type: "orderedList", Content: []*CommentNodeScheme{listItem(paragraph(text("numbered")))}},
+			}},
+		},
+		{
+			name:   "when the markup characters are escaped",
+			markup: "\\*not bold\\* and *bold \\* star* {{a\\|b}}",
+			want: &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+				paragraph(text("*not bold* and "), text("bold * star", &MarkScheme{Type: "strong"}), text(" "),
+					text("a|b", &MarkScheme{Type: "code"})),
+			}},
+		},
+		{
+			name:   "when the markup is empty",
+			markup: "",
+			want:   &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, ADFFromWikiMarkup(testCase.markup))
+		})
+	}
+}
+
+func TestCommentV2ToV3_RoundTrip(t *testing.T) {
+
+	bodies := []string{
+		"Login fails",
+		"Login *fails* on _Safari_ with {{HTTP 500}}\nsee [the logs|https://example.com] [~accountid:5b86be50b8e3cb5895860d6d]",
+		"h2. Steps\n\n# open the app\n#* on Safari\n# log in\n\n{code:go}\npanic(err)\n{code}\n\n{quote}\nquoted -text- and +more+\n{quote}\n\n----",
+	}
+
+	for _, body := range bodies {
+
+		comment := &IssueCommentSchemeV2{ID: "10001", Body: body, Created: "2022-05-13T11:26:40.690-0500", JSDPublic: true}
+
+		converted := CommentV2ToV3(comment)
+		assert.Equal(t, "10001", converted.ID)
+		assert.True(t, converted.JSDPublic)
+
+		assert.Equal(t, comment, CommentV3ToV2(converted))
+	}
+}
+
+func TestCommentV3ToV2_RoundTrip(t *testing.T) {
+
+	body := &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+		{Type: "heading", Attrs: map[string]interface{}{"level": 3}, Content: []*CommentNodeScheme{text("Summary")}},
+		paragraph(
+			text("Login "),
+			text("fails", &MarkScheme{Type: "strong"}),
+			text(" with "),
+			text("HTTP 500", &MarkScheme{Type: "code"}),
+			&CommentNodeScheme{Type: "hardBreak"},
+			text("the logs", &MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": "https://example.com"}}),
+		),
+		{Type: "bulletList", Content: []*CommentNodeScheme{
+			listItem(paragraph(text("first"))),
+			listItem(paragraph(text("second", &MarkScheme{Type: "em"}))),
+		}},
+		paragraph(text("snake_case *args* {id} [link|x]")),
+		{Type: "codeBlock", Content: []*CommentNodeScheme{text("go test ./... -run Test_*")}},
+	}}
+
+	comment := &IssueCommentScheme{ID: "10001", Body: body, Visibility: &CommentVisibilityScheme{Type: "role", Value: "Administrators"}}
+
+	assert.Equal(t, comment, CommentV2ToV3(CommentV3ToV2(comment)))
+	assert.Nil(t, CommentV2ToV3(nil))
+	assert.Nil(t, CommentV3ToV2(nil))
+}
+
+func TestCommentPayloadV2ToV3(t *testing.T) {
+
+	payload := &CommentPayloadSchemeV2{Body: "Imported from the *legacy* tracker"}
+
+	converted := CommentPayloadV2ToV3(payload)
+	assert.Equal(t, &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{
+		paragraph(text("Imported from the "), text("legacy", &MarkScheme{Type: "strong"}), text(" tracker")),
+	}}, converted.Body)
+
+	assert.Equal(t, payload, CommentPayloadV3ToV2(converted))
+	assert.Nil(t, CommentPayloadV2ToV3(nil))
+	assert.Nil(t, CommentPayloadV3ToV2(nil))
+}
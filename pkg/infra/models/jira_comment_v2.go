@@ -7,6 +7,11 @@ type IssueCommentPageSchemeV2 struct {
 	Comments   []*IssueCommentSchemeV2 `json:"comments,omitempty"`
 }
 
+type IssueCommentListSchemeV2 struct {
+	PageMeta
+	Values []*IssueCommentSchemeV2 `json:"values,omitempty"`
+}
+
 type IssueCommentSchemeV2 struct {
 	Self         string                   `json:"self,omitempty"`
 	ID           string                   `json:"id,omitempty"`
@@ -24,3 +29,6 @@ type CommentPayloadSchemeV2 struct {
 	Visibility *CommentVisibilityScheme `json:"visibility,omitempty"`
 	Body       string                   `json:"body,omitempty"`
 }
+
+// IssueCommentVisitorFuncV2 is called for each comment streamed from an issue, the stream stops when it returns an error
+type IssueCommentVisitorFuncV2 func(comment *IssueCommentSchemeV2) error
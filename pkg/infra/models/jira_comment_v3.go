@@ -13,6 +13,52 @@ func (n *CommentNodeScheme) AppendNode(node *CommentNodeScheme) {
 	n.Content = append(n.Content, node)
 }
 
+// NewDocument returns an empty ADF document, the root node of the comment bodies and the issue descriptions.
+func NewDocument() *CommentNodeScheme {
+	return &CommentNodeScheme{Version: 1, Type: "doc", Content: []*CommentNodeScheme{}}
+}
+
+// NewParagraph returns an empty paragraph, the text, mentions and links are appended to it.
+func NewParagraph() *CommentNodeScheme {
+	return &CommentNodeScheme{Type: "paragraph"}
+}
+
+// AppendText appends a text node with the marks, e.g. strong or em, and returns the node, so the calls can be chained.
+// The empty texts aren't appended, ADF rejects the empty text nodes.
+func (n *CommentNodeScheme) AppendText(text string, marks ...*MarkScheme) *CommentNodeScheme {
+
+	if text == "" {
+		return n
+	}
+
+	n.AppendNode(&CommentNodeScheme{Type: "text", Text: text, Marks: marks})
+	return n
+}
+
+// AppendMention appends a mention of the user with the account id and returns the node.
+func (n *CommentNodeScheme) AppendMention(accountID string) *CommentNodeScheme {
+	n.AppendNode(&CommentNodeScheme{Type: "mention", Attrs: map[string]interface{}{"id": accountID}})
+	return n
+}
+
+// AppendLink appends a text linking to the href and returns the node.
+func (n *CommentNodeScheme) AppendLink(text, href string) *CommentNodeScheme {
+	return n.AppendText(text, &MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": href}})
+}
+
+// AppendCodeBlock appends a code block with the language, e.g. go or java, and returns the node.
+// The code block is a block node, so it's appended to the document instead of a paragraph.
+func (n *CommentNodeScheme) AppendCodeBlock(language, code string) *CommentNodeScheme {
+
+	block := &CommentNodeScheme{Type: "codeBlock"}
+	if language != "" {
+		block.Attrs = map[string]interface{}{"language": language}
+	}
+
+	n.AppendNode(block.AppendText(code))
+	return n
+}
+
 type MarkScheme struct {
 	Type  string                 `json:"type,omitempty"`
 	Attrs map[string]interface{} `json:"attrs,omitempty"`
@@ -30,6 +76,11 @@ type IssueCommentPageScheme struct {
 	Comments   []*IssueCommentScheme `json:"comments,omitempty"`
 }
 
+type IssueCommentListScheme struct {
+	PageMeta
+	Values []*IssueCommentScheme `json:"values,omitempty"`
+}
+
 type IssueCommentScheme struct {
 	Self         string                   `json:"self,omitempty"`
 	ID           string                   `json:"id,omitempty"`
@@ -47,3 +98,6 @@ type CommentVisibilityScheme struct {
 	Type  string `json:"type,omitempty"`
 	Value string `json:"value,omitempty"`
 }
+
+// IssueCommentVisitorFunc is called for each comment streamed from an issue, the stream stops when it returns an error
+type IssueCommentVisitorFunc func(comment *IssueCommentScheme) error
@@ -1,6 +1,10 @@
 package models
 
-import "testing"
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
 
 func TestCommentNodeScheme_AppendNode(t *testing.T) {
 	type fields struct {
@@ -43,3 +47,71 @@ func TestCommentNodeScheme_AppendNode(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentNodeScheme_Builder(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		document func() *CommentNodeScheme
+		want     string
+	}{
+		{
+			name: "when the paragraph has text, mentions and links",
+			document: func() *CommentNodeScheme {
+
+				paragraph := NewParagraph().
+					AppendText("Hello ").
+					AppendMention("5b10ac8d82e05b22cc7d4ef5").
+					AppendText(", please review the ").
+					AppendLink("release notes", "https://example.com/notes").
+					AppendText(" today", &MarkScheme{Type: "strong"}, &MarkScheme{Type: "em"})
+
+				document := NewDocument()
+				document.AppendNode(paragraph)
+				return document
+			},
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"Hello "},
+				{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5"}},
+				{"type":"text","text":", please review the "},
+				{"type":"text","text":"release notes","marks":[{"type":"link","attrs":{"href":"https://example.com/notes"}}]},
+				{"type":"text","text":" today","marks":[{"type":"strong"},{"type":"em"}]}]}]}`,
+		},
+		{
+			name: "when the document has code blocks",
+			document: func() *CommentNodeScheme {
+				return NewDocument().
+					AppendCodeBlock("go", "fmt.Println(\"hello\")").
+					AppendCodeBlock("", "")
+			},
+			want: `{"version":1,"type":"doc","content":[
+				{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println(\"hello\")"}]},
+				{"type":"codeBlock"}]}`,
+		},
+		{
+			name: "when the texts are empty",
+			document: func() *CommentNodeScheme {
+				document := NewDocument()
+				document.AppendNode(NewParagraph().AppendText("").AppendText("DUMMY"))
+				return document
+			},
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"DUMMY"}]}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			document := testCase.document()
+
+			// The document is embedded as is by the comment and the issue payloads
+			payload, err := json.Marshal(&CommentPayloadScheme{Body: document})
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"body":`+testCase.want+`}`, string(payload))
+
+			var decoded *CommentNodeScheme
+			assert.NoError(t, json.Unmarshal([]byte(testCase.want), &decoded))
+			assert.Equal(t, document, decoded)
+		})
+	}
+}
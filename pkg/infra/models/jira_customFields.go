@@ -1,15 +1,50 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
-type CustomFields struct{ Fields []map[string]interface{} }
+type CustomFields struct {
+	Fields []map[string]interface{}
 
-func (c *CustomFields) Groups(customFieldID string, groups []string) error {
+	// FieldMap, when set, allows to pass the field display names instead of the field ids,
+	// use the ProjectFieldMapService.Resolve method to map the display names of a project.
+	FieldMap *ProjectFieldMapScheme
+}
+
+// fieldID returns the field id of a display name mapped on the FieldMap, the field ids are returned as is.
+func (c *CustomFields) fieldID(customFieldID string) string {
+
+	if fieldID, ok := c.FieldMap.FieldID(customFieldID); ok {
+		return fieldID
+	}
+
+	return customFieldID
+}
+
+// customFieldID returns the id of the custom field, the display names mapped on the FieldMap are resolved first.
+//
+// The ids without the customfield_ prefix are rejected, they're the ids of the system fields.
+func (c *CustomFields) customFieldID(customFieldID string) (string, error) {
 
 	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+		return "", ErrNoFieldIDError
+	}
+
+	fieldID := c.fieldID(customFieldID)
+	if !strings.HasPrefix(fieldID, "customfield_") {
+		return "", ErrInvalidCustomFieldIDError
+	}
+
+	return fieldID, nil
+}
+
+func (c *CustomFields) Groups(customFieldID string, groups []string) error {
+
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(groups) == 0 {
@@ -26,7 +61,7 @@ func (c *CustomFields) Groups(customFieldID string, groups []string) error {
 	}
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = groupsNode
+	fieldNode[fieldID] = groupsNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -37,8 +72,9 @@ func (c *CustomFields) Groups(customFieldID string, groups []string) error {
 
 func (c *CustomFields) Group(customFieldID, group string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(group) == 0 {
@@ -49,7 +85,7 @@ func (c *CustomFields) Group(customFieldID, group string) error {
 	groupNode["name"] = group
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = groupNode
+	fieldNode[fieldID] = groupNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -60,8 +96,9 @@ func (c *CustomFields) Group(customFieldID, group string) error {
 
 func (c *CustomFields) URL(customFieldID, URL string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(URL) == 0 {
@@ -69,7 +106,7 @@ func (c *CustomFields) URL(customFieldID, URL string) error {
 	}
 
 	var urlNode = map[string]interface{}{}
-	urlNode[customFieldID] = URL
+	urlNode[fieldID] = URL
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = urlNode
@@ -78,6 +115,8 @@ func (c *CustomFields) URL(customFieldID, URL string) error {
 	return nil
 }
 
+// Text sets the value of a text field, its id isn't required to be a custom field id,
+// the service management requests set the system fields with it, e.g. the summary.
 func (c *CustomFields) Text(customFieldID, textValue string) error {
 
 	if len(customFieldID) == 0 {
@@ -89,7 +128,7 @@ func (c *CustomFields) Text(customFieldID, textValue string) error {
 	}
 
 	var urlNode = map[string]interface{}{}
-	urlNode[customFieldID] = textValue
+	urlNode[c.fieldID(customFieldID)] = textValue
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = urlNode
@@ -98,41 +137,43 @@ func (c *CustomFields) Text(customFieldID, textValue string) error {
 	return nil
 }
 
-func (c *CustomFields) DateTime(customFieldID string, dateValue time.Time) error {
+func (c *CustomFields) DateTime(customFieldID string, dateTimeValue time.Time) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
-	if dateValue.IsZero() {
+	if dateTimeValue.IsZero() {
 		return ErrNoDateTimeTypeError
 	}
 
-	var dateNode = map[string]interface{}{}
-	dateNode[customFieldID] = dateValue.Format(time.RFC3339)
+	var dateTimeNode = map[string]interface{}{}
+	dateTimeNode[fieldID] = dateTimeValue.Format(DateFormatWorklogStarted)
 
 	var fieldsNode = map[string]interface{}{}
-	fieldsNode["fields"] = dateNode
+	fieldsNode["fields"] = dateTimeNode
 
 	c.Fields = append(c.Fields, fieldsNode)
 	return nil
 }
 
-func (c *CustomFields) Date(customFieldID string, dateTimeValue time.Time) (err error) {
+func (c *CustomFields) Date(customFieldID string, dateValue time.Time) (err error) {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
-	if dateTimeValue.IsZero() {
+	if dateValue.IsZero() {
 		return ErrNoDateTypeError
 	}
 
-	var dateTimeNode = map[string]interface{}{}
-	dateTimeNode[customFieldID] = dateTimeValue.Format("2006-01-02")
+	var dateNode = map[string]interface{}{}
+	dateNode[fieldID] = dateValue.Format("2006-01-02")
 
 	var fieldsNode = map[string]interface{}{}
-	fieldsNode["fields"] = dateTimeNode
+	fieldsNode["fields"] = dateNode
 
 	c.Fields = append(c.Fields, fieldsNode)
 	return
@@ -140,8 +181,9 @@ func (c *CustomFields) Date(customFieldID string, dateTimeValue time.Time) (err
 
 func (c *CustomFields) MultiSelect(customFieldID string, options []string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(options) == 0 {
@@ -158,7 +200,7 @@ func (c *CustomFields) MultiSelect(customFieldID string, options []string) error
 	}
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = groupsNode
+	fieldNode[fieldID] = groupsNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -169,8 +211,9 @@ func (c *CustomFields) MultiSelect(customFieldID string, options []string) error
 
 func (c *CustomFields) Select(customFieldID string, option string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(option) == 0 {
@@ -181,7 +224,7 @@ func (c *CustomFields) Select(customFieldID string, option string) error {
 	selectNode["value"] = option
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = selectNode
+	fieldNode[fieldID] = selectNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -192,8 +235,9 @@ func (c *CustomFields) Select(customFieldID string, option string) error {
 
 func (c *CustomFields) RadioButton(customFieldID, button string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(button) == 0 {
@@ -204,7 +248,7 @@ func (c *CustomFields) RadioButton(customFieldID, button string) error {
 	selectNode["value"] = button
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = selectNode
+	fieldNode[fieldID] = selectNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -215,8 +259,9 @@ func (c *CustomFields) RadioButton(customFieldID, button string) error {
 
 func (c *CustomFields) User(customFieldID string, accountID string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(accountID) == 0 {
@@ -227,7 +272,7 @@ func (c *CustomFields) User(customFieldID string, accountID string) error {
 	userNode["accountId"] = accountID
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = userNode
+	fieldNode[fieldID] = userNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -238,8 +283,9 @@ func (c *CustomFields) User(customFieldID string, accountID string) error {
 
 func (c *CustomFields) Users(customFieldID string, accountIDs []string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(accountIDs) == 0 {
@@ -256,7 +302,7 @@ func (c *CustomFields) Users(customFieldID string, accountIDs []string) error {
 	}
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = accountsNode
+	fieldNode[fieldID] = accountsNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -267,12 +313,13 @@ func (c *CustomFields) Users(customFieldID string, accountIDs []string) error {
 
 func (c *CustomFields) Number(customFieldID string, numberValue float64) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	var urlNode = map[string]interface{}{}
-	urlNode[customFieldID] = numberValue
+	urlNode[fieldID] = numberValue
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = urlNode
@@ -283,8 +330,9 @@ func (c *CustomFields) Number(customFieldID string, numberValue float64) error {
 
 func (c *CustomFields) CheckBox(customFieldID string, options []string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if len(options) == 0 {
@@ -301,7 +349,7 @@ func (c *CustomFields) CheckBox(customFieldID string, options []string) error {
 	}
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = groupsNode
+	fieldNode[fieldID] = groupsNode
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
@@ -312,8 +360,9 @@ func (c *CustomFields) CheckBox(customFieldID string, options []string) error {
 
 func (c *CustomFields) Cascading(customFieldID, parent, child string) error {
 
-	if len(customFieldID) == 0 {
-		return ErrNoFieldIDError
+	fieldID, err := c.customFieldID(customFieldID)
+	if err != nil {
+		return err
 	}
 
 	if parent == "" {
@@ -332,7 +381,24 @@ func (c *CustomFields) Cascading(customFieldID, parent, child string) error {
 	parentNode["child"] = childNode
 
 	var fieldNode = map[string]interface{}{}
-	fieldNode[customFieldID] = parentNode
+	fieldNode[fieldID] = parentNode
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Clear sends the field as null, so the update clears it, the system fields are accepted, e.g. duedate.
+func (c *CustomFields) Clear(fieldID string) error {
+
+	if len(fieldID) == 0 {
+		return ErrNoFieldIDError
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[c.fieldID(fieldID)] = nil
 
 	var fieldsNode = map[string]interface{}{}
 	fieldsNode["fields"] = fieldNode
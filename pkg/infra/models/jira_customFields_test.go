@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -236,7 +237,7 @@ func TestCustomFields_DateTime(t *testing.T) {
 	}
 	type args struct {
 		customFieldID string
-		dateValue     time.Time
+		dateTimeValue time.Time
 	}
 	testCases := []struct {
 		name    string
@@ -250,7 +251,7 @@ func TestCustomFields_DateTime(t *testing.T) {
 			fields: fields{},
 			args: args{
 				customFieldID: "customfield_10001",
-				dateValue:     time.Now().AddDate(0, -1, 0),
+				dateTimeValue: time.Now().AddDate(0, -1, 0),
 			},
 			wantErr: false,
 			Err:     nil,
@@ -261,7 +262,7 @@ func TestCustomFields_DateTime(t *testing.T) {
 			fields: fields{},
 			args: args{
 				customFieldID: "",
-				dateValue:     time.Now().AddDate(0, -1, 0),
+				dateTimeValue: time.Now().AddDate(0, -1, 0),
 			},
 			wantErr: true,
 			Err:     ErrNoFieldIDError,
@@ -272,7 +273,7 @@ func TestCustomFields_DateTime(t *testing.T) {
 			fields: fields{},
 			args: args{
 				customFieldID: "customfield_10001",
-				dateValue:     time.Time{},
+				dateTimeValue: time.Time{},
 			},
 			wantErr: true,
 			Err:     ErrNoDateTimeTypeError,
@@ -284,7 +285,7 @@ func TestCustomFields_DateTime(t *testing.T) {
 				Fields: testCase.fields.Fields,
 			}
 
-			err := c.DateTime(testCase.args.customFieldID, testCase.args.dateValue)
+			err := c.DateTime(testCase.args.customFieldID, testCase.args.dateTimeValue)
 
 			if testCase.wantErr {
 
@@ -967,3 +968,110 @@ func TestCustomFields_Users(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomFields_FieldMap(t *testing.T) {
+
+	customFields := &CustomFields{
+		FieldMap: &ProjectFieldMapScheme{
+			ProjectKeyOrID: "KAN",
+			Fields:         map[string]string{"Story point estimate": "customfield_10016"},
+		},
+	}
+
+	assert.NoError(t, customFields.Number("story point estimate", 5))
+	assert.NoError(t, customFields.Text("customfield_10020", "value"))
+
+	assert.Equal(t, []map[string]interface{}{
+		{"fields": map[string]interface{}{"customfield_10016": float64(5)}},
+		{"fields": map[string]interface{}{"customfield_10020": "value"}},
+	}, customFields.Fields)
+}
+
+func TestCustomFields_Body(t *testing.T) {
+
+	date := time.Date(2023, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		set  func(c *CustomFields) error
+		want string
+	}{
+		{
+			name: "when the field is a cascading select",
+			set:  func(c *CustomFields) error { return c.Cascading("customfield_10001", "America", "US") },
+			want: `{"fields":{"customfield_10001":{"value":"America","child":{"value":"US"}}}}`,
+		},
+		{
+			name: "when the field is a multi select",
+			set: func(c *CustomFields) error {
+				return c.MultiSelect("customfield_10002", []string{"Option 1", "Option 2"})
+			},
+			want: `{"fields":{"customfield_10002":[{"value":"Option 1"},{"value":"Option 2"}]}}`,
+		},
+		{
+			name: "when the field is a select",
+			set:  func(c *CustomFields) error { return c.Select("customfield_10003", "Option 1") },
+			want: `{"fields":{"customfield_10003":{"value":"Option 1"}}}`,
+		},
+		{
+			name: "when the field is a user picker",
+			set:  func(c *CustomFields) error { return c.User("customfield_10004", "5b10a2844c20165700ede21g") },
+			want: `{"fields":{"customfield_10004":{"accountId":"5b10a2844c20165700ede21g"}}}`,
+		},
+		{
+			name: "when the field is a multi user picker",
+			set: func(c *CustomFields) error {
+				return c.Users("customfield_10005", []string{"5b10a2844c20165700ede21g", "5b10ac8d82e05b22cc7d4ef5"})
+			},
+			want: `{"fields":{"customfield_10005":[{"accountId":"5b10a2844c20165700ede21g"},{"accountId":"5b10ac8d82e05b22cc7d4ef5"}]}}`,
+		},
+		{
+			name: "when the field is a number",
+			set:  func(c *CustomFields) error { return c.Number("customfield_10006", 1.5) },
+			want: `{"fields":{"customfield_10006":1.5}}`,
+		},
+		{
+			name: "when the field is a date",
+			set:  func(c *CustomFields) error { return c.Date("customfield_10007", date) },
+			want: `{"fields":{"customfield_10007":"2023-03-14"}}`,
+		},
+		{
+			name: "when the field is a date time",
+			set:  func(c *CustomFields) error { return c.DateTime("customfield_10008", date) },
+			want: `{"fields":{"customfield_10008":"2023-03-14T09:30:00.000+0000"}}`,
+		},
+		{
+			name: "when the field is a group picker",
+			set:  func(c *CustomFields) error { return c.Group("customfield_10009", "jira-users") },
+			want: `{"fields":{"customfield_10009":{"name":"jira-users"}}}`,
+		},
+		{
+			name: "when the field is a url",
+			set:  func(c *CustomFields) error { return c.URL("customfield_10010", "https://go-atlassian.io") },
+			want: `{"fields":{"customfield_10010":"https://go-atlassian.io"}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			customFields := &CustomFields{}
+			assert.NoError(t, testCase.set(customFields))
+			assert.Len(t, customFields.Fields, 1)
+
+			body, err := json.Marshal(customFields.Fields[0])
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, string(body))
+		})
+	}
+}
+
+func TestCustomFields_InvalidFieldID(t *testing.T) {
+
+	customFields := &CustomFields{}
+
+	assert.Equal(t, ErrInvalidCustomFieldIDError, customFields.Select("summary", "Option 1"))
+	assert.Equal(t, ErrInvalidCustomFieldIDError, customFields.Cascading("10001", "America", "US"))
+	assert.Equal(t, ErrInvalidCustomFieldIDError, customFields.Number("story point estimate", 5))
+	assert.Empty(t, customFields.Fields)
+}
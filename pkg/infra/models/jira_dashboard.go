@@ -7,6 +7,31 @@ type DashboardPageScheme struct {
 	Dashboards []*DashboardScheme `json:"dashboards,omitempty"`
 }
 
+// HasNext returns true if there are more dashboards to fetch after the current page.
+func (d *DashboardPageScheme) HasNext() bool {
+	return d != nil && hasNextPage(false, "", d.StartAt, len(d.Dashboards), d.Total)
+}
+
+// NextStartAt returns the startAt value needed to request the next page.
+func (d *DashboardPageScheme) NextStartAt() int {
+
+	if d == nil {
+		return 0
+	}
+
+	return d.StartAt + len(d.Dashboards)
+}
+
+// Values returns the dashboards of the page.
+func (d *DashboardPageScheme) Values() []*DashboardScheme {
+
+	if d == nil {
+		return nil
+	}
+
+	return d.Dashboards
+}
+
 type DashboardScheme struct {
 	ID               string                   `json:"id,omitempty"`
 	IsFavourite      bool                     `json:"isFavourite,omitempty"`
@@ -38,6 +63,21 @@ type DashboardSearchPageScheme struct {
 	Values     []*DashboardScheme `json:"values,omitempty"`
 }
 
+// HasNext returns true if there are more dashboards to fetch after the current page.
+func (d *DashboardSearchPageScheme) HasNext() bool {
+	return d != nil && hasNextPage(d.IsLast, "", d.StartAt, len(d.Values), d.Total)
+}
+
+// NextStartAt returns the startAt value needed to request the next page.
+func (d *DashboardSearchPageScheme) NextStartAt() int {
+
+	if d == nil {
+		return 0
+	}
+
+	return d.StartAt + len(d.Values)
+}
+
 type DashboardPayloadScheme struct {
 	Name             string                   `json:"name,omitempty"`
 	Description      string                   `json:"description,omitempty"`
@@ -48,7 +88,8 @@ type DashboardPayloadScheme struct {
 type DashboardSearchOptionsScheme struct {
 	DashboardName       string
 	OwnerAccountID      string
-	GroupPermissionName string
+	GroupPermissionName string // The name of the group, it can't be combined with the GroupPermissionID
+	GroupPermissionID   string
 	OrderBy             string
 	Expand              []string
 }
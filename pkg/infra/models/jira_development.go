@@ -0,0 +1,120 @@
+package models
+
+type DevelopmentDetailPageScheme struct {
+	Errors []*DevelopmentErrorScheme  `json:"errors,omitempty"`
+	Detail []*DevelopmentDetailScheme `json:"detail,omitempty"`
+}
+
+type DevelopmentErrorScheme struct {
+	Error    string                     `json:"error,omitempty"`
+	Instance *DevelopmentInstanceScheme `json:"_instance,omitempty"`
+}
+
+type DevelopmentDetailScheme struct {
+	Branches     []*DevelopmentBranchScheme      `json:"branches,omitempty"`
+	PullRequests []*DevelopmentPullRequestScheme `json:"pullRequests,omitempty"`
+	Repositories []*DevelopmentRepositoryScheme  `json:"repositories,omitempty"`
+	Instance     *DevelopmentInstanceScheme      `json:"_instance,omitempty"`
+}
+
+type DevelopmentInstanceScheme struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Type           string `json:"type,omitempty"`
+	TypeName       string `json:"typeName,omitempty"`
+	BaseURL        string `json:"baseUrl,omitempty"`
+	SingleInstance bool   `json:"singleInstance,omitempty"`
+}
+
+type DevelopmentBranchScheme struct {
+	Name                 string                          `json:"name,omitempty"`
+	URL                  string                          `json:"url,omitempty"`
+	CreatePullRequestURL string                          `json:"createPullRequestUrl,omitempty"`
+	Repository           *DevelopmentRepositoryScheme    `json:"repository,omitempty"`
+	LastCommit           *DevelopmentCommitScheme        `json:"lastCommit,omitempty"`
+	PullRequests         []*DevelopmentPullRequestScheme `json:"pullRequests,omitempty"`
+}
+
+type DevelopmentPullRequestScheme struct {
+	ID             string                              `json:"id,omitempty"`
+	Name           string                              `json:"name,omitempty"`
+	URL            string                              `json:"url,omitempty"`
+	Status         string                              `json:"status,omitempty"`
+	LastUpdate     string                              `json:"lastUpdate,omitempty"`
+	CommentCount   int                                 `json:"commentCount,omitempty"`
+	Author         *DevelopmentAuthorScheme            `json:"author,omitempty"`
+	Reviewers      []*DevelopmentReviewerScheme        `json:"reviewers,omitempty"`
+	Source         *DevelopmentPullRequestBranchScheme `json:"source,omitempty"`
+	Destination    *DevelopmentPullRequestBranchScheme `json:"destination,omitempty"`
+	RepositoryID   string                              `json:"repositoryId,omitempty"`
+	RepositoryName string                              `json:"repositoryName,omitempty"`
+	RepositoryURL  string                              `json:"repositoryUrl,omitempty"`
+}
+
+type DevelopmentPullRequestBranchScheme struct {
+	Branch string `json:"branch,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+type DevelopmentReviewerScheme struct {
+	Name     string `json:"name,omitempty"`
+	Avatar   string `json:"avatar,omitempty"`
+	Approved bool   `json:"approved,omitempty"`
+}
+
+type DevelopmentAuthorScheme struct {
+	Name   string `json:"name,omitempty"`
+	Avatar string `json:"avatar,omitempty"`
+}
+
+type DevelopmentRepositoryScheme struct {
+	ID      string                     `json:"id,omitempty"`
+	Name    string                     `json:"name,omitempty"`
+	Avatar  string                     `json:"avatar,omitempty"`
+	URL     string                     `json:"url,omitempty"`
+	Commits []*DevelopmentCommitScheme `json:"commits,omitempty"`
+}
+
+type DevelopmentCommitScheme struct {
+	ID              string                   `json:"id,omitempty"`
+	DisplayID       string                   `json:"displayId,omitempty"`
+	Message         string                   `json:"message,omitempty"`
+	URL             string                   `json:"url,omitempty"`
+	AuthorTimestamp string                   `json:"authorTimestamp,omitempty"`
+	FileCount       int                      `json:"fileCount,omitempty"`
+	Merge           bool                     `json:"merge,omitempty"`
+	Author          *DevelopmentAuthorScheme `json:"author,omitempty"`
+}
+
+type DevelopmentSummaryPageScheme struct {
+	Errors  []*DevelopmentErrorScheme `json:"errors,omitempty"`
+	Summary *DevelopmentSummaryScheme `json:"summary,omitempty"`
+}
+
+type DevelopmentSummaryScheme struct {
+	PullRequest *DevelopmentSummaryItemScheme `json:"pullrequest,omitempty"`
+	Branch      *DevelopmentSummaryItemScheme `json:"branch,omitempty"`
+	Repository  *DevelopmentSummaryItemScheme `json:"repository,omitempty"`
+	Build       *DevelopmentSummaryItemScheme `json:"build,omitempty"`
+	Review      *DevelopmentSummaryItemScheme `json:"review,omitempty"`
+	Deployment  *DevelopmentSummaryItemScheme `json:"deployment-environment,omitempty"`
+}
+
+type DevelopmentSummaryItemScheme struct {
+	Overall        *DevelopmentSummaryOverallScheme             `json:"overall,omitempty"`
+	ByInstanceType map[string]*DevelopmentSummaryInstanceScheme `json:"byInstanceType,omitempty"`
+}
+
+type DevelopmentSummaryOverallScheme struct {
+	Count       int    `json:"count,omitempty"`
+	LastUpdated string `json:"lastUpdated,omitempty"`
+	State       string `json:"state,omitempty"`
+	StateCount  int    `json:"stateCount,omitempty"`
+	DataType    string `json:"dataType,omitempty"`
+	Open        bool   `json:"open,omitempty"`
+}
+
+type DevelopmentSummaryInstanceScheme struct {
+	Count int    `json:"count,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
@@ -1,22 +1,23 @@
 package models
 
 type IssueFieldScheme struct {
-	ID            string                         `json:"id,omitempty"`
-	Key           string                         `json:"key,omitempty"`
-	Name          string                         `json:"name,omitempty"`
-	Custom        bool                           `json:"custom,omitempty"`
-	Orderable     bool                           `json:"orderable,omitempty"`
-	Navigable     bool                           `json:"navigable,omitempty"`
-	Searchable    bool                           `json:"searchable,omitempty"`
-	ClauseNames   []string                       `json:"clauseNames,omitempty"`
-	Scope         *TeamManagedProjectScopeScheme `json:"scope,omitempty"`
-	Schema        *IssueFieldSchemaScheme        `json:"schema,omitempty"`
-	Description   string                         `json:"description,omitempty"`
-	IsLocked      bool                           `json:"isLocked,omitempty"`
-	SearcherKey   string                         `json:"searcherKey,omitempty"`
-	ScreensCount  int                            `json:"screensCount,omitempty"`
-	ContextsCount int                            `json:"contextsCount,omitempty"`
-	LastUsed      *IssueFieldLastUsedScheme      `json:"lastUsed,omitempty"`
+	ID               string                         `json:"id,omitempty"`
+	Key              string                         `json:"key,omitempty"`
+	Name             string                         `json:"name,omitempty"`
+	UntranslatedName string                         `json:"untranslatedName,omitempty"`
+	Custom           bool                           `json:"custom,omitempty"`
+	Orderable        bool                           `json:"orderable,omitempty"`
+	Navigable        bool                           `json:"navigable,omitempty"`
+	Searchable       bool                           `json:"searchable,omitempty"`
+	ClauseNames      []string                       `json:"clauseNames,omitempty"`
+	Scope            *TeamManagedProjectScopeScheme `json:"scope,omitempty"`
+	Schema           *IssueFieldSchemaScheme        `json:"schema,omitempty"`
+	Description      string                         `json:"description,omitempty"`
+	IsLocked         bool                           `json:"isLocked,omitempty"`
+	SearcherKey      string                         `json:"searcherKey,omitempty"`
+	ScreensCount     int                            `json:"screensCount,omitempty"`
+	ContextsCount    int                            `json:"contextsCount,omitempty"`
+	LastUsed         *IssueFieldLastUsedScheme      `json:"lastUsed,omitempty"`
 }
 
 type IssueFieldSchemaScheme struct {
@@ -46,6 +47,9 @@ type FieldSearchOptionsScheme struct {
 	Query   string
 	OrderBy string
 	Expand  []string
+
+	// AcceptLanguage pins the locale of the field names returned, e.g. "fr-FR", the user locale is used by default
+	AcceptLanguage string
 }
 
 type CustomFieldScheme struct {
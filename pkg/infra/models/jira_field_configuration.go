@@ -1,5 +1,10 @@
 package models
 
+import (
+	"encoding/json"
+	"strconv"
+)
+
 type FieldConfigurationPageScheme struct {
 	MaxResults int                         `json:"maxResults,omitempty"`
 	StartAt    int                         `json:"startAt,omitempty"`
@@ -33,4 +38,35 @@ type FieldConfigurationItemScheme struct {
 	IsRequired  bool   `json:"isRequired,omitempty"`
 	Description string `json:"description,omitempty"`
 	Renderer    string `json:"renderer,omitempty"`
+
+	// ExplicitFields are the attributes sent even when they're false, so the update unsets them, e.g. isRequired
+	ExplicitFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the item, the ExplicitFields are sent with their value.
+func (f FieldConfigurationItemScheme) MarshalJSON() ([]byte, error) {
+
+	type alias FieldConfigurationItemScheme
+
+	data, err := json.Marshal(alias(f))
+	if err != nil || len(f.ExplicitFields) == 0 {
+		return data, err
+	}
+
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for _, field := range f.ExplicitFields {
+
+		switch field {
+		case "isHidden":
+			object[field] = json.RawMessage(strconv.FormatBool(f.IsHidden))
+		case "isRequired":
+			object[field] = json.RawMessage(strconv.FormatBool(f.IsRequired))
+		}
+	}
+
+	return json.Marshal(object)
 }
@@ -0,0 +1,39 @@
+package models
+
+type IssueFieldOptionPageScheme struct {
+	PageMeta
+	Values []*IssueFieldOptionScheme `json:"values,omitempty"`
+}
+
+type IssueFieldOptionScheme struct {
+	ID         int                           `json:"id,omitempty"`
+	Value      string                        `json:"value,omitempty"`
+	Properties map[string]interface{}        `json:"properties,omitempty"`
+	Config     *IssueFieldOptionConfigScheme `json:"config,omitempty"`
+}
+
+type IssueFieldOptionPayloadScheme struct {
+	Value      string                        `json:"value,omitempty"`
+	Properties map[string]interface{}        `json:"properties,omitempty"`
+	Config     *IssueFieldOptionConfigScheme `json:"config,omitempty"`
+}
+
+type IssueFieldOptionConfigScheme struct {
+	Scope      *IssueFieldOptionScopeScheme `json:"scope,omitempty"`
+	Attributes []string                     `json:"attributes,omitempty"`
+}
+
+type IssueFieldOptionScopeScheme struct {
+	Projects  []int                                 `json:"projects,omitempty"`
+	Projects2 []*IssueFieldOptionProjectScopeScheme `json:"projects2,omitempty"`
+	Global    *IssueFieldOptionGlobalScopeScheme    `json:"global,omitempty"`
+}
+
+type IssueFieldOptionProjectScopeScheme struct {
+	ID         int      `json:"id,omitempty"`
+	Attributes []string `json:"attributes,omitempty"`
+}
+
+type IssueFieldOptionGlobalScopeScheme struct {
+	Attributes []string `json:"attributes,omitempty"`
+}
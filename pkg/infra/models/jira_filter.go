@@ -9,6 +9,21 @@ type FilterPageScheme struct {
 	Values     []*FilterScheme `json:"values,omitempty"`
 }
 
+// HasNext returns true if there are more filters to fetch after the current page.
+func (f *FilterPageScheme) HasNext() bool {
+	return f != nil && hasNextPage(f.IsLast, "", f.StartAt, len(f.Values), f.Total)
+}
+
+// NextStartAt returns the startAt value needed to request the next page.
+func (f *FilterPageScheme) NextStartAt() int {
+
+	if f == nil {
+		return 0
+	}
+
+	return f.StartAt + len(f.Values)
+}
+
 type FilterSearchPageScheme struct {
 	Self       string                `json:"self,omitempty"`
 	MaxResults int                   `json:"maxResults,omitempty"`
@@ -18,6 +33,21 @@ type FilterSearchPageScheme struct {
 	Values     []*FilterDetailScheme `json:"values,omitempty"`
 }
 
+// HasNext returns true if there are more filters to fetch after the current page.
+func (f *FilterSearchPageScheme) HasNext() bool {
+	return f != nil && hasNextPage(f.IsLast, "", f.StartAt, len(f.Values), f.Total)
+}
+
+// NextStartAt returns the startAt value needed to request the next page.
+func (f *FilterSearchPageScheme) NextStartAt() int {
+
+	if f == nil {
+		return 0
+	}
+
+	return f.StartAt + len(f.Values)
+}
+
 type FilterDetailScheme struct {
 	Self             string                      `json:"self,omitempty"`
 	ID               string                      `json:"id,omitempty"`
@@ -81,7 +111,8 @@ type FilterPayloadScheme struct {
 type FilterSearchOptionScheme struct {
 	Name      string
 	AccountID string
-	Group     string
+	Group     string // The name of the group, it can't be combined with the GroupID
+	GroupID   string
 	OrderBy   string
 	ProjectID int
 	IDs       []int
@@ -92,9 +123,25 @@ type ShareFilterScopeScheme struct {
 	Scope string `json:"scope"`
 }
 
+const (
+	FilterShareTypeUser          = "user"
+	FilterShareTypeGroup         = "group"
+	FilterShareTypeProject       = "project"
+	FilterShareTypeProjectRole   = "projectRole"
+	FilterShareTypeGlobal        = "global"
+	FilterShareTypeAuthenticated = "authenticated"
+
+	// FilterShareRightsView and FilterShareRightsEdit are combined as a bitmask, e.g. view and edit = 3
+	FilterShareRightsView = 1
+	FilterShareRightsEdit = 2
+)
+
 type PermissionFilterPayloadScheme struct {
 	Type          string `json:"type,omitempty"`
 	ProjectID     string `json:"projectId,omitempty"`
 	GroupName     string `json:"groupname,omitempty"`
+	GroupID       string `json:"groupId,omitempty"`
 	ProjectRoleID string `json:"projectRoleId,omitempty"`
+	AccountID     string `json:"accountId,omitempty"`
+	Rights        int    `json:"rights,omitempty"`
 }
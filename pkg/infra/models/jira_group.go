@@ -12,10 +12,11 @@ type UserGroupScheme struct {
 }
 
 type GroupScheme struct {
-	Name   string               `json:"name,omitempty"`
-	Self   string               `json:"self,omitempty"`
-	Users  *GroupUserPageScheme `json:"users,omitempty"`
-	Expand string               `json:"expand,omitempty"`
+	Name    string               `json:"name,omitempty"`
+	GroupID string               `json:"groupId,omitempty"`
+	Self    string               `json:"self,omitempty"`
+	Users   *GroupUserPageScheme `json:"users,omitempty"`
+	Expand  string               `json:"expand,omitempty"`
 }
 
 type GroupUserPageScheme struct {
@@ -0,0 +1,175 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// legacySprintAttributeRegex matches the attribute names of the toString-encoded sprints, e.g: com.atlassian.greenhopper.service.sprint.Sprint@1b2c3d[id=5,rapidViewId=2,state=ACTIVE,name=Sprint 1].
+var legacySprintAttributeRegex = regexp.MustCompile(`(?:^|,)([a-zA-Z]+)=`)
+
+// ParseSprints extracts the sprints stored on the sprint custom field of an issue.
+//
+// It supports the object form returned by the agile API and the newer platform API, as well as the legacy toString-encoded form returned on the platform API by the old Jira versions.
+func ParseSprints(buffer bytes.Buffer, customField string) ([]*SprintDetailScheme, error) {
+
+	raw := struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}{}
+
+	if err := json.Unmarshal(buffer.Bytes(), &raw); err != nil {
+		return nil, ErrNoCustomFieldUnmarshalError
+	}
+
+	if raw.Fields == nil {
+		return nil, ErrNoFieldInformationError
+	}
+
+	value, containsField := raw.Fields[customField]
+	if !containsField || string(value) == "null" {
+		return nil, nil
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(value, &elements); err != nil {
+		return nil, ErrNoMultiSelectTypeError
+	}
+
+	var sprints []*SprintDetailScheme
+	for _, element := range elements {
+
+		var legacy string
+		if err := json.Unmarshal(element, &legacy); err == nil {
+
+			sprint, err := parseLegacySprint(legacy)
+			if err != nil {
+				return nil, err
+			}
+
+			sprints = append(sprints, sprint)
+			continue
+		}
+
+		object := struct {
+			SprintDetailScheme
+			BoardID int `json:"boardId,omitempty"`
+		}{}
+
+		if err := json.Unmarshal(element, &object); err != nil {
+			return nil, ErrNoMultiSelectTypeError
+		}
+
+		sprint := object.SprintDetailScheme
+		if sprint.OriginBoardID == 0 {
+			sprint.OriginBoardID = object.BoardID
+		}
+
+		sprints = append(sprints, &sprint)
+	}
+
+	return sprints, nil
+}
+
+func parseLegacySprint(value string) (*SprintDetailScheme, error) {
+
+	start, end := strings.Index(value, "["), strings.LastIndex(value, "]")
+	if start == -1 || end < start {
+		return nil, ErrNoMultiSelectTypeError
+	}
+
+	content := value[start+1 : end]
+	matches := legacySprintAttributeRegex.FindAllStringSubmatchIndex(content, -1)
+
+	attributes := make(map[string]string)
+	for index, match := range matches {
+
+		valueEnd := len(content)
+		if index+1 < len(matches) {
+			valueEnd = matches[index+1][0]
+		}
+
+		attributeValue := content[match[1]:valueEnd]
+		if attributeValue == "<null>" {
+			attributeValue = ""
+		}
+
+		attributes[content[match[2]:match[3]]] = attributeValue
+	}
+
+	sprint := &SprintDetailScheme{
+		State:        attributes["state"],
+		Name:         attributes["name"],
+		StartDate:    attributes["startDate"],
+		EndDate:      attributes["endDate"],
+		CompleteDate: attributes["completeDate"],
+		Goal:         attributes["goal"],
+	}
+
+	if id, err := strconv.Atoi(attributes["id"]); err == nil {
+		sprint.ID = id
+	}
+
+	if boardID, err := strconv.Atoi(attributes["rapidViewId"]); err == nil {
+		sprint.OriginBoardID = boardID
+	}
+
+	return sprint, nil
+}
+
+// ParseEpicKey extracts the key of the epic linked to an issue.
+//
+// It checks the parent field used by the team-managed projects, the epic link custom field used by the company-managed projects and the epic object returned by the agile API, in that order.
+//
+// An empty key is returned if the issue isn't linked to an epic.
+func ParseEpicKey(buffer bytes.Buffer, epicLinkField string) (string, error) {
+
+	raw := struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}{}
+
+	if err := json.Unmarshal(buffer.Bytes(), &raw); err != nil {
+		return "", ErrNoCustomFieldUnmarshalError
+	}
+
+	if raw.Fields == nil {
+		return "", ErrNoFieldInformationError
+	}
+
+	issueType := struct {
+		Subtask bool `json:"subtask,omitempty"`
+	}{}
+
+	if value, ok := raw.Fields["issuetype"]; ok {
+		_ = json.Unmarshal(value, &issueType)
+	}
+
+	// The parent of a sub-task is a standard issue, not an epic
+	if value, ok := raw.Fields["parent"]; ok && !issueType.Subtask {
+
+		parent := new(ParentScheme)
+		if err := json.Unmarshal(value, parent); err == nil && parent.Key != "" {
+			return parent.Key, nil
+		}
+	}
+
+	if value, ok := raw.Fields[epicLinkField]; ok && epicLinkField != "" {
+
+		var epicKey string
+		if err := json.Unmarshal(value, &epicKey); err == nil && epicKey != "" {
+			return epicKey, nil
+		}
+	}
+
+	if value, ok := raw.Fields["epic"]; ok {
+
+		epic := new(EpicScheme)
+		if err := json.Unmarshal(value, epic); err == nil && epic.Key != "" {
+			return epic.Key, nil
+		}
+	}
+
+	return "", nil
+}
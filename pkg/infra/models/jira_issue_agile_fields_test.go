@@ -0,0 +1,175 @@
+package models
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseSprints(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		payload string
+		want    []*SprintDetailScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the sprints are returned as objects",
+			payload: `{"fields":{"customfield_10020":[{"id":5,"name":"KP Sprint 1","state":"closed","boardId":4,
+"goal":"","startDate":"2022-01-10T12:00:00.000Z","endDate":"2022-01-24T12:00:00.000Z","completeDate":"2022-01-24T13:00:00.000Z"}]}}`,
+			want: []*SprintDetailScheme{
+				{
+					ID:            5,
+					State:         "closed",
+					Name:          "KP Sprint 1",
+					StartDate:     "2022-01-10T12:00:00.000Z",
+					EndDate:       "2022-01-24T12:00:00.000Z",
+					CompleteDate:  "2022-01-24T13:00:00.000Z",
+					OriginBoardID: 4,
+				},
+			},
+		},
+
+		{
+			name:    "when the sprints are returned as legacy strings",
+			payload: `{"fields":{"customfield_10020":["com.atlassian.greenhopper.service.sprint.Sprint@1b2c3d[id=5,rapidViewId=2,state=ACTIVE,name=Sprint 1, the best one,goal=<null>,startDate=2022-01-10T12:00:00.000Z,endDate=2022-01-24T12:00:00.000Z,completeDate=<null>,sequence=5]"]}}`,
+			want: []*SprintDetailScheme{
+				{
+					ID:            5,
+					State:         "ACTIVE",
+					Name:          "Sprint 1, the best one",
+					StartDate:     "2022-01-10T12:00:00.000Z",
+					EndDate:       "2022-01-24T12:00:00.000Z",
+					OriginBoardID: 2,
+				},
+			},
+		},
+
+		{
+			name:    "when the custom field is empty",
+			payload: `{"fields":{"customfield_10020":null}}`,
+			want:    nil,
+		},
+
+		{
+			name:    "when the legacy string is malformed",
+			payload: `{"fields":{"customfield_10020":["com.atlassian.greenhopper.service.sprint.Sprint@1b2c3d"]}}`,
+			wantErr: true,
+			Err:     ErrNoMultiSelectTypeError,
+		},
+
+		{
+			name:    "when the custom field is not an array",
+			payload: `{"fields":{"customfield_10020":"Sprint 1"}}`,
+			wantErr: true,
+			Err:     ErrNoMultiSelectTypeError,
+		},
+
+		{
+			name:    "when the buffer does not contain the fields",
+			payload: `{"key":"KP-1"}`,
+			wantErr: true,
+			Err:     ErrNoFieldInformationError,
+		},
+
+		{
+			name:    "when the buffer is not a valid json",
+			payload: `{}{`,
+			wantErr: true,
+			Err:     ErrNoCustomFieldUnmarshalError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			buffer := bytes.Buffer{}
+			buffer.WriteString(testCase.payload)
+
+			got, err := ParseSprints(buffer, "customfield_10020")
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestParseEpicKey(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		payload string
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:    "when the epic is the parent of a team-managed issue",
+			payload: `{"fields":{"issuetype":{"subtask":false},"parent":{"id":"10001","key":"KP-1"},"customfield_10014":"KP-2"}}`,
+			want:    "KP-1",
+		},
+
+		{
+			name:    "when the issue is a sub-task",
+			payload: `{"fields":{"issuetype":{"subtask":true},"parent":{"id":"10001","key":"KP-1"},"customfield_10014":"KP-2"}}`,
+			want:    "KP-2",
+		},
+
+		{
+			name:    "when the epic is linked using the epic link custom field",
+			payload: `{"fields":{"customfield_10014":"KP-2"}}`,
+			want:    "KP-2",
+		},
+
+		{
+			name:    "when the epic is returned by the agile api",
+			payload: `{"fields":{"customfield_10014":null,"epic":{"id":10003,"key":"KP-3","name":"Epic"}}}`,
+			want:    "KP-3",
+		},
+
+		{
+			name:    "when the issue is not linked to an epic",
+			payload: `{"fields":{"summary":"Summary"}}`,
+			want:    "",
+		},
+
+		{
+			name:    "when the buffer does not contain the fields",
+			payload: `{"key":"KP-1"}`,
+			wantErr: true,
+			Err:     ErrNoFieldInformationError,
+		},
+
+		{
+			name:    "when the buffer is not a valid json",
+			payload: `{}{`,
+			wantErr: true,
+			Err:     ErrNoCustomFieldUnmarshalError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			buffer := bytes.Buffer{}
+			buffer.WriteString(testCase.payload)
+
+			got, err := ParseEpicKey(buffer, "customfield_10014")
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
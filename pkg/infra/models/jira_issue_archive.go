@@ -0,0 +1,44 @@
+package models
+
+// IssueArchivalSyncResponseScheme is the result of the archival or the restoration of the issues, the issues not
+// updated are grouped by the reason of the failure.
+type IssueArchivalSyncResponseScheme struct {
+	Errors                *IssueArchivalErrorsScheme `json:"errors,omitempty"`
+	NumberOfIssuesUpdated int                        `json:"numberOfIssuesUpdated,omitempty"`
+}
+
+type IssueArchivalErrorsScheme struct {
+	IssueIsSubtask             *IssueArchivalErrorScheme `json:"issueIsSubtask,omitempty"`
+	IssuesInArchivedProjects   *IssueArchivalErrorScheme `json:"issuesInArchivedProjects,omitempty"`
+	IssuesInUnlicensedProjects *IssueArchivalErrorScheme `json:"issuesInUnlicensedProjects,omitempty"`
+	IssuesNotFound             *IssueArchivalErrorScheme `json:"issuesNotFound,omitempty"`
+	UserDoesNotHavePermission  *IssueArchivalErrorScheme `json:"userDoesNotHavePermission,omitempty"`
+}
+
+type IssueArchivalErrorScheme struct {
+	Count          int      `json:"count,omitempty"`
+	IssueIdsOrKeys []string `json:"issueIdsOrKeys,omitempty"`
+	Message        string   `json:"message,omitempty"`
+}
+
+type IssueArchivalExportPayloadScheme struct {
+	ArchivedBy        []string                        `json:"archivedBy,omitempty"`
+	ArchivedDateRange *IssueArchivalExportRangeScheme `json:"archivedDateRange,omitempty"`
+	IssueTypes        []string                        `json:"issueTypes,omitempty"`
+	Projects          []string                        `json:"projects,omitempty"`
+	Reporters         []string                        `json:"reporters,omitempty"`
+}
+
+// IssueArchivalExportRangeScheme is the range of the archival dates, the dates are formatted as YYYY-MM-DD.
+type IssueArchivalExportRangeScheme struct {
+	DateAfter  string `json:"dateAfter,omitempty"`
+	DateBefore string `json:"dateBefore,omitempty"`
+}
+
+type IssueArchivalExportScheme struct {
+	Payload       string `json:"payload,omitempty"`
+	Progress      int    `json:"progress,omitempty"`
+	Status        string `json:"status,omitempty"`
+	SubmittedTime string `json:"submittedTime,omitempty"`
+	TaskID        string `json:"taskId,omitempty"`
+}
@@ -44,3 +44,27 @@ type AttachmentHumanMetadataEntryScheme struct {
 	MediaType string `json:"mediaType,omitempty"`
 	Label     string `json:"label,omitempty"`
 }
+
+type AttachmentRawMetadataScheme struct {
+	Entries         []*AttachmentRawMetadataEntryScheme `json:"entries,omitempty"`
+	TotalEntryCount int                                 `json:"totalEntryCount,omitempty"`
+}
+
+type AttachmentRawMetadataEntryScheme struct {
+	EntryIndex      int    `json:"entryIndex,omitempty"`
+	AbbreviatedName string `json:"abbreviatedName,omitempty"`
+	MediaType       string `json:"mediaType,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Size            int    `json:"size,omitempty"`
+}
+
+type AttachmentThumbnailOptionsScheme struct {
+	Width             int  // The maximum width to scale the thumbnail to
+	Height            int  // The maximum height to scale the thumbnail to
+	FallbackToDefault bool // Whether a default thumbnail is returned when the requested thumbnail is not found
+}
+
+type AttachmentThumbnailScheme struct {
+	ContentType string
+	Content     []byte
+}
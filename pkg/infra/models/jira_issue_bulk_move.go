@@ -0,0 +1,18 @@
+package models
+
+// IssueBulkMoveReportScheme represents the result of a bulk transition, the issue keys are sorted in the search order.
+type IssueBulkMoveReportScheme struct {
+	DryRun    bool                         // Whether the transitions were only resolved
+	Succeeded []string                     // The keys of the issues transitioned, or that would be transitioned on a dry run
+	Skipped   []*IssueBulkMoveResultScheme // The issues without a matching transition
+	Failed    []*IssueBulkMoveResultScheme // The issues that couldn't be transitioned
+
+	// Processed contains the already processed keys and the keys transitioned, pass it to resume a bulk transition
+	Processed []string
+}
+
+type IssueBulkMoveResultScheme struct {
+	IssueKey string
+	Reason   string // The reason the issue was skipped
+	Err      error
+}
@@ -0,0 +1,42 @@
+package models
+
+const (
+	IssueCloneSubtaskStep    = "subtask"    // The subtask couldn't be read or created
+	IssueCloneLinkStep       = "link"       // The issue link couldn't be re-created
+	IssueCloneAttachmentStep = "attachment" // The attachment couldn't be downloaded or re-uploaded
+)
+
+// IssueCloneDefaultFields are the fields copied to the clone when the options don't define them.
+var IssueCloneDefaultFields = []string{
+	"summary", "description", "issuetype", "project", "parent", "priority", "labels", "components",
+	"fixVersions", "versions", "duedate", "environment", "assignee",
+}
+
+type IssueCloneOptionsScheme struct {
+	Fields        []string // The fields copied to the clone, IssueCloneDefaultFields is used when they're not set
+	SummaryPrefix string   // The text added before the summary of the clones, e.g. "CLONE - "
+	Subtasks      bool     // Clone the subtasks of the issue under the new issue
+	Links         bool     // Re-create the issue links, the links between cloned issues point to the clones
+	Attachments   bool     // Download the attachments and upload them to the clones
+}
+
+type IssueCloneResultScheme struct {
+	IssueKey    string            // The key of the new issue
+	Issues      map[string]string // The keys of the source issues mapped to the keys of the clones, subtasks included
+	Links       int               // The number of issue links re-created
+	Attachments int               // The number of attachments re-uploaded
+	Failures    []*IssueCloneFailureScheme
+}
+
+// IssueCloneFailureScheme represents a part of the issue that couldn't be cloned, the rest of the clone is kept.
+type IssueCloneFailureScheme struct {
+	IssueKey string // The key of the source issue
+	Step     string // The part of the issue, e.g. IssueCloneLinkStep
+	ID       string // The id of the link or attachment, empty for the subtasks
+	Err      error
+}
+
+// Failed reports whether a part of the issue couldn't be cloned.
+func (i *IssueCloneResultScheme) Failed() bool {
+	return len(i.Failures) != 0
+}
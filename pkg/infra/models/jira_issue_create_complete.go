@@ -0,0 +1,57 @@
+package models
+
+import "io"
+
+const (
+	IssueCreateAttachmentStep = "attachment" // The attachment couldn't be uploaded
+	IssueCreateLinkStep       = "link"       // The issue link couldn't be created
+	IssueCreateWatcherStep    = "watcher"    // The watcher couldn't be added
+	IssueCreateCommentStep    = "comment"    // The initial comment couldn't be added
+)
+
+// IssueCreateExtrasScheme represents the extras added to an issue created with the ADF format, the extras are
+// added in order: attachments, links, watchers and the comment.
+type IssueCreateExtrasScheme struct {
+	Attachments []*IssueCreateAttachmentScheme
+	Links       []*IssueCreateLinkScheme
+	Watchers    []string              // The account ids of the watchers
+	Comment     *CommentPayloadScheme // The initial comment, skipped when nil
+}
+
+// IssueCreateExtrasSchemeV2 represents the extras added to an issue created with the rich text format, the extras are
+// added in order: attachments, links, watchers and the comment.
+type IssueCreateExtrasSchemeV2 struct {
+	Attachments []*IssueCreateAttachmentScheme
+	Links       []*IssueCreateLinkScheme
+	Watchers    []string                // The account ids of the watchers
+	Comment     *CommentPayloadSchemeV2 // The initial comment, skipped when nil
+}
+
+type IssueCreateAttachmentScheme struct {
+	Name   string    // The file name of the attachment
+	Reader io.Reader // The content of the attachment
+}
+
+// IssueCreateLinkScheme represents a link from the new issue, the new issue is the inward issue of the link.
+type IssueCreateLinkScheme struct {
+	TypeName   string // The name of the issue link type, e.g. "Blocks"
+	OutwardKey string // The key of the outward issue
+}
+
+type IssueCreateCompleteResultScheme struct {
+	Issue     *IssueResponseScheme // The new issue, it's kept when the extras fail
+	Succeeded []*IssueCreateStepScheme
+	Failures  []*IssueCreateStepScheme
+}
+
+// IssueCreateStepScheme represents an extra added to the new issue.
+type IssueCreateStepScheme struct {
+	Step   string // The kind of extra, e.g. IssueCreateLinkStep
+	Target string // The attachment name, the outward issue key or the watcher account id, empty for the comment
+	Err    error  // The reason of the failure, nil for the succeeded extras
+}
+
+// Failed reports whether an extra couldn't be added to the new issue.
+func (i *IssueCreateCompleteResultScheme) Failed() bool {
+	return len(i.Failures) != 0
+}
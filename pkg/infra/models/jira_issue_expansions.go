@@ -0,0 +1,104 @@
+package models
+
+import "encoding/json"
+
+type IssueRenderedFieldsScheme struct {
+	Description string                          `json:"description,omitempty"`
+	Environment string                          `json:"environment,omitempty"`
+	Comment     *IssueRenderedCommentPageScheme `json:"comment,omitempty"`
+}
+
+type IssueRenderedCommentPageScheme struct {
+	StartAt    int                           `json:"startAt,omitempty"`
+	MaxResults int                           `json:"maxResults,omitempty"`
+	Total      int                           `json:"total,omitempty"`
+	Comments   []*IssueRenderedCommentScheme `json:"comments,omitempty"`
+}
+
+type IssueRenderedCommentScheme struct {
+	Self    string      `json:"self,omitempty"`
+	ID      string      `json:"id,omitempty"`
+	Author  *UserScheme `json:"author,omitempty"`
+	Body    string      `json:"body,omitempty"`
+	Created string      `json:"created,omitempty"`
+	Updated string      `json:"updated,omitempty"`
+}
+
+// Rendered returns the HTML rendered fields, the issue must be fetched using the "renderedFields" expand.
+func (i *IssueScheme) Rendered() (*IssueRenderedFieldsScheme, error) {
+	return parseRenderedFields(i.RenderedFields)
+}
+
+// RenderedField returns the HTML value of a rendered field by its id, e.g. a text custom field.
+// The value is not found when the issue wasn't fetched using the "renderedFields" expand or the field isn't rendered.
+func (i *IssueScheme) RenderedField(fieldID string) (string, bool) {
+	return parseRenderedField(i.RenderedFields, fieldID)
+}
+
+// FieldIDsByName inverts the field names returned by the "names" expand, mapping the field display names to the field ids.
+func (i *IssueScheme) FieldIDsByName() map[string]string {
+	return invertFieldNames(i.Names)
+}
+
+// Rendered returns the HTML rendered fields, the issue must be fetched using the "renderedFields" expand.
+func (i *IssueSchemeV2) Rendered() (*IssueRenderedFieldsScheme, error) {
+	return parseRenderedFields(i.RenderedFields)
+}
+
+// RenderedField returns the HTML value of a rendered field by its id, e.g. a text custom field.
+// The value is not found when the issue wasn't fetched using the "renderedFields" expand or the field isn't rendered.
+func (i *IssueSchemeV2) RenderedField(fieldID string) (string, bool) {
+	return parseRenderedField(i.RenderedFields, fieldID)
+}
+
+// FieldIDsByName inverts the field names returned by the "names" expand, mapping the field display names to the field ids.
+func (i *IssueSchemeV2) FieldIDsByName() map[string]string {
+	return invertFieldNames(i.Names)
+}
+
+func parseRenderedFields(raw json.RawMessage) (*IssueRenderedFieldsScheme, error) {
+
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	fields := new(IssueRenderedFieldsScheme)
+	if err := json.Unmarshal(raw, fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func parseRenderedField(raw json.RawMessage, fieldID string) (string, bool) {
+
+	if len(fieldID) == 0 || len(raw) == 0 {
+		return "", false
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", false
+	}
+
+	var value *string
+	if err := json.Unmarshal(fields[fieldID], &value); err != nil || value == nil {
+		return "", false
+	}
+
+	return *value, true
+}
+
+func invertFieldNames(names map[string]string) map[string]string {
+
+	if names == nil {
+		return nil
+	}
+
+	fieldIDs := make(map[string]string, len(names))
+	for fieldID, name := range names {
+		fieldIDs[name] = fieldID
+	}
+
+	return fieldIDs
+}
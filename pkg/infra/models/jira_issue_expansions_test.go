@@ -0,0 +1,77 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueScheme_Expansions(t *testing.T) {
+
+	payload := []byte(`{
+		"key": "KP-1",
+		"renderedFields": {
+			"description": "<p>description</p>",
+			"environment": null,
+			"customfield_10010": "<p>text field</p>",
+			"comment": {
+				"startAt": 0,
+				"maxResults": 1,
+				"total": 1,
+				"comments": [{"id": "10000", "body": "<p>comment</p>", "created": "Today 10:00 AM"}]
+			}
+		},
+		"names": {"summary": "Summary", "customfield_10010": "Story notes"},
+		"schema": {"customfield_10010": {"type": "string", "custom": "com.atlassian.jira.plugin.system.customfieldtypes:textarea", "customId": 10010}}
+	}`)
+
+	issue := new(IssueScheme)
+	assert.NoError(t, json.Unmarshal(payload, issue))
+
+	rendered, err := issue.Rendered()
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>description</p>", rendered.Description)
+	assert.Equal(t, "", rendered.Environment)
+	assert.Equal(t, "<p>comment</p>", rendered.Comment.Comments[0].Body)
+
+	html, ok := issue.RenderedField("customfield_10010")
+	assert.True(t, ok)
+	assert.Equal(t, "<p>text field</p>", html)
+
+	_, ok = issue.RenderedField("environment")
+	assert.False(t, ok)
+
+	_, ok = issue.RenderedField("customfield_10020")
+	assert.False(t, ok)
+
+	_, ok = issue.RenderedField("comment")
+	assert.False(t, ok)
+
+	_, ok = issue.RenderedField("")
+	assert.False(t, ok)
+
+	assert.Equal(t, map[string]string{"Summary": "summary", "Story notes": "customfield_10010"}, issue.FieldIDsByName())
+	assert.Equal(t, 10010, issue.Schema["customfield_10010"].CustomID)
+
+	issueV2 := new(IssueSchemeV2)
+	assert.NoError(t, json.Unmarshal(payload, issueV2))
+
+	renderedV2, err := issueV2.Rendered()
+	assert.NoError(t, err)
+	assert.Equal(t, rendered, renderedV2)
+	assert.Equal(t, issue.FieldIDsByName(), issueV2.FieldIDsByName())
+
+	htmlV2, ok := issueV2.RenderedField("customfield_10010")
+	assert.True(t, ok)
+	assert.Equal(t, html, htmlV2)
+
+	empty := new(IssueScheme)
+	rendered, err = empty.Rendered()
+	assert.NoError(t, err)
+	assert.Nil(t, rendered)
+	assert.Nil(t, empty.FieldIDsByName())
+
+	_, ok = empty.RenderedField("description")
+	assert.False(t, ok)
+}
@@ -0,0 +1,61 @@
+package models_test
+
+import (
+	"encoding/json"
+	"fmt"
+	models "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// The payloads to create an epic, a story on the epic and a sub-task on the story.
+//
+// The story payload uses the parent field of the team-managed projects, in the company-managed projects the epic link
+// field returned by the ProjectService.EpicLinkField method is passed to SetEpic and the custom fields are merged into the payload.
+func ExampleIssueScheme_SetEpic() {
+
+	epic := &models.IssueScheme{
+		Fields: &models.IssueFieldsScheme{
+			Project:   &models.ProjectScheme{Key: "KP"},
+			IssueType: &models.IssueTypeScheme{Name: "Epic"},
+			Summary:   "Checkout redesign",
+		},
+	}
+
+	// The epic key is returned by the Issue.Create method, e.g. KP-1
+	story := &models.IssueScheme{
+		Fields: &models.IssueFieldsScheme{
+			Project:   &models.ProjectScheme{Key: "KP"},
+			IssueType: &models.IssueTypeScheme{Name: "Story"},
+			Summary:   "Payment form",
+		},
+	}
+
+	if err := story.SetEpic("KP-1", "", nil); err != nil {
+		panic(err)
+	}
+
+	// The story key is returned by the Issue.Create method, e.g. KP-2
+	subtask := &models.IssueScheme{
+		Fields: &models.IssueFieldsScheme{
+			Project:   &models.ProjectScheme{Key: "KP"},
+			IssueType: &models.IssueTypeScheme{Name: "Sub-task"},
+			Summary:   "Card validation",
+		},
+	}
+
+	subtask.SetParent("KP-2")
+
+	for _, payload := range []*models.IssueScheme{epic, story, subtask} {
+
+		payloadAsBytes, err := json.Marshal(payload)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(string(payloadAsBytes))
+	}
+
+	// Output:
+	// {"fields":{"issuetype":{"name":"Epic"},"project":{"key":"KP"},"summary":"Checkout redesign"}}
+	// {"fields":{"parent":{"key":"KP-1"},"issuetype":{"name":"Story"},"project":{"key":"KP"},"summary":"Payment form"}}
+	// {"fields":{"parent":{"key":"KP-2"},"issuetype":{"name":"Sub-task"},"project":{"key":"KP"},"summary":"Card validation"}}
+}
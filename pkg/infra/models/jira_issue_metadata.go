@@ -1,5 +1,11 @@
 package models
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
 type IssueMetadataCreateOptions struct {
 	ProjectIDs     []string
 	ProjectKeys    []string
@@ -7,3 +13,115 @@ type IssueMetadataCreateOptions struct {
 	IssueTypeNames []string
 	Expand         string
 }
+
+type IssueFieldCreateMetadataPageScheme struct {
+	PageMeta
+	Fields  []*FieldMetadataScheme `json:"fields,omitempty"`
+	Results []*FieldMetadataScheme `json:"results,omitempty"`
+}
+
+// IssueTypeCreateMetadataPageScheme represents a page of the issue types of a project, the Cloud instances return
+// the issue types on IssueTypes and the Data Center instances on Values.
+type IssueTypeCreateMetadataPageScheme struct {
+	PageMeta
+	IssueTypes []*IssueTypeCreateMetadataScheme `json:"issueTypes,omitempty"`
+	Values     []*IssueTypeCreateMetadataScheme `json:"values,omitempty"`
+}
+
+// ProjectIssueCreateMetadataScheme represents the create screen fields of the issue types of the projects.
+type ProjectIssueCreateMetadataScheme struct {
+	Expand   string                         `json:"expand,omitempty"`
+	Projects []*ProjectCreateMetadataScheme `json:"projects,omitempty"`
+}
+
+type ProjectCreateMetadataScheme struct {
+	Self       string                           `json:"self,omitempty"`
+	ID         string                           `json:"id,omitempty"`
+	Key        string                           `json:"key,omitempty"`
+	Name       string                           `json:"name,omitempty"`
+	AvatarUrls *AvatarURLScheme                 `json:"avatarUrls,omitempty"`
+	IssueTypes []*IssueTypeCreateMetadataScheme `json:"issuetypes,omitempty"`
+}
+
+// IssueTypeCreateMetadataScheme represents an issue type of a project, the fields are only returned
+// when the projects.issuetypes.fields expand is requested, they're mapped by field id.
+type IssueTypeCreateMetadataScheme struct {
+	Self             string                          `json:"self,omitempty"`
+	ID               string                          `json:"id,omitempty"`
+	Description      string                          `json:"description,omitempty"`
+	IconURL          string                          `json:"iconUrl,omitempty"`
+	Name             string                          `json:"name,omitempty"`
+	UntranslatedName string                          `json:"untranslatedName,omitempty"`
+	Subtask          bool                            `json:"subtask,omitempty"`
+	Expand           string                          `json:"expand,omitempty"`
+	Fields           map[string]*FieldMetadataScheme `json:"fields,omitempty"`
+}
+
+// IssueEditMetadataScheme represents the edit screen fields of an issue, mapped by field id.
+type IssueEditMetadataScheme struct {
+	Fields map[string]*FieldMetadataScheme `json:"fields,omitempty"`
+}
+
+type FieldMetadataScheme struct {
+	Required        bool                       `json:"required,omitempty"`
+	Schema          *IssueFieldSchemaScheme    `json:"schema,omitempty"`
+	Name            string                     `json:"name,omitempty"`
+	FieldID         string                     `json:"fieldId,omitempty"`
+	Key             string                     `json:"key,omitempty"`
+	AutoCompleteURL string                     `json:"autoCompleteUrl,omitempty"`
+	HasDefaultValue bool                       `json:"hasDefaultValue,omitempty"`
+	Operations      []string                   `json:"operations,omitempty"`
+	AllowedValues   []*FieldAllowedValueScheme `json:"allowedValues,omitempty"`
+	DefaultValue    interface{}                `json:"defaultValue,omitempty"`
+}
+
+// FieldAllowedValueScheme represents an allowed value of a field, the attributes depend on the field type,
+// so the common attributes are mapped and the original value is stored on the Raw attribute.
+type FieldAllowedValueScheme struct {
+	Self     string          `json:"self,omitempty"`
+	ID       string          `json:"id,omitempty"`
+	Key      string          `json:"key,omitempty"`
+	Name     string          `json:"name,omitempty"`
+	Value    string          `json:"value,omitempty"`
+	Disabled bool            `json:"disabled,omitempty"`
+	Raw      json.RawMessage `json:"-"`
+}
+
+func (f *FieldAllowedValueScheme) UnmarshalJSON(data []byte) error {
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	*f = FieldAllowedValueScheme{Raw: append(json.RawMessage{}, data...)}
+
+	attributes, isObject := value.(map[string]interface{})
+	if !isObject {
+		// Some fields, e.g. the labels, return the allowed values as scalars
+		f.Value = fmt.Sprint(value)
+		return nil
+	}
+
+	attributeAsString := func(name string) string {
+
+		attribute, ok := attributes[name]
+		if !ok || attribute == nil {
+			return ""
+		}
+
+		return fmt.Sprint(attribute)
+	}
+
+	f.Self = attributeAsString("self")
+	f.ID = attributeAsString("id")
+	f.Key = attributeAsString("key")
+	f.Name = attributeAsString("name")
+	f.Value = attributeAsString("value")
+	f.Disabled, _ = attributes["disabled"].(bool)
+
+	return nil
+}
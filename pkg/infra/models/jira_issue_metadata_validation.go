@@ -0,0 +1,244 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+const (
+	FieldViolationRequired     = "required"
+	FieldViolationAllowedValue = "allowed_value"
+	FieldViolationType         = "type"
+	FieldViolationNotOnScreen  = "not_on_screen"
+)
+
+type FieldViolationScheme struct {
+	FieldID string `json:"fieldId,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// ValidateCreatePayload validates the issue create payload against the create metadata of the issue type.
+//
+// The payload should contain the "fields" object, e.g. the map returned by the IssueScheme.MergeCustomFields method.
+// It checks the required fields are present, the fields are on the create screen, the values are among the allowed values
+// and the values match the field type.
+// A nil slice is returned if the payload doesn't contain violations.
+func ValidateCreatePayload(metadata []*FieldMetadataScheme, payload map[string]interface{}) ([]*FieldViolationScheme, error) {
+
+	if payload == nil {
+		return nil, ErrNilPayloadError
+	}
+
+	// Normalize the payload values, the custom fields are merged as Go values instead of JSON values
+	payloadAsBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := struct {
+		Fields map[string]interface{} `json:"fields"`
+	}{}
+
+	if err := json.Unmarshal(payloadAsBytes, &normalized); err != nil {
+		return nil, err
+	}
+
+	var (
+		violations []*FieldViolationScheme
+		fieldIDs   = make(map[string]bool)
+	)
+
+	for _, field := range metadata {
+
+		if field == nil {
+			continue
+		}
+
+		fieldID := field.FieldID
+		if fieldID == "" {
+			fieldID = field.Key
+		}
+
+		fieldIDs[fieldID] = true
+
+		value, isPresent := normalized.Fields[fieldID]
+		if !isPresent || isEmptyFieldValue(value) {
+
+			if field.Required && !field.HasDefaultValue {
+				violations = append(violations, &FieldViolationScheme{
+					FieldID: fieldID,
+					Code:    FieldViolationRequired,
+					Reason:  fmt.Sprintf("the field %v is required", field.Name),
+				})
+			}
+
+			continue
+		}
+
+		if violation := validateFieldType(fieldID, field, value); violation != nil {
+			violations = append(violations, violation)
+			continue
+		}
+
+		violations = append(violations, validateAllowedValues(fieldID, field, value)...)
+	}
+
+	for _, fieldID := range sortedFieldIDs(normalized.Fields) {
+
+		if fieldIDs[fieldID] {
+			continue
+		}
+
+		violations = append(violations, &FieldViolationScheme{
+			FieldID: fieldID,
+			Code:    FieldViolationNotOnScreen,
+			Reason:  fmt.Sprintf("the field %v is not on the create screen", fieldID),
+		})
+	}
+
+	return violations, nil
+}
+
+func isEmptyFieldValue(value interface{}) bool {
+
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+
+	return false
+}
+
+func validateFieldType(fieldID string, field *FieldMetadataScheme, value interface{}) *FieldViolationScheme {
+
+	if field.Schema == nil {
+		return nil
+	}
+
+	var isValid bool
+	switch field.Schema.Type {
+	case "string":
+
+		switch value := value.(type) {
+		case string:
+			isValid = true
+		case map[string]interface{}:
+			// The v3 text fields are documents in the Atlassian Document Format
+			isValid = value["type"] == "doc"
+		}
+
+	case "number":
+		_, isValid = value.(float64)
+	case "array":
+		_, isValid = value.([]interface{})
+	case "date", "datetime":
+		_, isValid = value.(string)
+	case "any", "":
+		isValid = true
+	default:
+		// The remaining types (user, option, priority, version, etc) are sent as objects
+		_, isValid = value.(map[string]interface{})
+	}
+
+	if isValid {
+		return nil
+	}
+
+	return &FieldViolationScheme{
+		FieldID: fieldID,
+		Code:    FieldViolationType,
+		Reason:  fmt.Sprintf("the field %v expects a value of type %v", field.Name, field.Schema.Type),
+	}
+}
+
+func validateAllowedValues(fieldID string, field *FieldMetadataScheme, value interface{}) []*FieldViolationScheme {
+
+	if len(field.AllowedValues) == 0 {
+		return nil
+	}
+
+	values, isArray := value.([]interface{})
+	if !isArray {
+		values = []interface{}{value}
+	}
+
+	var violations []*FieldViolationScheme
+	for _, element := range values {
+
+		if isAllowedValue(field.AllowedValues, element) {
+			continue
+		}
+
+		violations = append(violations, &FieldViolationScheme{
+			FieldID: fieldID,
+			Code:    FieldViolationAllowedValue,
+			Reason:  fmt.Sprintf("the value %v is not allowed on the field %v", describeFieldValue(element), field.Name),
+		})
+	}
+
+	return violations
+}
+
+func isAllowedValue(allowedValues []*FieldAllowedValueScheme, value interface{}) bool {
+
+	attributes, isObject := value.(map[string]interface{})
+	if !isObject {
+
+		for _, allowedValue := range allowedValues {
+			if allowedValue.Value == fmt.Sprint(value) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, allowedValue := range allowedValues {
+
+		candidates := map[string]string{
+			"id":    allowedValue.ID,
+			"key":   allowedValue.Key,
+			"name":  allowedValue.Name,
+			"value": allowedValue.Value,
+		}
+
+		for attribute, candidate := range candidates {
+
+			expected, ok := attributes[attribute]
+			if ok && candidate != "" && fmt.Sprint(expected) == candidate {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func describeFieldValue(value interface{}) string {
+
+	valueAsBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(valueAsBytes)
+}
+
+func sortedFieldIDs(fields map[string]interface{}) []string {
+
+	fieldIDs := make([]string, 0, len(fields))
+	for fieldID := range fields {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+
+	sort.Strings(fieldIDs)
+	return fieldIDs
+}
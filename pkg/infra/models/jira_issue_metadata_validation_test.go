@@ -0,0 +1,210 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidateCreatePayload(t *testing.T) {
+
+	metadataMocked := []*FieldMetadataScheme{
+		{FieldID: "project", Name: "Project", Required: true, Schema: &IssueFieldSchemaScheme{Type: "project"}},
+		{FieldID: "summary", Name: "Summary", Required: true, Schema: &IssueFieldSchemaScheme{Type: "string"}},
+		{FieldID: "description", Name: "Description", Schema: &IssueFieldSchemaScheme{Type: "string"}},
+		{FieldID: "reporter", Name: "Reporter", Required: true, HasDefaultValue: true, Schema: &IssueFieldSchemaScheme{Type: "user"}},
+		{FieldID: "customfield_10042", Name: "Story Points", Schema: &IssueFieldSchemaScheme{Type: "number"}},
+		{
+			FieldID:       "labels",
+			Name:          "Labels",
+			Schema:        &IssueFieldSchemaScheme{Type: "array", Items: "string"},
+			AllowedValues: nil,
+		},
+		{
+			FieldID: "customfield_10050",
+			Name:    "Teams",
+			Schema:  &IssueFieldSchemaScheme{Type: "array", Items: "option"},
+			AllowedValues: []*FieldAllowedValueScheme{
+				{ID: "10100", Value: "Backend"},
+				{ID: "10101", Value: "Frontend"},
+			},
+		},
+	}
+
+	customFieldsMocked := &CustomFields{}
+	_ = customFieldsMocked.MultiSelect("customfield_10050", []string{"Backend", "Mobile"})
+	_ = customFieldsMocked.Number("customfield_10042", 3)
+
+	payloadMocked, err := (&IssueScheme{
+		Fields: &IssueFieldsScheme{
+			Project: &ProjectScheme{Key: "DUMMY"},
+			Labels:  []string{"triaged"},
+			Description: &CommentNodeScheme{
+				Version: 1,
+				Type:    "doc",
+			},
+		},
+	}).MergeCustomFields(customFieldsMocked)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		metadata []*FieldMetadataScheme
+		payload  map[string]interface{}
+		want     []*FieldViolationScheme
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name:     "when the payload contains violations",
+			metadata: metadataMocked,
+			payload:  payloadMocked,
+			want: []*FieldViolationScheme{
+				{FieldID: "summary", Code: FieldViolationRequired, Reason: "the field Summary is required"},
+				{FieldID: "customfield_10050", Code: FieldViolationAllowedValue, Reason: `the value {"value":"Mobile"} is not allowed on the field Teams`},
+			},
+		},
+
+		{
+			name:     "when the field types don't match",
+			metadata: metadataMocked,
+			payload: map[string]interface{}{
+				"fields": map[string]interface{}{
+					"project":           map[string]interface{}{"key": "DUMMY"},
+					"summary":           10,
+					"customfield_10042": "3",
+				},
+			},
+			want: []*FieldViolationScheme{
+				{FieldID: "summary", Code: FieldViolationType, Reason: "the field Summary expects a value of type string"},
+				{FieldID: "customfield_10042", Code: FieldViolationType, Reason: "the field Story Points expects a value of type number"},
+			},
+		},
+
+		{
+			name:     "when the payload contains fields not available on the screen",
+			metadata: metadataMocked,
+			payload: map[string]interface{}{
+				"fields": map[string]interface{}{
+					"project":     map[string]interface{}{"key": "DUMMY"},
+					"summary":     "Summary",
+					"environment": "Production",
+				},
+			},
+			want: []*FieldViolationScheme{
+				{FieldID: "environment", Code: FieldViolationNotOnScreen, Reason: "the field environment is not on the create screen"},
+			},
+		},
+
+		{
+			name:     "when the payload is valid",
+			metadata: metadataMocked,
+			payload: map[string]interface{}{
+				"fields": map[string]interface{}{
+					"project":           map[string]interface{}{"key": "DUMMY"},
+					"summary":           "Summary",
+					"customfield_10050": []map[string]interface{}{{"id": "10101"}},
+				},
+			},
+			want: nil,
+		},
+
+		{
+			name:     "when the payload is not provided",
+			metadata: metadataMocked,
+			payload:  nil,
+			wantErr:  true,
+			Err:      ErrNilPayloadError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := ValidateCreatePayload(testCase.metadata, testCase.payload)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestFieldAllowedValueScheme_UnmarshalJSON(t *testing.T) {
+
+	var values []*FieldAllowedValueScheme
+	data := `[{"self":"https://ctreminiom.atlassian.net/rest/api/3/customFieldOption/10100","id":"10100","value":"Backend","disabled":true},{"id":10000,"name":"DUMMY","key":"DUMMY"},"triaged"]`
+
+	assert.NoError(t, json.Unmarshal([]byte(data), &values))
+	assert.Equal(t, 3, len(values))
+
+	assert.Equal(t, "10100", values[0].ID)
+	assert.Equal(t, "Backend", values[0].Value)
+	assert.True(t, values[0].Disabled)
+	assert.JSONEq(t, `{"self":"https://ctreminiom.atlassian.net/rest/api/3/customFieldOption/10100","id":"10100","value":"Backend","disabled":true}`, string(values[0].Raw))
+
+	assert.Equal(t, "10000", values[1].ID)
+	assert.Equal(t, "DUMMY", values[1].Key)
+
+	assert.Equal(t, "triaged", values[2].Value)
+}
+
+func TestIssueEditMetadataScheme_UnmarshalJSON(t *testing.T) {
+
+	var metadata IssueEditMetadataScheme
+	data := `{"fields":{
+		"summary":{"required":true,"schema":{"type":"string","system":"summary"},"name":"Summary","key":"summary","operations":["set"]},
+		"priority":{"required":false,"schema":{"type":"priority","system":"priority"},"name":"Priority","key":"priority",
+			"operations":["set"],"allowedValues":[{"self":"https://ctreminiom.atlassian.net/rest/api/3/priority/1","name":"Highest","id":"1"}]}}}`
+
+	assert.NoError(t, json.Unmarshal([]byte(data), &metadata))
+	assert.Len(t, metadata.Fields, 2)
+
+	assert.True(t, metadata.Fields["summary"].Required)
+	assert.Equal(t, "string", metadata.Fields["summary"].Schema.Type)
+	assert.Equal(t, []string{"set"}, metadata.Fields["summary"].Operations)
+
+	assert.Equal(t, "Priority", metadata.Fields["priority"].Name)
+	assert.Equal(t, "1", metadata.Fields["priority"].AllowedValues[0].ID)
+	assert.Equal(t, "Highest", metadata.Fields["priority"].AllowedValues[0].Name)
+}
+
+func TestProjectIssueCreateMetadataScheme_UnmarshalJSON(t *testing.T) {
+
+	var metadata ProjectIssueCreateMetadataScheme
+	data := `{"expand":"projects","projects":[{"self":"https://ctreminiom.atlassian.net/rest/api/3/project/10000","id":"10000",
+		"key":"DUMMY","name":"Dummy","avatarUrls":{"48x48":"https://ctreminiom.atlassian.net/avatar/48"},
+		"issuetypes":[{"id":"10001","name":"Bug","subtask":false,"expand":"fields","fields":{
+			"components":{"required":true,"schema":{"type":"array","items":"component","system":"components"},"name":"Components",
+				"key":"components","hasDefaultValue":false,"operations":["add","set","remove"],
+				"allowedValues":[{"self":"https://ctreminiom.atlassian.net/rest/api/3/component/10000","id":"10000","name":"Backend"}]},
+			"labels":{"required":false,"schema":{"type":"array","items":"string","system":"labels"},"name":"Labels","key":"labels",
+				"hasDefaultValue":true,"defaultValue":["triaged"],"operations":["add","set","remove"]}}}]}]}`
+
+	assert.NoError(t, json.Unmarshal([]byte(data), &metadata))
+	assert.Len(t, metadata.Projects, 1)
+
+	project := metadata.Projects[0]
+	assert.Equal(t, "DUMMY", project.Key)
+	assert.Equal(t, "https://ctreminiom.atlassian.net/avatar/48", project.AvatarUrls.Four8X48)
+	assert.Len(t, project.IssueTypes, 1)
+
+	issueType := project.IssueTypes[0]
+	assert.Equal(t, "Bug", issueType.Name)
+	assert.Len(t, issueType.Fields, 2)
+
+	components := issueType.Fields["components"]
+	assert.True(t, components.Required)
+	assert.Equal(t, "component", components.Schema.Items)
+	assert.Equal(t, "Backend", components.AllowedValues[0].Name)
+	assert.JSONEq(t, `{"self":"https://ctreminiom.atlassian.net/rest/api/3/component/10000","id":"10000","name":"Backend"}`,
+		string(components.AllowedValues[0].Raw))
+
+	labels := issueType.Fields["labels"]
+	assert.True(t, labels.HasDefaultValue)
+	assert.Equal(t, []interface{}{"triaged"}, labels.DefaultValue)
+}
@@ -57,3 +57,158 @@ func (u *UpdateOperations) AddStringOperation(customFieldID, operation, value st
 
 	return nil
 }
+
+// AddMultiRawOperation adds the verb for the raw value of the field, the operations on the same field are kept,
+// e.g. the "add" verb with the {"name": "v1.0.0"} mapping adds a fix version without removing the others.
+func (u *UpdateOperations) AddMultiRawOperation(fieldID, operation string, mapping map[string]interface{}) error {
+
+	if len(operation) == 0 {
+		return ErrNoEditOperatorError
+	}
+
+	if len(mapping) == 0 {
+		return ErrNoEditValueError
+	}
+
+	return u.addOperation(fieldID, operation, mapping)
+}
+
+// NewUpdateOperations returns an empty update operations builder.
+//
+// The operations are serialized using the "update" notation used by the edit issue and transition payloads.
+func NewUpdateOperations() *UpdateOperations {
+	return &UpdateOperations{}
+}
+
+// AddLabel adds the "add" verb for the given label.
+func (u *UpdateOperations) AddLabel(label string) error {
+
+	if len(label) == 0 {
+		return ErrNoEditValueError
+	}
+
+	return u.addOperation("labels", "add", label)
+}
+
+// RemoveLabel adds the "remove" verb for the given label.
+func (u *UpdateOperations) RemoveLabel(label string) error {
+
+	if len(label) == 0 {
+		return ErrNoEditValueError
+	}
+
+	return u.addOperation("labels", "remove", label)
+}
+
+// AddComponentByName adds the "add" verb for the component with the given name.
+func (u *UpdateOperations) AddComponentByName(name string) error {
+	return u.addNamedOperation("components", "add", "name", name)
+}
+
+// AddComponentByID adds the "add" verb for the component with the given id.
+func (u *UpdateOperations) AddComponentByID(componentID string) error {
+	return u.addNamedOperation("components", "add", "id", componentID)
+}
+
+// RemoveComponentByName adds the "remove" verb for the component with the given name.
+func (u *UpdateOperations) RemoveComponentByName(name string) error {
+	return u.addNamedOperation("components", "remove", "name", name)
+}
+
+// RemoveComponentByID adds the "remove" verb for the component with the given id.
+func (u *UpdateOperations) RemoveComponentByID(componentID string) error {
+	return u.addNamedOperation("components", "remove", "id", componentID)
+}
+
+// AddFixVersionByName adds the "add" verb for the fix version with the given name.
+func (u *UpdateOperations) AddFixVersionByName(name string) error {
+	return u.addNamedOperation("fixVersions", "add", "name", name)
+}
+
+// AddFixVersionByID adds the "add" verb for the fix version with the given id.
+func (u *UpdateOperations) AddFixVersionByID(versionID string) error {
+	return u.addNamedOperation("fixVersions", "add", "id", versionID)
+}
+
+// RemoveFixVersionByName adds the "remove" verb for the fix version with the given name.
+func (u *UpdateOperations) RemoveFixVersionByName(name string) error {
+	return u.addNamedOperation("fixVersions", "remove", "name", name)
+}
+
+// RemoveFixVersionByID adds the "remove" verb for the fix version with the given id.
+func (u *UpdateOperations) RemoveFixVersionByID(versionID string) error {
+	return u.addNamedOperation("fixVersions", "remove", "id", versionID)
+}
+
+// SetField adds the "set" verb for the given field.
+func (u *UpdateOperations) SetField(fieldID string, value interface{}) error {
+	return u.addOperation(fieldID, "set", value)
+}
+
+// AddIssueLink adds the "add" verb to the issuelinks field, linking the issue to the outward issue key
+//
+// using the issue link type name, e.g: "Blocks".
+func (u *UpdateOperations) AddIssueLink(typeName, outwardIssueKey string) error {
+
+	if len(typeName) == 0 {
+		return ErrNoLinkTypeNameError
+	}
+
+	if len(outwardIssueKey) == 0 {
+		return ErrNoIssueKeyOrIDError
+	}
+
+	link := map[string]interface{}{
+		"type":         map[string]interface{}{"name": typeName},
+		"outwardIssue": map[string]interface{}{"key": outwardIssueKey},
+	}
+
+	return u.addOperation("issuelinks", "add", link)
+}
+
+func (u *UpdateOperations) addNamedOperation(fieldID, operation, attribute, value string) error {
+
+	if len(value) == 0 {
+		return ErrNoEditValueError
+	}
+
+	return u.addOperation(fieldID, operation, map[string]interface{}{attribute: value})
+}
+
+// addOperation appends the operation to the field node, the operations on the same field are
+//
+// kept in insertion order, so they're sent to Jira in the same order they were added.
+func (u *UpdateOperations) addOperation(fieldID, operation string, value interface{}) error {
+
+	if len(fieldID) == 0 {
+		return ErrNoFieldIDError
+	}
+
+	var operationNode = map[string]interface{}{}
+	operationNode[operation] = value
+
+	for _, node := range u.Fields {
+
+		fieldNode, ok := node["update"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		operations, ok := fieldNode[fieldID].([]map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		fieldNode[fieldID] = append(operations, operationNode)
+		return nil
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[fieldID] = []map[string]interface{}{operationNode}
+
+	var updateNode = map[string]interface{}{}
+	updateNode["update"] = fieldNode
+
+	u.Fields = append(u.Fields, updateNode)
+	return nil
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
 	"reflect"
 	"testing"
 )
@@ -141,3 +143,129 @@ func TestUpdateOperations_AddStringOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateOperations_Builder(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		build    func(operations *UpdateOperations) error
+		expected string
+	}{
+		{
+			name: "when the labels, summary and components are edited",
+			build: func(operations *UpdateOperations) error {
+
+				if err := operations.AddLabel("triaged"); err != nil {
+					return err
+				}
+
+				if err := operations.RemoveLabel("blocker"); err != nil {
+					return err
+				}
+
+				if err := operations.SetField("summary", "Bug in business logic"); err != nil {
+					return err
+				}
+
+				return operations.SetField("components", "")
+			},
+			expected: `{"update":{"components":[{"set":""}],"labels":[{"add":"triaged"},{"remove":"blocker"}],"summary":[{"set":"Bug in business logic"}]}}`,
+		},
+
+		{
+			name: "when the components and fix versions are edited",
+			build: func(operations *UpdateOperations) error {
+
+				if err := operations.AddComponentByName("backend"); err != nil {
+					return err
+				}
+
+				if err := operations.RemoveComponentByID("10000"); err != nil {
+					return err
+				}
+
+				if err := operations.AddFixVersionByID("10001"); err != nil {
+					return err
+				}
+
+				return operations.RemoveFixVersionByName("1.2")
+			},
+			expected: `{"update":{"components":[{"add":{"name":"backend"}},{"remove":{"id":"10000"}}],"fixVersions":[{"add":{"id":"10001"}},{"remove":{"name":"1.2"}}]}}`,
+		},
+
+		{
+			name: "when an issue link is added",
+			build: func(operations *UpdateOperations) error {
+				return operations.AddIssueLink("Duplicate", "PR-2")
+			},
+			expected: `{"update":{"issuelinks":[{"add":{"type":{"name":"Duplicate"},"outwardIssue":{"key":"PR-2"}}}]}}`,
+		},
+
+		{
+			name: "when raw operations are added on the same field",
+			build: func(operations *UpdateOperations) error {
+
+				if err := operations.AddStringOperation("labels", "add", "triaged"); err != nil {
+					return err
+				}
+
+				if err := operations.AddMultiRawOperation("fixVersions", "add", map[string]interface{}{"name": "1.2"}); err != nil {
+					return err
+				}
+
+				return operations.AddMultiRawOperation("fixVersions", "remove", map[string]interface{}{"id": "10001"})
+			},
+			expected: `{"update":{"fixVersions":[{"add":{"name":"1.2"}},{"remove":{"id":"10001"}}],"labels":[{"add":"triaged"}]}}`,
+		},
+
+		{
+			name: "when conflicting operations are added on the same field",
+			build: func(operations *UpdateOperations) error {
+
+				if err := operations.AddLabel("triaged"); err != nil {
+					return err
+				}
+
+				if err := operations.RemoveLabel("triaged"); err != nil {
+					return err
+				}
+
+				return operations.AddLabel("triaged")
+			},
+			expected: `{"update":{"labels":[{"add":"triaged"},{"remove":"triaged"},{"add":"triaged"}]}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			operations := NewUpdateOperations()
+			assert.NoError(t, testCase.build(operations))
+
+			payload, err := (&IssueScheme{}).MergeOperations(operations)
+			assert.NoError(t, err)
+
+			payloadAsBytes, err := json.Marshal(payload)
+			assert.NoError(t, err)
+
+			assert.JSONEq(t, testCase.expected, string(payloadAsBytes))
+		})
+	}
+}
+
+func TestUpdateOperations_BuilderErrors(t *testing.T) {
+
+	operations := NewUpdateOperations()
+
+	assert.EqualError(t, operations.AddLabel(""), ErrNoEditValueError.Error())
+	assert.EqualError(t, operations.RemoveLabel(""), ErrNoEditValueError.Error())
+	assert.EqualError(t, operations.AddComponentByName(""), ErrNoEditValueError.Error())
+	assert.EqualError(t, operations.AddFixVersionByID(""), ErrNoEditValueError.Error())
+	assert.EqualError(t, operations.SetField("", "value"), ErrNoFieldIDError.Error())
+	assert.EqualError(t, operations.AddIssueLink("", "PR-2"), ErrNoLinkTypeNameError.Error())
+	assert.EqualError(t, operations.AddIssueLink("Duplicate", ""), ErrNoIssueKeyOrIDError.Error())
+	assert.EqualError(t, operations.AddMultiRawOperation("", "add", map[string]interface{}{"name": "1.2"}), ErrNoFieldIDError.Error())
+	assert.EqualError(t, operations.AddMultiRawOperation("fixVersions", "", map[string]interface{}{"name": "1.2"}), ErrNoEditOperatorError.Error())
+	assert.EqualError(t, operations.AddMultiRawOperation("fixVersions", "add", nil), ErrNoEditValueError.Error())
+	assert.Equal(t, 0, len(operations.Fields))
+}
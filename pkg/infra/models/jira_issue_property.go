@@ -0,0 +1,30 @@
+package models
+
+import "encoding/json"
+
+type IssuePropertyKeysScheme struct {
+	Keys []*IssuePropertyKeyScheme `json:"keys,omitempty"`
+}
+
+type IssuePropertyKeyScheme struct {
+	Self string `json:"self,omitempty"`
+	Key  string `json:"key,omitempty"`
+}
+
+// IssuePropertyScheme is an issue property, the value is kept raw so it can be decoded into the type of the app state.
+type IssuePropertyScheme struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type IssuePropertyBulkPayloadScheme struct {
+	Value  interface{}                    `json:"value"`
+	Filter *IssuePropertyBulkFilterScheme `json:"filter,omitempty"`
+}
+
+// IssuePropertyBulkFilterScheme selects the issues of the bulk set, every issue the user can edit is updated when it's not set.
+type IssuePropertyBulkFilterScheme struct {
+	EntityIds    []int       `json:"entityIds,omitempty"`
+	CurrentValue interface{} `json:"currentValue,omitempty"`
+	HasProperty  *bool       `json:"hasProperty,omitempty"`
+}
@@ -1,5 +1,26 @@
 package models
 
+const (
+	SearchValidateStrict = "strict" // The search fails on the invalid or restricted clauses
+	SearchValidateWarn   = "warn"   // The invalid or restricted clauses are dropped and reported on the warning messages
+	SearchValidateNone   = "none"   // The invalid or restricted clauses are dropped silently
+
+	SearchPermissionWarningClause = "clause" // A JQL clause was dropped, e.g. a project or field the user can't browse
+	SearchPermissionWarningField  = "field"  // A requested field is missing on every issue returned, it may be outside the field context
+)
+
+// IssueSearchPermissionWarningScheme represents a part of the search the user couldn't access, the issues or the
+// fields were left out of the results without failing the search.
+type IssueSearchPermissionWarningScheme struct {
+	Kind    string // The part of the search, e.g. SearchPermissionWarningClause
+	Name    string // The name of the field referenced by the clause or the id of the field missing
+	Message string // The warning message returned by the site, empty for the missing fields
+}
+
+type IssueSearchApproximateCountScheme struct {
+	Count int `json:"count"`
+}
+
 type IssueSearchCheckPayloadScheme struct {
 	IssueIds []int    `json:"issueIds,omitempty"`
 	JQLs     []string `json:"jqls,omitempty"`
@@ -13,3 +34,26 @@ type IssueMatchesScheme struct {
 	MatchedIssues []int    `json:"matchedIssues,omitempty"`
 	Errors        []string `json:"errors,omitempty"`
 }
+
+type IssuePickerSuggestionsScheme struct {
+	Sections []*IssuePickerSectionScheme `json:"sections,omitempty"`
+}
+
+// IssuePickerSectionScheme is a group of suggestions, e.g. the history search or the current search.
+type IssuePickerSectionScheme struct {
+	ID     string                    `json:"id,omitempty"`
+	Label  string                    `json:"label,omitempty"`
+	Msg    string                    `json:"msg,omitempty"`
+	Sub    string                    `json:"sub,omitempty"`
+	Issues []*IssuePickerIssueScheme `json:"issues,omitempty"`
+}
+
+// IssuePickerIssueScheme is a suggested issue, the KeyHTML and the Summary highlight the matched characters.
+type IssuePickerIssueScheme struct {
+	ID          int    `json:"id,omitempty"`
+	Img         string `json:"img,omitempty"`
+	Key         string `json:"key,omitempty"`
+	KeyHTML     string `json:"keyHtml,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+	SummaryText string `json:"summaryText,omitempty"`
+}
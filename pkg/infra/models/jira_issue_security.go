@@ -5,8 +5,48 @@ type IssueSecurityLevelsScheme struct {
 }
 
 type IssueSecurityLevelScheme struct {
-	Self        string `json:"self,omitempty"`
-	ID          string `json:"id,omitempty"`
-	Description string `json:"description,omitempty"`
-	Name        string `json:"name,omitempty"`
+	Self                  string `json:"self,omitempty"`
+	ID                    string `json:"id,omitempty"`
+	Description           string `json:"description,omitempty"`
+	Name                  string `json:"name,omitempty"`
+	IsDefault             bool   `json:"isDefault,omitempty"`
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty"`
+}
+
+type IssueSecurityLevelPageScheme struct {
+	PageMeta
+	Values []*IssueSecurityLevelScheme `json:"values,omitempty"`
+}
+
+type IssueSecurityLevelSearchOptionsScheme struct {
+	IDs         []string
+	SchemeIDs   []string
+	OnlyDefault bool
+}
+
+type IssueSecurityLevelMemberScheme struct {
+	ID                    string                                `json:"id,omitempty"`
+	IssueSecurityLevelID  string                                `json:"issueSecurityLevelId,omitempty"`
+	IssueSecuritySchemeID string                                `json:"issueSecuritySchemeId,omitempty"`
+	Holder                *IssueSecurityLevelMemberHolderScheme `json:"holder,omitempty"`
+	Managed               bool                                  `json:"managed,omitempty"`
+}
+
+type IssueSecurityLevelMemberHolderScheme struct {
+	Type      string `json:"type,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Expand    string `json:"expand,omitempty"`
+}
+
+type IssueSecurityLevelMemberPageScheme struct {
+	PageMeta
+	Values []*IssueSecurityLevelMemberScheme `json:"values,omitempty"`
+}
+
+type IssueSecurityLevelMemberSearchOptionsScheme struct {
+	IDs       []string
+	SchemeIDs []string
+	LevelIDs  []string
+	Expand    []string
 }
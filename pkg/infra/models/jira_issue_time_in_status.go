@@ -0,0 +1,99 @@
+package models
+
+import "time"
+
+type TimeInStatusOptionsScheme struct {
+	BusinessHours    *BusinessHoursScheme // The calendar used to measure the intervals, the wall-clock time is used when it's not set
+	StopAtResolution bool                 // Whether the open interval of a resolved issue ends on the resolution date
+	Now              time.Time            // The end of the open interval, the current time by default
+}
+
+// BusinessHoursScheme represents a weekly calendar, e.g. Monday to Friday from 9:00 to 17:00.
+type BusinessHoursScheme struct {
+	Location *time.Location // The calendar timezone, UTC by default
+	Weekdays []time.Weekday // The working days, Monday to Friday by default
+	Start    time.Duration  // The start of the working hours since midnight
+	End      time.Duration  // The end of the working hours since midnight, the whole day is used when Start and End aren't set
+}
+
+// IssueTimeInStatusScheme represents the status intervals of an issue and the total duration of every status.
+type IssueTimeInStatusScheme struct {
+	IssueKey  string
+	Intervals []*IssueStatusIntervalScheme
+	Totals    map[string]time.Duration // The durations keyed by status name
+}
+
+type IssueStatusIntervalScheme struct {
+	StatusID string
+	Status   string
+	From     time.Time
+	To       time.Time
+	Duration time.Duration
+	Open     bool // Whether the issue is still on the status
+}
+
+// Duration returns the business time between the dates.
+func (b *BusinessHoursScheme) Duration(from, to time.Time) time.Duration {
+
+	if b == nil {
+		return to.Sub(from)
+	}
+
+	if !to.After(from) {
+		return 0
+	}
+
+	location := b.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	weekdays := b.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+
+	working := make(map[time.Weekday]bool, len(weekdays))
+	for _, weekday := range weekdays {
+		working[weekday] = true
+	}
+
+	start, end := b.Start, b.End
+	if start == 0 && end == 0 {
+		end = 24 * time.Hour
+	}
+
+	from, to = from.In(location), to.In(location)
+
+	var duration time.Duration
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location); day.Before(to); day = day.AddDate(0, 0, 1) {
+
+		if !working[day.Weekday()] {
+			continue
+		}
+
+		// The window ends are built from the wall-clock hour and minute, adding the duration to the midnight
+		// shifts the window by an hour on the days with a daylight saving change.
+		windowStart := businessHoursAt(day, start, location)
+		windowEnd := businessHoursAt(day, end, location)
+
+		if windowStart.Before(from) {
+			windowStart = from
+		}
+
+		if windowEnd.After(to) {
+			windowEnd = to
+		}
+
+		if windowEnd.After(windowStart) {
+			duration += windowEnd.Sub(windowStart)
+		}
+	}
+
+	return duration
+}
+
+// businessHoursAt returns the wall-clock time of the day, the 24h offset is the midnight of the next day.
+func businessHoursAt(day time.Time, offset time.Duration, location *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, location)
+}
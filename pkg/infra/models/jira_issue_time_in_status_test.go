@@ -0,0 +1,62 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBusinessHoursScheme_Duration(t *testing.T) {
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("the timezone database is not available")
+	}
+
+	// Friday 2023-01-06 at 15:00 UTC to Monday 2023-01-09 at 11:00 UTC
+	from := time.Date(2023, 1, 6, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 1, 9, 11, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		businessHours *BusinessHoursScheme
+		want          time.Duration
+	}{
+		{
+			name: "when the calendar is not set",
+			want: 68 * time.Hour,
+		},
+		{
+			name:          "when the whole working days are used",
+			businessHours: &BusinessHoursScheme{},
+			want:          20 * time.Hour,
+		},
+		{
+			name:          "when the working hours are set",
+			businessHours: &BusinessHoursScheme{Start: 9 * time.Hour, End: 17 * time.Hour},
+			want:          4 * time.Hour,
+		},
+		{
+			name:          "when the calendar has a timezone",
+			businessHours: &BusinessHoursScheme{Location: newYork, Start: 9 * time.Hour, End: 17 * time.Hour},
+			want:          7 * time.Hour,
+		},
+		{
+			name:          "when the weekdays are set",
+			businessHours: &BusinessHoursScheme{Weekdays: []time.Weekday{time.Saturday}, Start: 9 * time.Hour, End: 17 * time.Hour},
+			want:          8 * time.Hour,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.businessHours.Duration(from, to))
+		})
+	}
+
+	assert.Equal(t, time.Duration(0), (&BusinessHoursScheme{}).Duration(to, from))
+
+	// The daylight saving time starts on Sunday 2023-03-12 at 02:00 on New York, the window keeps the 09:00 to 17:00 EDT hours
+	dstBusinessHours := &BusinessHoursScheme{Location: newYork, Weekdays: []time.Weekday{time.Sunday}, Start: 9 * time.Hour, End: 17 * time.Hour}
+	assert.Equal(t, 8*time.Hour, dstBusinessHours.Duration(time.Date(2023, 3, 12, 13, 0, 0, 0, time.UTC), time.Date(2023, 3, 12, 21, 0, 0, 0, time.UTC)))
+}
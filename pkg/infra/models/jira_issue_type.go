@@ -1,5 +1,10 @@
 package models
 
+const (
+	IssueTypeHierarchyLevelStandard = 0  // The standard issue types, e.g. Task or Bug
+	IssueTypeHierarchyLevelSubtask  = -1 // The sub-task issue types
+)
+
 type IssueTypeScheme struct {
 	Self           string                `json:"self,omitempty"`
 	ID             string                `json:"id,omitempty"`
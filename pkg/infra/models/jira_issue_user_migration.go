@@ -0,0 +1,53 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	IssueUserMigrationActionVote   = "vote"   // The target account must vote on the issue
+	IssueUserMigrationActionUnvote = "unvote" // The source account must remove its vote from the issue
+)
+
+type IssueUserMigrationReportScheme struct {
+	Issues    []*IssueUserMigrationResultScheme // The result of each issue, in the search order
+	Processed []string                          // The keys of the issues completed, failed issues are not included
+}
+
+type IssueUserMigrationResultScheme struct {
+	IssueKey string
+	Migrated bool     // Whether the issue was migrated by the library
+	Actions  []string // The manual actions left to complete the migration, e.g. IssueUserMigrationActionVote
+	Err      error
+}
+
+// Failed returns the results of the issues that couldn't be processed.
+func (i *IssueUserMigrationReportScheme) Failed() []*IssueUserMigrationResultScheme {
+
+	var failed []*IssueUserMigrationResultScheme
+	for _, result := range i.Issues {
+
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// ExcludeProcessed returns the JQL query excluding the processed issues, use it to resume a migration.
+func (i *IssueUserMigrationReportScheme) ExcludeProcessed(jql string) string {
+
+	if len(i.Processed) == 0 {
+		return jql
+	}
+
+	exclusion := fmt.Sprintf("key NOT IN (%v)", strings.Join(i.Processed, ", "))
+
+	if jql == "" {
+		return exclusion
+	}
+
+	return fmt.Sprintf("(%v) AND %v", jql, exclusion)
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIssueUserMigrationReportScheme_ExcludeProcessed(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		processed []string
+		jql       string
+		want      string
+	}{
+		{
+			name:      "when issues were processed",
+			processed: []string{"KP-1", "KP-2"},
+			jql:       "project = KP OR project = KT",
+			want:      "(project = KP OR project = KT) AND key NOT IN (KP-1, KP-2)",
+		},
+		{
+			name:      "when the jql is not provided",
+			processed: []string{"KP-1"},
+			want:      "key NOT IN (KP-1)",
+		},
+		{
+			name: "when no issues were processed",
+			jql:  "project = KP",
+			want: "project = KP",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			report := &IssueUserMigrationReportScheme{Processed: testCase.processed}
+			assert.Equal(t, testCase.want, report.ExcludeProcessed(testCase.jql))
+		})
+	}
+}
+
+func TestIssueUserMigrationReportScheme_Failed(t *testing.T) {
+
+	report := &IssueUserMigrationReportScheme{
+		Issues: []*IssueUserMigrationResultScheme{
+			{IssueKey: "KP-1", Migrated: true},
+			{IssueKey: "KP-2", Err: errors.New("forbidden")},
+		},
+	}
+
+	assert.Equal(t, []*IssueUserMigrationResultScheme{{IssueKey: "KP-2", Err: errors.New("forbidden")}}, report.Failed())
+}
@@ -6,12 +6,15 @@ import (
 )
 
 type IssueSchemeV2 struct {
-	ID          string                   `json:"id,omitempty"`
-	Key         string                   `json:"key,omitempty"`
-	Self        string                   `json:"self,omitempty"`
-	Transitions []*IssueTransitionScheme `json:"transitions,omitempty"`
-	Changelog   *IssueChangelogScheme    `json:"changelog,omitempty"`
-	Fields      *IssueFieldsSchemeV2     `json:"fields,omitempty"`
+	ID             string                             `json:"id,omitempty"`
+	Key            string                             `json:"key,omitempty"`
+	Self           string                             `json:"self,omitempty"`
+	Transitions    []*IssueTransitionScheme           `json:"transitions,omitempty"`
+	Changelog      *IssueChangelogScheme              `json:"changelog,omitempty"`
+	Fields         *IssueFieldsSchemeV2               `json:"fields,omitempty"`
+	RenderedFields json.RawMessage                    `json:"renderedFields,omitempty"`
+	Names          map[string]string                  `json:"names,omitempty"`
+	Schema         map[string]*IssueFieldSchemaScheme `json:"schema,omitempty"`
 }
 
 func (i *IssueSchemeV2) MergeCustomFields(fields *CustomFields) (map[string]interface{}, error) {
@@ -84,6 +87,65 @@ func (i *IssueSchemeV2) ToMap() (map[string]interface{}, error) {
 	return issueSchemeAsMap, nil
 }
 
+// SetSecurityLevel sets the issue security level, identified by id, on the issue payload.
+func (i *IssueSchemeV2) SetSecurityLevel(levelID string) *IssueSchemeV2 {
+
+	if i.Fields == nil {
+		i.Fields = &IssueFieldsSchemeV2{}
+	}
+
+	i.Fields.Security = &SecurityScheme{ID: levelID}
+
+	return i
+}
+
+// ClearFields clears the fields, identified by id, of the issue, the fields are sent as null on the issue payload.
+func (i *IssueSchemeV2) ClearFields(fieldIDs ...string) *IssueSchemeV2 {
+
+	if i.Fields == nil {
+		i.Fields = &IssueFieldsSchemeV2{}
+	}
+
+	i.Fields.NullFields = append(i.Fields.NullFields, fieldIDs...)
+
+	return i
+}
+
+// SetParent sets the parent issue, identified by key, on the issue payload, e.g. the parent of a sub-task.
+func (i *IssueSchemeV2) SetParent(issueKey string) *IssueSchemeV2 {
+
+	if i.Fields == nil {
+		i.Fields = &IssueFieldsSchemeV2{}
+	}
+
+	i.Fields.Parent = &ParentScheme{Key: issueKey}
+
+	return i
+}
+
+// SetEpic links the issue payload to an epic, identified by key.
+//
+// The team-managed projects use the parent field, leave the epicLinkFieldID empty.
+// The company-managed projects use the epic link custom field, it's added to the customFields,
+// the ProjectService.EpicLinkField method returns the field to use for a project.
+func (i *IssueSchemeV2) SetEpic(epicKey, epicLinkFieldID string, customFields *CustomFields) error {
+
+	if epicKey == "" {
+		return ErrNoIssueKeyOrIDError
+	}
+
+	if epicLinkFieldID == "" {
+		i.SetParent(epicKey)
+		return nil
+	}
+
+	if customFields == nil {
+		return ErrNoCustomFieldError
+	}
+
+	return customFields.Text(epicLinkFieldID, epicKey)
+}
+
 type IssueFieldsSchemeV2 struct {
 	Parent                   *ParentScheme             `json:"parent,omitempty"`
 	IssueType                *IssueTypeScheme          `json:"issuetype,omitempty"`
@@ -112,6 +174,22 @@ type IssueFieldsSchemeV2 struct {
 	Comment                  *IssueCommentPageSchemeV2 `json:"comment,omitempty"`
 	Subtasks                 []*IssueScheme            `json:"subtasks,omitempty"`
 	Security                 *SecurityScheme           `json:"security,omitempty"`
+
+	// NullFields are the ids of the fields sent as null, so the update clears them, e.g. duedate or assignee
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the fields, the NullFields are sent as null.
+func (i IssueFieldsSchemeV2) MarshalJSON() ([]byte, error) {
+
+	type alias IssueFieldsSchemeV2
+
+	data, err := json.Marshal(alias(i))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalNullFields(data, i.NullFields)
 }
 
 type ParentScheme struct {
@@ -150,6 +228,24 @@ type IssueBulkResponseScheme struct {
 	Errors []*IssueBulkResponseErrorScheme `json:"errors,omitempty"`
 }
 
+type IssueBulkFetchPayloadScheme struct {
+	Expand         []string `json:"expand,omitempty"`
+	Fields         []string `json:"fields,omitempty"`
+	FieldsByKeys   bool     `json:"fieldsByKeys,omitempty"`
+	IssueIdsOrKeys []string `json:"issueIdsOrKeys,omitempty"`
+	Properties     []string `json:"properties,omitempty"`
+}
+
+type IssueBulkFetchErrorScheme struct {
+	ID           string `json:"id,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+type IssueBulkFetchSchemeV2 struct {
+	Issues      []*IssueSchemeV2             `json:"issues,omitempty"`
+	IssueErrors []*IssueBulkFetchErrorScheme `json:"issueErrors,omitempty"`
+}
+
 type IssueBulkResponseErrorScheme struct {
 	Status        int `json:"status"`
 	ElementErrors struct {
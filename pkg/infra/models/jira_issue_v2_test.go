@@ -226,6 +226,26 @@ func TestIssueSchemeV2_ToMap(t *testing.T) {
 			},
 			wantErr: false,
 		},
+
+		{
+			name: "when the fields are cleared",
+			fields: fields{
+				Key: "DUMMY-1",
+				Fields: &IssueFieldsSchemeV2{
+					Summary:    "Test",
+					NullFields: []string{"duedate", "assignee"},
+				},
+			},
+			want: map[string]interface{}{
+				"key": "DUMMY-1",
+				"fields": map[string]interface{}{
+					"summary":  "Test",
+					"duedate":  nil,
+					"assignee": nil,
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -6,12 +6,15 @@ import (
 )
 
 type IssueScheme struct {
-	ID          string                   `json:"id,omitempty"`
-	Key         string                   `json:"key,omitempty"`
-	Self        string                   `json:"self,omitempty"`
-	Transitions []*IssueTransitionScheme `json:"transitions,omitempty"`
-	Changelog   *IssueChangelogScheme    `json:"changelog,omitempty"`
-	Fields      *IssueFieldsScheme       `json:"fields,omitempty"`
+	ID             string                             `json:"id,omitempty"`
+	Key            string                             `json:"key,omitempty"`
+	Self           string                             `json:"self,omitempty"`
+	Transitions    []*IssueTransitionScheme           `json:"transitions,omitempty"`
+	Changelog      *IssueChangelogScheme              `json:"changelog,omitempty"`
+	Fields         *IssueFieldsScheme                 `json:"fields,omitempty"`
+	RenderedFields json.RawMessage                    `json:"renderedFields,omitempty"`
+	Names          map[string]string                  `json:"names,omitempty"`
+	Schema         map[string]*IssueFieldSchemaScheme `json:"schema,omitempty"`
 }
 
 func (i *IssueScheme) MergeCustomFields(fields *CustomFields) (map[string]interface{}, error) {
@@ -84,6 +87,65 @@ func (i *IssueScheme) ToMap() (map[string]interface{}, error) {
 	return issueSchemeAsMap, nil
 }
 
+// SetSecurityLevel sets the issue security level, identified by id, on the issue payload.
+func (i *IssueScheme) SetSecurityLevel(levelID string) *IssueScheme {
+
+	if i.Fields == nil {
+		i.Fields = &IssueFieldsScheme{}
+	}
+
+	i.Fields.Security = &SecurityScheme{ID: levelID}
+
+	return i
+}
+
+// ClearFields clears the fields, identified by id, of the issue, the fields are sent as null on the issue payload.
+func (i *IssueScheme) ClearFields(fieldIDs ...string) *IssueScheme {
+
+	if i.Fields == nil {
+		i.Fields = &IssueFieldsScheme{}
+	}
+
+	i.Fields.NullFields = append(i.Fields.NullFields, fieldIDs...)
+
+	return i
+}
+
+// SetParent sets the parent issue, identified by key, on the issue payload, e.g. the parent of a sub-task.
+func (i *IssueScheme) SetParent(issueKey string) *IssueScheme {
+
+	if i.Fields == nil {
+		i.Fields = &IssueFieldsScheme{}
+	}
+
+	i.Fields.Parent = &ParentScheme{Key: issueKey}
+
+	return i
+}
+
+// SetEpic links the issue payload to an epic, identified by key.
+//
+// The team-managed projects use the parent field, leave the epicLinkFieldID empty.
+// The company-managed projects use the epic link custom field, it's added to the customFields,
+// the ProjectService.EpicLinkField method returns the field to use for a project.
+func (i *IssueScheme) SetEpic(epicKey, epicLinkFieldID string, customFields *CustomFields) error {
+
+	if epicKey == "" {
+		return ErrNoIssueKeyOrIDError
+	}
+
+	if epicLinkFieldID == "" {
+		i.SetParent(epicKey)
+		return nil
+	}
+
+	if customFields == nil {
+		return ErrNoCustomFieldError
+	}
+
+	return customFields.Text(epicLinkFieldID, epicKey)
+}
+
 type IssueFieldsScheme struct {
 	Parent                   *ParentScheme           `json:"parent,omitempty"`
 	IssueType                *IssueTypeScheme        `json:"issuetype,omitempty"`
@@ -113,6 +175,22 @@ type IssueFieldsScheme struct {
 	Subtasks                 []*IssueScheme          `json:"subtasks,omitempty"`
 	Security                 *SecurityScheme         `json:"security,omitempty"`
 	Attachment               []*AttachmentScheme     `json:"attachment,omitempty"`
+
+	// NullFields are the ids of the fields sent as null, so the update clears them, e.g. duedate or assignee
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the fields, the NullFields are sent as null.
+func (i IssueFieldsScheme) MarshalJSON() ([]byte, error) {
+
+	type alias IssueFieldsScheme
+
+	data, err := json.Marshal(alias(i))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalNullFields(data, i.NullFields)
 }
 
 type IssueTransitionScheme struct {
@@ -125,6 +203,9 @@ type IssueTransitionScheme struct {
 	IsAvailable   bool          `json:"isAvailable,omitempty"`
 	IsConditional bool          `json:"isConditional,omitempty"`
 	IsLooped      bool          `json:"isLooped,omitempty"`
+
+	// Fields are the fields of the transition screen, they're returned when the transitions.fields are expanded
+	Fields map[string]*FieldMetadataScheme `json:"fields,omitempty"`
 }
 
 type StatusScheme struct {
@@ -176,7 +257,112 @@ type IssueNotifyUserScheme struct {
 }
 
 type IssueNotifyGroupScheme struct {
-	Name string `json:"name,omitempty"`
+	Name    string `json:"name,omitempty"`
+	GroupID string `json:"groupId,omitempty"`
+}
+
+// Recipients returns the "to" block of the notification, creating it if needed.
+func (i *IssueNotifyOptionsScheme) Recipients() *IssueNotifyToScheme {
+
+	if i.To == nil {
+		i.To = &IssueNotifyToScheme{}
+	}
+
+	return i.To
+}
+
+// Restrictions returns the "restrict" block of the notification, creating it if needed.
+func (i *IssueNotifyOptionsScheme) Restrictions() *IssueNotifyRestrictScheme {
+
+	if i.Restrict == nil {
+		i.Restrict = &IssueNotifyRestrictScheme{}
+	}
+
+	return i.Restrict
+}
+
+// HasRecipients reports whether the notification is sent to a role, a user or a group.
+func (i *IssueNotifyToScheme) HasRecipients() bool {
+
+	if i == nil {
+		return false
+	}
+
+	return i.Reporter || i.Assignee || i.Watchers || i.Voters || len(i.Users) != 0 || len(i.Groups) != 0
+}
+
+// AddUsers adds the users, identified by account id, to the notification recipients.
+func (i *IssueNotifyToScheme) AddUsers(accountIDs ...string) *IssueNotifyToScheme {
+
+	for _, accountID := range accountIDs {
+		i.Users = append(i.Users, &IssueNotifyUserScheme{AccountID: accountID})
+	}
+
+	return i
+}
+
+// AddGroupsByID adds the groups, identified by group id, to the notification recipients.
+func (i *IssueNotifyToScheme) AddGroupsByID(groupIDs ...string) *IssueNotifyToScheme {
+
+	for _, groupID := range groupIDs {
+		i.Groups = append(i.Groups, &IssueNotifyGroupScheme{GroupID: groupID})
+	}
+
+	return i
+}
+
+// AddGroupsByName adds the groups, identified by group name, to the notification recipients.
+//
+// The group name is deprecated, use AddGroupsByID instead.
+func (i *IssueNotifyToScheme) AddGroupsByName(names ...string) *IssueNotifyToScheme {
+
+	for _, name := range names {
+		i.Groups = append(i.Groups, &IssueNotifyGroupScheme{Name: name})
+	}
+
+	return i
+}
+
+// AddGroupsByID restricts the notification to the members of the groups, identified by group id.
+func (i *IssueNotifyRestrictScheme) AddGroupsByID(groupIDs ...string) *IssueNotifyRestrictScheme {
+
+	for _, groupID := range groupIDs {
+		i.Groups = append(i.Groups, &IssueNotifyGroupScheme{GroupID: groupID})
+	}
+
+	return i
+}
+
+// AddGroupsByName restricts the notification to the members of the groups, identified by group name.
+//
+// The group name is deprecated, use AddGroupsByID instead.
+func (i *IssueNotifyRestrictScheme) AddGroupsByName(names ...string) *IssueNotifyRestrictScheme {
+
+	for _, name := range names {
+		i.Groups = append(i.Groups, &IssueNotifyGroupScheme{Name: name})
+	}
+
+	return i
+}
+
+// AddPermissionsByKey restricts the notification to the users with the permissions, e.g. "BROWSE".
+func (i *IssueNotifyRestrictScheme) AddPermissionsByKey(keys ...string) *IssueNotifyRestrictScheme {
+
+	for _, key := range keys {
+		i.Permissions = append(i.Permissions, &IssueNotifyPermissionScheme{Key: key})
+	}
+
+	return i
+}
+
+// AddPermissionsByID restricts the notification to the users with the permissions, identified by id.
+func (i *IssueNotifyRestrictScheme) AddPermissionsByID(permissionIDs ...string) *IssueNotifyRestrictScheme {
+
+	for _, permissionID := range permissionIDs {
+		i.Permissions = append(i.Permissions, &IssueNotifyPermissionScheme{ID: permissionID})
+	}
+
+	return i
 }
 
 type IssueBulkSchemeV3 struct {
@@ -184,6 +370,11 @@ type IssueBulkSchemeV3 struct {
 	CustomFields *CustomFields
 }
 
+type IssueBulkFetchSchemeV3 struct {
+	Issues      []*IssueScheme               `json:"issues,omitempty"`
+	IssueErrors []*IssueBulkFetchErrorScheme `json:"issueErrors,omitempty"`
+}
+
 type BulkIssueSchemeV3 struct {
 	Issues []*IssueScheme `json:"issues,omitempty"`
 }
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
 	"reflect"
 	"testing"
 )
@@ -252,3 +254,192 @@ func TestIssueScheme_ToMap(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueNotifyOptionsScheme_Builders(t *testing.T) {
+
+	options := &IssueNotifyOptionsScheme{
+		Subject:  "Escalation",
+		TextBody: "The issue has been escalated",
+	}
+
+	options.Recipients().
+		AddUsers("5b10a2844c20165700ede21g").
+		AddGroupsByID("276f955c-63d7-42c8-9520-92d01dca0625").
+		AddGroupsByName("jira-administrators")
+
+	options.Recipients().Reporter = true
+
+	options.Restrictions().
+		AddGroupsByID("276f955c-63d7-42c8-9520-92d01dca0625").
+		AddPermissionsByKey("BROWSE").
+		AddPermissionsByID("10")
+
+	expected := `{
+		"subject": "Escalation",
+		"textBody": "The issue has been escalated",
+		"to": {
+			"reporter": true,
+			"users": [{"accountId": "5b10a2844c20165700ede21g"}],
+			"groups": [{"groupId": "276f955c-63d7-42c8-9520-92d01dca0625"}, {"name": "jira-administrators"}]
+		},
+		"restrict": {
+			"groups": [{"groupId": "276f955c-63d7-42c8-9520-92d01dca0625"}],
+			"permissions": [{"key": "BROWSE"}, {"id": "10"}]
+		}
+	}`
+
+	optionsAsBytes, err := json.Marshal(options)
+	assert.NoError(t, err)
+	assert.JSONEq(t, expected, string(optionsAsBytes))
+}
+
+func TestIssueNotifyToScheme_HasRecipients(t *testing.T) {
+
+	var to *IssueNotifyToScheme
+	assert.False(t, to.HasRecipients())
+
+	to = &IssueNotifyToScheme{}
+	assert.False(t, to.HasRecipients())
+
+	assert.True(t, (&IssueNotifyToScheme{Watchers: true}).HasRecipients())
+	assert.True(t, to.AddGroupsByID("276f955c-63d7-42c8-9520-92d01dca0625").HasRecipients())
+}
+
+func TestIssueScheme_ClearFields(t *testing.T) {
+
+	issue := &IssueScheme{}
+	issue.ClearFields("duedate", "assignee")
+	issue.Fields.Summary = "New summary"
+
+	issueAsBytes, err := json.Marshal(issue)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields":{"summary":"New summary","duedate":null,"assignee":null}}`, string(issueAsBytes))
+
+	// The null fields are kept when the custom fields are merged
+	customFields := &CustomFields{}
+	assert.NoError(t, customFields.Clear("customfield_10020"))
+
+	issueAsMap, err := issue.MergeCustomFields(customFields)
+	assert.NoError(t, err)
+
+	issueAsBytes, err = json.Marshal(issueAsMap)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields":{"summary":"New summary","duedate":null,"assignee":null,"customfield_10020":null}}`, string(issueAsBytes))
+}
+
+func TestIssueScheme_SetSecurityLevel(t *testing.T) {
+
+	issue := &IssueScheme{}
+	issue.SetSecurityLevel("10021")
+
+	issueAsBytes, err := json.Marshal(issue)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields": {"security": {"id": "10021"}}}`, string(issueAsBytes))
+
+	issueV2 := &IssueSchemeV2{Fields: &IssueFieldsSchemeV2{Summary: "New summary test"}}
+	issueV2.SetSecurityLevel("10021")
+
+	issueAsBytes, err = json.Marshal(issueV2)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields": {"summary": "New summary test", "security": {"id": "10021"}}}`, string(issueAsBytes))
+}
+
+func TestIssueScheme_SetParent(t *testing.T) {
+
+	issue := &IssueScheme{Fields: &IssueFieldsScheme{IssueType: &IssueTypeScheme{Name: "Sub-task"}}}
+	issue.SetParent("KP-2")
+
+	issueAsBytes, err := json.Marshal(issue)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields": {"issuetype": {"name": "Sub-task"}, "parent": {"key": "KP-2"}}}`, string(issueAsBytes))
+
+	issueV2 := &IssueSchemeV2{}
+	issueV2.SetParent("KP-2")
+
+	issueAsBytes, err = json.Marshal(issueV2)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields": {"parent": {"key": "KP-2"}}}`, string(issueAsBytes))
+}
+
+func TestIssueScheme_SetEpic(t *testing.T) {
+
+	testCases := []struct {
+		name            string
+		epicKey         string
+		epicLinkFieldID string
+		customFields    *CustomFields
+		want            string
+		wantErr         bool
+		Err             error
+	}{
+		{
+			name:    "when the project is team-managed",
+			epicKey: "KP-1",
+			want:    `{"fields": {"summary": "Story", "parent": {"key": "KP-1"}}}`,
+		},
+		{
+			name:            "when the project is company-managed",
+			epicKey:         "KP-1",
+			epicLinkFieldID: "customfield_10014",
+			customFields:    &CustomFields{},
+			want:            `{"fields": {"summary": "Story", "customfield_10014": "KP-1"}}`,
+		},
+		{
+			name:            "when the custom fields are not provided",
+			epicKey:         "KP-1",
+			epicLinkFieldID: "customfield_10014",
+			wantErr:         true,
+			Err:             ErrNoCustomFieldError,
+		},
+		{
+			name:    "when the epic key is not provided",
+			wantErr: true,
+			Err:     ErrNoIssueKeyOrIDError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			issue := &IssueScheme{Fields: &IssueFieldsScheme{Summary: "Story"}}
+			issueV2 := &IssueSchemeV2{Fields: &IssueFieldsSchemeV2{Summary: "Story"}}
+
+			err := issue.SetEpic(testCase.epicKey, testCase.epicLinkFieldID, testCase.customFields)
+			errV2 := issueV2.SetEpic(testCase.epicKey, testCase.epicLinkFieldID, testCase.customFields)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				assert.EqualError(t, errV2, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NoError(t, errV2)
+
+			payload, err := issue.ToMap()
+			assert.NoError(t, err)
+
+			// The company-managed projects carry the epic link on the custom fields
+			if testCase.customFields != nil {
+				payload, err = issue.MergeCustomFields(testCase.customFields)
+				assert.NoError(t, err)
+			}
+
+			payloadAsBytes, err := json.Marshal(payload)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, string(payloadAsBytes))
+
+			payloadV2, err := issueV2.ToMap()
+			assert.NoError(t, err)
+
+			if testCase.customFields != nil {
+				payloadV2, err = issueV2.MergeCustomFields(testCase.customFields)
+				assert.NoError(t, err)
+			}
+
+			payloadAsBytes, err = json.Marshal(payloadV2)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, string(payloadAsBytes))
+		})
+	}
+}
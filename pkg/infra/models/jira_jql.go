@@ -38,3 +38,37 @@ type QueryPropertyScheme struct {
 	Path   string `json:"path"`
 	Type   string `json:"type"`
 }
+
+type JQLQueryToSanitizeScheme struct {
+	AccountID string `json:"accountId,omitempty"`
+	Query     string `json:"query,omitempty"`
+}
+
+type JQLSanitizedQueriesScheme struct {
+	Queries []*JQLSanitizedQueryScheme `json:"queries,omitempty"`
+}
+
+// JQLSanitizedQueryScheme is the result of a sanitized query, the SanitizedQuery is empty when the query is invalid.
+type JQLSanitizedQueryScheme struct {
+	AccountID      string                   `json:"accountId,omitempty"`
+	InitialQuery   string                   `json:"initialQuery,omitempty"`
+	SanitizedQuery string                   `json:"sanitizedQuery,omitempty"`
+	Errors         *JQLSanitizeErrorsScheme `json:"errors,omitempty"`
+}
+
+type JQLSanitizeErrorsScheme struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+}
+
+// JQLConvertedQueriesScheme are the queries with the user names and keys converted to account ids, in the order
+// they were sent, the queries referencing unknown users are converted without them.
+type JQLConvertedQueriesScheme struct {
+	QueryStrings            []string                          `json:"queryStrings,omitempty"`
+	QueriesWithUnknownUsers []*JQLQueryWithUnknownUsersScheme `json:"queriesWithUnknownUsers,omitempty"`
+}
+
+type JQLQueryWithUnknownUsersScheme struct {
+	OriginalQuery  string `json:"originalQuery,omitempty"`
+	ConvertedQuery string `json:"convertedQuery,omitempty"`
+}
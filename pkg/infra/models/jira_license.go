@@ -0,0 +1,106 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	JiraSoftwareApplicationKey    = "jira-software"
+	JiraServiceDeskApplicationKey = "jira-servicedesk"
+	JiraCoreApplicationKey        = "jira-core"
+)
+
+const (
+	FreeLicensePlan     = "FREE"
+	StandardLicensePlan = "STANDARD"
+	PremiumLicensePlan  = "PREMIUM"
+)
+
+// applicationKeyAliases maps the product names used across the Atlassian APIs to the application keys.
+var applicationKeyAliases = map[string]string{
+	"jira-service-desk":       JiraServiceDeskApplicationKey,
+	"jira-service-management": JiraServiceDeskApplicationKey,
+	"jira-servicemanagement":  JiraServiceDeskApplicationKey,
+	"jira-work-management":    JiraCoreApplicationKey,
+	"jira-business":           JiraCoreApplicationKey,
+}
+
+// NormalizeApplicationKey returns the application key of a Jira product, e.g. jira-service-management
+// is returned as jira-servicedesk, the unknown keys are returned in lower case.
+func NormalizeApplicationKey(applicationKey string) string {
+
+	applicationKey = strings.ToLower(strings.TrimSpace(applicationKey))
+	if alias, ok := applicationKeyAliases[applicationKey]; ok {
+		return alias
+	}
+
+	return applicationKey
+}
+
+type InstanceLicenseScheme struct {
+	Applications []*LicensedApplicationScheme `json:"applications,omitempty"`
+}
+
+// Application returns the license of an application, nil is returned when the application is not licensed.
+func (i *InstanceLicenseScheme) Application(applicationKey string) *LicensedApplicationScheme {
+
+	applicationKey = NormalizeApplicationKey(applicationKey)
+	for _, application := range i.Applications {
+		if application.ID == applicationKey {
+			return application
+		}
+	}
+
+	return nil
+}
+
+type LicensedApplicationScheme struct {
+	ID   string `json:"id,omitempty"`
+	Plan string `json:"plan,omitempty"`
+}
+
+// LicenseApproximateCountScheme represents the approximate number of users of the instance or an application,
+// the API returns the count as a string, so it's parsed as an integer.
+type LicenseApproximateCountScheme struct {
+	Key   string `json:"key,omitempty"`
+	Value int    `json:"value"`
+}
+
+func (l *LicenseApproximateCountScheme) UnmarshalJSON(data []byte) error {
+
+	var raw struct {
+		Key   string      `json:"key"`
+		Value interface{} `json:"value"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*l = LicenseApproximateCountScheme{Key: NormalizeApplicationKey(raw.Key)}
+
+	switch value := raw.Value.(type) {
+	case nil:
+	case float64:
+		l.Value = int(value)
+	case string:
+
+		if value == "" {
+			return nil
+		}
+
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("jira: invalid approximate license count %q", value)
+		}
+
+		l.Value = count
+	default:
+		return fmt.Errorf("jira: invalid approximate license count %v", value)
+	}
+
+	return nil
+}
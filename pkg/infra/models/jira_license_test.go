@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLicenseApproximateCountScheme_UnmarshalJSON(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		data    string
+		want    LicenseApproximateCountScheme
+		wantErr bool
+	}{
+		{
+			name: "when the count is a string",
+			data: `{"key":"jira-software","value":"1250"}`,
+			want: LicenseApproximateCountScheme{Key: JiraSoftwareApplicationKey, Value: 1250},
+		},
+		{
+			name: "when the count is a number",
+			data: `{"key":"Jira-Service-Management","value":35}`,
+			want: LicenseApproximateCountScheme{Key: JiraServiceDeskApplicationKey, Value: 35},
+		},
+		{
+			name: "when the count is not set",
+			data: `{"key":"jira-core"}`,
+			want: LicenseApproximateCountScheme{Key: JiraCoreApplicationKey},
+		},
+		{
+			name:    "when the count is not a number",
+			data:    `{"key":"jira-core","value":"many"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var got LicenseApproximateCountScheme
+			err := json.Unmarshal([]byte(testCase.data), &got)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestInstanceLicenseScheme_Application(t *testing.T) {
+
+	license := &InstanceLicenseScheme{Applications: []*LicensedApplicationScheme{
+		{ID: JiraSoftwareApplicationKey, Plan: StandardLicensePlan},
+	}}
+
+	assert.Equal(t, StandardLicensePlan, license.Application("Jira-Software").Plan)
+	assert.Nil(t, license.Application(JiraCoreApplicationKey))
+}
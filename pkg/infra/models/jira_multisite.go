@@ -0,0 +1,38 @@
+package models
+
+import "sort"
+
+// AccessibleResourceScheme represents a site the OAuth token can access.
+type AccessibleResourceScheme struct {
+	ID        string   `json:"id,omitempty"` // The cloud id of the site
+	URL       string   `json:"url,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+	AvatarURL string   `json:"avatarUrl,omitempty"`
+}
+
+// MultiSiteReportScheme represents the outcome of a func run on several sites, keyed by cloud id.
+type MultiSiteReportScheme struct {
+	Sites map[string]*MultiSiteResultScheme
+}
+
+// MultiSiteResultScheme represents the outcome of a func run on a site.
+type MultiSiteResultScheme struct {
+	CloudID string
+	Err     error // The error of the func or of the client construction, nil when the func succeeded
+	Skipped bool  // The func didn't run, a previous site failed on fail-fast mode or the context was cancelled
+}
+
+// Failed returns the sorted cloud ids of the sites where the func returned an error.
+func (m *MultiSiteReportScheme) Failed() []string {
+
+	var failed []string
+	for cloudID, result := range m.Sites {
+		if result.Err != nil {
+			failed = append(failed, cloudID)
+		}
+	}
+
+	sort.Strings(failed)
+	return failed
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NameResolutionError is returned when a status or field name is missing or ambiguous,
+// use errors.Is with the wrapped error, e.g. ErrAmbiguousStatusNameError, to check the reason.
+type NameResolutionError struct {
+	Err        error
+	Name       string   // The name requested
+	Candidates []string // The ids matching an ambiguous name
+}
+
+func (n *NameResolutionError) Error() string {
+
+	if len(n.Candidates) == 0 {
+		return fmt.Sprintf("%v: %q", n.Err, n.Name)
+	}
+
+	return fmt.Sprintf("%v: %q, candidates: %v", n.Err, n.Name, strings.Join(n.Candidates, ", "))
+}
+
+func (n *NameResolutionError) Unwrap() error {
+	return n.Err
+}
@@ -43,9 +43,14 @@ type PermissionGrantScheme struct {
 }
 
 type PermissionGrantHolderScheme struct {
-	Type      string `json:"type,omitempty"`
-	Parameter string `json:"parameter,omitempty"`
-	Expand    string `json:"expand,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Parameter   string             `json:"parameter,omitempty"`
+	Value       string             `json:"value,omitempty"`
+	Expand      string             `json:"expand,omitempty"`
+	User        *UserScheme        `json:"user,omitempty"`
+	Group       *GroupDetailScheme `json:"group,omitempty"`
+	ProjectRole *ProjectRoleScheme `json:"projectRole,omitempty"`
+	Field       *IssueFieldScheme  `json:"field,omitempty"`
 }
 
 type PermissionGrantPayloadScheme struct {
@@ -0,0 +1,131 @@
+package models
+
+import "strconv"
+
+const (
+	PermissionHolderTypeAnyone                  = "anyone"
+	PermissionHolderTypeApplicationRole         = "applicationRole"
+	PermissionHolderTypeAssignee                = "assignee"
+	PermissionHolderTypeGroup                   = "group"
+	PermissionHolderTypeGroupCustomField        = "groupCustomField"
+	PermissionHolderTypeProjectLead             = "projectLead"
+	PermissionHolderTypeProjectRole             = "projectRole"
+	PermissionHolderTypeReporter                = "reporter"
+	PermissionHolderTypeServiceDeskCustomerOnly = "sd.customer.portal.only"
+	PermissionHolderTypeUser                    = "user"
+	PermissionHolderTypeUserCustomField         = "userCustomField"
+)
+
+// permissionHolderTypes maps the holder types to whether they require an identifier.
+// The application role holder doesn't, a holder without application key means any logged-in user.
+var permissionHolderTypes = map[string]bool{
+	PermissionHolderTypeAnyone:                  false,
+	PermissionHolderTypeApplicationRole:         false,
+	PermissionHolderTypeAssignee:                false,
+	PermissionHolderTypeGroup:                   true,
+	PermissionHolderTypeGroupCustomField:        true,
+	PermissionHolderTypeProjectLead:             false,
+	PermissionHolderTypeProjectRole:             true,
+	PermissionHolderTypeReporter:                false,
+	PermissionHolderTypeServiceDeskCustomerOnly: false,
+	PermissionHolderTypeUser:                    true,
+	PermissionHolderTypeUserCustomField:         true,
+}
+
+// PermissionHolderAnyone returns the holder granting the permission to anyone, including anonymous users.
+func PermissionHolderAnyone() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeAnyone}
+}
+
+// PermissionHolderAnyLoggedInUser returns the holder granting the permission to any logged-in user.
+func PermissionHolderAnyLoggedInUser() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeApplicationRole}
+}
+
+// PermissionHolderApplicationRole returns the holder granting the permission to the users of an application, e.g. "jira-software".
+func PermissionHolderApplicationRole(applicationKey string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeApplicationRole, Parameter: applicationKey}
+}
+
+// PermissionHolderAssignee returns the holder granting the permission to the issue assignee.
+func PermissionHolderAssignee() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeAssignee}
+}
+
+// PermissionHolderReporter returns the holder granting the permission to the issue reporter.
+func PermissionHolderReporter() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeReporter}
+}
+
+// PermissionHolderProjectLead returns the holder granting the permission to the project lead.
+func PermissionHolderProjectLead() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeProjectLead}
+}
+
+// PermissionHolderGroupByID returns the holder granting the permission to the members of a group, identified by group id.
+//
+// The group id is sent in the value field, the parameter field only accepts the group name.
+func PermissionHolderGroupByID(groupID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeGroup, Value: groupID}
+}
+
+// PermissionHolderGroupByName returns the holder granting the permission to the members of a group, identified by group name.
+//
+// The group name is deprecated, use PermissionHolderGroupByID instead.
+func PermissionHolderGroupByName(name string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeGroup, Parameter: name}
+}
+
+// PermissionHolderGroupCustomField returns the holder granting the permission to the group selected in a group picker custom field.
+func PermissionHolderGroupCustomField(fieldID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeGroupCustomField, Parameter: fieldID}
+}
+
+// PermissionHolderProjectRole returns the holder granting the permission to the members of a project role, identified by role id.
+func PermissionHolderProjectRole(roleID int) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeProjectRole, Parameter: strconv.Itoa(roleID)}
+}
+
+// PermissionHolderUser returns the holder granting the permission to a user, identified by account id.
+func PermissionHolderUser(accountID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeUser, Parameter: accountID}
+}
+
+// PermissionHolderUserCustomField returns the holder granting the permission to the user selected in a user picker custom field.
+func PermissionHolderUserCustomField(fieldID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeUserCustomField, Parameter: fieldID}
+}
+
+// PermissionHolderServiceDeskCustomers returns the holder granting the permission to the service desk customers using the portal only.
+func PermissionHolderServiceDeskCustomers() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeServiceDeskCustomerOnly}
+}
+
+// Identifier returns the identifier of the holder, the value field takes precedence over the deprecated parameter field.
+func (p *PermissionGrantHolderScheme) Identifier() string {
+
+	if p.Value != "" {
+		return p.Value
+	}
+
+	return p.Parameter
+}
+
+// Validate checks the holder type is known and the holder carries an identifier when the type requires one.
+func (p *PermissionGrantHolderScheme) Validate() error {
+
+	if p == nil {
+		return ErrNoPermissionHolderError
+	}
+
+	required, ok := permissionHolderTypes[p.Type]
+	if !ok {
+		return ErrInvalidPermissionHolderTypeError
+	}
+
+	if required && p.Identifier() == "" {
+		return ErrNoPermissionHolderParameterError
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPermissionGrantHolderScheme_Constructors(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		holder *PermissionGrantHolderScheme
+		want   string
+	}{
+		{
+			name:   "when the holder is anyone",
+			holder: PermissionHolderAnyone(),
+			want:   `{"type":"anyone"}`,
+		},
+		{
+			name:   "when the holder is any logged-in user",
+			holder: PermissionHolderAnyLoggedInUser(),
+			want:   `{"type":"applicationRole"}`,
+		},
+		{
+			name:   "when the holder is an application role",
+			holder: PermissionHolderApplicationRole("jira-software"),
+			want:   `{"type":"applicationRole","parameter":"jira-software"}`,
+		},
+		{
+			name:   "when the holder is the assignee",
+			holder: PermissionHolderAssignee(),
+			want:   `{"type":"assignee"}`,
+		},
+		{
+			name:   "when the holder is the reporter",
+			holder: PermissionHolderReporter(),
+			want:   `{"type":"reporter"}`,
+		},
+		{
+			name:   "when the holder is the project lead",
+			holder: PermissionHolderProjectLead(),
+			want:   `{"type":"projectLead"}`,
+		},
+		{
+			name:   "when the holder is a group by id",
+			holder: PermissionHolderGroupByID("276f955c-63d7-42c8-9520-92d01dca0625"),
+			want:   `{"type":"group","value":"276f955c-63d7-42c8-9520-92d01dca0625"}`,
+		},
+		{
+			name:   "when the holder is a group by name",
+			holder: PermissionHolderGroupByName("jira-administrators"),
+			want:   `{"type":"group","parameter":"jira-administrators"}`,
+		},
+		{
+			name:   "when the holder is a group custom field",
+			holder: PermissionHolderGroupCustomField("customfield_10040"),
+			want:   `{"type":"groupCustomField","parameter":"customfield_10040"}`,
+		},
+		{
+			name:   "when the holder is a project role",
+			holder: PermissionHolderProjectRole(10002),
+			want:   `{"type":"projectRole","parameter":"10002"}`,
+		},
+		{
+			name:   "when the holder is a user",
+			holder: PermissionHolderUser("5b10a2844c20165700ede21g"),
+			want:   `{"type":"user","parameter":"5b10a2844c20165700ede21g"}`,
+		},
+		{
+			name:   "when the holder is a user custom field",
+			holder: PermissionHolderUserCustomField("customfield_10041"),
+			want:   `{"type":"userCustomField","parameter":"customfield_10041"}`,
+		},
+		{
+			name:   "when the holder is the service desk customers",
+			holder: PermissionHolderServiceDeskCustomers(),
+			want:   `{"type":"sd.customer.portal.only"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			assert.NoError(t, testCase.holder.Validate())
+
+			holderAsBytes, err := json.Marshal(testCase.holder)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, string(holderAsBytes))
+
+			holder := new(PermissionGrantHolderScheme)
+			assert.NoError(t, json.Unmarshal(holderAsBytes, holder))
+			assert.Equal(t, testCase.holder, holder)
+		})
+	}
+}
+
+func TestPermissionGrantHolderScheme_Unmarshal(t *testing.T) {
+
+	// The group holders return both the deprecated group name and the group id
+	response := `{
+		"type": "group",
+		"parameter": "jira-administrators",
+		"value": "276f955c-63d7-42c8-9520-92d01dca0625",
+		"expand": "group",
+		"group": {
+			"name": "jira-administrators",
+			"groupId": "276f955c-63d7-42c8-9520-92d01dca0625"
+		}
+	}`
+
+	holder := new(PermissionGrantHolderScheme)
+	assert.NoError(t, json.Unmarshal([]byte(response), holder))
+
+	assert.Equal(t, "276f955c-63d7-42c8-9520-92d01dca0625", holder.Identifier())
+	assert.Equal(t, "jira-administrators", holder.Group.Name)
+	assert.NoError(t, holder.Validate())
+}
+
+func TestPermissionGrantHolderScheme_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		holder *PermissionGrantHolderScheme
+		Err    error
+	}{
+		{
+			name:   "when the holder is not provided",
+			holder: nil,
+			Err:    ErrNoPermissionHolderError,
+		},
+		{
+			name:   "when the holder type is not known",
+			holder: &PermissionGrantHolderScheme{Type: "projectrole", Parameter: "10002"},
+			Err:    ErrInvalidPermissionHolderTypeError,
+		},
+		{
+			name:   "when the holder type is not provided",
+			holder: &PermissionGrantHolderScheme{Parameter: "10002"},
+			Err:    ErrInvalidPermissionHolderTypeError,
+		},
+		{
+			name:   "when the holder identifier is not provided",
+			holder: &PermissionGrantHolderScheme{Type: PermissionHolderTypeUser},
+			Err:    ErrNoPermissionHolderParameterError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.EqualError(t, testCase.holder.Validate(), testCase.Err.Error())
+		})
+	}
+}
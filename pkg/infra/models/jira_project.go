@@ -1,5 +1,13 @@
 package models
 
+import "strings"
+
+const (
+	SoftwareProjectType    = "software"
+	ServiceDeskProjectType = "service_desk"
+	BusinessProjectType    = "business"
+)
+
 const (
 	BusinessContentManagementProjectTemplate    = "com.atlassian.jira-core-project-templates:jira-core-simplified-content-management"
 	BusinessDocumentApprovalProjectTemplate     = "com.atlassian.jira-core-project-templates:jira-core-simplified-document-approval"
@@ -12,6 +20,12 @@ const (
 	ITSMServiceDeskProjectTemplate              = "com.atlassian.servicedesk:simplified-it-service-desk"
 	ITSMInternalServiceDeskProjectTemplate      = "com.atlassian.servicedesk:simplified-internal-service-desk"
 	ITSMExternalServiceDeskProjectTemplate      = "com.atlassian.servicedesk:simplified-external-service-desk"
+	ITSMServiceManagementProjectTemplate        = "com.atlassian.servicedesk:simplified-it-service-management"
+	ITSMGeneralServiceDeskProjectTemplate       = "com.atlassian.servicedesk:simplified-general-service-desk"
+	ITSMHRServiceDeskProjectTemplate            = "com.atlassian.servicedesk:simplified-hr-service-desk"
+	ITSMFacilitiesServiceDeskProjectTemplate    = "com.atlassian.servicedesk:simplified-facilities-service-desk"
+	ITSMLegalServiceDeskProjectTemplate         = "com.atlassian.servicedesk:simplified-legal-service-desk"
+	SoftwareBasicProjectTemplate                = "com.pyxis.greenhopper.jira:gh-simplified-basic"
 	SoftwareTeamManagedKanbanProjectTemplate    = "com.pyxis.greenhopper.jira:gh-simplified-agility-kanban"
 	SoftwareTeamManagedScrumProjectTemplate     = "com.pyxis.greenhopper.jira:gh-simplified-agility-scrum"
 	SoftwareCompanyManagedKanbanProjectTemplate = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
@@ -32,6 +46,54 @@ type ProjectPayloadScheme struct {
 	ProjectTypeKey      string `json:"projectTypeKey"`
 	Key                 string `json:"key"`
 	CategoryID          int    `json:"categoryId"`
+
+	// The schemes associated with the project, the default schemes are used when they're not set
+	WorkflowScheme           int `json:"workflowScheme,omitempty"`
+	IssueTypeScheme          int `json:"issueTypeScheme,omitempty"`
+	IssueTypeScreenScheme    int `json:"issueTypeScreenScheme,omitempty"`
+	FieldConfigurationScheme int `json:"fieldConfigurationScheme,omitempty"`
+}
+
+// projectTemplatePrefixes maps the project template key prefixes to the project type of the templates.
+var projectTemplatePrefixes = map[string]string{
+	"com.pyxis.greenhopper.jira:":                SoftwareProjectType,
+	"com.atlassian.servicedesk:":                 ServiceDeskProjectType,
+	"com.atlassian.jira-core-project-templates:": BusinessProjectType,
+}
+
+// validateProjectTemplate checks the project type is known and the project template belongs to the project type.
+//
+// The templates with an unknown prefix aren't checked.
+func validateProjectTemplate(projectTypeKey, projectTemplateKey string) error {
+
+	if projectTypeKey == "" {
+		return nil
+	}
+
+	switch projectTypeKey {
+	case SoftwareProjectType, ServiceDeskProjectType, BusinessProjectType:
+	default:
+		return ErrInvalidProjectTypeKeyError
+	}
+
+	for prefix, templateType := range projectTemplatePrefixes {
+
+		if strings.HasPrefix(projectTemplateKey, prefix) && templateType != projectTypeKey {
+			return ErrProjectTemplateTypeMismatchError
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the project type key is set and matches the project template key, e.g. a Scrum template requires the software type.
+func (p *ProjectPayloadScheme) Validate() error {
+
+	if p.ProjectTypeKey == "" {
+		return ErrProjectTypeKeyError
+	}
+
+	return validateProjectTemplate(p.ProjectTypeKey, p.ProjectTemplateKey)
 }
 
 type NewProjectCreatedScheme struct {
@@ -165,6 +227,11 @@ type ProjectUpdateScheme struct {
 	CategoryID          int    `json:"categoryId,omitempty"`
 }
 
+// Validate checks the project type key, if set, matches the project template key.
+func (p *ProjectUpdateScheme) Validate() error {
+	return validateProjectTemplate(p.ProjectTypeKey, p.ProjectTemplateKey)
+}
+
 type ProjectStatusPageScheme struct {
 	Self     string                        `json:"self,omitempty"`
 	ID       string                        `json:"id,omitempty"`
@@ -182,6 +249,27 @@ type ProjectStatusDetailsScheme struct {
 	StatusCategory *StatusCategoryScheme `json:"statusCategory,omitempty"`
 }
 
+type ProjectIssueTypeHierarchyScheme struct {
+	ProjectID int                       `json:"projectId,omitempty"`
+	Hierarchy []*ProjectHierarchyScheme `json:"hierarchy,omitempty"`
+}
+
+// Level returns the hierarchy level, e.g. 1 for the epic level, or nil if the project does not use it.
+func (p *ProjectIssueTypeHierarchyScheme) Level(level int) *ProjectHierarchyScheme {
+
+	if p == nil {
+		return nil
+	}
+
+	for _, hierarchy := range p.Hierarchy {
+		if hierarchy.Level == level {
+			return hierarchy
+		}
+	}
+
+	return nil
+}
+
 type ProjectHierarchyScheme struct {
 	EntityID   string                             `json:"entityId,omitempty"`
 	Level      int                                `json:"level,omitempty"`
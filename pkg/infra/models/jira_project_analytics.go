@@ -0,0 +1,39 @@
+package models
+
+import "sort"
+
+type IssueEngagementOptionsScheme struct {
+	Concurrency int // The number of issues resolved at the same time, 5 by default
+	PageSize    int // The number of issues fetched per search page, 50 by default
+	MaxRetries  int // The number of retries when the requests are rate limited, 3 by default
+}
+
+type IssueEngagementReportScheme struct {
+	Issues int                                   // The number of issues scanned
+	Users  map[string]*IssueEngagementUserScheme // The watchers and voters, keyed by account id
+}
+
+type IssueEngagementUserScheme struct {
+	AccountID   string
+	DisplayName string
+	Active      bool
+	Watching    []string // The keys of the issues watched by the user
+	Voted       []string // The keys of the issues voted by the user
+}
+
+// InactiveUsers returns the deactivated users watching or voting any of the issues, sorted by account id.
+func (r *IssueEngagementReportScheme) InactiveUsers() []*IssueEngagementUserScheme {
+
+	var users []*IssueEngagementUserScheme
+	for _, user := range r.Users {
+		if !user.Active {
+			users = append(users, user)
+		}
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].AccountID < users[j].AccountID
+	})
+
+	return users
+}
@@ -0,0 +1,26 @@
+package models
+
+// ProjectArchivalReportScheme represents the result of the archival of the inactive projects
+type ProjectArchivalReportScheme struct {
+
+	// DryRun is set when the projects were only evaluated, the archived projects are the projects that would be archived
+	DryRun bool
+
+	// Archived are the projects archived, or the projects that would be archived on a dry run
+	Archived []*ProjectArchivalResultScheme
+
+	// Skipped are the excluded projects, the projects without issues or insight and the projects with activity since the cutoff
+	Skipped []*ProjectArchivalResultScheme
+
+	// Failed are the projects that couldn't be evaluated or archived
+	Failed []*ProjectArchivalResultScheme
+}
+
+// ProjectArchivalResultScheme represents a project evaluated for the archival, with the reason of the result
+type ProjectArchivalResultScheme struct {
+	ID     string
+	Key    string
+	Name   string
+	Reason string
+	Err    error
+}
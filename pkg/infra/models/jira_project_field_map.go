@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProjectFieldMapScheme represents the field ids of a project keyed by their display names.
+//
+// Team-managed projects use project-scoped custom fields, so the same display name maps to a different
+// field id on every project, set the map on the CustomFields.FieldMap attribute to set the fields by display name.
+type ProjectFieldMapScheme struct {
+	ProjectKeyOrID string
+	Fields         map[string]string // The field ids keyed by the display names requested
+}
+
+// FieldID returns the field id of a display name, the display names are matched ignoring the case.
+func (p *ProjectFieldMapScheme) FieldID(displayName string) (string, bool) {
+
+	if p == nil {
+		return "", false
+	}
+
+	if fieldID, ok := p.Fields[displayName]; ok {
+		return fieldID, true
+	}
+
+	for name, fieldID := range p.Fields {
+		if strings.EqualFold(name, displayName) {
+			return fieldID, true
+		}
+	}
+
+	return "", false
+}
+
+// FieldNameResolutionError is returned when a field display name is missing or ambiguous on a project,
+// use errors.Is with the wrapped error, e.g. ErrAmbiguousFieldNameError, to check the reason.
+type FieldNameResolutionError struct {
+	Err            error
+	ProjectKeyOrID string
+	Name           string   // The display name requested
+	Candidates     []string // The field ids matching an ambiguous display name
+}
+
+func (f *FieldNameResolutionError) Error() string {
+
+	if len(f.Candidates) == 0 {
+		return fmt.Sprintf("%v: %q on %v", f.Err, f.Name, f.ProjectKeyOrID)
+	}
+
+	return fmt.Sprintf("%v: %q on %v, candidates: %v", f.Err, f.Name, f.ProjectKeyOrID, strings.Join(f.Candidates, ", "))
+}
+
+func (f *FieldNameResolutionError) Unwrap() error {
+	return f.Err
+}
@@ -26,7 +26,8 @@ type EventNotificationScheme struct {
 	Expand           string             `json:"expand,omitempty"`
 	ID               int                `json:"id,omitempty"`
 	NotificationType string             `json:"notificationType,omitempty"`
-	Parameter        string             `json:"parameter,omitempty"`
+	Parameter        string             `json:"parameter,omitempty"` // The group name on the group notifications, the Recipient is the group id
+	Recipient        string             `json:"recipient,omitempty"`
 	EmailAddress     string             `json:"emailAddress,omitempty"`
 	Group            *GroupScheme       `json:"group,omitempty"`
 	Field            *IssueFieldScheme  `json:"field,omitempty"`
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEventNotificationScheme_GroupRecipient(t *testing.T) {
+
+	data := []byte(`{"id":10,"notificationType":"Group","parameter":"jira-administrators",
+		"recipient":"276f955c-63d7-42c8-9520-92d01dca0625",
+		"group":{"name":"jira-administrators","groupId":"276f955c-63d7-42c8-9520-92d01dca0625"}}`)
+
+	notification := new(EventNotificationScheme)
+	assert.NoError(t, json.Unmarshal(data, notification))
+
+	// The group notifications are identified by the group id, the parameter keeps the group name
+	assert.Equal(t, "276f955c-63d7-42c8-9520-92d01dca0625", notification.Recipient)
+	assert.Equal(t, notification.Recipient, notification.Group.GroupID)
+	assert.Equal(t, "jira-administrators", notification.Parameter)
+}
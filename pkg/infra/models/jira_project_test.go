@@ -0,0 +1,70 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestProjectPayloadScheme_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		payload *ProjectPayloadScheme
+		Err     error
+	}{
+		{
+			name:    "when the software template is used on a software project",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: SoftwareProjectType, ProjectTemplateKey: SoftwareTeamManagedKanbanProjectTemplate},
+		},
+		{
+			name:    "when the service management template is used on a service desk project",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: ServiceDeskProjectType, ProjectTemplateKey: ITSMServiceManagementProjectTemplate},
+		},
+		{
+			name:    "when the business template is used on a business project",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: BusinessProjectType, ProjectTemplateKey: BusinessProjectManagementProjectTemplate},
+		},
+		{
+			name:    "when the template is not set",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: BusinessProjectType},
+		},
+		{
+			name:    "when the template prefix is unknown",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: SoftwareProjectType, ProjectTemplateKey: "com.example:custom-template"},
+		},
+		{
+			name:    "when the project type is not set",
+			payload: &ProjectPayloadScheme{ProjectTemplateKey: SoftwareTeamManagedKanbanProjectTemplate},
+			Err:     ErrProjectTypeKeyError,
+		},
+		{
+			name:    "when the project type is unknown",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: "ops"},
+			Err:     ErrInvalidProjectTypeKeyError,
+		},
+		{
+			name:    "when the service desk template is used on a software project",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: SoftwareProjectType, ProjectTemplateKey: ITSMHRServiceDeskProjectTemplate},
+			Err:     ErrProjectTemplateTypeMismatchError,
+		},
+		{
+			name:    "when the scrum template is used on a business project",
+			payload: &ProjectPayloadScheme{ProjectTypeKey: BusinessProjectType, ProjectTemplateKey: SoftwareCompanyManagedScrumProjectTemplate},
+			Err:     ErrProjectTemplateTypeMismatchError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.Err, testCase.payload.Validate())
+		})
+	}
+}
+
+func TestProjectUpdateScheme_Validate(t *testing.T) {
+
+	assert.NoError(t, (&ProjectUpdateScheme{Name: "Project DUMMY #3"}).Validate())
+	assert.NoError(t, (&ProjectUpdateScheme{ProjectTemplateKey: BusinessTaskTrackingProjectTemplate}).Validate())
+	assert.Equal(t, ErrProjectTemplateTypeMismatchError,
+		(&ProjectUpdateScheme{ProjectTypeKey: ServiceDeskProjectType, ProjectTemplateKey: SoftwareBasicProjectTemplate}).Validate())
+}
@@ -0,0 +1,31 @@
+package models
+
+const (
+	// ProjectVersionFixField is the field of the issues fixed on a version
+	ProjectVersionFixField = "fixVersion"
+
+	// ProjectVersionAffectedField is the field of the issues affecting a version
+	ProjectVersionAffectedField = "affectedVersion"
+)
+
+// ProjectUsageOptionsScheme represents the options used to list the issues referencing a component or a version
+type ProjectUsageOptionsScheme struct {
+
+	// Fields are the fields returned on the Fields map of the issues, the summary and the status are always returned
+	Fields []string
+
+	// MaxResults is the page size of the search, 50 by default
+	MaxResults int
+
+	// Limit is the maximum number of issues returned, all the issues are returned when it's 0
+	Limit int
+}
+
+// ProjectUsageIssueScheme represents an issue referencing a component or a version
+type ProjectUsageIssueScheme struct {
+	ID      string                 `json:"id,omitempty" jira:"@id"`
+	Key     string                 `json:"key,omitempty" jira:"@key"`
+	Summary string                 `json:"summary,omitempty" jira:"summary,optional"`
+	Status  *StatusScheme          `json:"status,omitempty" jira:"status,optional"`
+	Fields  map[string]interface{} `json:"fields,omitempty" jira:"@fields"`
+}
@@ -0,0 +1,47 @@
+package models
+
+type RemoteLinkScheme struct {
+	ID           int                          `json:"id,omitempty"`
+	Self         string                       `json:"self,omitempty"`
+	GlobalID     string                       `json:"globalId,omitempty"`
+	Application  *RemoteLinkApplicationScheme `json:"application,omitempty"`
+	Relationship string                       `json:"relationship,omitempty"`
+	Object       *RemoteLinkObjectScheme      `json:"object,omitempty"`
+}
+
+// RemoteLinkPayloadScheme is the payload of a remote link, the links sharing the same global id are updated in place.
+type RemoteLinkPayloadScheme struct {
+	GlobalID     string                       `json:"globalId,omitempty"`
+	Application  *RemoteLinkApplicationScheme `json:"application,omitempty"`
+	Relationship string                       `json:"relationship,omitempty"`
+	Object       *RemoteLinkObjectScheme      `json:"object,omitempty"`
+}
+
+type RemoteLinkApplicationScheme struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type RemoteLinkObjectScheme struct {
+	URL     string                  `json:"url,omitempty"`
+	Title   string                  `json:"title,omitempty"`
+	Summary string                  `json:"summary,omitempty"`
+	Icon    *RemoteLinkIconScheme   `json:"icon,omitempty"`
+	Status  *RemoteLinkStatusScheme `json:"status,omitempty"`
+}
+
+type RemoteLinkIconScheme struct {
+	URL16x16 string `json:"url16x16,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Link     string `json:"link,omitempty"`
+}
+
+type RemoteLinkStatusScheme struct {
+	Resolved bool                  `json:"resolved,omitempty"`
+	Icon     *RemoteLinkIconScheme `json:"icon,omitempty"`
+}
+
+type RemoteLinkIdentify struct {
+	ID   int    `json:"id,omitempty"`
+	Self string `json:"self,omitempty"`
+}
@@ -34,3 +34,11 @@ type RoleActorScheme struct {
 type RoleActorUserScheme struct {
 	AccountID string `json:"accountId,omitempty"`
 }
+
+// ProjectRoleActorPayloadScheme represents the actors added to a project role, the groups are identified
+// by their names or by their ids, but not both.
+type ProjectRoleActorPayloadScheme struct {
+	Groups   []string `json:"group,omitempty"`
+	GroupIDs []string `json:"groupId,omitempty"`
+	Users    []string `json:"user,omitempty"`
+}
@@ -0,0 +1,20 @@
+package models
+
+const (
+	SearchExportCSV    = "csv"    // One row per issue, the header contains the field names
+	SearchExportNDJSON = "ndjson" // One issue JSON object per line, with only the exported fields
+)
+
+// IssueSearchExportOptionsScheme represents the options used to export the searched issues
+type IssueSearchExportOptionsScheme struct {
+	ValidateQuery string
+
+	// MaxResults is the page size of the search, 50 by default
+	MaxResults int
+
+	// Separator joins the values of the multi-value fields on the CSV cells, "; " by default
+	Separator string
+
+	// Progress is called once the issues of a page are written, with the issues written so far and the search total
+	Progress func(exported, total int)
+}
@@ -0,0 +1,35 @@
+package models
+
+const (
+	// IssueSearchIntoKeyTag is the reserved jira tag of the struct field receiving the issue key
+	IssueSearchIntoKeyTag = "@key"
+
+	// IssueSearchIntoIDTag is the reserved jira tag of the struct field receiving the issue id
+	IssueSearchIntoIDTag = "@id"
+
+	// IssueSearchIntoSelfTag is the reserved jira tag of the struct field receiving the issue self url
+	IssueSearchIntoSelfTag = "@self"
+
+	// IssueSearchIntoFieldsTag is the reserved jira tag of the struct field receiving every returned field of the issue,
+	// e.g. a map[string]interface{} field
+	IssueSearchIntoFieldsTag = "@fields"
+)
+
+// IssueSearchIntoOptionsScheme represents the options used to decode the searched issues into user structs
+type IssueSearchIntoOptionsScheme struct {
+	Expand        []string
+	ValidateQuery string
+
+	// StartAt is the index of the first issue returned
+	StartAt int
+
+	// MaxResults is the page size of the search, 50 by default
+	MaxResults int
+
+	// Limit is the maximum number of issues decoded, all the issues are decoded when it's 0
+	Limit int
+
+	// Strict returns an error when a tagged field is missing on an issue, unless the tag has the optional option,
+	// the missing fields are left with their zero value otherwise
+	Strict bool
+}
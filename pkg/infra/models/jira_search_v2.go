@@ -7,4 +7,8 @@ type IssueSearchSchemeV2 struct {
 	Total           int              `json:"total,omitempty"`
 	Issues          []*IssueSchemeV2 `json:"issues,omitempty"`
 	WarningMessages []string         `json:"warningMessages,omitempty"`
+
+	// PermissionWarnings contains the clauses and fields restricted to the user, they're read from the
+	// warning messages and the fields requested, it's only filled with the SearchValidateWarn validate mode.
+	PermissionWarnings []*IssueSearchPermissionWarningScheme `json:"-"`
 }
@@ -7,6 +7,10 @@ type IssueSearchScheme struct {
 	Total           int            `json:"total,omitempty"`
 	Issues          []*IssueScheme `json:"issues,omitempty"`
 	WarningMessages []string       `json:"warningMessages,omitempty"`
+
+	// PermissionWarnings contains the clauses and fields restricted to the user, they're read from the
+	// warning messages and the fields requested, it's only filled with the SearchValidateWarn validate mode.
+	PermissionWarnings []*IssueSearchPermissionWarningScheme `json:"-"`
 }
 
 type IssueTransitionsScheme struct {
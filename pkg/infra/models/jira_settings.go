@@ -0,0 +1,6 @@
+package models
+
+type ColumnItemScheme struct {
+	Label string `json:"label,omitempty"`
+	Value string `json:"value,omitempty"`
+}
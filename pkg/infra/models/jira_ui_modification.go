@@ -0,0 +1,91 @@
+package models
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	// UIModificationMaxDataLength is the maximum number of characters of the data of a UI modification
+	UIModificationMaxDataLength = 50000
+
+	// UIModificationMaxPerApp is the maximum number of UI modifications an app can create
+	UIModificationMaxPerApp = 1000
+)
+
+const (
+	UIModificationGlobalIssueCreateView = "GIC"
+	UIModificationIssueView             = "IssueView"
+	UIModificationIssueTransitionView   = "IssueTransition"
+)
+
+type UIModificationPageScheme struct {
+	PageMeta
+	Values []*UIModificationDetailsScheme `json:"values,omitempty"`
+}
+
+type UIModificationDetailsScheme struct {
+	ID          string                         `json:"id,omitempty"`
+	Name        string                         `json:"name,omitempty"`
+	Description string                         `json:"description,omitempty"`
+	Self        string                         `json:"self,omitempty"`
+	Data        string                         `json:"data,omitempty"`
+	Contexts    []*UIModificationContextScheme `json:"contexts,omitempty"`
+}
+
+// UIModificationContextScheme represents the project, issue type and view where a UI modification is applied,
+// the "*" wildcard matches all the projects, issue types or views.
+type UIModificationContextScheme struct {
+	ID          string `json:"id,omitempty"`
+	ProjectID   string `json:"projectId,omitempty"`
+	IssueTypeID string `json:"issueTypeId,omitempty"`
+	ViewType    string `json:"viewType,omitempty"`
+	IsAvailable bool   `json:"isAvailable,omitempty"`
+}
+
+type UIModificationPayloadScheme struct {
+	Name        string                         `json:"name,omitempty"`
+	Description string                         `json:"description,omitempty"`
+	Data        string                         `json:"data,omitempty"`
+	Contexts    []*UIModificationContextScheme `json:"contexts,omitempty"`
+}
+
+// Validate checks the data length and the contexts of the UI modification, the name is only required on creation.
+func (u *UIModificationPayloadScheme) Validate() error {
+
+	if utf8.RuneCountInString(u.Data) > UIModificationMaxDataLength {
+		return ErrUIModificationDataTooLargeError
+	}
+
+	for _, context := range u.Contexts {
+
+		if context == nil || context.ProjectID == "" || context.IssueTypeID == "" || context.ViewType == "" {
+			return ErrNoUIModificationContextError
+		}
+	}
+
+	return nil
+}
+
+type UIModificationIdentifiersScheme struct {
+	ID   string `json:"id,omitempty"`
+	Self string `json:"self,omitempty"`
+}
+
+// UIModificationLimitError is returned when the app reached the maximum number of UI modifications,
+// it matches ErrUIModificationLimitError with errors.Is and wraps the error of the rejected request.
+type UIModificationLimitError struct {
+	Err error
+}
+
+func (u *UIModificationLimitError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrUIModificationLimitError, u.Err)
+}
+
+func (u *UIModificationLimitError) Unwrap() error {
+	return u.Err
+}
+
+func (u *UIModificationLimitError) Is(target error) bool {
+	return target == ErrUIModificationLimitError
+}
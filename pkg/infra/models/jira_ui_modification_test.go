@@ -0,0 +1,16 @@
+package models
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUIModificationLimitError(t *testing.T) {
+
+	err := error(&UIModificationLimitError{Err: ErrInvalidStatusCodeError})
+
+	assert.True(t, errors.Is(err, ErrUIModificationLimitError))
+	assert.True(t, errors.Is(err, ErrInvalidStatusCodeError))
+	assert.EqualError(t, err, ErrUIModificationLimitError.Error()+": "+ErrInvalidStatusCodeError.Error())
+}
@@ -1,20 +1,41 @@
 package models
 
+import "encoding/json"
+
+const (
+	VersionExpandOperations   = "operations"
+	VersionExpandIssuesStatus = "issuesstatus"
+	VersionExpandDriver       = "driver"
+	VersionExpandApprovers    = "approvers"
+)
+
 type VersionScheme struct {
+	Expand                    string                                  `json:"expand,omitempty"`
 	Self                      string                                  `json:"self,omitempty"`
 	ID                        string                                  `json:"id,omitempty"`
 	Description               string                                  `json:"description,omitempty"`
 	Name                      string                                  `json:"name,omitempty"`
 	Archived                  bool                                    `json:"archived,omitempty"`
 	Released                  bool                                    `json:"released,omitempty"`
+	StartDate                 string                                  `json:"startDate,omitempty"`
 	ReleaseDate               string                                  `json:"releaseDate,omitempty"`
 	Overdue                   bool                                    `json:"overdue,omitempty"`
+	UserStartDate             string                                  `json:"userStartDate,omitempty"`
 	UserReleaseDate           string                                  `json:"userReleaseDate,omitempty"`
 	ProjectID                 int                                     `json:"projectId,omitempty"`
+	Driver                    string                                  `json:"driver,omitempty"`
+	Approvers                 []*VersionApproverScheme                `json:"approvers,omitempty"`
 	Operations                []*VersionOperation                     `json:"operations,omitempty"`
 	IssuesStatusForFixVersion *VersionIssuesStatusForFixVersionScheme `json:"issuesStatusForFixVersion,omitempty"`
 }
 
+type VersionApproverScheme struct {
+	AccountID     string `json:"accountId,omitempty"`
+	Status        string `json:"status,omitempty"`
+	Description   string `json:"description,omitempty"`
+	DeclineReason string `json:"declineReason,omitempty"`
+}
+
 type VersionOperation struct {
 	ID         string `json:"id,omitempty"`
 	StyleClass string `json:"styleClass,omitempty"`
@@ -40,6 +61,21 @@ type VersionPageScheme struct {
 	Values     []*VersionScheme `json:"values,omitempty"`
 }
 
+// HasNext returns true if there are more versions to fetch after the current page.
+func (v *VersionPageScheme) HasNext() bool {
+	return v != nil && hasNextPage(v.IsLast, v.NextPage, v.StartAt, len(v.Values), v.Total)
+}
+
+// NextStartAt returns the startAt value needed to request the next page.
+func (v *VersionPageScheme) NextStartAt() int {
+
+	if v == nil {
+		return 0
+	}
+
+	return v.StartAt + len(v.Values)
+}
+
 type VersionGetsOptions struct {
 	OrderBy string
 	Query   string
@@ -48,13 +84,34 @@ type VersionGetsOptions struct {
 }
 
 type VersionPayloadScheme struct {
-	Archived    bool   `json:"archived,omitempty"`
-	ReleaseDate string `json:"releaseDate,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	ProjectID   int    `json:"projectId,omitempty"`
-	Released    bool   `json:"released,omitempty"`
-	StartDate   string `json:"startDate,omitempty"`
+	Archived    bool                     `json:"archived,omitempty"`
+	ReleaseDate string                   `json:"releaseDate,omitempty"`
+	Name        string                   `json:"name,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	ProjectID   int                      `json:"projectId,omitempty"`
+	Released    bool                     `json:"released,omitempty"`
+	StartDate   string                   `json:"startDate,omitempty"`
+	Driver      string                   `json:"driver,omitempty"`
+	Approvers   []*VersionApproverScheme `json:"approvers,omitempty"`
+
+	// MoveUnfixedIssuesTo is the self URL of the version receiving the unresolved issues, it's only used on the updates
+	MoveUnfixedIssuesTo string `json:"moveUnfixedIssuesTo,omitempty"`
+
+	// NullFields are the fields sent as null, so the update clears them, e.g. releaseDate or driver
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the payload, the NullFields are sent as null.
+func (v VersionPayloadScheme) MarshalJSON() ([]byte, error) {
+
+	type alias VersionPayloadScheme
+
+	data, err := json.Marshal(alias(v))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalNullFields(data, v.NullFields)
 }
 
 type VersionIssueCountsScheme struct {
@@ -86,3 +143,31 @@ type VersionDetailScheme struct {
 	Released    bool   `json:"released,omitempty"`
 	ReleaseDate string `json:"releaseDate,omitempty"`
 }
+
+type VersionRelatedWorkScheme struct {
+	RelatedWorkID string `json:"relatedWorkId,omitempty"`
+	Category      string `json:"category,omitempty"`
+	Title         string `json:"title,omitempty"`
+	URL           string `json:"url,omitempty"`
+	IssueID       int    `json:"issueId,omitempty"`
+}
+
+const (
+	VersionPositionFirst   = "First"   // The version is moved to the start of the project versions
+	VersionPositionLast    = "Last"    // The version is moved to the end of the project versions
+	VersionPositionEarlier = "Earlier" // The version is moved one position up
+	VersionPositionLater   = "Later"   // The version is moved one position down
+)
+
+// VersionMovePayloadScheme represents the new position of a version, either after another version or on a position.
+type VersionMovePayloadScheme struct {
+	After    string `json:"after,omitempty"`    // The self URL of the version placed before the moved version
+	Position string `json:"position,omitempty"` // One of the VersionPosition values
+}
+
+// VersionReleaseScheme represents a released version and its unresolved issues at the release.
+type VersionReleaseScheme struct {
+	Version          *VersionScheme
+	UnresolvedIssues int            // The unresolved issues at the release, they're left on the version unless moved
+	MovedTo          *VersionScheme // The version receiving the unresolved issues, nil when they're not moved
+}
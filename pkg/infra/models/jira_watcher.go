@@ -8,13 +8,14 @@ type IssueWatcherScheme struct {
 }
 
 type UserDetailScheme struct {
-	Self         string `json:"self,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Key          string `json:"key,omitempty"`
-	AccountID    string `json:"accountId,omitempty"`
-	EmailAddress string `json:"emailAddress,omitempty"`
-	DisplayName  string `json:"displayName,omitempty"`
-	Active       bool   `json:"active,omitempty"`
-	TimeZone     string `json:"timeZone,omitempty"`
-	AccountType  string `json:"accountType,omitempty"`
+	Self         string           `json:"self,omitempty"`
+	Name         string           `json:"name,omitempty"`
+	Key          string           `json:"key,omitempty"`
+	AccountID    string           `json:"accountId,omitempty"`
+	EmailAddress string           `json:"emailAddress,omitempty"`
+	AvatarUrls   *AvatarURLScheme `json:"avatarUrls,omitempty"`
+	DisplayName  string           `json:"displayName,omitempty"`
+	Active       bool             `json:"active,omitempty"`
+	TimeZone     string           `json:"timeZone,omitempty"`
+	AccountType  string           `json:"accountType,omitempty"`
 }
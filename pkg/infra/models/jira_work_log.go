@@ -1,10 +1,13 @@
 package models
 
+import "time"
+
 type WorklogOptionsScheme struct {
 	Notify               bool
 	AdjustEstimate       string
 	NewEstimate          string
 	ReduceBy             string
+	IncreaseBy           string // IncreaseBy is only used by the worklog deletion, when the estimate is adjusted manually
 	OverrideEditableFlag bool
 	Expand               []string
 }
@@ -25,9 +28,14 @@ type WorklogPayloadSchemeV2 struct {
 	TimeSpentSeconds int                           `json:"timeSpentSeconds,omitempty"`
 }
 
+// FormatWorklogStarted formats the date as the started date of a worklog payload.
+func FormatWorklogStarted(started time.Time) string {
+	return started.Format(DateFormatWorklogStarted)
+}
+
 type ChangedWorklogPageScheme struct {
-	Since    int                     `json:"since,omitempty"`
-	Until    int                     `json:"until,omitempty"`
+	Since    int64                   `json:"since,omitempty"`
+	Until    int64                   `json:"until,omitempty"`
 	Self     string                  `json:"self,omitempty"`
 	NextPage string                  `json:"nextPage,omitempty"`
 	LastPage bool                    `json:"lastPage,omitempty"`
@@ -36,7 +44,7 @@ type ChangedWorklogPageScheme struct {
 
 type ChangedWorklogScheme struct {
 	WorklogID   int                             `json:"worklogId,omitempty"`
-	UpdatedTime int                             `json:"updatedTime,omitempty"`
+	UpdatedTime int64                           `json:"updatedTime,omitempty"`
 	Properties  []*ChangedWorklogPropertyScheme `json:"properties,omitempty"`
 }
 
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFormatWorklogStarted(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		started time.Time
+		want    string
+	}{
+		{
+			name:    "when the date has no milliseconds",
+			started: time.Date(2021, 1, 17, 12, 34, 0, 0, time.UTC),
+			want:    "2021-01-17T12:34:00.000+0000",
+		},
+		{
+			name:    "when the date has a timezone offset",
+			started: time.Date(2021, 1, 17, 12, 34, 5, 120000000, time.FixedZone("CST", -6*60*60)),
+			want:    "2021-01-17T12:34:05.120-0600",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, FormatWorklogStarted(testCase.started))
+		})
+	}
+}
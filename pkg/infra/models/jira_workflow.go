@@ -107,3 +107,23 @@ type WorkflowConditionScheme struct {
 	Operator      string                     `json:"operator,omitempty"`
 	Type          string                     `json:"type,omitempty"`
 }
+
+type WorkflowProjectUsageScheme struct {
+	WorkflowID string                   `json:"workflowId,omitempty"`
+	Projects   *WorkflowUsagePageScheme `json:"projects,omitempty"`
+}
+
+type WorkflowIssueTypeUsageScheme struct {
+	WorkflowID string                   `json:"workflowId,omitempty"`
+	ProjectID  string                   `json:"projectId,omitempty"`
+	IssueTypes *WorkflowUsagePageScheme `json:"issueTypes,omitempty"`
+}
+
+type WorkflowUsagePageScheme struct {
+	NextPageToken string                          `json:"nextPageToken,omitempty"`
+	Values        []*WorkflowUsageReferenceScheme `json:"values,omitempty"`
+}
+
+type WorkflowUsageReferenceScheme struct {
+	ID string `json:"id,omitempty"`
+}
@@ -17,6 +17,7 @@ type WorkflowStatusDetailScheme struct {
 	Scope          *WorkflowStatusScopeScheme `json:"scope,omitempty"`
 	Description    string                     `json:"description,omitempty"`
 	Usages         []*ProjectIssueTypesScheme `json:"usages,omitempty"`
+	WorkflowUsages []*WorkflowUsagesScheme    `json:"workflowUsages,omitempty"`
 }
 
 type WorkflowStatusScopeScheme struct {
@@ -33,6 +34,11 @@ type ProjectIssueTypesScheme struct {
 	IssueTypes []string       `json:"issueTypes,omitempty"`
 }
 
+type WorkflowUsagesScheme struct {
+	WorkflowID   string `json:"workflowId,omitempty"`
+	WorkflowName string `json:"workflowName,omitempty"`
+}
+
 type WorkflowStatusPayloadScheme struct {
 	Statuses []*WorkflowStatusNodeScheme `json:"statuses,omitempty"`
 	Scope    *WorkflowStatusScopeScheme  `json:"scope,omitempty"`
@@ -50,14 +56,18 @@ type WorkflowStatusSearchParams struct {
 	SearchString   string
 	StatusCategory string
 	Expand         []string
+
+	// AcceptLanguage pins the locale of the status names returned, e.g. "fr-FR", the user locale is used by default
+	AcceptLanguage string
 }
 
 type StatusDetailScheme struct {
-	Self           string                     `json:"self,omitempty"`
-	Description    string                     `json:"description,omitempty"`
-	IconURL        string                     `json:"iconUrl,omitempty"`
-	Name           string                     `json:"name,omitempty"`
-	ID             string                     `json:"id,omitempty"`
-	StatusCategory *StatusCategoryScheme      `json:"statusCategory,omitempty"`
-	Scope          *WorkflowStatusScopeScheme `json:"scope,omitempty"`
+	Self             string                     `json:"self,omitempty"`
+	Description      string                     `json:"description,omitempty"`
+	IconURL          string                     `json:"iconUrl,omitempty"`
+	Name             string                     `json:"name,omitempty"`
+	UntranslatedName string                     `json:"untranslatedName,omitempty"`
+	ID               string                     `json:"id,omitempty"`
+	StatusCategory   *StatusCategoryScheme      `json:"statusCategory,omitempty"`
+	Scope            *WorkflowStatusScopeScheme `json:"scope,omitempty"`
 }
@@ -0,0 +1,24 @@
+package models
+
+import "context"
+
+type suppressNotificationsKey struct{}
+
+// WithSuppressNotifications returns a copy of the context that suppresses the notifications, the services
+// send notifyUsers=false on the endpoints that support it, e.g. the issue update or the worklog mutations.
+//
+// The endpoints without a notification parameter, e.g. the issue assignment or the transitions, still notify the users.
+func WithSuppressNotifications(ctx context.Context) context.Context {
+	return context.WithValue(ctx, suppressNotificationsKey{}, true)
+}
+
+// NotificationsSuppressed reports whether the context was created by WithSuppressNotifications.
+func NotificationsSuppressed(ctx context.Context) bool {
+
+	if ctx == nil {
+		return false
+	}
+
+	suppressed, _ := ctx.Value(suppressNotificationsKey{}).(bool)
+	return suppressed
+}
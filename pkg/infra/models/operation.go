@@ -0,0 +1,25 @@
+package models
+
+import "context"
+
+type operationKey struct{}
+
+// WithOperation returns a copy of the context naming the requests created with it, e.g. jira.v3.project.version.search.
+//
+// The service methods name their requests with their own operation, the product and the API version of the client
+// followed by the path of the method on the client. The name is available on the request context, for the HTTP clients
+// wrapping the requests, e.g. a metrics recorder, and on ResponseScheme.Operation.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFromContext returns the operation of the context, empty when the context wasn't created by WithOperation.
+func OperationFromContext(ctx context.Context) string {
+
+	if ctx == nil {
+		return ""
+	}
+
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
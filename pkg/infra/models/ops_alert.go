@@ -0,0 +1,123 @@
+package models
+
+const (
+	AlertPriorityP1 = "P1"
+	AlertPriorityP2 = "P2"
+	AlertPriorityP3 = "P3"
+	AlertPriorityP4 = "P4"
+	AlertPriorityP5 = "P5"
+)
+
+// AlertPayloadScheme represents the payload used to create an alert
+type AlertPayloadScheme struct {
+	Message     string                  `json:"message"`
+	Alias       string                  `json:"alias,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Responders  []*AlertResponderScheme `json:"responders,omitempty"`
+	VisibleTo   []*AlertResponderScheme `json:"visibleTo,omitempty"`
+	Actions     []string                `json:"actions,omitempty"`
+	Tags        []string                `json:"tags,omitempty"`
+	Details     map[string]string       `json:"details,omitempty"`
+	Entity      string                  `json:"entity,omitempty"`
+	Source      string                  `json:"source,omitempty"`
+	Priority    string                  `json:"priority,omitempty"`
+	User        string                  `json:"user,omitempty"`
+	Note        string                  `json:"note,omitempty"`
+}
+
+// AlertResponderScheme represents a responder of an alert, the type is team, user, escalation or schedule
+type AlertResponderScheme struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// AlertActionPayloadScheme represents the payload used to close, acknowledge or add a note to an alert
+type AlertActionPayloadScheme struct {
+	User   string `json:"user,omitempty"`
+	Source string `json:"source,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+// AlertRequestScheme represents an accepted alert request, the alerts are created and updated asynchronously,
+// use the request id to poll the status of the request
+type AlertRequestScheme struct {
+	Result    string  `json:"result,omitempty"`
+	Took      float64 `json:"took,omitempty"`
+	RequestID string  `json:"requestId,omitempty"`
+}
+
+// AlertRequestStatusScheme represents the status of an alert request
+type AlertRequestStatusScheme struct {
+	Action        string `json:"action,omitempty"`
+	ProcessedAt   string `json:"processedAt,omitempty"`
+	IntegrationID string `json:"integrationId,omitempty"`
+	IsSuccess     bool   `json:"isSuccess,omitempty"`
+	Status        string `json:"status,omitempty"`
+	AlertID       string `json:"alertId,omitempty"`
+	Alias         string `json:"alias,omitempty"`
+}
+
+type AlertScheme struct {
+	ID             string                  `json:"id,omitempty"`
+	TinyID         string                  `json:"tinyId,omitempty"`
+	Alias          string                  `json:"alias,omitempty"`
+	Message        string                  `json:"message,omitempty"`
+	Status         string                  `json:"status,omitempty"`
+	Acknowledged   bool                    `json:"acknowledged,omitempty"`
+	IsSeen         bool                    `json:"isSeen,omitempty"`
+	Tags           []string                `json:"tags,omitempty"`
+	Snoozed        bool                    `json:"snoozed,omitempty"`
+	SnoozedUntil   string                  `json:"snoozedUntil,omitempty"`
+	Count          int                     `json:"count,omitempty"`
+	LastOccurredAt string                  `json:"lastOccuredAt,omitempty"`
+	CreatedAt      string                  `json:"createdAt,omitempty"`
+	UpdatedAt      string                  `json:"updatedAt,omitempty"`
+	Source         string                  `json:"source,omitempty"`
+	Owner          string                  `json:"owner,omitempty"`
+	OwnerTeamID    string                  `json:"ownerTeamId,omitempty"`
+	Priority       string                  `json:"priority,omitempty"`
+	Responders     []*AlertResponderScheme `json:"responders,omitempty"`
+	Integration    *AlertIntegrationScheme `json:"integration,omitempty"`
+	Report         *AlertReportScheme      `json:"report,omitempty"`
+	Actions        []string                `json:"actions,omitempty"`
+	Entity         string                  `json:"entity,omitempty"`
+	Description    string                  `json:"description,omitempty"`
+	Details        map[string]string       `json:"details,omitempty"`
+}
+
+type AlertIntegrationScheme struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+type AlertReportScheme struct {
+	AckTime        int64  `json:"ackTime,omitempty"`
+	CloseTime      int64  `json:"closeTime,omitempty"`
+	AcknowledgedBy string `json:"acknowledgedBy,omitempty"`
+	ClosedBy       string `json:"closedBy,omitempty"`
+}
+
+// AlertSearchOptionsScheme represents the options used to list the alerts
+type AlertSearchOptionsScheme struct {
+
+	// Query is the search query of the alerts, e.g. status: open AND priority: P1
+	Query string
+
+	// Sort is the field used to sort the alerts, e.g. createdAt
+	Sort string
+
+	// Order is the order of the alerts, asc or desc
+	Order string
+}
+
+type AlertPageScheme struct {
+	Values []*AlertScheme        `json:"values,omitempty"`
+	Count  int                   `json:"count,omitempty"`
+	Links  *AlertPageLinksScheme `json:"links,omitempty"`
+}
+
+type AlertPageLinksScheme struct {
+	Next string `json:"next,omitempty"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "encoding/json"
+
+// marshalNullFields adds the null fields to the encoded object, the payload fields are omitted when they're empty,
+// so the null fields are the only way to clear a field, e.g. the due date of an issue.
+func marshalNullFields(data []byte, nullFields []string) ([]byte, error) {
+
+	if len(nullFields) == 0 {
+		return data, nil
+	}
+
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for _, field := range nullFields {
+		object[field] = json.RawMessage("null")
+	}
+
+	return json.Marshal(object)
+}
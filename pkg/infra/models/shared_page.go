@@ -0,0 +1,56 @@
+package models
+
+// Paginated is implemented by the page schemes, the schemes embedding the PageMeta or the ServiceManagementPageScheme
+// struct and the version, dashboard and filter pages.
+type Paginated interface {
+	HasNext() bool
+	NextStartAt() int
+}
+
+// PageMeta represents the offset-based pagination attributes shared by the Jira page schemes.
+type PageMeta struct {
+	Self       string `json:"self,omitempty"`
+	NextPage   string `json:"nextPage,omitempty"`
+	MaxResults int    `json:"maxResults,omitempty"`
+	StartAt    int    `json:"startAt,omitempty"`
+	Total      int    `json:"total,omitempty"`
+	IsLast     bool   `json:"isLast,omitempty"`
+}
+
+// HasNext returns true if there are more records to fetch after the current page.
+//
+// The isLast flag takes precedence, the nextPage link and the total are used when the endpoint doesn't return the flag.
+// The page size is used as the number of records of the page, the pages without page size have no next page.
+func (p *PageMeta) HasNext() bool {
+
+	if p == nil {
+		return false
+	}
+
+	return hasNextPage(p.IsLast, p.NextPage, p.StartAt, p.MaxResults, p.Total)
+}
+
+// NextStartAt returns the startAt value needed to request the next page.
+func (p *PageMeta) NextStartAt() int {
+
+	if p == nil {
+		return 0
+	}
+
+	return p.StartAt + p.MaxResults
+}
+
+// hasNextPage reports whether there are records after the count records of the page starting at startAt.
+// The pages without records have no next page, so the same page is never requested again.
+func hasNextPage(isLast bool, nextPage string, startAt, count, total int) bool {
+
+	if isLast || count <= 0 {
+		return false
+	}
+
+	if nextPage != "" {
+		return true
+	}
+
+	return startAt+count < total
+}
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPageMeta_HasNext(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		page *PageMeta
+		want bool
+	}{
+		{
+			name: "when the page is the last one",
+			page: &PageMeta{StartAt: 0, MaxResults: 50, Total: 100, IsLast: true},
+			want: false,
+		},
+
+		{
+			name: "when the page contains the next page link",
+			page: &PageMeta{StartAt: 0, MaxResults: 50, NextPage: "https://ctreminiom.atlassian.net/rest/api/3/project/KP/version?startAt=50"},
+			want: true,
+		},
+
+		{
+			name: "when the total is greater than the records fetched",
+			page: &PageMeta{StartAt: 50, MaxResults: 50, Total: 101},
+			want: true,
+		},
+
+		{
+			name: "when the total is equal to the records fetched",
+			page: &PageMeta{StartAt: 50, MaxResults: 50, Total: 100},
+			want: false,
+		},
+
+		{
+			name: "when the page size is not returned",
+			page: &PageMeta{StartAt: 0, Total: 100},
+			want: false,
+		},
+
+		{
+			name: "when the page is nil",
+			page: nil,
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.page.HasNext())
+		})
+	}
+}
+
+func TestPageMeta_NextStartAt(t *testing.T) {
+
+	page := &PageMeta{StartAt: 50, MaxResults: 25}
+	assert.Equal(t, 75, page.NextStartAt())
+
+	var nilPage *PageMeta
+	assert.Equal(t, 0, nilPage.NextStartAt())
+}
+
+func TestPageMeta_JSONCompatibility(t *testing.T) {
+
+	data := []byte(`{"self":"https://ctreminiom.atlassian.net/rest/api/3/project/KP/version","maxResults":1,"startAt":0,"total":2,"isLast":false,"values":[{"id":"10000","name":"v1.0"}]}`)
+
+	page := new(VersionPageScheme)
+	assert.NoError(t, json.Unmarshal(data, page))
+
+	assert.Equal(t, 2, page.Total)
+	assert.Equal(t, 1, page.MaxResults)
+	assert.Equal(t, 1, len(page.Values))
+	assert.True(t, page.HasNext())
+	assert.Equal(t, 1, page.NextStartAt())
+
+	var paginated Paginated = page
+	assert.True(t, paginated.HasNext())
+
+	encoded, err := json.Marshal(page)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"self":"https://ctreminiom.atlassian.net/rest/api/3/project/KP/version","maxResults":1,"total":2,"values":[{"id":"10000","name":"v1.0"}]}`, string(encoded))
+}
+
+func TestPageSchemes_Paginated(t *testing.T) {
+
+	// The page size is larger than the records returned, the next page starts after the records
+	versions := &VersionPageScheme{StartAt: 0, MaxResults: 50, Total: 3, Values: []*VersionScheme{{ID: "1"}, {ID: "2"}}}
+	assert.True(t, versions.HasNext())
+	assert.Equal(t, 2, versions.NextStartAt())
+
+	// The empty pages never request the same page again
+	empty := &FilterSearchPageScheme{StartAt: 50, Total: 100}
+	assert.False(t, empty.HasNext())
+
+	dashboards := &DashboardPageScheme{StartAt: 0, MaxResults: 1, Total: 2, Dashboards: []*DashboardScheme{{ID: "10000"}}}
+	assert.True(t, dashboards.HasNext())
+	assert.Equal(t, 1, dashboards.NextStartAt())
+	assert.Equal(t, dashboards.Dashboards, dashboards.Values())
+
+	// The service management pages are followed by their next link
+	customerRequests := &CustomerRequestPageScheme{ServiceManagementPageScheme: ServiceManagementPageScheme{
+		Size: 1, Start: 50, Links: &ServiceManagementPageLinksScheme{Next: "https://ctreminiom.atlassian.net/rest/servicedeskapi/request?start=51"}}}
+	assert.True(t, customerRequests.HasNext())
+	assert.Equal(t, 51, customerRequests.NextStartAt())
+
+	var pages = []Paginated{
+		&VersionPageScheme{IsLast: true, Values: []*VersionScheme{{ID: "1"}}},
+		&CustomerRequestPageScheme{ServiceManagementPageScheme: ServiceManagementPageScheme{IsLastPage: true}},
+		&FilterPageScheme{Total: 1, Values: []*FilterScheme{{ID: "1"}}},
+		&DashboardSearchPageScheme{Total: 1, Values: []*DashboardScheme{{ID: "1"}}},
+		(*VersionPageScheme)(nil),
+	}
+
+	for _, page := range pages {
+		assert.False(t, page.HasNext())
+	}
+}
@@ -12,4 +12,11 @@ type ResponseScheme struct {
 	Endpoint string
 	Method   string
 	Bytes    bytes.Buffer
+
+	// Operation is the name of the service method sending the request, e.g. jira.v3.project.version.search
+	Operation string
+
+	// UnknownFields are the response fields the scheme can't decode, they're only detected by the clients
+	// with the strict decoding enabled.
+	UnknownFields []string
 }
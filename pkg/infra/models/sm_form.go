@@ -0,0 +1,221 @@
+package models
+
+import (
+	"sort"
+	"strconv"
+)
+
+const (
+	FormQuestionShortText   = "ts"
+	FormQuestionLongText    = "tl"
+	FormQuestionParagraph   = "tp"
+	FormQuestionEmail       = "te"
+	FormQuestionURL         = "tu"
+	FormQuestionNumber      = "no"
+	FormQuestionDate        = "da"
+	FormQuestionDateTime    = "dt"
+	FormQuestionTime        = "ti"
+	FormQuestionDropdown    = "cd"
+	FormQuestionMultiSelect = "cl"
+	FormQuestionCheckboxes  = "cm"
+	FormQuestionRadio       = "cs"
+	FormQuestionSingleUser  = "us"
+	FormQuestionMultiUser   = "um"
+	FormQuestionAttachment  = "at"
+)
+
+const (
+	FormQuestionKindText       = "text"
+	FormQuestionKindNumber     = "number"
+	FormQuestionKindDate       = "date"
+	FormQuestionKindChoice     = "choice"
+	FormQuestionKindUser       = "user"
+	FormQuestionKindAttachment = "attachment"
+	FormQuestionKindUnknown    = "unknown"
+)
+
+const (
+	FormStatusOpen      = "o"
+	FormStatusSubmitted = "s"
+	FormStatusLocked    = "l"
+)
+
+var formQuestionKinds = map[string]string{
+	FormQuestionShortText:   FormQuestionKindText,
+	FormQuestionLongText:    FormQuestionKindText,
+	FormQuestionParagraph:   FormQuestionKindText,
+	FormQuestionEmail:       FormQuestionKindText,
+	FormQuestionURL:         FormQuestionKindText,
+	FormQuestionNumber:      FormQuestionKindNumber,
+	FormQuestionDate:        FormQuestionKindDate,
+	FormQuestionDateTime:    FormQuestionKindDate,
+	FormQuestionTime:        FormQuestionKindDate,
+	FormQuestionDropdown:    FormQuestionKindChoice,
+	FormQuestionMultiSelect: FormQuestionKindChoice,
+	FormQuestionCheckboxes:  FormQuestionKindChoice,
+	FormQuestionRadio:       FormQuestionKindChoice,
+	FormQuestionSingleUser:  FormQuestionKindUser,
+	FormQuestionMultiUser:   FormQuestionKindUser,
+	FormQuestionAttachment:  FormQuestionKindAttachment,
+}
+
+type FormIndexScheme struct {
+	ID           string                       `json:"id,omitempty"`
+	Name         string                       `json:"name,omitempty"`
+	Updated      string                       `json:"updated,omitempty"`
+	Submitted    bool                         `json:"submitted,omitempty"`
+	Lock         bool                         `json:"lock,omitempty"`
+	Internal     bool                         `json:"internal,omitempty"`
+	FormTemplate *FormTemplateReferenceScheme `json:"formTemplate,omitempty"`
+}
+
+type FormTemplateReferenceScheme struct {
+	ID string `json:"id,omitempty"`
+}
+
+type FormScheme struct {
+	ID      string            `json:"id,omitempty"`
+	Updated string            `json:"updated,omitempty"`
+	Design  *FormDesignScheme `json:"design,omitempty"`
+	State   *FormStateScheme  `json:"state,omitempty"`
+}
+
+type FormDesignScheme struct {
+	Settings  *FormSettingsScheme            `json:"settings,omitempty"`
+	Questions map[string]*FormQuestionScheme `json:"questions,omitempty"`
+}
+
+type FormSettingsScheme struct {
+	TemplateID string `json:"templateId,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+type FormQuestionScheme struct {
+	Type        string              `json:"type,omitempty"`
+	Label       string              `json:"label,omitempty"`
+	Description string              `json:"description,omitempty"`
+	QuestionKey string              `json:"questionKey,omitempty"`
+	JiraField   string              `json:"jiraField,omitempty"`
+	Choices     []*FormChoiceScheme `json:"choices,omitempty"`
+}
+
+// Kind returns the kind of answer expected by the question, e.g. FormQuestionKindChoice for the dropdowns.
+func (f *FormQuestionScheme) Kind() string {
+
+	if kind, ok := formQuestionKinds[f.Type]; ok {
+		return kind
+	}
+
+	return FormQuestionKindUnknown
+}
+
+type FormChoiceScheme struct {
+	ID    string `json:"id,omitempty"`
+	Label string `json:"label,omitempty"`
+}
+
+type FormStateScheme struct {
+	Status     string                       `json:"status,omitempty"`
+	Visibility string                       `json:"visibility,omitempty"`
+	Answers    map[string]*FormAnswerScheme `json:"answers,omitempty"`
+}
+
+type FormAnswerScheme struct {
+	Text    string                  `json:"text,omitempty"`
+	Date    string                  `json:"date,omitempty"`
+	Time    string                  `json:"time,omitempty"`
+	Choices []string                `json:"choices,omitempty"`
+	Users   []*FormAnswerUserScheme `json:"users,omitempty"`
+	Files   []*FormAnswerFileScheme `json:"files,omitempty"`
+}
+
+type FormAnswerUserScheme struct {
+	ID string `json:"id,omitempty"`
+}
+
+type FormAnswerFileScheme struct {
+	ID string `json:"id,omitempty"`
+}
+
+type FormStatusScheme struct {
+	Status string `json:"status,omitempty"`
+}
+
+// FormQuestionAnswerScheme joins a question of the form with its answer, the choices are returned by label.
+type FormQuestionAnswerScheme struct {
+	QuestionID string
+	Label      string
+	Type       string
+	Kind       string
+	Text       string   // The text and number answers
+	Date       string   // The date answers, using the YYYY-MM-DD format
+	Time       string   // The time answers, using the HH:mm format
+	Choices    []string // The labels of the selected choices
+	Users      []string // The account ids of the selected users
+}
+
+// Answers returns the answers of the form, ordered by the question ids, the questions without answer are included.
+func (f *FormScheme) Answers() []*FormQuestionAnswerScheme {
+
+	if f.Design == nil {
+		return nil
+	}
+
+	questionIDs := make([]string, 0, len(f.Design.Questions))
+	for questionID := range f.Design.Questions {
+		questionIDs = append(questionIDs, questionID)
+	}
+
+	sort.Slice(questionIDs, func(x, y int) bool {
+
+		left, leftErr := strconv.Atoi(questionIDs[x])
+		right, rightErr := strconv.Atoi(questionIDs[y])
+		if leftErr != nil || rightErr != nil {
+			return questionIDs[x] < questionIDs[y]
+		}
+
+		return left < right
+	})
+
+	answers := make([]*FormQuestionAnswerScheme, 0, len(questionIDs))
+	for _, questionID := range questionIDs {
+
+		question := f.Design.Questions[questionID]
+		answer := &FormQuestionAnswerScheme{
+			QuestionID: questionID,
+			Label:      question.Label,
+			Type:       question.Type,
+			Kind:       question.Kind(),
+		}
+
+		if f.State != nil && f.State.Answers[questionID] != nil {
+
+			value := f.State.Answers[questionID]
+			answer.Text, answer.Date, answer.Time = value.Text, value.Date, value.Time
+
+			for _, choiceID := range value.Choices {
+				answer.Choices = append(answer.Choices, question.choiceLabel(choiceID))
+			}
+
+			for _, user := range value.Users {
+				answer.Users = append(answer.Users, user.ID)
+			}
+		}
+
+		answers = append(answers, answer)
+	}
+
+	return answers
+}
+
+// choiceLabel returns the label of a choice, the id is returned when the choice is not defined.
+func (f *FormQuestionScheme) choiceLabel(choiceID string) string {
+
+	for _, choice := range f.Choices {
+		if choice.ID == choiceID {
+			return choice.Label
+		}
+	}
+
+	return choiceID
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFormScheme_Answers(t *testing.T) {
+
+	data := `{
+		"id": "1d1b0b9a-3de8-4e18-a9fd-ec0fe4e34e41",
+		"design": {
+			"questions": {
+				"10": {"type": "us", "label": "Manager"},
+				"2": {"type": "cd", "label": "Laptop", "choices": [{"id": "1", "label": "MacBook Pro"}, {"id": "2", "label": "ThinkPad"}]},
+				"1": {"type": "ts", "label": "Full name"},
+				"3": {"type": "da", "label": "Start date"},
+				"4": {"type": "xx", "label": "Signature"}
+			}
+		},
+		"state": {
+			"status": "s",
+			"answers": {
+				"1": {"text": "Carlos Treminio"},
+				"2": {"choices": ["2"]},
+				"3": {"date": "2023-04-01"},
+				"10": {"users": [{"id": "5b86be50b8e3cb5895860d6d"}]}
+			}
+		}
+	}`
+
+	form := new(FormScheme)
+	assert.NoError(t, json.Unmarshal([]byte(data), form))
+
+	assert.Equal(t, []*FormQuestionAnswerScheme{
+		{QuestionID: "1", Label: "Full name", Type: FormQuestionShortText, Kind: FormQuestionKindText, Text: "Carlos Treminio"},
+		{QuestionID: "2", Label: "Laptop", Type: FormQuestionDropdown, Kind: FormQuestionKindChoice, Choices: []string{"ThinkPad"}},
+		{QuestionID: "3", Label: "Start date", Type: FormQuestionDate, Kind: FormQuestionKindDate, Date: "2023-04-01"},
+		{QuestionID: "4", Label: "Signature", Type: "xx", Kind: FormQuestionKindUnknown},
+		{QuestionID: "10", Label: "Manager", Type: FormQuestionSingleUser, Kind: FormQuestionKindUser, Users: []string{"5b86be50b8e3cb5895860d6d"}},
+	}, form.Answers())
+
+	assert.Nil(t, (&FormScheme{}).Answers())
+}
@@ -0,0 +1,46 @@
+package models
+
+// ServiceManagementPageScheme represents the pagination shared by the service management pages,
+// the pages are followed by their next link until the last page.
+//
+// The page schemes embed it, so the pages implement the Paginated interface.
+type ServiceManagementPageScheme struct {
+	Size       int                               `json:"size,omitempty"`
+	Start      int                               `json:"start,omitempty"`
+	Limit      int                               `json:"limit,omitempty"`
+	IsLastPage bool                              `json:"isLastPage,omitempty"`
+	Links      *ServiceManagementPageLinksScheme `json:"_links,omitempty"`
+}
+
+type ServiceManagementPageLinksScheme struct {
+	Self    string `json:"self,omitempty"`
+	Base    string `json:"base,omitempty"`
+	Context string `json:"context,omitempty"`
+	Next    string `json:"next,omitempty"`
+	Prev    string `json:"prev,omitempty"`
+}
+
+// HasNext reports whether the page is followed by another page.
+func (s *ServiceManagementPageScheme) HasNext() bool {
+	return s != nil && !s.IsLastPage && s.Links != nil && s.Links.Next != ""
+}
+
+// NextStartAt returns the start value needed to request the next page.
+func (s *ServiceManagementPageScheme) NextStartAt() int {
+
+	if s == nil {
+		return 0
+	}
+
+	return s.Start + s.Size
+}
+
+// PageLinks returns the links of the page, it's used to follow the next link.
+func (s *ServiceManagementPageScheme) PageLinks() *ServiceManagementPageLinksScheme {
+
+	if s == nil {
+		return nil
+	}
+
+	return s.Links
+}
@@ -0,0 +1,7 @@
+package models
+
+// PortalAnnouncementScheme represents the announcement banner of the help center or of a customer portal.
+type PortalAnnouncementScheme struct {
+	Header  string `json:"header,omitempty"`
+	Message string `json:"message,omitempty"`
+}
@@ -31,22 +31,13 @@ type CustomerRequestTransitionPageLinkScheme struct {
 }
 
 type CustomerRequestPageScheme struct {
-	Size       int                          `json:"size,omitempty"`
-	Start      int                          `json:"start,omitempty"`
-	Limit      int                          `json:"limit,omitempty"`
-	IsLastPage bool                         `json:"isLastPage,omitempty"`
-	Values     []*CustomerRequestScheme     `json:"values,omitempty"`
-	Expands    []string                     `json:"_expands,omitempty"`
-	Links      *CustomerRequestsLinksScheme `json:"_links,omitempty"`
+	ServiceManagementPageScheme
+	Values  []*CustomerRequestScheme `json:"values,omitempty"`
+	Expands []string                 `json:"_expands,omitempty"`
 }
 
-type CustomerRequestsLinksScheme struct {
-	Self    string `json:"self,omitempty"`
-	Base    string `json:"base,omitempty"`
-	Context string `json:"context,omitempty"`
-	Next    string `json:"next,omitempty"`
-	Prev    string `json:"prev,omitempty"`
-}
+// CustomerRequestsLinksScheme is kept for compatibility, the customer request pages use the shared page links.
+type CustomerRequestsLinksScheme = ServiceManagementPageLinksScheme
 
 type CustomerRequestTypeScheme struct {
 	ID            string   `json:"id,omitempty"`
@@ -112,6 +103,7 @@ type CustomerRequestScheme struct {
 	Reporter           *CustomerRequestReporterScheme            `json:"reporter,omitempty"`
 	RequestFieldValues []*CustomerRequestRequestFieldValueScheme `json:"requestFieldValues,omitempty"`
 	CurrentStatus      *CustomerRequestCurrentStatusScheme       `json:"currentStatus,omitempty"`
+	SLA                *RequestSLAPageScheme                     `json:"sla,omitempty"`
 	Expands            []string                                  `json:"_expands,omitempty"`
 	Links              *CustomerRequestLinksScheme               `json:"_links,omitempty"`
 }
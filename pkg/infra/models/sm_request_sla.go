@@ -26,9 +26,20 @@ type RequestSLAScheme struct {
 }
 
 type RequestSLAOngoingCycleScheme struct {
-	Breached            bool `json:"breached,omitempty"`
-	Paused              bool `json:"paused,omitempty"`
-	WithinCalendarHours bool `json:"withinCalendarHours,omitempty"`
+	StartTime           *CustomerRequestDateScheme `json:"startTime,omitempty"`
+	BreachTime          *CustomerRequestDateScheme `json:"breachTime,omitempty"`
+	Breached            bool                       `json:"breached,omitempty"`
+	Paused              bool                       `json:"paused,omitempty"`
+	WithinCalendarHours bool                       `json:"withinCalendarHours,omitempty"`
+	GoalDuration        *RequestSLADurationScheme  `json:"goalDuration,omitempty"`
+	ElapsedTime         *RequestSLADurationScheme  `json:"elapsedTime,omitempty"`
+	RemainingTime       *RequestSLADurationScheme  `json:"remainingTime,omitempty"`
+}
+
+// RequestSLADurationScheme represents an SLA duration, the remaining time is negative once the SLA is breached.
+type RequestSLADurationScheme struct {
+	Millis   int    `json:"millis,omitempty"`
+	Friendly string `json:"friendly,omitempty"`
 }
 
 type RequestSLALinkScheme struct {
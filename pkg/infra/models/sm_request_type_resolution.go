@@ -0,0 +1,33 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RequestTypeResolutionScheme represents the service desk and request type matching their names.
+type RequestTypeResolutionScheme struct {
+	ServiceDesk *ServiceDeskScheme
+	RequestType *RequestTypeScheme
+}
+
+// RequestTypeResolutionError is returned when a service desk or request type name is missing or ambiguous,
+// use errors.Is with the wrapped error, e.g. ErrAmbiguousRequestTypeError, to check the reason.
+type RequestTypeResolutionError struct {
+	Err        error
+	Name       string   // The name requested
+	Candidates []string // The names matching an ambiguous name, or the names available when the name is missing
+}
+
+func (r *RequestTypeResolutionError) Error() string {
+
+	if len(r.Candidates) == 0 {
+		return fmt.Sprintf("%v: %q", r.Err, r.Name)
+	}
+
+	return fmt.Sprintf("%v: %q, candidates: %v", r.Err, r.Name, strings.Join(r.Candidates, ", "))
+}
+
+func (r *RequestTypeResolutionError) Unwrap() error {
+	return r.Err
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+type SLAMonitorOptionsScheme struct {
+	ServiceDeskIDs []int
+	Threshold      time.Duration // The requests with less remaining time are reported, only the breached requests when zero
+
+	// Watermark contains the requests of the previous result watermark, those requests aren't reported again
+	// on the same state
+	Watermark []*SLAMonitorWatermarkScheme
+
+	Concurrency int              // The number of service desks paged at the same time, 5 by default
+	Now         func() time.Time // The clock used to evaluate the breach times, time.Now by default
+}
+
+const (
+	SLAMonitorStateAtRisk   = "at-risk"  // The most urgent SLA of the request has less remaining time than the threshold
+	SLAMonitorStateBreached = "breached" // The most urgent SLA of the request is breached
+)
+
+// SLAMonitorWatermarkScheme represents a request reported on a state, the request is reported again when
+// its state changes, e.g. when an at-risk request is breached.
+type SLAMonitorWatermarkScheme struct {
+	IssueKey string
+	State    string // One of the SLAMonitorState values
+}
+
+type SLAMonitorResultScheme struct {
+	Requests []*SLAMonitorRequestScheme // The requests at risk, sorted by urgency
+
+	// Watermark contains the requests reported on this and the previous checks that are still open, with the state
+	// of their last report, it's passed to the next check
+	Watermark []*SLAMonitorWatermarkScheme
+
+	Errors []*SLAMonitorErrorScheme
+}
+
+// SLAMonitorRequestScheme represents a request with an SLA breached or at risk.
+type SLAMonitorRequestScheme struct {
+	Request   *CustomerRequestScheme
+	SLAs      []*SLAMonitorCycleScheme // The SLAs breached or at risk, sorted by urgency
+	Remaining time.Duration            // The remaining time of the most urgent SLA, negative when it's breached
+	Breached  bool
+}
+
+type SLAMonitorCycleScheme struct {
+	SLA       *RequestSLAScheme
+	Remaining time.Duration // The remaining time to the goal, negative when the SLA is breached
+	Breached  bool
+}
+
+// SLAMonitorErrorScheme represents a service desk that couldn't be paged, the other service desks are still checked.
+type SLAMonitorErrorScheme struct {
+	ServiceDeskID int
+	Err           error
+}
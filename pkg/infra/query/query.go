@@ -0,0 +1,86 @@
+// Package query holds the grammar shared by the JQL and CQL builders: the clauses joined by logical operators,
+// the negations and the ORDER BY fields.
+package query
+
+import "strings"
+
+// Expression is a query or a clause of a query, the builders wrap it on their own Query types.
+//
+// The expressions are immutable, every method returns a new expression.
+type Expression struct {
+	Clause string
+
+	// Operator is the logical operator joining the clauses of the expression, it's empty on simple clauses
+	Operator string
+	OrderBy  []string
+}
+
+// String returns the clause followed by the ORDER BY fields.
+func (e Expression) String() string {
+
+	if len(e.OrderBy) == 0 {
+		return e.Clause
+	}
+
+	orderBy := "ORDER BY " + strings.Join(e.OrderBy, ", ")
+	if e.Clause == "" {
+		return orderBy
+	}
+
+	return e.Clause + " " + orderBy
+}
+
+// Join joins the expression and the clauses with the operator, the groups joined by another operator are wrapped
+// on parentheses and the empty clauses are skipped. The ORDER BY fields of the expression are kept.
+func (e Expression) Join(operator string, clauses []Expression) Expression {
+
+	var parts []string
+	for _, clause := range append([]Expression{e}, clauses...) {
+
+		if clause.Clause == "" {
+			continue
+		}
+
+		if clause.Operator != "" && clause.Operator != operator {
+			parts = append(parts, "("+clause.Clause+")")
+		} else {
+			parts = append(parts, clause.Clause)
+		}
+	}
+
+	joined := Expression{Clause: strings.Join(parts, " "+operator+" "), OrderBy: e.OrderBy}
+	if len(parts) > 1 {
+		joined.Operator = operator
+	}
+
+	return joined
+}
+
+// SortBy appends the ORDER BY field, the field already contains the direction.
+func (e Expression) SortBy(orderBy string) Expression {
+	return Expression{
+		Clause:   e.Clause,
+		Operator: e.Operator,
+		OrderBy:  append(append([]string{}, e.OrderBy...), orderBy),
+	}
+}
+
+// Not negates the clause, the groups are wrapped on parentheses.
+func Not(clause Expression) Expression {
+
+	if clause.Operator != "" {
+		return Expression{Clause: "NOT (" + clause.Clause + ")"}
+	}
+
+	return Expression{Clause: "NOT " + clause.Clause}
+}
+
+// Quote returns the value wrapped on double quotes, escaping the backslashes and the double quotes of the value.
+func Quote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// List returns the formatted values joined on parentheses, e.g. ("ENG", "OPS")
+func List(formatted []string) string {
+	return "(" + strings.Join(formatted, ", ") + ")"
+}
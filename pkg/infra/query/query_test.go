@@ -0,0 +1,38 @@
+package query
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestExpression_Join(t *testing.T) {
+
+	project := Expression{Clause: `project = "ENG"`}
+	statuses := project.Join("OR", []Expression{{Clause: `status = "Open"`}, {}, {Clause: `status = "Done"`}})
+
+	assert.Equal(t, `project = "ENG" OR status = "Open" OR status = "Done"`, statuses.String())
+	assert.Equal(t, "OR", statuses.Operator)
+
+	// The groups joined by another operator are wrapped on parentheses
+	query := Expression{Clause: `type = "Bug"`}.Join("AND", []Expression{statuses}).SortBy("rank").SortBy("created DESC")
+	assert.Equal(t, `type = "Bug" AND (project = "ENG" OR status = "Open" OR status = "Done") ORDER BY rank, created DESC`, query.String())
+
+	assert.Equal(t, `NOT (project = "ENG" OR status = "Open" OR status = "Done")`, Not(statuses).String())
+	assert.Equal(t, `NOT project = "ENG"`, Not(project).String())
+
+	assert.Equal(t, "ORDER BY rank", Expression{}.SortBy("rank").String())
+	assert.Equal(t, "", Expression{}.Join("AND", []Expression{{}}).String())
+}
+
+func TestExpression_Immutable(t *testing.T) {
+
+	base := Expression{}.SortBy("rank")
+	_ = base.SortBy("created")
+
+	assert.Equal(t, []string{"rank"}, base.OrderBy)
+}
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, `"say \"hi\" C:\\temp"`, Quote(`say "hi" C:\temp`))
+	assert.Equal(t, `("ENG", "OPS")`, List([]string{Quote("ENG"), Quote("OPS")}))
+}
@@ -0,0 +1,139 @@
+package replay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Fixture is a recorded request and its response, stored as an indented JSON file.
+type Fixture struct {
+
+	// Key matches the replayed requests, it's built from the operation, the method, the normalized URL and the body hash
+	Key      string           `json:"key"`
+	Sequence int              `json:"sequence"`
+	Request  *FixtureRequest  `json:"request"`
+	Response *FixtureResponse `json:"response,omitempty"`
+}
+
+// FixtureRequest is a recorded request, the URL is normalized: without the scheme and the host, the query sorted.
+type FixtureRequest struct {
+	Operation string      `json:"operation,omitempty"`
+	Method    string      `json:"method"`
+	URL       string      `json:"url"`
+	Header    http.Header `json:"header,omitempty"`
+	Body      *Body       `json:"body,omitempty"`
+}
+
+type FixtureResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       *Body       `json:"body,omitempty"`
+}
+
+// Body is a recorded body, stored as JSON when it's a JSON document, so the fixture diffs are reviewable, as text
+// when it's valid UTF-8 and as base64 otherwise, e.g. an attachment.
+type Body struct {
+	JSON   json.RawMessage `json:"json,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Base64 string          `json:"base64,omitempty"`
+}
+
+// NewBody returns the body of the data, nil when there's no data.
+func NewBody(data []byte) *Body {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err == nil {
+
+		// The document is re-encoded, so the object keys are sorted and the body hash doesn't depend on the key order
+		indented, err := json.MarshalIndent(document, "", "  ")
+		if err == nil {
+			return &Body{JSON: indented}
+		}
+	}
+
+	if utf8.Valid(data) {
+		return &Body{Text: string(data)}
+	}
+
+	return &Body{Base64: base64.StdEncoding.EncodeToString(data)}
+}
+
+// Bytes returns the data of the body.
+func (b *Body) Bytes() ([]byte, error) {
+
+	switch {
+	case b == nil:
+		return nil, nil
+	case len(b.JSON) != 0:
+		return b.JSON, nil
+	case b.Base64 != "":
+		return base64.StdEncoding.DecodeString(b.Base64)
+	default:
+		return []byte(b.Text), nil
+	}
+}
+
+// hash returns the first 16 hex digits of the SHA-256 of the body, empty when there's no body.
+func (b *Body) hash() string {
+
+	data, err := b.Bytes()
+	if err != nil || len(data) == 0 {
+		return ""
+	}
+
+	if len(b.JSON) != 0 {
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, data); err == nil {
+			data = compacted.Bytes()
+		}
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// normalizeURL returns the path and the sorted query of the URL, the fixtures don't depend on the site.
+func normalizeURL(location *url.URL) string {
+
+	normalized := strings.TrimPrefix(location.EscapedPath(), "/")
+
+	if query := location.Query(); len(query) != 0 {
+		normalized += "?" + query.Encode()
+	}
+
+	return normalized
+}
+
+// key returns the key of the request, the request must be sanitized first so the recorded and the replayed keys match.
+func (r *FixtureRequest) key() string {
+	return fmt.Sprintf("%v %v %v %v", r.Operation, r.Method, r.URL, r.Body.hash())
+}
+
+// fileName returns the name of the fixture file, the operation followed by the hash of the key and the sequence.
+func (f *Fixture) fileName() string {
+
+	name := f.Request.Operation
+	if name == "" {
+		name = strings.ToLower(f.Request.Method)
+	}
+
+	sum := sha256.Sum256([]byte(f.Key))
+
+	if f.Sequence == 0 {
+		return fmt.Sprintf("%v-%v.json", name, hex.EncodeToString(sum[:])[:12])
+	}
+
+	return fmt.Sprintf("%v-%v-%v.json", name, hex.EncodeToString(sum[:])[:12], f.Sequence)
+}
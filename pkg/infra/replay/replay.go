@@ -0,0 +1,312 @@
+// Package replay records the responses of the Atlassian sites into fixtures and replays them, for the hermetic tests.
+//
+// The transport is an HTTP client, it's passed to the client constructors, e.g. v3.New(transport, site). The fixtures
+// are matched by the operation of the request, see models.WithOperation, its method, its normalized URL and the hash
+// of its body. The same request sent several times is recorded on a sequence, the replay serves the sequence in order
+// and repeats its last response.
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service/common"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Transport records the requests sent by a client or replays the recorded fixtures.
+type Transport struct {
+	client     common.HttpClient
+	directory  string
+	sanitizers []Sanitizer
+
+	mu       sync.Mutex
+	fixtures map[string][]*Fixture
+	served   map[string]int
+}
+
+// NewRecorder returns a transport sending the requests with the client and writing their sanitized fixtures
+// into the directory, the directory is created when it doesn't exist.
+//
+// The credentials headers are always stripped, the sanitizers run after them, on the order they're passed.
+func NewRecorder(client common.HttpClient, directory string, sanitizers ...Sanitizer) (*Transport, error) {
+
+	if client == nil {
+		return nil, model.ErrNoReplayClientError
+	}
+
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, err
+	}
+
+	return &Transport{
+		client:     client,
+		directory:  directory,
+		sanitizers: append([]Sanitizer{StripHeaders(DefaultStrippedHeaders...)}, sanitizers...),
+		fixtures:   make(map[string][]*Fixture),
+		served:     make(map[string]int),
+	}, nil
+}
+
+// NewReplayer returns a transport serving the fixtures of the directory, the sanitizers must be the sanitizers
+// used to record them, so the requests are rewritten the same way before they're matched.
+func NewReplayer(directory string, sanitizers ...Sanitizer) (*Transport, error) {
+
+	files, err := filepath.Glob(filepath.Join(directory, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &Transport{
+		directory:  directory,
+		sanitizers: append([]Sanitizer{StripHeaders(DefaultStrippedHeaders...)}, sanitizers...),
+		fixtures:   make(map[string][]*Fixture),
+		served:     make(map[string]int),
+	}
+
+	for _, file := range files {
+
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		fixture := new(Fixture)
+		if err := json.Unmarshal(data, fixture); err != nil {
+			return nil, fmt.Errorf("replay: unable to decode the fixture %v: %w", filepath.Base(file), err)
+		}
+
+		if fixture.Request == nil || fixture.Response == nil {
+			return nil, fmt.Errorf("replay: the fixture %v has no request or response", filepath.Base(file))
+		}
+
+		transport.fixtures[fixture.Key] = append(transport.fixtures[fixture.Key], fixture)
+	}
+
+	for _, sequence := range transport.fixtures {
+		sort.Slice(sequence, func(i, j int) bool { return sequence[i].Sequence < sequence[j].Sequence })
+	}
+
+	return transport, nil
+}
+
+// Do records the request when the transport is a recorder, otherwise it serves the fixture matching the request.
+//
+// The replay fails with ErrNoReplayFixtureError when no fixture matches, the error contains the diff between the
+// request and the closest fixture.
+func (t *Transport) Do(request *http.Request) (*http.Response, error) {
+
+	requestBody, err := readBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	fixture := &Fixture{
+		Request: &FixtureRequest{
+			Operation: model.OperationFromContext(request.Context()),
+			Method:    request.Method,
+			URL:       normalizeURL(request.URL),
+			Header:    headerOf(request.Header),
+			Body:      NewBody(requestBody),
+		},
+	}
+
+	if t.client == nil {
+		return t.replay(request, fixture)
+	}
+
+	return t.record(request, requestBody, fixture)
+}
+
+func (t *Transport) record(request *http.Request, requestBody []byte, fixture *Fixture) (*http.Response, error) {
+
+	// The body was read to record it, the request sent is given a new reader
+	if requestBody != nil {
+		request.Body = ioutil.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	fixture.Response = &FixtureResponse{
+		StatusCode: response.StatusCode,
+		Header:     headerOf(response.Header),
+		Body:       NewBody(responseBody),
+	}
+
+	// The sanitized body doesn't keep the length of the recorded body, and the date would change the fixture on every record
+	fixture.Response.Header.Del("Content-Length")
+	fixture.Response.Header.Del("Date")
+
+	t.sanitize(fixture)
+
+	t.mu.Lock()
+	fixture.Sequence = len(t.fixtures[fixture.Key])
+	t.fixtures[fixture.Key] = append(t.fixtures[fixture.Key], fixture)
+	t.mu.Unlock()
+
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(t.directory, fixture.fileName()), append(data, '\n'), 0644); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (t *Transport) replay(request *http.Request, fixture *Fixture) (*http.Response, error) {
+
+	t.sanitize(fixture)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sequence, ok := t.fixtures[fixture.Key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v %v\n%v", model.ErrNoReplayFixtureError, fixture.Request.Method, fixture.Request.URL,
+			t.closestDiff(fixture.Request))
+	}
+
+	index := t.served[fixture.Key]
+	if index < len(sequence)-1 {
+		t.served[fixture.Key] = index + 1
+	}
+
+	recorded := sequence[index].Response
+
+	body, err := recorded.Body.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %v", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+func (t *Transport) sanitize(fixture *Fixture) {
+
+	for _, sanitizer := range t.sanitizers {
+		sanitizer(fixture)
+	}
+
+	fixture.Key = fixture.Request.key()
+}
+
+// closestDiff returns the differences between the request and the fixture sharing the most attributes with it.
+func (t *Transport) closestDiff(request *FixtureRequest) string {
+
+	var (
+		closest *Fixture
+		best    = -1
+	)
+
+	for _, sequence := range t.fixtures {
+
+		candidate := sequence[0]
+
+		score := 0
+		if candidate.Request.Operation == request.Operation {
+			score += 4
+		}
+
+		if candidate.Request.Method == request.Method {
+			score += 2
+		}
+
+		if strings.SplitN(candidate.Request.URL, "?", 2)[0] == strings.SplitN(request.URL, "?", 2)[0] {
+			score += 2
+		}
+
+		if candidate.Request.URL == request.URL {
+			score++
+		}
+
+		// The ties are broken by the file name, so the error doesn't change between the runs
+		if score > best || (score == best && candidate.fileName() < closest.fileName()) {
+			closest, best = candidate, score
+		}
+	}
+
+	if closest == nil {
+		return "no fixture recorded in " + t.directory
+	}
+
+	var diff strings.Builder
+	fmt.Fprintf(&diff, "closest fixture %v:\n", closest.fileName())
+
+	lines := func(name, want, got string) {
+		if want != got {
+			fmt.Fprintf(&diff, "- %v: %v\n+ %v: %v\n", name, want, name, got)
+		}
+	}
+
+	lines("operation", closest.Request.Operation, request.Operation)
+	lines("method", closest.Request.Method, request.Method)
+	lines("url", closest.Request.URL, request.URL)
+
+	lines("body", compacted(closest.Request.Body), compacted(request.Body))
+
+	return strings.TrimSuffix(diff.String(), "\n")
+}
+
+// compacted returns the body on a single line when it's a JSON document.
+func compacted(body *Body) string {
+
+	data, err := body.Bytes()
+	if err != nil {
+		return err.Error()
+	}
+
+	if body != nil && len(body.JSON) != 0 {
+		var buffer bytes.Buffer
+		if err := json.Compact(&buffer, data); err == nil {
+			return buffer.String()
+		}
+	}
+
+	return string(data)
+}
+
+func readBody(request *http.Request) ([]byte, error) {
+
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	defer request.Body.Close()
+	return ioutil.ReadAll(request.Body)
+}
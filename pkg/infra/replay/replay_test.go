@@ -0,0 +1,149 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTransport_RecordAndReplay(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "replay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "atlassian.xsrf.token=SECRET")
+
+		switch r.URL.Path {
+		case "/rest/api/3/user":
+			_, _ = w.Write([]byte(`{"displayName":"Carlos","accountId":"5b10ac8d82e05b22cc7d4ef5"}`))
+		default:
+			_, _ = w.Write([]byte(`{"version":` + strings.Repeat("1", calls) + `}`))
+		}
+	}))
+	defer server.Close()
+
+	newRequest := func(operation, method, endpoint, body string) *http.Request {
+
+		ctx := context.Background()
+		if operation != "" {
+			ctx = model.WithOperation(ctx, operation)
+		}
+
+		request, err := http.NewRequestWithContext(ctx, method, server.URL+endpoint, strings.NewReader(body))
+		assert.NoError(t, err)
+
+		request.Header.Set("Authorization", "Basic U0VDUkVU")
+		return request
+	}
+
+	read := func(transport *Transport, request *http.Request) (int, string) {
+
+		response, err := transport.Do(request)
+		assert.NoError(t, err)
+
+		body, err := ioutil.ReadAll(response.Body)
+		assert.NoError(t, err)
+
+		return response.StatusCode, string(body)
+	}
+
+	sanitizer := HashAccountIDs("salt")
+
+	recorder, err := NewRecorder(server.Client(), directory, sanitizer)
+	assert.NoError(t, err)
+
+	// The recorder returns the live responses
+	_, body := read(recorder, newRequest("jira.v3.user.get", http.MethodGet, "/rest/api/3/user?expand=groups&accountId=5b10ac8d82e05b22cc7d4ef5", ""))
+	assert.JSONEq(t, `{"displayName":"Carlos","accountId":"5b10ac8d82e05b22cc7d4ef5"}`, body)
+
+	read(recorder, newRequest("jira.v3.project.update", http.MethodPut, "/rest/api/3/project/KP", `{"name":"KP","lead":"A"}`))
+	read(recorder, newRequest("jira.v3.project.update", http.MethodPut, "/rest/api/3/project/KP", `{"lead":"A","name":"KP"}`))
+
+	files, err := filepath.Glob(filepath.Join(directory, "*.json"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 3)
+
+	for _, file := range files {
+
+		data, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+
+		assert.NotContains(t, string(data), "SECRET")
+		assert.NotContains(t, string(data), "U0VDUkVU")
+		assert.NotContains(t, string(data), "5b10ac8d82e05b22cc7d4ef5")
+	}
+
+	replayer, err := NewReplayer(directory, sanitizer)
+	assert.NoError(t, err)
+
+	t.Run("when the request matches a fixture", func(t *testing.T) {
+
+		// The query is normalized and the account id is hashed like the recorded one
+		code, body := read(replayer, newRequest("jira.v3.user.get", http.MethodGet, "/rest/api/3/user?accountId=5b10ac8d82e05b22cc7d4ef5&expand=groups", ""))
+		assert.Equal(t, http.StatusOK, code)
+		assert.JSONEq(t, `{"displayName":"Carlos","accountId":"hashed:2348b25cdee57bfe21a27953"}`, body)
+	})
+
+	t.Run("when the request is recorded several times", func(t *testing.T) {
+
+		// The JSON bodies match regardless of their key order, the sequence is served in order and its last response repeated
+		for _, want := range []string{`{"version":11}`, `{"version":111}`, `{"version":111}`} {
+			_, body := read(replayer, newRequest("jira.v3.project.update", http.MethodPut, "/rest/api/3/project/KP", `{"name":"KP","lead":"A"}`))
+			assert.JSONEq(t, want, body)
+		}
+	})
+
+	t.Run("when no fixture matches the request", func(t *testing.T) {
+
+		_, err := replayer.Do(newRequest("jira.v3.project.update", http.MethodPut, "/rest/api/3/project/KP", `{"name":"KP","lead":"B"}`))
+		assert.True(t, errors.Is(err, model.ErrNoReplayFixtureError))
+		assert.Contains(t, err.Error(), `- body: {"lead":"A","name":"KP"}`)
+		assert.Contains(t, err.Error(), `+ body: {"lead":"B","name":"KP"}`)
+		assert.NotContains(t, err.Error(), "- url:")
+	})
+
+	assert.Equal(t, 3, calls)
+}
+
+func TestNewRecorder(t *testing.T) {
+
+	_, err := NewRecorder(nil, "")
+	assert.EqualError(t, err, model.ErrNoReplayClientError.Error())
+}
+
+func TestNewReplayer(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "replay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+
+	replayer, err := NewReplayer(directory)
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+	assert.NoError(t, err)
+
+	_, err = replayer.Do(request)
+	assert.True(t, errors.Is(err, model.ErrNoReplayFixtureError))
+	assert.Contains(t, err.Error(), "no fixture recorded")
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "broken.json"), []byte(`{"key":`), 0644))
+
+	_, err = NewReplayer(directory)
+	assert.Error(t, err)
+}
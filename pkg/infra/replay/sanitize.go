@@ -0,0 +1,142 @@
+package replay
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Sanitizer rewrites a fixture before its key is built and before it's written, e.g. to remove the secrets.
+//
+// The sanitizers run on the recorded requests and on the replayed requests, without their response, so a sanitizer
+// rewriting the request must be deterministic or the replayed requests won't match their fixture.
+type Sanitizer func(fixture *Fixture)
+
+// DefaultStrippedHeaders are the headers carrying the credentials, they're always removed from the fixtures.
+var DefaultStrippedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// StripHeaders removes the headers from the request and the response of the fixtures.
+func StripHeaders(names ...string) Sanitizer {
+
+	return func(fixture *Fixture) {
+
+		for _, name := range names {
+
+			if fixture.Request != nil {
+				fixture.Request.Header.Del(name)
+			}
+
+			if fixture.Response != nil {
+				fixture.Response.Header.Del(name)
+			}
+		}
+	}
+}
+
+// HashAccountIDs replaces the account ids of the fixtures with a salted hash, the same account id is always
+// replaced with the same hash, so the relations between the users are kept.
+//
+// The account ids are the values of the JSON fields and the query parameters named accountId, the names
+// are matched case-insensitively and more names can be passed, e.g. reporterId.
+func HashAccountIDs(salt string, names ...string) Sanitizer {
+
+	fields := map[string]bool{"accountid": true, "accountids": true}
+	for _, name := range names {
+		fields[strings.ToLower(name)] = true
+	}
+
+	hash := func(accountID string) string {
+		sum := sha256.Sum256([]byte(salt + accountID))
+		return "hashed:" + hex.EncodeToString(sum[:])[:24]
+	}
+
+	return func(fixture *Fixture) {
+
+		if fixture.Request != nil {
+			fixture.Request.URL = hashQuery(fixture.Request.URL, fields, hash)
+			fixture.Request.Body = hashBody(fixture.Request.Body, fields, hash)
+		}
+
+		if fixture.Response != nil {
+			fixture.Response.Body = hashBody(fixture.Response.Body, fields, hash)
+		}
+	}
+}
+
+func hashQuery(normalized string, fields map[string]bool, hash func(string) string) string {
+
+	location, err := url.Parse(normalized)
+	if err != nil || location.RawQuery == "" {
+		return normalized
+	}
+
+	query := location.Query()
+	for name, values := range query {
+
+		if !fields[strings.ToLower(name)] {
+			continue
+		}
+
+		for index, value := range values {
+			values[index] = hash(value)
+		}
+	}
+
+	location.RawQuery = query.Encode()
+	return normalizeURL(location)
+}
+
+func hashBody(body *Body, fields map[string]bool, hash func(string) string) *Body {
+
+	if body == nil || len(body.JSON) == 0 {
+		return body
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(body.JSON, &document); err != nil {
+		return body
+	}
+
+	data, err := json.Marshal(hashValue(document, false, fields, hash))
+	if err != nil {
+		return body
+	}
+
+	return NewBody(data)
+}
+
+// hashValue hashes the strings of the value, including the strings of the arrays, when the value is an account id.
+func hashValue(value interface{}, accountID bool, fields map[string]bool, hash func(string) string) interface{} {
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for name, field := range typed {
+			typed[name] = hashValue(field, fields[strings.ToLower(name)], fields, hash)
+		}
+
+	case []interface{}:
+		for index, element := range typed {
+			typed[index] = hashValue(element, accountID, fields, hash)
+		}
+
+	case string:
+		if accountID && typed != "" {
+			return hash(typed)
+		}
+	}
+
+	return value
+}
+
+// headerOf returns a copy of the header, nil when it's empty, so the fixtures don't contain empty header objects.
+func headerOf(header http.Header) http.Header {
+
+	if len(header) == 0 {
+		return nil
+	}
+
+	return header.Clone()
+}
@@ -0,0 +1,170 @@
+// Package throttle limits the requests sent at the same time by the bulk helpers.
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Controller limits the number of requests in flight.
+//
+// The adaptive controller follows an AIMD policy: the limit is halved when the site answers with a 429 or a 503
+// and grows by one request per limit of successful requests, as long as the X-RateLimit-Remaining header,
+// when sent, leaves room for the current limit.
+//
+// Only the requests acquired after the last decrease can halve the limit again, the requests already in flight
+// when the site started throttling don't collapse the limit. The Retry-After header pauses every acquisition,
+// so the workers don't retry all at once.
+type Controller struct {
+	mu          sync.Mutex
+	limit       float64
+	min, max    float64
+	fixed       bool
+	inFlight    int
+	generation  int
+	pausedUntil time.Time
+	wake        chan struct{}
+}
+
+// NewAdaptive returns a controller starting at the initial limit, growing up to the max limit.
+func NewAdaptive(initial, max int) *Controller {
+
+	if max < 1 {
+		max = 1
+	}
+
+	if initial < 1 {
+		initial = 1
+	}
+
+	if initial > max {
+		initial = max
+	}
+
+	return &Controller{limit: float64(initial), min: 1, max: float64(max), wake: make(chan struct{})}
+}
+
+// NewFixed returns a controller pinned to the limit, the responses don't change it.
+func NewFixed(limit int) *Controller {
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &Controller{limit: float64(limit), min: float64(limit), max: float64(limit), fixed: true, wake: make(chan struct{})}
+}
+
+// Limit returns the current number of requests allowed in flight.
+func (c *Controller) Limit() int {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return int(c.limit)
+}
+
+// Max returns the highest limit the controller can reach, the callers size their worker pools with it.
+func (c *Controller) Max() int {
+	return int(c.max)
+}
+
+// Acquire waits for a free slot and returns the func releasing it with the status code and the headers of the response.
+//
+// A zero status code releases the slot without changing the limit, e.g. when the request could not be sent.
+func (c *Controller) Acquire(ctx context.Context) (func(code int, header http.Header), error) {
+
+	for {
+
+		c.mu.Lock()
+
+		wait := time.Until(c.pausedUntil)
+		if wait <= 0 && c.inFlight < int(c.limit) {
+
+			c.inFlight++
+			generation := c.generation
+			c.mu.Unlock()
+
+			var once sync.Once
+			return func(code int, header http.Header) {
+				once.Do(func() { c.release(generation, code, header) })
+			}, nil
+		}
+
+		wake := c.wake
+		c.mu.Unlock()
+
+		var (
+			timer   *time.Timer
+			timeout <-chan time.Time
+		)
+
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return nil, ctx.Err()
+		case <-wake:
+		case <-timeout:
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+func (c *Controller) release(generation, code int, header http.Header) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.inFlight--
+
+	if !c.fixed {
+
+		switch {
+		case code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable:
+
+			if generation == c.generation {
+
+				c.limit /= 2
+				if c.limit < c.min {
+					c.limit = c.min
+				}
+
+				c.generation++
+			}
+
+			if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds > 0 {
+
+				if until := time.Now().Add(time.Duration(seconds) * time.Second); until.After(c.pausedUntil) {
+					c.pausedUntil = until
+				}
+			}
+
+		case code >= 200 && code < 300:
+
+			if remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil && remaining <= int(c.limit) {
+				break
+			}
+
+			c.limit += 1 / c.limit
+			if c.limit > c.max {
+				c.limit = c.max
+			}
+		}
+	}
+
+	// Wakes the waiting acquisitions, the limit or the slots in flight changed
+	close(c.wake)
+	c.wake = make(chan struct{})
+}
@@ -0,0 +1,235 @@
+package throttle
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestController_Fixed(t *testing.T) {
+
+	controller := NewFixed(3)
+
+	for _, code := range []int{http.StatusTooManyRequests, http.StatusOK, http.StatusServiceUnavailable} {
+
+		release, err := controller.Acquire(context.Background())
+		assert.NoError(t, err)
+
+		release(code, nil)
+		assert.Equal(t, 3, controller.Limit())
+	}
+
+	assert.Equal(t, 3, controller.Max())
+}
+
+func TestController_Adaptive(t *testing.T) {
+
+	t.Run("when the requests in flight are throttled", func(t *testing.T) {
+
+		controller := NewAdaptive(8, 20)
+
+		var releases []func(int, http.Header)
+		for slot := 0; slot < 8; slot++ {
+
+			release, err := controller.Acquire(context.Background())
+			assert.NoError(t, err)
+
+			releases = append(releases, release)
+		}
+
+		// The requests acquired before the first decrease halve the limit once
+		for _, release := range releases {
+			release(http.StatusTooManyRequests, nil)
+		}
+
+		assert.Equal(t, 4, controller.Limit())
+
+		release, err := controller.Acquire(context.Background())
+		assert.NoError(t, err)
+
+		release(http.StatusServiceUnavailable, nil)
+		assert.Equal(t, 2, controller.Limit())
+	})
+
+	t.Run("when the requests succeed", func(t *testing.T) {
+
+		controller := NewAdaptive(2, 3)
+
+		for request := 0; request < 10; request++ {
+
+			release, err := controller.Acquire(context.Background())
+			assert.NoError(t, err)
+
+			release(http.StatusOK, nil)
+		}
+
+		assert.Equal(t, 3, controller.Limit())
+	})
+
+	t.Run("when the rate limit remaining is low", func(t *testing.T) {
+
+		controller := NewAdaptive(2, 10)
+
+		for request := 0; request < 10; request++ {
+
+			release, err := controller.Acquire(context.Background())
+			assert.NoError(t, err)
+
+			release(http.StatusOK, http.Header{"X-Ratelimit-Remaining": []string{"1"}})
+		}
+
+		assert.Equal(t, 2, controller.Limit())
+	})
+
+	t.Run("when the request is not sent", func(t *testing.T) {
+
+		controller := NewAdaptive(2, 10)
+
+		release, err := controller.Acquire(context.Background())
+		assert.NoError(t, err)
+
+		release(0, nil)
+		release(http.StatusTooManyRequests, nil)
+
+		assert.Equal(t, 2, controller.Limit())
+	})
+
+	t.Run("when the limit is reached", func(t *testing.T) {
+
+		controller := NewAdaptive(1, 1)
+
+		_, err := controller.Acquire(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err = controller.Acquire(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("when the site asks to retry later", func(t *testing.T) {
+
+		controller := NewAdaptive(4, 4)
+
+		release, err := controller.Acquire(context.Background())
+		assert.NoError(t, err)
+
+		release(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err = controller.Acquire(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// rateLimitedServer throttles the requests above the capacity in flight.
+type rateLimitedServer struct {
+	capacity  int32
+	inFlight  int32
+	accepted  int32
+	throttled int32
+}
+
+func (r *rateLimitedServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+
+	inFlight := atomic.AddInt32(&r.inFlight, 1)
+	defer atomic.AddInt32(&r.inFlight, -1)
+
+	if inFlight > r.capacity {
+		atomic.AddInt32(&r.throttled, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+
+	time.Sleep(2 * time.Millisecond)
+
+	atomic.AddInt32(&r.accepted, 1)
+	w.WriteHeader(http.StatusOK)
+}
+
+// simulate sends the requests through the controller with the workers, the throttled requests are retried.
+func simulate(t *testing.T, controller *Controller, requests int) *rateLimitedServer {
+
+	site := &rateLimitedServer{capacity: 4}
+
+	server := httptest.NewServer(site)
+	defer server.Close()
+
+	jobs := make(chan int)
+
+	var workers sync.WaitGroup
+	for worker := 0; worker < controller.Max(); worker++ {
+
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+
+			for range jobs {
+
+				for {
+
+					release, err := controller.Acquire(context.Background())
+					if err != nil {
+						t.Error(err)
+						return
+					}
+
+					response, err := http.Get(server.URL)
+					if err != nil {
+						release(0, nil)
+						t.Error(err)
+						return
+					}
+
+					_ = response.Body.Close()
+					release(response.StatusCode, response.Header)
+
+					if response.StatusCode != http.StatusTooManyRequests {
+						break
+					}
+
+					time.Sleep(time.Millisecond)
+				}
+			}
+		}()
+	}
+
+	for job := 0; job < requests; job++ {
+		jobs <- job
+	}
+
+	close(jobs)
+	workers.Wait()
+
+	return site
+}
+
+func TestController_Simulation(t *testing.T) {
+
+	const requests = 300
+
+	adaptive := NewAdaptive(5, 20)
+	adaptiveSite := simulate(t, adaptive, requests)
+
+	fixedSite := simulate(t, NewFixed(20), requests)
+
+	assert.EqualValues(t, requests, adaptiveSite.accepted)
+	assert.EqualValues(t, requests, fixedSite.accepted)
+
+	t.Logf("throttled requests, adaptive: %v, fixed: %v, final limit: %v", adaptiveSite.throttled, fixedSite.throttled, adaptive.Limit())
+
+	// The limit stays around the capacity of the site instead of growing up to the max limit
+	assert.LessOrEqual(t, adaptive.Limit(), 2*int(adaptiveSite.capacity))
+
+	// The throttled requests are a fraction of the requests, far below the requests throttled with the pinned concurrency
+	assert.Less(t, int(adaptiveSite.throttled), requests/4)
+	assert.Less(t, 4*adaptiveSite.throttled, fixedSite.throttled)
+}
@@ -0,0 +1,36 @@
+package throttle
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Retry retries the call while the site responds with a 429 status code, up to the max retries.
+//
+// The Retry-After header sets the wait, otherwise the wait starts at one second and doubles on each attempt.
+func Retry(ctx context.Context, maxRetries int, call func() (*model.ResponseScheme, error)) (*model.ResponseScheme, error) {
+
+	for attempt := 0; ; attempt++ {
+
+		response, err := call()
+		if err == nil || response == nil || response.Code != http.StatusTooManyRequests || attempt >= maxRetries {
+			return response, err
+		}
+
+		wait := time.Second << uint(attempt)
+		if response.Response != nil {
+			if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+				wait = time.Duration(seconds) * time.Second
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return response, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestRetry(t *testing.T) {
+
+	rateLimited := &model.ResponseScheme{
+		Response: &http.Response{Header: http.Header{"Retry-After": []string{"0"}}},
+		Code:     http.StatusTooManyRequests,
+	}
+
+	t.Run("when the call is rate limited", func(t *testing.T) {
+
+		attempts := 0
+		response, err := Retry(context.Background(), 3, func() (*model.ResponseScheme, error) {
+
+			attempts++
+			if attempts < 3 {
+				return rateLimited, errors.New("client: rate limited")
+			}
+
+			return &model.ResponseScheme{Code: http.StatusOK}, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("when the retries are exhausted", func(t *testing.T) {
+
+		attempts := 0
+		_, err := Retry(context.Background(), 1, func() (*model.ResponseScheme, error) {
+			attempts++
+			return rateLimited, errors.New("client: rate limited")
+		})
+
+		assert.EqualError(t, err, "client: rate limited")
+		assert.Equal(t, 2, attempts)
+	})
+}
@@ -0,0 +1,27 @@
+package agile
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type BuildConnector interface {
+
+	// Submit updates or inserts the builds, the builds are associated with the issues using their issue keys.
+	//
+	// The properties are stored with the builds, they can be used to delete the builds later.
+	//
+	// The builds rejected and the unknown issue keys are returned on the response, the request succeeds anyway.
+	//
+	// POST /rest/builds/0.1/bulk
+	//
+	// TODO: the documentation needs to be created
+	Submit(ctx context.Context, builds []*model.BuildInfoScheme, properties map[string]string) (*model.BuildBulkResponseScheme, *model.ResponseScheme, error)
+
+	// DeleteByProperties deletes the builds with all the properties provided, the deletion is processed asynchronously.
+	//
+	// DELETE /rest/builds/0.1/bulkByProperties
+	//
+	// TODO: the documentation needs to be created
+	DeleteByProperties(ctx context.Context, properties map[string]string) (*model.ResponseScheme, error)
+}
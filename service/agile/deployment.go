@@ -0,0 +1,27 @@
+package agile
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type DeploymentConnector interface {
+
+	// Submit updates or inserts the deployments, the deployments are associated with the issues using the associations.
+	//
+	// The properties are stored with the deployments, they can be used to delete the deployments later.
+	//
+	// The deployments rejected and the unknown issue keys are returned on the response, the request succeeds anyway.
+	//
+	// POST /rest/deployments/0.1/bulk
+	//
+	// TODO: the documentation needs to be created
+	Submit(ctx context.Context, deployments []*model.DeploymentInfoScheme, properties map[string]string) (*model.DeploymentBulkResponseScheme, *model.ResponseScheme, error)
+
+	// DeleteByProperties deletes the deployments with all the properties provided, the deletion is processed asynchronously.
+	//
+	// DELETE /rest/deployments/0.1/bulkByProperties
+	//
+	// TODO: the documentation needs to be created
+	DeleteByProperties(ctx context.Context, properties map[string]string) (*model.ResponseScheme, error)
+}
@@ -0,0 +1,27 @@
+package agile
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type ReportConnector interface {
+
+	// SprintReport returns the completed, not completed and punted issues of a sprint with their estimate sums.
+	//
+	// This endpoint is undocumented, the client internal APIs needs to be enabled.
+	//
+	// GET /rest/greenhopper/1.0/rapid/charts/sprintreport
+	//
+	// TODO: the documentation needs to be created
+	SprintReport(ctx context.Context, boardID, sprintID int) (*model.SprintReportScheme, *model.ResponseScheme, error)
+
+	// Velocity returns the commitment and the completed estimate of the closed sprints of a board.
+	//
+	// This endpoint is undocumented, the client internal APIs needs to be enabled.
+	//
+	// GET /rest/greenhopper/1.0/rapid/charts/velocity.json
+	//
+	// TODO: the documentation needs to be created
+	Velocity(ctx context.Context, boardID int) (*model.BoardVelocityScheme, *model.ResponseScheme, error)
+}
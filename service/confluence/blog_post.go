@@ -0,0 +1,62 @@
+package confluence
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"time"
+)
+
+type BlogPostConnector interface {
+
+	// Gets returns all blog posts, the blog posts can be filtered by space and sorted by their created date.
+	//
+	// The blog posts are paginated with a cursor, use the cursor of the returned chunk to fetch the next chunk.
+	//
+	// GET /wiki/api/v2/blogposts
+	//
+	// TODO: the documentation needs to be created
+	Gets(ctx context.Context, options *model.BlogPostOptionsScheme, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error)
+
+	// Get returns a specific blog post.
+	//
+	// The body is returned on the format requested, the latest version is returned when the version is 0.
+	//
+	// GET /wiki/api/v2/blogposts/{id}
+	//
+	// TODO: the documentation needs to be created
+	Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*model.BlogPostScheme, *model.ResponseScheme, error)
+
+	// Create creates a new blog post in the space specified by the spaceId.
+	//
+	// The created date can be set to backdate the blog post, only when the site permits it.
+	//
+	// POST /wiki/api/v2/blogposts
+	//
+	// TODO: the documentation needs to be created
+	Create(ctx context.Context, payload *model.BlogPostPayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error)
+
+	// Update updates a blog post by id.
+	//
+	// The version number of the payload must be the current version number incremented by one.
+	//
+	// PUT /wiki/api/v2/blogposts/{id}
+	//
+	// TODO: the documentation needs to be created
+	Update(ctx context.Context, blogPostID int, payload *model.BlogPostPayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error)
+
+	// Delete deletes a blog post by id.
+	//
+	// DELETE /wiki/api/v2/blogposts/{id}
+	//
+	// TODO: the documentation needs to be created
+	Delete(ctx context.Context, blogPostID int) (*model.ResponseScheme, error)
+
+	// GetsByDateRange returns the blog posts created between two dates, both dates included, newest first.
+	//
+	// The blog posts of every space are returned when no space ids are provided.
+	//
+	// GET /wiki/api/v2/blogposts
+	//
+	// TODO: the documentation needs to be created
+	GetsByDateRange(ctx context.Context, spaceIDs []int, from, to time.Time) ([]*model.BlogPostScheme, *model.ResponseScheme, error)
+}
@@ -9,8 +9,33 @@ type CommentConnector interface {
 
 	// Gets returns the comments on a piece of content.
 	//
+	// The location filters the comments, e.g. inline, footer or resolved, the replies are returned with the children.comment expand.
+	//
 	// GET /wiki/rest/api/content/{id}/child/comment
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content/comments#get-content-comments
 	Gets(ctx context.Context, contentID string, expand, location []string, startAt, maxResults int) (*model.ContentPageScheme, *model.ResponseScheme, error)
+
+	// Create creates a footer or an inline comment on a page or a blog post.
+	//
+	// The inline comments need the extensions inline properties with the original selection and the marker ref.
+	//
+	// POST /wiki/rest/api/content
+	//
+	// TODO: the documentation needs to be created
+	Create(ctx context.Context, payload *model.ContentCommentPayloadScheme) (*model.ContentScheme, *model.ResponseScheme, error)
+
+	// Resolve resolves an inline comment.
+	//
+	// PUT /wiki/api/v2/inline-comments/{comment-id}
+	//
+	// TODO: the documentation needs to be created
+	Resolve(ctx context.Context, commentID string) (*model.InlineCommentScheme, *model.ResponseScheme, error)
+
+	// Reopen reopens a resolved inline comment.
+	//
+	// PUT /wiki/api/v2/inline-comments/{comment-id}
+	//
+	// TODO: the documentation needs to be created
+	Reopen(ctx context.Context, commentID string) (*model.InlineCommentScheme, *model.ResponseScheme, error)
 }
@@ -86,4 +86,17 @@ type ContentConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content#archive-pages
 	Archive(ctx context.Context, payload *model.ContentArchivePayloadScheme) (*model.ContentArchiveResultScheme, *model.ResponseScheme, error)
+
+	// ExportTree walks a page and its descendant pages depth-first, calling the visitor with the storage body
+	//
+	// and the attachments of each page. The pages are fetched concurrently but visited in depth-first order.
+	//
+	// The export resumes after the checkpoint page when options.Checkpoint is set.
+	//
+	// GET /wiki/rest/api/content/{id}
+	//
+	// GET /wiki/rest/api/content/{id}/child/page
+	//
+	// GET /wiki/rest/api/content/{id}/child/attachment
+	ExportTree(ctx context.Context, rootPageID string, options *model.ContentExportOptionsScheme, visitor model.ContentExportVisitorFunc) (*model.ResponseScheme, error)
 }
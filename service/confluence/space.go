@@ -71,4 +71,21 @@ type SpaceConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/space#get-content-by-type-for-space
 	ContentByType(ctx context.Context, spaceKey, contentType, depth string, expand []string, startAt, maxResults int) (*model.ContentPageScheme, *model.ResponseScheme, error)
+
+	// SubscribeUser adds a space watch for the user and, when includeExistingPages is set, adds a content watch
+	// to each page of the space, watching the given number of pages at the same time.
+	//
+	// The pages that couldn't be watched are listed on the report, the walk continues after them.
+	//
+	// When the walk is interrupted, the report is returned with the error and a resume token, set it on the options to
+	// resume the subscription.
+	SubscribeUser(ctx context.Context, spaceKey, accountID string, includeExistingPages bool, concurrency int,
+		options *model.SpaceSubscriptionOptionsScheme) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error)
+
+	// UnsubscribeUserEverywhere removes the space watches of the user and the content watches of the pages and blog posts
+	// of the spaces, the content watches of every space are removed when no space keys are set.
+	//
+	// The watched contents are found with the watcher CQL field, the unsubscription is idempotent, so it can be run again.
+	UnsubscribeUserEverywhere(ctx context.Context, accountID string, spaceKeys []string,
+		options *model.SpaceSubscriptionOptionsScheme) (*model.SpaceSubscriptionReportScheme, *model.ResponseScheme, error)
 }
@@ -0,0 +1,19 @@
+package confluence
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"io"
+)
+
+type SpaceExportConnector interface {
+
+	// Export triggers the HTML or XML export of a space, polls the long task until the export finishes
+	// and streams the archive to the writer, only the download link is returned when the writer is nil.
+	//
+	// POST /wiki/rest/api/space/{spaceKey}/export
+	//
+	// TODO: the documentation needs to be created
+	Export(ctx context.Context, spaceKey, format string, writer io.Writer, options *model.SpaceExportOptionsScheme) (*model.SpaceExportScheme,
+		*model.ResponseScheme, error)
+}
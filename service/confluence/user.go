@@ -0,0 +1,14 @@
+package confluence
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type UserConnector interface {
+
+	// Groups returns the groups that a user is a member of.
+	//
+	// GET /wiki/rest/api/user/memberof
+	Groups(ctx context.Context, accountID string, startAt, maxResults int) (*model.UserMembershipPageScheme, *model.ResponseScheme, error)
+}
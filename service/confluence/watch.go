@@ -0,0 +1,39 @@
+package confluence
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type UserWatchConnector interface {
+
+	// Content returns whether a user is watching a piece of content.
+	//
+	// GET /wiki/rest/api/user/watch/content/{contentId}
+	Content(ctx context.Context, contentID, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error)
+
+	// AddContent adds a user as a watcher to a piece of content.
+	//
+	// POST /wiki/rest/api/user/watch/content/{contentId}
+	AddContent(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error)
+
+	// RemoveContent removes a user as a watcher from a piece of content.
+	//
+	// DELETE /wiki/rest/api/user/watch/content/{contentId}
+	RemoveContent(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error)
+
+	// Space returns whether a user is watching a space.
+	//
+	// GET /wiki/rest/api/user/watch/space/{spaceKey}
+	Space(ctx context.Context, spaceKey, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error)
+
+	// AddSpace adds a user as a watcher to a space.
+	//
+	// POST /wiki/rest/api/user/watch/space/{spaceKey}
+	AddSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error)
+
+	// RemoveSpace removes a user as a watcher from a space.
+	//
+	// DELETE /wiki/rest/api/user/watch/space/{spaceKey}
+	RemoveSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error)
+}
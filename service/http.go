@@ -14,3 +14,39 @@ type Client interface {
 	TransformTheHTTPResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error)
 	TransformStructToReader(structure interface{}) (io.Reader, error)
 }
+
+// StreamClient is a client returning the HTTP responses without reading their body, the caller closes the body.
+type StreamClient interface {
+	Client
+	Stream(request *http.Request) (*http.Response, error)
+}
+
+// OperationClient is a client naming the requests with the operation of the service method sending them,
+// e.g. project.version.search, see models.WithOperation.
+type OperationClient interface {
+	Client
+	NewOperationRequest(ctx context.Context, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error)
+	NewOperationFormRequest(ctx context.Context, operation, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error)
+}
+
+// NewOperationRequest creates the request of the service method with the operation, the clients not implementing
+// OperationClient create the request without it.
+func NewOperationRequest(ctx context.Context, client Client, operation, method, apiEndpoint string, payload io.Reader) (*http.Request, error) {
+
+	if named, ok := client.(OperationClient); ok {
+		return named.NewOperationRequest(ctx, operation, method, apiEndpoint, payload)
+	}
+
+	return client.NewRequest(ctx, method, apiEndpoint, payload)
+}
+
+// NewOperationFormRequest creates the form request of the service method with the operation, the clients not
+// implementing OperationClient create the request without it.
+func NewOperationFormRequest(ctx context.Context, client Client, operation, method, apiEndpoint, contentType string, payload io.Reader) (*http.Request, error) {
+
+	if named, ok := client.(OperationClient); ok {
+		return named.NewOperationFormRequest(ctx, operation, method, apiEndpoint, contentType, payload)
+	}
+
+	return client.NewFormRequest(ctx, method, apiEndpoint, contentType, payload)
+}
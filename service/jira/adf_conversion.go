@@ -0,0 +1,29 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type ADFConversionConnector interface {
+
+	// WikiToADF converts wiki markup bodies to ADF documents, the bodies are sent in batches.
+	//
+	// A result is returned per body, on the order of the bodies. A body that can't be converted has the result error set,
+	// a failed batch stops the conversion and returns the results of the previous batches with the error.
+	//
+	// POST /rest/api/3/adf/convert
+	//
+	// TODO: the documentation needs to be created
+	WikiToADF(ctx context.Context, items []string) ([]*model.ADFConversionResultScheme, *model.ResponseScheme, error)
+
+	// ADFToWiki converts ADF documents to wiki markup bodies, the documents are sent in batches.
+	//
+	// A result is returned per document, on the order of the documents. A document that can't be converted has the result
+	// error set, a failed batch stops the conversion and returns the results of the previous batches with the error.
+	//
+	// POST /rest/api/3/adf/convert
+	//
+	// TODO: the documentation needs to be created
+	ADFToWiki(ctx context.Context, items []*model.CommentNodeScheme) ([]*model.ADFConversionResultScheme, *model.ResponseScheme, error)
+}
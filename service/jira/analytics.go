@@ -0,0 +1,17 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type ProjectAnalyticsConnector interface {
+
+	// Engagement returns the watchers and voters of the issues matching a JQL query, keyed by account id
+	// and joined with the user active status.
+	//
+	// The issues are resolved concurrently, the rate limited requests are retried.
+	//
+	// TODO: the documentation needs to be created
+	Engagement(ctx context.Context, jql string, options *model.IssueEngagementOptionsScheme) (*model.IssueEngagementReportScheme, *model.ResponseScheme, error)
+}
@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/service"
 	"io"
 )
 
@@ -40,19 +41,65 @@ type AttachmentConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#get-all-metadata-for-an-expanded-attachment
 	Human(ctx context.Context, attachmentId string) (*model.AttachmentHumanMetadataScheme, *model.ResponseScheme, error)
 
+	// Raw returns the metadata for the contents of an attachment, if it is an archive.
+	//
+	// For example, if the attachment is a ZIP archive, then information about the files in the archive is returned.
+	//
+	// Unlike Human, the sizes are returned in bytes and the attachment metadata isn't returned.
+	//
+	// GET /rest/api/{2-3}/attachment/{id}/expand/raw
+	//
+	// Experimental Endpoint
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#get-contents-metadata-for-an-expanded-attachment
+	Raw(ctx context.Context, attachmentId string) (*model.AttachmentRawMetadataScheme, *model.ResponseScheme, error)
+
 	// Add adds one attachment to an issue. Attachments are posted as multipart/form-data (RFC 1867).
 	//
+	// The file is streamed while the request is sent, it's never loaded into memory in full.
+	//
 	// POST /rest/api/{2-3}/issue/{issueIdOrKey}/attachments
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#add-attachment
 	Add(ctx context.Context, issueKeyOrId, fileName string, file io.Reader) ([]*model.AttachmentScheme, *model.ResponseScheme, error)
 
-	// Download returns the contents of an attachment. A Range header can be set to define a range of bytes within the attachment to download.
+	// Download writes the contents of an attachment into the writer.
+	//
+	// The contents are streamed into the writer, the redirect to the media service is followed when redirect is true.
 	//
-	// See the HTTP Range header standard for details.
+	// The Content-Type and the Content-Length headers are available on the response.
 	//
 	// GET /rest/api/{2-3}/attachment/content/{id}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#download-attachment
-	Download(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error)
+	Download(ctx context.Context, attachmentID string, redirect bool, w io.Writer) (*model.ResponseScheme, error)
+
+	// DownloadThumbnail writes the thumbnail of an attachment into the writer, the redirect to the media service is followed.
+	//
+	// GET /rest/api/{2-3}/attachment/thumbnail/{id}
+	//
+	// TODO: the documentation needs to be created
+	DownloadThumbnail(ctx context.Context, attachmentID string, options *model.AttachmentThumbnailOptionsScheme, w io.Writer) (*model.ResponseScheme, error)
+
+	// Thumbnail returns the thumbnail of an attachment, it's read into memory by DownloadThumbnail.
+	//
+	// The redirect to the media service is followed without the authorization header.
+	//
+	// GET /rest/api/{2-3}/attachment/thumbnail/{id}
+	//
+	// TODO: the documentation needs to be created
+	Thumbnail(ctx context.Context, attachmentID string, options *model.AttachmentThumbnailOptionsScheme) (*model.AttachmentThumbnailScheme, *model.ResponseScheme, error)
+
+	// Copy copies an attachment of another issue or site to an issue, the source client is the client of the site
+	// of the attachment, e.g. a client with other credentials.
+	//
+	// The attachment is streamed from the download into the multipart upload without buffering the file,
+	// the filename is preserved and the new attachment is returned.
+	//
+	// The upload limit and the attachment settings of the target site are checked before the download.
+	//
+	// GET /rest/api/{2-3}/attachment/content/{id}
+	//
+	// POST /rest/api/{2-3}/issue/{issueIdOrKey}/attachments
+	Copy(ctx context.Context, source service.StreamClient, sourceAttachmentID, targetIssueKeyOrID string) (*model.AttachmentScheme, *model.ResponseScheme, error)
 }
@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"time"
 )
 
 type CommentRichTextConnector interface {
@@ -22,12 +23,37 @@ type CommentRichTextConnector interface {
 	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
 	Get(ctx context.Context, issueKeyOrId, commentId string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error)
 
+	// List returns the comments by their ids, the comments of the issues the user can't see are not returned.
+	//
+	// POST /rest/api/{2-3}/comment/list
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	List(ctx context.Context, commentIDs []int, expand []string) (*model.IssueCommentListSchemeV2, *model.ResponseScheme, error)
+
 	// Add adds a comment to an issue.
 	//
 	// POST /rest/api/{2-3}/issue/{issueIdOrKey}/comment
 	//
 	//https://docs.go-atlassian.io/jira-software-cloud/issues/comments#add-comment
 	Add(ctx context.Context, issueKeyOrId string, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error)
+
+	// Update updates a comment, the users watching the issue are notified when notify is true
+	// and the context doesn't suppress the notifications.
+	//
+	// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/comment/{id}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Update(ctx context.Context, issueKeyOrId, commentId string, notify bool, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error)
+
+	// Stream pages through the comments of an issue, calling the visitor for each comment created or updated since the date,
+	// all the comments are streamed when the date is zero.
+	//
+	// The comments can only be ordered by their creation date: created, +created or -created, -created by default.
+	//
+	// Every comment is read whatever the order, so the comments created before the date and edited since are streamed.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/comment
+	Stream(ctx context.Context, issueKeyOrId, orderBy string, since time.Time, visitor model.IssueCommentVisitorFuncV2) (*model.ResponseScheme, error)
 }
 
 type CommentADFConnector interface {
@@ -47,12 +73,37 @@ type CommentADFConnector interface {
 	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
 	Get(ctx context.Context, issueKeyOrId, commentId string) (*model.IssueCommentScheme, *model.ResponseScheme, error)
 
+	// List returns the comments by their ids, the comments of the issues the user can't see are not returned.
+	//
+	// POST /rest/api/{2-3}/comment/list
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	List(ctx context.Context, commentIDs []int, expand []string) (*model.IssueCommentListScheme, *model.ResponseScheme, error)
+
 	// Add adds a comment to an issue.
 	//
 	// POST /rest/api/{2-3}/issue/{issueIdOrKey}/comment
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#add-comment
 	Add(ctx context.Context, issueKeyOrId string, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error)
+
+	// Update updates a comment, the users watching the issue are notified when notify is true
+	// and the context doesn't suppress the notifications.
+	//
+	// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/comment/{id}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Update(ctx context.Context, issueKeyOrId, commentId string, notify bool, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error)
+
+	// Stream pages through the comments of an issue, calling the visitor for each comment created or updated since the date,
+	// all the comments are streamed when the date is zero.
+	//
+	// The comments can only be ordered by their creation date: created, +created or -created, -created by default.
+	//
+	// Every comment is read whatever the order, so the comments created before the date and edited since are streamed.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/comment
+	Stream(ctx context.Context, issueKeyOrId, orderBy string, since time.Time, visitor model.IssueCommentVisitorFunc) (*model.ResponseScheme, error)
 }
 
 type CommentSharedConnector interface {
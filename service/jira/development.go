@@ -0,0 +1,31 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type DevelopmentConnector interface {
+
+	// Get returns the development information of an issue, e.g. the branches, pull requests and repositories.
+	//
+	// The applicationType is the source control provider, e.g. "GitHub", "bitbucket" or "stash".
+	//
+	// The dataType is the kind of development information, e.g. "branch", "pullrequest" or "repository".
+	//
+	// This endpoint is undocumented, the client internal APIs needs to be enabled.
+	//
+	// GET /rest/dev-status/1.0/issue/detail
+	//
+	// TODO: the documentation needs to be created
+	Get(ctx context.Context, issueID, applicationType, dataType string) (*model.DevelopmentDetailPageScheme, *model.ResponseScheme, error)
+
+	// Summary returns the summary of the development information of an issue.
+	//
+	// This endpoint is undocumented, the client internal APIs needs to be enabled.
+	//
+	// GET /rest/dev-status/1.0/issue/summary
+	//
+	// TODO: the documentation needs to be created
+	Summary(ctx context.Context, issueID string) (*model.DevelopmentSummaryPageScheme, *model.ResponseScheme, error)
+}
@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// FieldOptionConnector is the interface that wraps the Jira issue field options
+//
+// It contains the methods required to manipulate the options of the select list fields created by Connect apps,
+// for the custom fields created in Jira or using the REST API use the FieldContextOptionConnector instead.
+type FieldOptionConnector interface {
+
+	// Gets returns a paginated list of all the options of a select list issue field.
+	//
+	// GET /rest/api/{2-3}/field/{fieldKey}/option
+	//
+	// TODO: the documentation needs to be created
+	Gets(ctx context.Context, fieldKey string, startAt, maxResults int) (*model.IssueFieldOptionPageScheme, *model.ResponseScheme, error)
+
+	// Get returns an option from a select list issue field.
+	//
+	// GET /rest/api/{2-3}/field/{fieldKey}/option/{optionId}
+	//
+	// TODO: the documentation needs to be created
+	Get(ctx context.Context, fieldKey string, optionID int) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error)
+
+	// Create creates an option for a select list issue field.
+	//
+	// Note that this operation only works for issue field select list options added by Connect apps.
+	//
+	// POST /rest/api/{2-3}/field/{fieldKey}/option
+	//
+	// TODO: the documentation needs to be created
+	Create(ctx context.Context, fieldKey string, payload *model.IssueFieldOptionPayloadScheme) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error)
+
+	// Update updates or creates an option for a select list issue field.
+	//
+	// PUT /rest/api/{2-3}/field/{fieldKey}/option/{optionId}
+	//
+	// TODO: the documentation needs to be created
+	Update(ctx context.Context, fieldKey string, optionID int, payload *model.IssueFieldOptionPayloadScheme) (*model.IssueFieldOptionScheme, *model.ResponseScheme, error)
+
+	// Delete deletes an option from a select list issue field.
+	//
+	// DELETE /rest/api/{2-3}/field/{fieldKey}/option/{optionId}
+	//
+	// TODO: the documentation needs to be created
+	Delete(ctx context.Context, fieldKey string, optionID int) (*model.ResponseScheme, error)
+
+	// Replace deselects an issue-field select-list option from all issues where it is selected,
+	// a different option can be selected to replace the deselected option.
+	//
+	// The update can also be limited to a smaller set of issues by using a JQL query.
+	//
+	// This is an asynchronous operation, use the Task service to follow the returned task.
+	//
+	// DELETE /rest/api/{2-3}/field/{fieldKey}/option/{optionId}/issue
+	//
+	// TODO: the documentation needs to be created
+	Replace(ctx context.Context, fieldKey string, optionID, replaceWith int, jql string) (*model.TaskScheme, *model.ResponseScheme, error)
+}
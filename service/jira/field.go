@@ -32,6 +32,8 @@ type FieldConnector interface {
 
 	// Search returns a paginated list of fields for Classic Jira projects.
 	//
+	// The options AcceptLanguage pins the locale of the field names returned.
+	//
 	// GET /rest/api/{2-3}/field/search
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/fields#get-fields-paginated
@@ -45,6 +47,34 @@ type FieldConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/fields#delete-field
 	Delete(ctx context.Context, fieldId string) (*model.TaskScheme, *model.ResponseScheme, error)
+
+	// AddToDefaultScreen adds a field to the default tab of the default screen.
+	//
+	// The custom fields aren't visible on the issues until they're added to a screen.
+	//
+	// POST /rest/api/{2-3}/screens/addToDefault/{fieldId}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/screens#add-field-to-default-screen
+	AddToDefaultScreen(ctx context.Context, fieldId string) (*model.ResponseScheme, error)
+
+	// ScreensForField returns a paginated list of the screens a field is used in.
+	//
+	// Use the "tab" expand to include the screen tabs the field is used in.
+	//
+	// GET /rest/api/{2-3}/field/{fieldId}/screens
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/screens#get-screens-for-a-field
+	ScreensForField(ctx context.Context, fieldId string, startAt, maxResults int, expand []string) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error)
+
+	// ResolveByName returns the field matching the name, whatever the locale of the user.
+	//
+	// The fields are matched, in order, on the exact untranslated name, on the exact localized name,
+	// then on both names ignoring the case.
+	//
+	// GET /rest/api/{2-3}/field
+	//
+	// TODO: the documentation needs to be created
+	ResolveByName(ctx context.Context, name string) (*model.IssueFieldScheme, *model.ResponseScheme, error)
 }
 
 type FieldTrashConnector interface {
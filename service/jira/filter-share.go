@@ -38,6 +38,8 @@ type FilterSharingConnector interface {
 	//
 	// it will overwrite all share permissions for the filter.
 	//
+	// The group shares that only contain the group name are sent with the group id resolved by the group service.
+	//
 	// POST /rest/api/{2-3}/filter/{id}/permission
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/filters/sharing#add-share-permission
@@ -56,8 +58,18 @@ type FilterSharingConnector interface {
 
 	// Delete deletes a share permission from a filter.
 	//
+	// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+	//
 	// DELETE /rest/api/{2-3}/filter/{id}/permission/{permissionId}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/filters/sharing#delete-share-permission
 	Delete(ctx context.Context, filterId, permissionId int) (*model.ResponseScheme, error)
+
+	// PreviewDelete returns the share permission removed from a filter, and the token confirming the deletion
+	// when the client is on protected mode.
+	//
+	// GET /rest/api/{2-3}/filter/{id}/permission/{permissionId}
+	//
+	// TODO: the documentation needs to be created
+	PreviewDelete(ctx context.Context, filterId, permissionId int) (*model.FilterShareDeletionPreviewScheme, *model.ResponseScheme, error)
 }
@@ -50,5 +50,42 @@ type GroupConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/groups#remove-user-from-group
 	Remove(ctx context.Context, groupName, accountId string) (*model.ResponseScheme, error)
 
+	// DeleteByID deletes a group by its id.
+	//
+	// DELETE /rest/api/{2-3}/group
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/groups#remove-group
+	DeleteByID(ctx context.Context, groupID string) (*model.ResponseScheme, error)
+
+	// MembersByID returns a paginated list of all users in a group, the group is identified by its id.
+	//
+	// GET /rest/api/{2-3}/group/member
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/groups#get-users-from-groups
+	MembersByID(ctx context.Context, groupID string, inactive bool, startAt, maxResults int) (*model.GroupMemberPageScheme, *model.ResponseScheme, error)
+
+	// AddByID adds a user to a group, the group is identified by its id.
+	//
+	// POST /rest/api/{2-3}/group/user
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/groups#add-user-to-group
+	AddByID(ctx context.Context, groupID, accountId string) (*model.GroupScheme, *model.ResponseScheme, error)
+
+	// RemoveByID removes a user from a group, the group is identified by its id.
+	//
+	// DELETE /rest/api/{2-3}/group/user
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/groups#remove-user-from-group
+	RemoveByID(ctx context.Context, groupID, accountId string) (*model.ResponseScheme, error)
+
+	// ResolveID returns the id of the group with the name, the name is matched ignoring the case.
+	//
+	// The ids resolved are cached, the services that only receive a group name use it to send the group id.
+	//
+	// GET /rest/api/{2-3}/group/bulk
+	//
+	// TODO: the documentation needs to be created
+	ResolveID(ctx context.Context, groupName string) (string, *model.ResponseScheme, error)
+
 	// TODO: GET /rest/api/3/groups/picker needs to be parsed
 }
@@ -31,6 +31,8 @@ type IssueSharedConnector interface {
 	//  1. "-1", the issue is assigned to the default assignee for the project.
 	//  2. null, the issue is set to unassigned.
 	//
+	// The assignee endpoint doesn't accept the notifyUsers parameter, the suppressed notifications are ignored.
+	//
 	// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/assignee
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#assign-issue
@@ -49,12 +51,77 @@ type IssueSharedConnector interface {
 	//
 	// given its status, the response will return any empty transitions list.
 	//
+	// Expand the transitions.fields to get the fields settable on the screen of each transition.
+	//
 	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/transitions
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-transitions
-	Transitions(ctx context.Context, issueKeyOrId string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error)
-	// TODO The Transitions methods requires more parameters such as expand, transitionId, and more
+	Transitions(ctx context.Context, issueKeyOrId string, expand []string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error)
+	// TODO The Transitions methods requires more parameters such as transitionId, and more
 	// The parameters are documented on this [page](https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-transitions-get)
+
+	// Clone creates a copy of an issue, the subtasks, issue links and attachments are cloned when the options enable them.
+	//
+	// The REST API doesn't clone issues atomically, the parts that can't be cloned are reported on the result
+	// failures while the new issues are kept.
+	//
+	// The cancellation of the context is checked between the steps, the result of the steps completed is returned with the error.
+	//
+	// TODO: the documentation needs to be created
+	Clone(ctx context.Context, issueKeyOrId string, options *model.IssueCloneOptionsScheme) (*model.IssueCloneResultScheme, *model.ResponseScheme, error)
+
+	// BulkMove transitions the issues matching a JQL query, the transition is matched by id or by name, ignoring the case,
+	// against the transitions available on every issue, the issues without a matching transition are skipped.
+	//
+	// The fields are set on every transition, the issues on the already processed keys are not transitioned again.
+	//
+	// The dry run resolves the transitions without transitioning the issues.
+	//
+	// A positive concurrency pins the requests in flight, otherwise it starts at 5 and adapts to the rate limit of the site.
+	//
+	// TODO: the documentation needs to be created
+	BulkMove(ctx context.Context, jql, transitionNameOrID string, fields map[string]interface{}, concurrency int, dryRun bool,
+		processedKeys []string) (*model.IssueBulkMoveReportScheme, *model.ResponseScheme, error)
+
+	// TimeInStatus returns the status intervals of an issue, rebuilt from the issue creation date and its changelog,
+	// and the total duration of every status.
+	//
+	// The intervals are measured on the business hours calendar of the options, the wall-clock time is used by default.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/changelog
+	//
+	// TODO: the documentation needs to be created
+	TimeInStatus(ctx context.Context, issueKeyOrId string, options *model.TimeInStatusOptionsScheme) (*model.IssueTimeInStatusScheme, *model.ResponseScheme, error)
+
+	// Archive archives up to 1000 issues in a single call, the issues not archived are listed on the errors of the result.
+	//
+	// PUT /rest/api/{2-3}/issue/archive
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Archive(ctx context.Context, issueKeysOrIDs []string) (*model.IssueArchivalSyncResponseScheme, *model.ResponseScheme, error)
+
+	// ArchiveByJQL starts the archival of the issues returned by the JQL query, the archival is asynchronous,
+	// the returned string is the URL of the task tracking its progress.
+	//
+	// POST /rest/api/{2-3}/issue/archive
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	ArchiveByJQL(ctx context.Context, jql string) (string, *model.ResponseScheme, error)
+
+	// Unarchive restores up to 1000 archived issues in a single call, the issues not restored are listed on the errors of the result.
+	//
+	// PUT /rest/api/{2-3}/issue/unarchive
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Unarchive(ctx context.Context, issueKeysOrIDs []string) (*model.IssueArchivalSyncResponseScheme, *model.ResponseScheme, error)
+
+	// ExportArchived starts the export of the archived issues matching the filters of the payload, the export
+	// is asynchronous and its file is emailed to the user who started it.
+	//
+	// PUT /rest/api/{2-3}/issues/archive/export
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	ExportArchived(ctx context.Context, payload *model.IssueArchivalExportPayloadScheme) (*model.IssueArchivalExportScheme, *model.ResponseScheme, error)
 }
 
 type IssueRichTextConnector interface {
@@ -73,11 +140,25 @@ type IssueRichTextConnector interface {
 	//
 	// 2.Transitions may be applied, to move the issues or subtasks to a workflow step other than the default start step, and issue properties set.
 	//
+	// The issues are created in batches of 50, the failed element numbers of the errors are the positions of the issues on the payload.
+	//
+	// A failed batch stops the creation, the issues created by the previous batches are returned with the error.
+	//
 	// POST /rest/api/{2-3}/issue/bulk
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-create-issue
 	Creates(ctx context.Context, payload []*model.IssueBulkSchemeV2) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error)
 
+	// CreateComplete creates an issue and adds the extras in order: attachments, links, watchers and the initial comment.
+	//
+	// The new issue is never rolled back, the extras that can't be added are reported on the result failures.
+	//
+	// The cancellation of the context is checked between the extras, the result of the extras added is returned with the error.
+	//
+	// TODO: the documentation needs to be created
+	CreateComplete(ctx context.Context, payload *model.IssueSchemeV2, customFields *model.CustomFields, extras *model.IssueCreateExtrasSchemeV2) (
+		*model.IssueCreateCompleteResultScheme, *model.ResponseScheme, error)
+
 	// Get returns the details for an issue.
 	//
 	// The issue is identified by its ID or key, however, if the identifier doesn't match an issue, a case-insensitive search
@@ -97,6 +178,8 @@ type IssueRichTextConnector interface {
 	//
 	// The edits to the issue's fields are defined using update and fields
 	//
+	// The users are notified when notify is true and the context doesn't suppress the notifications.
+	//
 	// PUT /rest/api/{2-3}/issue/{issueIdOrKey}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#edit-issue
@@ -111,6 +194,18 @@ type IssueRichTextConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrId, transitionId string, options *model.IssueMoveOptionsV2) (*model.ResponseScheme, error)
+
+	// BulkFetch returns the details for a set of requested issues.
+	//
+	// The issues are fetched in batches of up to 100 issues, the results are merged following the order of the requested issues.
+	//
+	// The issues that could not be fetched are returned on the issue errors.
+	//
+	// POST /rest/api/{2-3}/issue/bulkfetch
+	//
+	// TODO: the documentation needs to be created
+	BulkFetch(ctx context.Context, issueKeysOrIDs, fields, expand, properties []string, fieldsByKeys bool) (*model.IssueBulkFetchSchemeV2,
+		*model.ResponseScheme, error)
 }
 
 type IssueADFConnector interface {
@@ -129,11 +224,25 @@ type IssueADFConnector interface {
 	//
 	// 2.Transitions may be applied, to move the issues or subtasks to a workflow step other than the default start step, and issue properties set.
 	//
+	// The issues are created in batches of 50, the failed element numbers of the errors are the positions of the issues on the payload.
+	//
+	// A failed batch stops the creation, the issues created by the previous batches are returned with the error.
+	//
 	// POST /rest/api/{2-3}/issue/bulk
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-create-issue
 	Creates(ctx context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error)
 
+	// CreateComplete creates an issue and adds the extras in order: attachments, links, watchers and the initial comment.
+	//
+	// The new issue is never rolled back, the extras that can't be added are reported on the result failures.
+	//
+	// The cancellation of the context is checked between the extras, the result of the extras added is returned with the error.
+	//
+	// TODO: the documentation needs to be created
+	CreateComplete(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields, extras *model.IssueCreateExtrasScheme) (
+		*model.IssueCreateCompleteResultScheme, *model.ResponseScheme, error)
+
 	// Get returns the details for an issue.
 	//
 	// The issue is identified by its ID or key, however, if the identifier doesn't match an issue, a case-insensitive search
@@ -153,6 +262,8 @@ type IssueADFConnector interface {
 	//
 	// The edits to the issue's fields are defined using update and fields
 	//
+	// The users are notified when notify is true and the context doesn't suppress the notifications.
+	//
 	// PUT /rest/api/{2-3}/issue/{issueIdOrKey}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#edit-issue
@@ -167,4 +278,16 @@ type IssueADFConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrId, transitionId string, options *model.IssueMoveOptionsV3) (*model.ResponseScheme, error)
+
+	// BulkFetch returns the details for a set of requested issues.
+	//
+	// The issues are fetched in batches of up to 100 issues, the results are merged following the order of the requested issues.
+	//
+	// The issues that could not be fetched are returned on the issue errors.
+	//
+	// POST /rest/api/{2-3}/issue/bulkfetch
+	//
+	// TODO: the documentation needs to be created
+	BulkFetch(ctx context.Context, issueKeysOrIDs, fields, expand, properties []string, fieldsByKeys bool) (*model.IssueBulkFetchSchemeV3,
+		*model.ResponseScheme, error)
 }
@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// IssuePropertyConnector is an interface that defines the methods available from the issue property API.
+// Use it to store the custom data of the apps against the issues.
+type IssuePropertyConnector interface {
+
+	// Keys returns the keys of the properties of an issue.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/properties
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Keys(ctx context.Context, issueKeyOrId string) (*model.IssuePropertyKeysScheme, *model.ResponseScheme, error)
+
+	// Get returns the key and the raw value of an issue property.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/properties/{propertyKey}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Get(ctx context.Context, issueKeyOrId, propertyKey string) (*model.IssuePropertyScheme, *model.ResponseScheme, error)
+
+	// Set sets the value of an issue property, the value can be any value marshalable to JSON, e.g. a json.RawMessage.
+	//
+	// The value must be a valid, non-empty JSON blob. The maximum length is 32768 characters.
+	//
+	// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/properties/{propertyKey}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Set(ctx context.Context, issueKeyOrId, propertyKey string, value interface{}) (*model.ResponseScheme, error)
+
+	// Delete deletes an issue property.
+	//
+	// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/properties/{propertyKey}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Delete(ctx context.Context, issueKeyOrId, propertyKey string) (*model.ResponseScheme, error)
+
+	// BulkSet sets the value of a property on the issues selected by the filter.
+	//
+	// The update is an asynchronous task, the response redirects to the task.
+	//
+	// PUT /rest/api/{2-3}/issue/properties/{propertyKey}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkPayloadScheme) (*model.ResponseScheme, error)
+}
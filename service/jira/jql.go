@@ -15,4 +15,23 @@ type JQLConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/jql#parse-jql-query
 	Parse(ctx context.Context, validationType string, JqlQueries []string) (*models.ParsedQueryPageScheme, *models.ResponseScheme, error)
+
+	// Sanitize sanitizes the JQL queries, the values the user can't see are replaced by their ids, e.g. a project name.
+	//
+	// The queries are sanitized in context of their account id, or of the current user when it's not set.
+	//
+	// POST /rest/api/{2-3}/jql/sanitize
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Sanitize(ctx context.Context, queries []*models.JQLQueryToSanitizeScheme) (*models.JQLSanitizedQueriesScheme, *models.ResponseScheme, error)
+
+	// ConvertUserIdentifiers converts the user names and the user keys of the JQL queries to account ids.
+	//
+	// The queries are sent in batches of 100 queries, the limit of the endpoint, and the converted queries are merged.
+	// When a batch fails, the queries converted by the previous batches are returned with the error.
+	//
+	// POST /rest/api/{2-3}/jql/pdcleaner
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	ConvertUserIdentifiers(ctx context.Context, queries []string) (*models.JQLConvertedQueriesScheme, *models.ResponseScheme, error)
 }
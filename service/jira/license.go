@@ -0,0 +1,30 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type LicenseConnector interface {
+
+	// Get returns licensing information about the Jira instance, e.g. the plan of each application.
+	//
+	// GET /rest/api/{2-3}/instance/license
+	//
+	// TODO: the documentation needs to be created
+	Get(ctx context.Context) (*model.InstanceLicenseScheme, *model.ResponseScheme, error)
+
+	// ApproximateCount returns the total approximate number of user accounts across all the Jira licenses.
+	//
+	// GET /rest/api/{2-3}/license/approximateLicenseCount
+	//
+	// TODO: the documentation needs to be created
+	ApproximateCount(ctx context.Context) (*model.LicenseApproximateCountScheme, *model.ResponseScheme, error)
+
+	// ApproximateCountByApplication returns the approximate number of user accounts of a Jira application.
+	//
+	// GET /rest/api/{2-3}/license/approximateLicenseCount/product/{applicationKey}
+	//
+	// TODO: the documentation needs to be created
+	ApproximateCountByApplication(ctx context.Context, applicationKey string) (*model.LicenseApproximateCountScheme, *model.ResponseScheme, error)
+}
@@ -53,6 +53,63 @@ type LinkAdfIssueConnector interface {
 	Create(ctx context.Context, payload *model.LinkPayloadSchemeV3) (*model.ResponseScheme, error)
 }
 
+// RemoteLinkConnector is an interface that defines the methods available from the Issue Remote Link API.
+// Use it to link the issues to the objects of the external systems, e.g. a pull request or an incident.
+type RemoteLinkConnector interface {
+
+	// Gets returns the remote links of an issue.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Gets(ctx context.Context, issueKeyOrId string) ([]*model.RemoteLinkScheme, *model.ResponseScheme, error)
+
+	// Get returns a remote link of an issue.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink/{linkId}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Get(ctx context.Context, issueKeyOrId, linkId string) (*model.RemoteLinkScheme, *model.ResponseScheme, error)
+
+	// GetByGlobalID returns the remote link of an issue with the global id.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink?globalId={globalId}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	GetByGlobalID(ctx context.Context, issueKeyOrId, globalId string) (*model.RemoteLinkScheme, *model.ResponseScheme, error)
+
+	// Create creates or updates a remote link of an issue.
+	//
+	// When the global id of the payload matches a remote link of the issue, the link is updated and the response
+	// status code is 200, otherwise the link is created and the status code is 201.
+	//
+	// POST /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Create(ctx context.Context, issueKeyOrId string, payload *model.RemoteLinkPayloadScheme) (*model.RemoteLinkIdentify, *model.ResponseScheme, error)
+
+	// Update updates a remote link of an issue, the fields not set on the payload are removed from the link.
+	//
+	// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink/{linkId}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Update(ctx context.Context, issueKeyOrId, linkId string, payload *model.RemoteLinkPayloadScheme) (*model.ResponseScheme, error)
+
+	// DeleteByID deletes a remote link of an issue.
+	//
+	// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink/{linkId}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	DeleteByID(ctx context.Context, issueKeyOrId, linkId string) (*model.ResponseScheme, error)
+
+	// DeleteByGlobalID deletes the remote link of an issue with the global id.
+	//
+	// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/remotelink?globalId={globalId}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	DeleteByGlobalID(ctx context.Context, issueKeyOrId, globalId string) (*model.ResponseScheme, error)
+}
+
 // LinkTypeConnector is an interface that defines the methods available from Issue Link Type  API.
 // Use it to get, create, update, and delete link issue types as well as get lists of all link issue types.
 type LinkTypeConnector interface {
@@ -17,6 +17,15 @@ type MetadataConnector interface {
 	// TODO: the documentation needs to be created
 	Get(ctx context.Context, issueKeyOrId string, overrideScreenSecurity, overrideEditableFlag bool) (gjson.Result, *model.ResponseScheme, error)
 
+	// GetTyped returns the edit screen fields for an issue that are visible to and editable by the user.
+	//
+	// The fields are decoded into their metadata, mapped by field id, instead of being returned as a raw result.
+	//
+	// GET /rest/api/{2-3}/issue/{issueIdOrKey}/editmeta
+	//
+	// TODO: the documentation needs to be created
+	GetTyped(ctx context.Context, issueKeyOrId string, overrideScreenSecurity, overrideEditableFlag bool) (*model.IssueEditMetadataScheme, *model.ResponseScheme, error)
+
 	// Create returns details of projects, issue types within projects, and, when requested,
 	//
 	// the create screen fields for each issue type for the user.
@@ -25,4 +34,31 @@ type MetadataConnector interface {
 	//
 	// TODO: the documentation needs to be created
 	Create(ctx context.Context, opts *model.IssueMetadataCreateOptions) (gjson.Result, *model.ResponseScheme, error)
+
+	// CreateTyped returns details of projects, issue types within projects, and, when requested,
+	//
+	// the create screen fields for each issue type for the user, decoded into their metadata.
+	//
+	// GET /rest/api/{2-3}/issue/createmeta
+	//
+	// TODO: the documentation needs to be created
+	CreateTyped(ctx context.Context, opts *model.IssueMetadataCreateOptions) (*model.ProjectIssueCreateMetadataScheme, *model.ResponseScheme, error)
+
+	// CreateIssueTypes returns a page of issue type metadata for a specified project.
+	//
+	// Use the information to populate the requests in Create issue.
+	//
+	// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes
+	//
+	// TODO: the documentation needs to be created
+	CreateIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*model.IssueTypeCreateMetadataPageScheme, *model.ResponseScheme, error)
+
+	// CreateIssueTypeFields returns a page of field metadata for a specified project and issue type id.
+	//
+	// Use the information to populate the requests in Create issue.
+	//
+	// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes/{issueTypeId}
+	//
+	// TODO: the documentation needs to be created
+	CreateIssueTypeFields(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (*model.IssueFieldCreateMetadataPageScheme, *model.ResponseScheme, error)
 }
@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"time"
 )
 
 type ProjectConnector interface {
@@ -41,6 +42,8 @@ type ProjectConnector interface {
 	//
 	// To restore a project, use the Jira UI.
 	//
+	// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+	//
 	// DELETE /rest/api/{2-3}/project/{projectIdOrKey}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects#delete-project
@@ -52,11 +55,21 @@ type ProjectConnector interface {
 	//
 	// 2. asynchronous. Follow the location link in the response to determine the status of the task and use Get task to obtain subsequent updates.
 	//
+	// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+	//
 	// POST /rest/api/{2-3}/project/{projectIdOrKey}/delete
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects#delete-project-asynchronously
 	DeleteAsynchronously(ctx context.Context, projectKeyOrId string) (*model.TaskScheme, *model.ResponseScheme, error)
 
+	// PreviewDelete returns the project and its approximate number of issues, and the token confirming the deletion
+	// when the client is on protected mode. The token confirms the deletion by the project key or id.
+	//
+	// GET /rest/api/{2-3}/project/{projectIdOrKey}
+	//
+	// TODO: the documentation needs to be created
+	PreviewDelete(ctx context.Context, projectKeyOrId string) (*model.ProjectDeletionPreviewScheme, *model.ResponseScheme, error)
+
 	// Archive archives a project. Archived projects cannot be deleted.
 	//
 	// To delete an archived project, restore the project and then delete it.
@@ -90,6 +103,33 @@ type ProjectConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects#get-project-notification-scheme
 	NotificationScheme(ctx context.Context, projectKeyOrId string, expand []string) (*model.NotificationSchemeScheme, *model.ResponseScheme, error)
+
+	// Hierarchy returns the issue type hierarchy for a next-gen project.
+	//
+	// The issue type hierarchy for a project consists of the subtask, base, epic and any custom levels, with the issue types at each level.
+	//
+	// GET /rest/api/{2-3}/project/{projectId}/hierarchy
+	//
+	// TODO: the documentation needs to be created
+	Hierarchy(ctx context.Context, projectID int) (*model.ProjectIssueTypeHierarchyScheme, *model.ResponseScheme, error)
+
+	// EpicLinkField returns the field used to link the issues of a project to an epic.
+	//
+	// An empty field id is returned for the team-managed projects, and the company-managed projects without epic link field,
+	// the issues are linked to the epic with the parent field, e.g. IssueScheme.SetEpic(epicKey, "", nil).
+	//
+	// GET /rest/api/{2-3}/project/{projectIdOrKey}
+	//
+	// TODO: the documentation needs to be created
+	EpicLinkField(ctx context.Context, projectKeyOrId string) (string, *model.ResponseScheme, error)
+
+	// ArchiveInactive archives the live projects without issues updated since the date, the excluded project keys are skipped.
+	//
+	// The last issue update of each project is read from the project insight, the projects without issues or without
+	// insight are skipped, on a dry run the projects are only evaluated.
+	//
+	// The projects that couldn't be evaluated or archived are listed on the report with the error.
+	ArchiveInactive(ctx context.Context, inactiveSince time.Time, dryRun bool, exclude []string) (*model.ProjectArchivalReportScheme, *model.ResponseScheme, error)
 }
 
 type ProjectCategoryConnector interface {
@@ -175,6 +215,12 @@ type ProjectComponentConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/components#get-component
 	Get(ctx context.Context, componentId string) (*model.ComponentScheme, *model.ResponseScheme, error)
+
+	// Issues returns the issues assigned to the component, running a paginated issue search,
+	// the issues with a resolution are skipped when onlyUnresolved is set.
+	//
+	// POST /rest/api/{2-3}/search
+	Issues(ctx context.Context, componentId string, onlyUnresolved bool, options *model.ProjectUsageOptionsScheme) ([]*model.ProjectUsageIssueScheme, *model.ResponseScheme, error)
 }
 
 type ProjectFeatureConnector interface {
@@ -302,12 +348,26 @@ type ProjectRoleActorConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/roles/actors#add-actors-to-project-role
 	Add(ctx context.Context, projectKeyOrId string, roleId int, accountIds, groups []string) (*model.ProjectRoleScheme, *model.ResponseScheme, error)
 
+	// AddActors adds actors to a project role for the project, the groups are identified by their names or by their ids.
+	//
+	// POST /rest/api/{2-3}/project/{projectIdOrKey}/role/{id}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/projects/roles/actors#add-actors-to-project-role
+	AddActors(ctx context.Context, projectKeyOrId string, roleId int, payload *model.ProjectRoleActorPayloadScheme) (*model.ProjectRoleScheme, *model.ResponseScheme, error)
+
 	// Delete deletes actors from a project role for the project.
 	//
 	// DELETE /rest/api/{2-3}/project/{projectIdOrKey}/role/{id}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/roles/actors#delete-actors-from-project-role
 	Delete(ctx context.Context, projectKeyOrId string, roleId int, accountId, group string) (*model.ResponseScheme, error)
+
+	// DeleteByGroupID deletes a group from a project role for the project, the group is identified by its id.
+	//
+	// DELETE /rest/api/{2-3}/project/{projectIdOrKey}/role/{id}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/projects/roles/actors#delete-actors-from-project-role
+	DeleteByGroupID(ctx context.Context, projectKeyOrId string, roleId int, groupId string) (*model.ResponseScheme, error)
 }
 
 type ProjectTypeConnector interface {
@@ -414,15 +474,45 @@ type ProjectVersionConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/versions#update-version
 	Update(ctx context.Context, versionId string, payload *model.VersionPayloadScheme) (*model.VersionScheme, *model.ResponseScheme, error)
 
+	// Move modifies the version's sequence within the project, which affects the display order of the versions in Jira.
+	//
+	// Either after, the self URL of the version placed before the version, or the position is set.
+	// The position is one of First, Last, Earlier or Later.
+	//
+	// POST /rest/api/{2-3}/version/{id}/move
+	//
+	// TODO: the documentation needs to be created
+	Move(ctx context.Context, versionId, after, position string) (*model.VersionScheme, *model.ResponseScheme, error)
+
+	// Release releases a version with today as the release date.
+	//
+	// The unresolved issues of the version are counted and returned, they're moved to the moveUnfixedIssuesTo version
+	// when it's set, otherwise they're left on the released version.
+	//
+	// PUT /rest/api/{2-3}/version/{id}
+	//
+	// TODO: the documentation needs to be created
+	Release(ctx context.Context, versionId, moveUnfixedIssuesTo string) (*model.VersionReleaseScheme, *model.ResponseScheme, error)
+
 	// Merge merges two project versions.
 	//
 	// The merge is completed by deleting the version specified in id and replacing any occurrences of
 	//
 	// its ID in fixVersion with the version ID specified in moveIssuesTo.
 	//
+	// On protected mode, the context must carry the confirmation token of PreviewDelete, see model.WithConfirmation.
+	//
 	// PUT /rest/api/{2-3}/version/{id}/mergeto/{moveIssuesTo}
 	Merge(ctx context.Context, versionId, versionMoveIssuesTo string) (*model.ResponseScheme, error)
 
+	// PreviewDelete returns the issue counts of a version deleted by a merge, and the token confirming the merge
+	// when the client is on protected mode.
+	//
+	// GET /rest/api/{2-3}/version/{id}/relatedIssueCounts
+	//
+	// TODO: the documentation needs to be created
+	PreviewDelete(ctx context.Context, versionId string) (*model.VersionDeletionPreviewScheme, *model.ResponseScheme, error)
+
 	// RelatedIssueCounts returns the following counts for a version:
 	//
 	// 1. Number of issues where the fixVersion is set to the version.
@@ -442,4 +532,38 @@ type ProjectVersionConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/versions#get-versions-unresolved-issues-count
 	UnresolvedIssueCount(ctx context.Context, versionId string) (*model.VersionUnresolvedIssuesCountScheme, *model.ResponseScheme, error)
+
+	// RelatedWorks returns the related work items linked to a version.
+	//
+	// GET /rest/api/{2-3}/version/{id}/relatedwork
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	RelatedWorks(ctx context.Context, versionId string) ([]*model.VersionRelatedWorkScheme, *model.ResponseScheme, error)
+
+	// CreateRelatedWork creates a related work item for the given version.
+	//
+	// POST /rest/api/{2-3}/version/{id}/relatedwork
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	CreateRelatedWork(ctx context.Context, versionId string, payload *model.VersionRelatedWorkScheme) (*model.VersionRelatedWorkScheme, *model.ResponseScheme, error)
+
+	// UpdateRelatedWork updates the given related work item, the payload must contain the related work id.
+	//
+	// PUT /rest/api/{2-3}/version/{id}/relatedwork
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	UpdateRelatedWork(ctx context.Context, versionId string, payload *model.VersionRelatedWorkScheme) (*model.VersionRelatedWorkScheme, *model.ResponseScheme, error)
+
+	// DeleteRelatedWork deletes the given related work item from the given version.
+	//
+	// DELETE /rest/api/{2-3}/version/{versionId}/relatedwork/{relatedWorkId}
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	DeleteRelatedWork(ctx context.Context, versionId, relatedWorkId string) (*model.ResponseScheme, error)
+
+	// Issues returns the issues referencing the version on the field, fixVersion or affectedVersion,
+	// running a paginated issue search. The fixVersion field is used when the field is not set.
+	//
+	// POST /rest/api/{2-3}/search
+	Issues(ctx context.Context, versionId, field string, options *model.ProjectUsageOptionsScheme) ([]*model.ProjectUsageIssueScheme, *model.ResponseScheme, error)
 }
@@ -0,0 +1,21 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type ProjectFieldMapConnector interface {
+
+	// Resolve maps the field display names, e.g. "Story point estimate", to the field ids of a project.
+	//
+	// The fields are read from the create metadata of the project issue types and cached per project.
+	//
+	// A *model.FieldNameResolutionError listing the candidates is returned when a display name is missing or ambiguous.
+	//
+	// TODO: the documentation needs to be created
+	Resolve(ctx context.Context, projectKeyOrID string, displayNames []string) (*model.ProjectFieldMapScheme, *model.ResponseScheme, error)
+
+	// Invalidate removes the cached fields of a project, all the projects are removed if the project is not set.
+	Invalidate(projectKeyOrID string)
+}
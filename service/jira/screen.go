@@ -9,10 +9,12 @@ type ScreenConnector interface {
 
 	// Fields returns a paginated list of the screens a field is used in.
 	//
+	// Use the "tab" expand to include the screen tabs the field is used in.
+	//
 	// GET /rest/api/{2-3}/field/{fieldId}/screens
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/screens#get-screens-for-a-field
-	Fields(ctx context.Context, fieldId string, startAt, maxResults int) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error)
+	Fields(ctx context.Context, fieldId string, startAt, maxResults int, expand []string) (*model.ScreenFieldPageScheme, *model.ResponseScheme, error)
 
 	// Gets returns a paginated list of all screens or those specified by one or more screen IDs.
 	//
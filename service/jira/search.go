@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"io"
 )
 
 type SearchSharedConnector interface {
@@ -13,6 +14,48 @@ type SearchSharedConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#check-issues-against-jql
 	Checks(ctx context.Context, payload *model.IssueSearchCheckPayloadScheme) (*model.IssueMatchesPageScheme, *model.ResponseScheme, error)
+
+	// SearchInto searches issues using a JQL query and decodes the fields of each issue into an element of dest.
+	//
+	// The dest must be a pointer to a slice of structs, the struct fields are matched to the issue fields by their jira tag,
+	// e.g. `jira:"customfield_10012"`, or by their json tag. The @key, @id and @self jira tags receive the issue key, id and self.
+	//
+	// The tagged fields are requested when the fields are not provided.
+	//
+	// POST /rest/api/{2-3}/search
+	//
+	// TODO: the documentation needs to be created
+	SearchInto(ctx context.Context, jql string, fields []string, dest interface{}, options *model.IssueSearchIntoOptionsScheme) (*model.ResponseScheme, error)
+
+	// CountByJQL returns the approximate count of the issues matching a JQL query, compare it with the total
+	// of a search to detect the issues left out because of the permissions of the user.
+	//
+	// POST /rest/api/{2-3}/search/approximate-count
+	//
+	// TODO: the documentation needs to be created
+	CountByJQL(ctx context.Context, jql string) (int, *model.ResponseScheme, error)
+
+	// Picker returns the issues suggested for the characters typed on an issue picker, the suggestions are grouped
+	// on the history and the current search sections.
+	//
+	// GET /rest/api/{2-3}/issue/picker
+	//
+	// TODO: The documentation needs to be created, raise a ticket here: https://github.com/ctreminiom/go-atlassian/issues
+	Picker(ctx context.Context, query, currentJQL, currentIssueKey, currentProjectID string, showSubTasks, showSubTaskParent bool) (*model.IssuePickerSuggestionsScheme, *model.ResponseScheme, error)
+
+	// Export searches issues using a JQL query and writes them to w, as CSV or as NDJSON.
+	//
+	// The CSV format writes a row per issue with the key and the fields, the header contains the field names. The custom
+	// fields are rendered with the typed parse helpers, and the values of the multi-value fields are joined by the
+	// options separator. The NDJSON format writes an issue JSON object per line, with only the exported fields.
+	//
+	// The issues are searched and written a page at a time. A failed search or a cancelled context stops the export
+	// and returns the error, the issues of the previous pages are kept on w.
+	//
+	// POST /rest/api/{2-3}/search
+	//
+	// TODO: the documentation needs to be created
+	Export(ctx context.Context, jql string, fields []string, format string, w io.Writer, options *model.IssueSearchExportOptionsScheme) (*model.ResponseScheme, error)
 }
 
 type SearchRichTextConnector interface {
@@ -20,6 +63,9 @@ type SearchRichTextConnector interface {
 
 	// Get search issues using JQL query under the HTTP Method GET
 	//
+	// The permission warnings of the result are only filled with the model.SearchValidateWarn validate mode, the
+	// restricted clauses are reported instead of failing the search and the missing fields are guessed from the issues.
+	//
 	// GET /rest/api/2/search
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#search-for-issues-using-jql-get
@@ -27,10 +73,21 @@ type SearchRichTextConnector interface {
 
 	// Post search issues using JQL query under the HTTP Method POST
 	//
+	// The permission warnings of the result are only filled with the model.SearchValidateWarn validate mode, the
+	// restricted clauses are reported instead of failing the search and the missing fields are guessed from the issues.
+	//
 	// POST /rest/api/2/search
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#search-for-issues-using-jql-get
 	Post(ctx context.Context, jql string, fields, expands []string, startAt, maxResults int, validate string) (*model.IssueSearchSchemeV2, *model.ResponseScheme, error)
+
+	// Iterate returns an iterator over the issues of a JQL query, the pages of pageSize issues are searched lazily,
+	// the next page is only searched when the issues of the current page are consumed.
+	//
+	// POST /rest/api/2/search
+	//
+	// TODO: the documentation needs to be created
+	Iterate(ctx context.Context, jql string, fields []string, pageSize int) SearchRichTextIterator
 }
 
 type SearchADFConnector interface {
@@ -38,6 +95,9 @@ type SearchADFConnector interface {
 
 	// Get search issues using JQL query under the HTTP Method GET
 	//
+	// The permission warnings of the result are only filled with the model.SearchValidateWarn validate mode, the
+	// restricted clauses are reported instead of failing the search and the missing fields are guessed from the issues.
+	//
 	// GET /rest/api/3/search
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#search-for-issues-using-jql-get
@@ -45,8 +105,49 @@ type SearchADFConnector interface {
 
 	// Post search issues using JQL query under the HTTP Method POST
 	//
+	// The permission warnings of the result are only filled with the model.SearchValidateWarn validate mode, the
+	// restricted clauses are reported instead of failing the search and the missing fields are guessed from the issues.
+	//
 	// POST /rest/api/3/search
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#search-for-issues-using-jql-get
 	Post(ctx context.Context, jql string, fields, expands []string, startAt, maxResults int, validate string) (*model.IssueSearchScheme, *model.ResponseScheme, error)
+
+	// Iterate returns an iterator over the issues of a JQL query, the pages of pageSize issues are searched lazily,
+	// the next page is only searched when the issues of the current page are consumed.
+	//
+	// POST /rest/api/3/search
+	//
+	// TODO: the documentation needs to be created
+	Iterate(ctx context.Context, jql string, fields []string, pageSize int) SearchADFIterator
+}
+
+// SearchIterator walks the issues of a JQL query, a page at a time.
+type SearchIterator interface {
+
+	// Next advances the iterator to the next issue, it returns false when the issues are exhausted,
+	// the context is cancelled or a page can't be searched. Err reports the cause.
+	Next() bool
+
+	// Response returns the response of the last page searched, including the page that failed.
+	Response() *model.ResponseScheme
+
+	// Err returns the error that stopped the iteration, nil when the issues were exhausted.
+	Err() error
+}
+
+// SearchRichTextIterator walks the issues of a JQL query with the rich text fields.
+type SearchRichTextIterator interface {
+	SearchIterator
+
+	// Issue returns the current issue, it's only valid after a call to Next returning true.
+	Issue() *model.IssueSchemeV2
+}
+
+// SearchADFIterator walks the issues of a JQL query with the ADF fields.
+type SearchADFIterator interface {
+	SearchIterator
+
+	// Issue returns the current issue, it's only valid after a call to Next returning true.
+	Issue() *model.IssueScheme
 }
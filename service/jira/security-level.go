@@ -0,0 +1,32 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type IssueSecurityLevelConnector interface {
+
+	// Get returns details of an issue security level.
+	//
+	// GET /rest/api/{2-3}/securitylevel/{id}
+	//
+	// TODO: the documentation needs to be created
+	Get(ctx context.Context, levelID string) (*model.IssueSecurityLevelScheme, *model.ResponseScheme, error)
+
+	// Gets returns a paginated list of issue security levels.
+	//
+	// Only issue security levels in the context of classic projects are returned.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/level
+	//
+	// TODO: the documentation needs to be created
+	Gets(ctx context.Context, options *model.IssueSecurityLevelSearchOptionsScheme, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error)
+
+	// Members returns a paginated list of issue security level members.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/level/member
+	//
+	// TODO: the documentation needs to be created
+	Members(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptionsScheme, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error)
+}
@@ -0,0 +1,25 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type SettingsConnector interface {
+
+	// Columns returns the default issue navigator columns.
+	//
+	// GET /rest/api/{2-3}/settings/columns
+	//
+	// TODO: the documentation needs to be created
+	Columns(ctx context.Context) ([]*model.ColumnItemScheme, *model.ResponseScheme, error)
+
+	// SetColumns sets the default issue navigator columns.
+	//
+	// The columns are sent as form data, each column is a navigable field id, e.g. "summary".
+	//
+	// PUT /rest/api/{2-3}/settings/columns
+	//
+	// TODO: the documentation needs to be created
+	SetColumns(ctx context.Context, columns []string) (*model.ResponseScheme, error)
+}
@@ -39,11 +39,12 @@ type TypeConnector interface {
 	//
 	// If the issue type is in use, all uses are updated with the alternative issue type (alternativeIssueTypeId).
 	// A list of alternative issue types are obtained from the Get alternative issue types resource.
+	// The issue types in use can't be deleted without an alternative issue type, Jira returns a 409 response.
 	//
 	// DELETE /rest/api/{2-3}/issuetype/{id}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/type#delete-issue-type
-	Delete(ctx context.Context, issueTypeId string) (*model.ResponseScheme, error)
+	Delete(ctx context.Context, issueTypeId, alternativeIssueTypeId string) (*model.ResponseScheme, error)
 
 	// Alternatives returns a list of issue types that can be used to replace the issue type.
 	//
@@ -149,6 +150,8 @@ type TypeScreenSchemeConnector interface {
 
 	// Create creates an issue type screen scheme.
 	//
+	// The mappings must contain the default mapping, its issue type id is "default".
+	//
 	// POST /rest/api/{2-3}/issuetypescreenscheme
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/types/screen-scheme#create-issue-type-screen-scheme
@@ -196,6 +199,8 @@ type TypeScreenSchemeConnector interface {
 
 	// Append appends issue type to screen scheme mappings to an issue type screen scheme.
 	//
+	// The default mapping can't be appended, use UpdateDefault to update it.
+	//
 	// PUT /rest/api/{2-3}/issuetypescreenscheme/{issueTypeScreenSchemeId}/mapping
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/types/screen-scheme#append-mappings-to-issue-type-screen-scheme
@@ -0,0 +1,42 @@
+package jira
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type UIModificationConnector interface {
+
+	// Gets returns a paginated list of the UI modifications created by the calling Forge or Connect app.
+	//
+	// Use the data and contexts expand values to return the data and contexts of the UI modifications.
+	//
+	// GET /rest/api/{2-3}/uiModifications
+	//
+	// TODO: the documentation needs to be created
+	Gets(ctx context.Context, expand []string, startAt, maxResults int) (*model.UIModificationPageScheme, *model.ResponseScheme, error)
+
+	// Create creates a UI modification, the UI modifications can only be created by Forge or Connect apps.
+	//
+	// An app can create up to 1000 UI modifications, a *model.UIModificationLimitError
+	// matching ErrUIModificationLimitError is returned when the limit is reached.
+	//
+	// POST /rest/api/{2-3}/uiModifications
+	//
+	// TODO: the documentation needs to be created
+	Create(ctx context.Context, payload *model.UIModificationPayloadScheme) (*model.UIModificationIdentifiersScheme, *model.ResponseScheme, error)
+
+	// Update updates a UI modification, the contexts sent replace the contexts of the UI modification.
+	//
+	// PUT /rest/api/{2-3}/uiModifications/{uiModificationId}
+	//
+	// TODO: the documentation needs to be created
+	Update(ctx context.Context, uiModificationID string, payload *model.UIModificationPayloadScheme) (*model.ResponseScheme, error)
+
+	// Delete deletes a UI modification.
+	//
+	// DELETE /rest/api/{2-3}/uiModifications/{uiModificationId}
+	//
+	// TODO: the documentation needs to be created
+	Delete(ctx context.Context, uiModificationID string) (*model.ResponseScheme, error)
+}
@@ -35,4 +35,15 @@ type VoteConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/vote#delete-vote
 	Delete(ctx context.Context, issueKeyOrId string) (*model.ResponseScheme, error)
+
+	// Migrate reports the votes of the issues matching the JQL scope to move from an account to another account.
+	//
+	// The votes can only be added and removed by the voting user, the report lists the actions left by issue,
+	// e.g. the target account must vote and the source account must remove its vote.
+	//
+	// The issues are checked concurrently, a positive concurrency pins the requests in flight,
+	// otherwise it starts at 5 and adapts to the rate limit of the site.
+	//
+	// TODO: the documentation needs to be created
+	Migrate(ctx context.Context, fromAccountID, toAccountID, jqlScope string, concurrency int) (*model.IssueUserMigrationReportScheme, *model.ResponseScheme, error)
 }
@@ -23,7 +23,7 @@ type WatcherConnector interface {
 	// POST /rest/api/{2-3}/issue/{issueIdOrKey}/watchers
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#add-watcher
-	Add(ctx context.Context, issueKeyOrId string) (*model.ResponseScheme, error)
+	Add(ctx context.Context, issueKeyOrId, accountId string) (*model.ResponseScheme, error)
 
 	// Delete deletes a user as a watcher of an issue.
 	//
@@ -31,4 +31,23 @@ type WatcherConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#delete-watcher
 	Delete(ctx context.Context, issueKeyOrId, accountId string) (*model.ResponseScheme, error)
+
+	// AddAccount adds a user, identified by account id, as a watcher of an issue.
+	//
+	// POST /rest/api/{2-3}/issue/{issueIdOrKey}/watchers
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#add-watcher
+	//
+	// Deprecated: use Add, it sends the account id too.
+	AddAccount(ctx context.Context, issueKeyOrId, accountId string) (*model.ResponseScheme, error)
+
+	// Migrate moves the watches of the issues matching the JQL scope from an account to another account.
+	//
+	// The target account is added as a watcher before the source account is removed, the issues are migrated concurrently.
+	// A positive concurrency pins the requests in flight, otherwise it starts at 5 and adapts to the rate limit of the site.
+	//
+	// The failures are reported by issue, the report processed keys can be excluded from the scope to resume the migration.
+	//
+	// TODO: the documentation needs to be created
+	Migrate(ctx context.Context, fromAccountID, toAccountID, jqlScope string, concurrency int) (*model.IssueUserMigrationReportScheme, *model.ResponseScheme, error)
 }
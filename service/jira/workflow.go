@@ -42,6 +42,20 @@ type WorkflowConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/workflow#search-workflows
 	Delete(ctx context.Context, workflowId string) (*model.ResponseScheme, error)
+
+	// ProjectUsages returns the projects using a given workflow.
+	//
+	// GET /rest/api/{2-3}/workflow/{workflowId}/projectUsages
+	//
+	// TODO: the documentation needs to be created
+	ProjectUsages(ctx context.Context, workflowID, nextPageToken string, maxResults int) (*model.WorkflowProjectUsageScheme, *model.ResponseScheme, error)
+
+	// IssueTypeUsages returns the issue types in a project that are using a given workflow.
+	//
+	// GET /rest/api/{2-3}/workflow/{workflowId}/project/{projectId}/issueTypeUsages
+	//
+	// TODO: the documentation needs to be created
+	IssueTypeUsages(ctx context.Context, workflowID, projectID, nextPageToken string, maxResults int) (*model.WorkflowIssueTypeUsageScheme, *model.ResponseScheme, error)
 }
 
 type WorkflowSchemeConnector interface {
@@ -149,6 +163,8 @@ type WorkflowStatusConnector interface {
 
 	// Search returns a paginated list of statuses that match a search on name or project.
 	//
+	// The options AcceptLanguage pins the locale of the status names returned.
+	//
 	// GET /rest/api/{2-3}/statuses/search
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#search-workflow-statuses
@@ -176,4 +192,23 @@ type WorkflowStatusConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#get-workflow-status
 	Get(ctx context.Context, idOrName string) (*model.StatusDetailScheme, *model.ResponseScheme, error)
+
+	// Usages returns the statuses specified by one or more status IDs, including the projects and issue types using them.
+	//
+	// The usages are always expanded, use the expand to add the workflow usages.
+	//
+	// GET /rest/api/{2-3}/statuses
+	//
+	// TODO: the documentation needs to be created
+	Usages(ctx context.Context, ids, expand []string) ([]*model.WorkflowStatusDetailScheme, *model.ResponseScheme, error)
+
+	// ResolveByName returns the status matching the name, whatever the locale of the user.
+	//
+	// The statuses associated with active workflows are matched, in order, on the exact untranslated name,
+	// on the exact localized name, then on both names ignoring the case.
+	//
+	// GET /rest/api/{2-3}/status
+	//
+	// TODO: the documentation needs to be created
+	ResolveByName(ctx context.Context, name string) (*model.StatusDetailScheme, *model.ResponseScheme, error)
 }
@@ -52,10 +52,12 @@ type WorklogSharedConnector interface {
 	//
 	// This resource does not return worklogs deleted during the minute preceding the request.
 	//
+	// The since date is a UNIX timestamp in milliseconds, the next page is requested with the until of the previous page.
+	//
 	// GET /rest/api/{2-3}/worklog/deleted
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs#get-ids-of-deleted-worklogs
-	Deleted(ctx context.Context, since int) (result *model.ChangedWorklogPageScheme, response *model.ResponseScheme, err error)
+	Deleted(ctx context.Context, since int64) (result *model.ChangedWorklogPageScheme, response *model.ResponseScheme, err error)
 
 	// Updated returns a list of IDs and update timestamps for worklogs updated after a date and time.
 	//
@@ -66,10 +68,12 @@ type WorklogSharedConnector interface {
 	//
 	// This resource does not return worklogs updated during the minute preceding the request.
 	//
+	// The since date is a UNIX timestamp in milliseconds, the next page is requested with the until of the previous page.
+	//
 	// GET /rest/api/{2-3}/worklog/updated
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs#get-ids-of-updated-worklogs
-	Updated(ctx context.Context, since int, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error)
+	Updated(ctx context.Context, since int64, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error)
 }
 
 type WorklogRichTextConnector interface {
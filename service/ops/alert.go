@@ -0,0 +1,46 @@
+package ops
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type AlertConnector interface {
+
+	// Create creates an alert.
+	//
+	// The alerts are created asynchronously, use the request id of the returned request to poll its status.
+	//
+	// POST /v1/alerts
+	Create(ctx context.Context, payload *model.AlertPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error)
+
+	// Get returns an alert.
+	//
+	// GET /v1/alerts/{id}
+	Get(ctx context.Context, alertID string) (*model.AlertScheme, *model.ResponseScheme, error)
+
+	// List returns the alerts matching the search query, the alerts are paginated using the offset and the size.
+	//
+	// GET /v1/alerts
+	List(ctx context.Context, options *model.AlertSearchOptionsScheme, offset, size int) (*model.AlertPageScheme, *model.ResponseScheme, error)
+
+	// Close closes an alert, the alert is closed asynchronously.
+	//
+	// POST /v1/alerts/{id}/close
+	Close(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error)
+
+	// Acknowledge acknowledges an alert, the alert is acknowledged asynchronously.
+	//
+	// POST /v1/alerts/{id}/acknowledge
+	Acknowledge(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error)
+
+	// AddNote adds a note to an alert, the note is added asynchronously.
+	//
+	// POST /v1/alerts/{id}/notes
+	AddNote(ctx context.Context, alertID string, payload *model.AlertActionPayloadScheme) (*model.AlertRequestScheme, *model.ResponseScheme, error)
+
+	// RequestStatus returns the status of an alert request, the alert id is set once the request is processed.
+	//
+	// GET /v1/alerts/requests/{requestId}
+	RequestStatus(ctx context.Context, requestID string) (*model.AlertRequestStatusScheme, *model.ResponseScheme, error)
+}
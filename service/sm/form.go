@@ -0,0 +1,44 @@
+package sm
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type FormConnector interface {
+
+	// GetsOnRequest returns the forms attached to a customer request.
+	//
+	// GET /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form
+	//
+	// TODO: the documentation needs to be created
+	GetsOnRequest(ctx context.Context, issueKeyOrID string) ([]*model.FormIndexScheme, *model.ResponseScheme, error)
+
+	// Get returns a form attached to a customer request, use the FormScheme.Answers method to read the answers.
+	//
+	// GET /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form/{formId}
+	//
+	// TODO: the documentation needs to be created
+	Get(ctx context.Context, issueKeyOrID, formID string) (*model.FormScheme, *model.ResponseScheme, error)
+
+	// Attach adds a form to a customer request, using a form template of the project.
+	//
+	// POST /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form
+	//
+	// TODO: the documentation needs to be created
+	Attach(ctx context.Context, issueKeyOrID, formTemplateID string) (*model.FormScheme, *model.ResponseScheme, error)
+
+	// Submit submits a form attached to a customer request.
+	//
+	// PUT /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form/{formId}/action/submit
+	//
+	// TODO: the documentation needs to be created
+	Submit(ctx context.Context, issueKeyOrID, formID string) (*model.FormStatusScheme, *model.ResponseScheme, error)
+
+	// Reopen reopens a submitted form, so the answers can be changed.
+	//
+	// PUT /jira/forms/cloud/{cloudId}/issue/{issueIdOrKey}/form/{formId}/action/reopen
+	//
+	// TODO: the documentation needs to be created
+	Reopen(ctx context.Context, issueKeyOrID, formID string) (*model.FormStatusScheme, *model.ResponseScheme, error)
+}
@@ -0,0 +1,37 @@
+package sm
+
+import (
+	"context"
+	model "github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+type PortalConnector interface {
+
+	// HelpCenterAnnouncement returns the announcement of the help center.
+	//
+	// GET /rest/servicedeskapi/announcement
+	//
+	// TODO: the documentation needs to be created
+	HelpCenterAnnouncement(ctx context.Context) (*model.PortalAnnouncementScheme, *model.ResponseScheme, error)
+
+	// UpdateHelpCenterAnnouncement sets the announcement of the help center, an empty header and message remove it.
+	//
+	// PUT /rest/servicedeskapi/announcement
+	//
+	// TODO: the documentation needs to be created
+	UpdateHelpCenterAnnouncement(ctx context.Context, payload *model.PortalAnnouncementScheme) (*model.ResponseScheme, error)
+
+	// Announcement returns the announcement of the customer portal of a service desk.
+	//
+	// GET /rest/servicedeskapi/servicedesk/{serviceDeskId}/announcement
+	//
+	// TODO: the documentation needs to be created
+	Announcement(ctx context.Context, serviceDeskID int) (*model.PortalAnnouncementScheme, *model.ResponseScheme, error)
+
+	// UpdateAnnouncement sets the announcement of the customer portal of a service desk, an empty header and message remove it.
+	//
+	// PUT /rest/servicedeskapi/servicedesk/{serviceDeskId}/announcement
+	//
+	// TODO: the documentation needs to be created
+	UpdateAnnouncement(ctx context.Context, serviceDeskID int, payload *model.PortalAnnouncementScheme) (*model.ResponseScheme, error)
+}
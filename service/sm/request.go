@@ -16,6 +16,16 @@ type RequestConnector interface {
 	// https://docs.go-atlassian.io/jira-service-management/request#create-customer-request
 	Create(ctx context.Context, payload *model.CreateCustomerRequestPayloadScheme, fields *model.CustomerRequestFields) (*model.CustomerRequestScheme, *model.ResponseScheme, error)
 
+	// CreateByName creates a customer request, resolving the service desk and the request type by their names.
+	//
+	// The service desk and request type ids of the payload are replaced by the resolved ids.
+	//
+	// POST /rest/servicedeskapi/request
+	//
+	// TODO: the documentation needs to be created
+	CreateByName(ctx context.Context, serviceDeskNameOrProjectKey, requestTypeName string, payload *model.CreateCustomerRequestPayloadScheme,
+		fields *model.CustomerRequestFields) (*model.CustomerRequestScheme, *model.ResponseScheme, error)
+
 	// Gets returns all customer requests for the user executing the query.
 	//
 	// The returned customer requests are ordered chronologically by the latest activity on each request. For example, the latest status transition or comment.
@@ -25,6 +35,16 @@ type RequestConnector interface {
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request#get-customer-requests
 	Gets(ctx context.Context, options *model.ServiceRequestOptionScheme, start, limit int) (*model.CustomerRequestPageScheme, *model.ResponseScheme, error)
 
+	// GetsAll returns all customer requests for the user executing the query, a page at a time.
+	//
+	// The pages are followed by their next link until the last page, every page is passed to the visit func.
+	// The visit error stops the pagination and it's returned as is.
+	//
+	// GET /rest/servicedeskapi/request
+	//
+	// TODO: the documentation needs to be created
+	GetsAll(ctx context.Context, options *model.ServiceRequestOptionScheme, visit func(page *model.CustomerRequestPageScheme) error) (*model.ResponseScheme, error)
+
 	// Get returns a customer request.
 	//
 	// GET /rest/servicedeskapi/request/{issueIdOrKey}
@@ -49,4 +49,13 @@ type TypeConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request/types#get-request-type-fields
 	Fields(ctx context.Context, serviceDeskID, requestTypeID int) (*model.RequestTypeFieldsScheme, *model.ResponseScheme, error)
+
+	// Resolve returns the service desk and the request type matching their names, the names are case-insensitive.
+	//
+	// The service desk is matched by project key first, then by project name.
+	//
+	// A *model.RequestTypeResolutionError listing the candidates is returned when a name is missing or ambiguous.
+	//
+	// TODO: the documentation needs to be created
+	Resolve(ctx context.Context, serviceDeskNameOrProjectKey, requestTypeName string) (*model.RequestTypeResolutionScheme, *model.ResponseScheme, error)
 }